@@ -186,7 +186,7 @@ func TestAdvancedConfigurationCombinations(t *testing.T) {
 				assert.Len(t, cfg.AdditionalServices, 3)
 
 				// Check other options
-				assert.Equal(t, 20*time.Minute, cfg.Timeout)
+				assert.Equal(t, 20*time.Minute, cfg.Timeouts.Readiness)
 				assert.Equal(t, 8, cfg.Parallelism)
 				assert.Equal(t, "test-complex-network", cfg.EnclaveName)
 			},
@@ -302,3 +302,57 @@ func TestNetworkParamsValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestWithParticipantsMerging(t *testing.T) {
+	participants := []config.ParticipantConfig{
+		{ELType: client.Geth, CLType: client.Lighthouse, Count: 2},
+	}
+
+	cfg := defaultRunConfig()
+	WithConfig(&config.EthereumPackageConfig{
+		Participants:       []config.ParticipantConfig{{ELType: client.Besu, CLType: client.Teku}},
+		NetworkParams:      &config.NetworkParams{NetworkID: "424242"},
+		AdditionalServices: []config.AdditionalService{{Name: "dora"}},
+	})(cfg)
+	WithParticipants(participants)(cfg)
+	WithMEVBoost()(cfg)
+	WithExplorer()(cfg)
+	WithAdditionalServices("prometheus")(cfg)
+
+	// Mutating the caller's slice must not affect the run
+	participants[0].ELType = client.Erigon
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	require.Len(t, ethConfig.Participants, 1)
+	assert.Equal(t, client.Geth, ethConfig.Participants[0].ELType)
+	assert.Equal(t, "424242", ethConfig.NetworkParams.NetworkID)
+	require.NotNil(t, ethConfig.MEV)
+	assert.Equal(t, "full", ethConfig.MEV.Type)
+
+	var services []string
+	for _, svc := range ethConfig.AdditionalServices {
+		services = append(services, svc.Name)
+	}
+	assert.Equal(t, []string{"dora", "prometheus"}, services)
+}
+
+func TestWithParticipantsDuplicateCombos(t *testing.T) {
+	cfg := defaultRunConfig()
+	WithParticipants([]config.ParticipantConfig{
+		{ELType: client.Geth, CLType: client.Lighthouse},
+		{ELType: client.Besu, CLType: client.Teku},
+		{ELType: client.Geth, CLType: client.Lighthouse},
+	})(cfg)
+	_, err := buildEthereumConfig(cfg)
+	assert.ErrorContains(t, err, "participant 2: duplicates participant 0")
+
+	// Different versions of the same clients are distinct participants
+	cfg = defaultRunConfig()
+	WithParticipants([]config.ParticipantConfig{
+		{ELType: client.Geth, CLType: client.Lighthouse},
+		{ELType: client.Geth, CLType: client.Lighthouse, ELVersion: "v1.14.0"},
+	})(cfg)
+	_, err = buildEthereumConfig(cfg)
+	assert.NoError(t, err)
+}
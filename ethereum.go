@@ -2,13 +2,20 @@ package ethereum
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/ethpandaops/ethereum-package-go/pkg/artifacts"
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 	"github.com/ethpandaops/ethereum-package-go/pkg/config"
 	"github.com/ethpandaops/ethereum-package-go/pkg/discovery"
 	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
 	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 )
 
 const (
@@ -16,8 +23,17 @@ const (
 	DefaultPackageRepository = "github.com/ethpandaops/ethereum-package"
 	// DefaultPackageVersion is the pinned version of ethereum-package
 	DefaultPackageVersion = "5.0.1"
+
+	// diagnoseTimeout bounds the search of a failed deployment for resource exhaustion
+	diagnoseTimeout = 30 * time.Second
+	// diagnoseLogLines is how many recent log lines of each service are searched
+	diagnoseLogLines = 200
 )
 
+// ErrConfigHashMismatch is returned when an existing enclave was deployed from a
+// different config or package version than the one being run
+var ErrConfigHashMismatch = errors.New("config hash mismatch")
+
 // RunOption configures how the Ethereum network is started
 type RunOption func(*RunConfig)
 
@@ -45,25 +61,105 @@ type RunConfig struct {
 
 	// Global settings
 	GlobalLogLevel string
+	// MetricsExporter deploys an ethereum-metrics-exporter next to every node
+	MetricsExporter bool
+
+	// ProgressHandler receives structured deployment progress
+	ProgressHandler func(ProgressEvent)
+
+	// CheckpointSync has late-joining nodes sync from CheckpointSyncURL
+	CheckpointSync    bool
+	CheckpointSyncURL string
+
+	// FastDevnet enables the stricter fast devnet consistency checks
+	FastDevnet bool
+
+	// AutoFixValidatorCount raises per-node validator counts when the configuration
+	// has too few validators for its spec preset instead of failing
+	AutoFixValidatorCount bool
 
 	// Runtime options
-	DryRun         bool
-	Parallelism    int
-	VerboseMode    bool
+	DryRun      bool
+	Parallelism int
+	VerboseMode bool
+	Timeouts    Timeouts
+	// Timeout is how long to wait for services to become ready; zero keeps
+	// Timeouts.Readiness.
+	//
+	// Deprecated: use Timeouts.Readiness.
 	Timeout        time.Duration
 	WaitForGenesis bool
+	FanoutLimit    int   // max concurrent calls for network-wide operations
+	RandomSeed     int64 // seeds all randomized behavior; 0 picks a seed from the clock
+
+	// ClientMaxCPU (millicores) and ClientMaxMem (MB) cap the execution and
+	// consensus clients that set no limit of their own; zero leaves them uncapped
+	ClientMaxCPU int
+	ClientMaxMem int
+
+	// VerifyMonitoring makes Run check that Prometheus scrapes every client
+	VerifyMonitoring bool
+
+	// ArtifactsDir receives rendered Grafana panels when the network is cleaned
+	// up; GrafanaPanels selects them, every dashboard when empty
+	ArtifactsDir  string
+	GrafanaPanels []network.GrafanaPanel
+	// ArtifactsRetention prunes ArtifactsDir before each run and after the
+	// network's artifacts are written; the zero policy keeps everything
+	ArtifactsRetention artifacts.RetentionPolicy
+
+	// DockerHostOverride is the host published ports are reached on, for remote
+	// Docker daemons; empty uses the addresses Kurtosis reports
+	DockerHostOverride string
+
+	// TLS settings for clients fronted by https/wss endpoints. TLSConfig wins over
+	// the CA bundle and verification toggle, which Run turns into a config.
+	TLSConfig             *tls.Config
+	TLSCABundle           string
+	TLSInsecureSkipVerify bool
+
+	// Credentials for clients behind an authenticating proxy. ClientCredentials
+	// are keyed by service name and take precedence over Credentials.
+	Credentials       *client.Credentials
+	ClientCredentials map[string]*client.Credentials
+
+	MaxRestarts int // restarts tolerated per service before Run and Health fail; negative disables
+
+	// LogHealthPatterns make Health report clients whose logs match them as degraded; nil disables
+	LogHealthPatterns []network.LogPattern
+	// Policy denies or allows classes of network operations; operations without a rule are allowed
+	Policy []network.Rule
+
+	// IgnoreWarnings drops package validation warnings about IgnoredWarningPaths,
+	// or all of them if it is empty, instead of printing and keeping them
+	IgnoreWarnings      bool
+	IgnoredWarningPaths []string
 
 	// Lifecycle management
 	OrphanOnExit  bool // Don't cleanup enclave when process exits
 	ReuseExisting bool // Try to reuse existing enclave
 
+	// Engine the Kurtosis client connects to when none is injected: an SDK
+	// context, else the engine at KurtosisEngineHost, else the local engine
+	KurtosisContext    *kurtosis_context.KurtosisContext
+	KurtosisEngineHost string
+	KurtosisEnginePort uint16
+
 	// Dependencies (can be injected for testing)
 	KurtosisClient kurtosis.Client
+
+	// generatedEnclaveName is the default enclave name, replaced by one derived
+	// from the random seed unless an option chose another name
+	generatedEnclaveName string
+	// chainIDSet and globalLogLevelSet record that an option chose the value,
+	// which then overrides the one in an inline config or file
+	chainIDSet        bool
+	globalLogLevelSet bool
 }
 
 // defaultRunConfig returns a RunConfig with sensible defaults
 func defaultRunConfig() *RunConfig {
-	return &RunConfig{
+	cfg := &RunConfig{
 		PackageID:      DefaultPackageRepository,
 		PackageVersion: DefaultPackageVersion,
 		EnclaveName:    generateEnclaveName(),
@@ -72,11 +168,14 @@ func defaultRunConfig() *RunConfig {
 		DryRun:         false,
 		Parallelism:    4,
 		VerboseMode:    false,
-		Timeout:        10 * time.Minute,
+		Timeouts:       DefaultTimeouts(),
+		MaxRestarts:    -1,
 		GlobalLogLevel: "info",
 		OrphanOnExit:   false, // Auto-cleanup by default (testcontainers style)
 		ReuseExisting:  false,
 	}
+	cfg.generatedEnclaveName = cfg.EnclaveName
+	return cfg
 }
 
 // generateEnclaveName creates a unique enclave name to avoid conflicts
@@ -85,44 +184,73 @@ func generateEnclaveName() string {
 	return fmt.Sprintf("ethereum-package-%d", time.Now().UnixNano())
 }
 
+// seededEnclaveName derives the enclave name of a run from its random seed
+func seededEnclaveName(seed int64) string {
+	return fmt.Sprintf("ethereum-package-%x", uint64(network.DeriveSeed(seed, "enclave")))
+}
+
 // Run starts an Ethereum network and returns a Network interface
 func Run(ctx context.Context, opts ...RunOption) (network.Network, error) {
+	runStarted := time.Now()
+
 	// Apply configuration
 	cfg := defaultRunConfig()
 	for _, opt := range opts {
 		opt(cfg)
 	}
+	if cfg.Timeout > 0 {
+		cfg.Timeouts.Readiness = cfg.Timeout
+	}
 
 	// Validate configuration
 	if err := validateRunConfig(cfg); err != nil {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	if err := resolveRunConfig(cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	if cfg.EnclaveName == cfg.generatedEnclaveName {
+		cfg.EnclaveName = seededEnclaveName(cfg.RandomSeed)
+	}
+
 	fmt.Printf("[ethereum-package-go] Starting network deployment...\n")
 	fmt.Printf("[ethereum-package-go] Package: %s\n", cfg.PackageID)
 	if cfg.PackageVersion != "" {
 		fmt.Printf("[ethereum-package-go] Version: %s\n", cfg.PackageVersion)
 	}
 	fmt.Printf("[ethereum-package-go] Enclave: %s\n", cfg.EnclaveName)
+	fmt.Printf("[ethereum-package-go] Random seed: %d (reproduce with WithRandomSeed)\n", cfg.RandomSeed)
 
 	// Initialize Kurtosis client if not provided
 	if cfg.KurtosisClient == nil {
 		fmt.Printf("[ethereum-package-go] Initializing Kurtosis client...\n")
-		client, err := kurtosis.NewKurtosisClient(ctx)
+		client, err := newKurtosisClient(ctx, cfg)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create Kurtosis client: %w", err)
+			return nil, err
 		}
 		cfg.KurtosisClient = client
 		fmt.Printf("[ethereum-package-go] Kurtosis client initialized\n")
 	}
+	// The RPC timeout bounds readiness probes of built and injected clients
+	// alike; other Client implementations run their own probes
+	if kurtosisClient, ok := cfg.KurtosisClient.(*kurtosis.KurtosisClient); ok {
+		kurtosisClient.WithProbeTimeout(cfg.Timeouts.RPC)
+	}
 
 	// Build ethereum-package configuration
 	fmt.Printf("[ethereum-package-go] Building ethereum-package configuration...\n")
+	reportProgress(cfg, PhaseConfigure, "Building ethereum-package configuration")
 	ethConfig, err := buildEthereumConfig(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build configuration: %w", err)
 	}
 
+	// Surface suspicious participant settings that still deploy
+	for _, warning := range ethConfig.CheckParticipants().Warnings() {
+		fmt.Printf("[ethereum-package-go] WARNING: %s\n", warning)
+	}
+
 	// Log configuration details
 	if ethConfig.Participants != nil {
 		fmt.Printf("[ethereum-package-go] Participants: %d\n", len(ethConfig.Participants))
@@ -144,9 +272,34 @@ func Run(ctx context.Context, opts ...RunOption) (network.Network, error) {
 	}
 
 	// Create Kurtosis run configuration
-	packageID := cfg.PackageID
-	if cfg.PackageVersion != "" {
-		packageID = fmt.Sprintf("%s@%s", cfg.PackageID, cfg.PackageVersion)
+	packageID := packageRef(cfg)
+
+	// Refuse to deploy over an enclave that was created from a different config
+	configHash, err := config.Hash(ethConfig, packageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash configuration: %w", err)
+	}
+	fmt.Printf("[ethereum-package-go] Config hash: %s\n", configHash)
+	if err := checkConfigHash(ctx, cfg, configHash); err != nil {
+		return nil, err
+	}
+
+	// Fail before deploying when the port publisher's public ports are taken
+	if !cfg.DryRun {
+		if err := checkPortConflicts(ctx, cfg, ethConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	// Make room for this run's artifacts before deploying
+	if cfg.ArtifactsDir != "" && cfg.ArtifactsRetention.Enabled() {
+		pruned, err := artifacts.Prune(cfg.ArtifactsDir, cfg.ArtifactsRetention)
+		if err != nil {
+			fmt.Printf("[ethereum-package-go] WARNING: Failed to prune artifacts: %v\n", err)
+		}
+		if pruned != nil && len(pruned.Removed) > 0 {
+			fmt.Printf("[ethereum-package-go] Pruned %d artifacts (%d bytes)\n", len(pruned.Removed), pruned.Freed)
+		}
 	}
 
 	runConfig := kurtosis.RunPackageConfig{
@@ -158,75 +311,159 @@ func Run(ctx context.Context, opts ...RunOption) (network.Network, error) {
 		VerboseMode:     cfg.VerboseMode,
 		ImageDownload:   true,
 		NonBlockingMode: false,
+		OnProgress:      packageProgressHandler(cfg),
 	}
 
 	// Run the package
 	fmt.Printf("[ethereum-package-go] Starting ethereum-package deployment...\n")
 	fmt.Printf("[ethereum-package-go] This may take several minutes...\n")
-	result, err := cfg.KurtosisClient.RunPackage(ctx, runConfig)
+	reportProgress(cfg, PhaseDeploy, "Starting ethereum-package deployment")
+	deployCtx, cancelDeploy := context.WithTimeout(ctx, cfg.Timeouts.Deploy)
+	result, err := cfg.KurtosisClient.RunPackage(deployCtx, runConfig)
+	cancelDeploy()
 	if err != nil {
 		return nil, fmt.Errorf("failed to run ethereum-package: %w", err)
 	}
+	enclaveCreated := time.Now()
 	fmt.Printf("[ethereum-package-go] Package deployment completed\n")
+	reportProgress(cfg, PhaseDeploy, "Package deployment completed")
 
 	// Check for Kurtosis execution errors even if err is nil
 	fmt.Printf("[ethereum-package-go] Checking deployment result...\n")
 	if result.ExecutionError != nil {
 		fmt.Printf("[ethereum-package-go] ERROR: Execution failed: %v\n", result.ExecutionError)
-		return nil, fmt.Errorf("ethereum-package execution error: %w", result.ExecutionError)
+		return nil, diagnoseResources(ctx, cfg, fmt.Errorf("ethereum-package execution error: %w", result.ExecutionError))
 	}
 	if result.InterpretationError != nil {
 		fmt.Printf("[ethereum-package-go] ERROR: Interpretation failed: %v\n", result.InterpretationError)
 		return nil, fmt.Errorf("ethereum-package interpretation error: %w", result.InterpretationError)
 	}
-	if len(result.ValidationErrors) > 0 {
-		fmt.Printf("[ethereum-package-go] ERROR: Validation failed: %v\n", result.ValidationErrors)
-		return nil, fmt.Errorf("ethereum-package validation errors: %v", result.ValidationErrors)
+	validation := result.Validation
+	if cfg.IgnoreWarnings {
+		validation = validation.Without(cfg.IgnoredWarningPaths...)
+	}
+	if err := validation.Err(); err != nil {
+		fmt.Printf("[ethereum-package-go] ERROR: Validation failed: %v\n", err)
+		return nil, err
+	}
+	for _, warning := range validation.Warnings() {
+		fmt.Printf("[ethereum-package-go] WARNING: %s\n", warning)
 	}
 	fmt.Printf("[ethereum-package-go] Deployment validation passed\n")
+	if cfg.DryRun && result.Plan != nil {
+		fmt.Printf("[ethereum-package-go] Dry run plans %d services using %d images\n", len(result.Plan.Services), len(result.Plan.Images()))
+	}
+
+	// Record the config hash so later runs against this enclave can detect drift
+	if !cfg.DryRun {
+		if err := cfg.KurtosisClient.SetEnclaveLabel(ctx, cfg.EnclaveName, kurtosis.LabelConfigHash, configHash); err != nil {
+			fmt.Printf("[ethereum-package-go] WARNING: Failed to label enclave with config hash: %v\n", err)
+		}
+	}
 
 	// Wait for services to be ready
+	var servicesReady time.Time
 	if !cfg.DryRun {
-		fmt.Printf("[ethereum-package-go] Waiting for services to be ready (timeout: %v)...\n", cfg.Timeout)
-		err = cfg.KurtosisClient.WaitForServices(ctx, cfg.EnclaveName, []string{}, cfg.Timeout)
+		fmt.Printf("[ethereum-package-go] Waiting for services to be ready (timeout: %v)...\n", cfg.Timeouts.Readiness)
+		reportProgress(cfg, PhaseReadiness, "Waiting for services to be ready")
+		err = cfg.KurtosisClient.WaitForServices(ctx, cfg.EnclaveName, []string{}, cfg.Timeouts.Readiness)
 		if err != nil {
 			fmt.Printf("[ethereum-package-go] ERROR: Services failed to start: %v\n", err)
+			// Diagnose before cleanup removes the containers and their logs
+			err = diagnoseResources(ctx, cfg, fmt.Errorf("services failed to start: %w", err))
 			fmt.Printf("[ethereum-package-go] Cleaning up failed deployment...\n")
 			// Cleanup on failure
-			_ = cfg.KurtosisClient.DestroyEnclave(ctx, cfg.EnclaveName)
-			return nil, fmt.Errorf("services failed to start: %w", err)
+			destroyEnclave(ctx, cfg)
+			return nil, err
 		}
+		servicesReady = time.Now()
 		fmt.Printf("[ethereum-package-go] All services are ready\n")
+		reportProgress(cfg, PhaseReadiness, "All services are ready")
 	}
 
 	// Discover and map services
 	fmt.Printf("[ethereum-package-go] Discovering and mapping services...\n")
-	mapper := discovery.NewServiceMapper(cfg.KurtosisClient)
+	reportProgress(cfg, PhaseDiscovery, "Discovering and mapping services")
+	mapper := newServiceMapper(cfg).WithConfigHash(configHash).WithPlan(result.Plan).WithValidation(validation.Warnings())
 	network, err := mapper.MapToNetwork(ctx, cfg.EnclaveName, ethConfig, cfg.OrphanOnExit)
 	if err != nil {
 		fmt.Printf("[ethereum-package-go] ERROR: Failed to discover services: %v\n", err)
 		fmt.Printf("[ethereum-package-go] Cleaning up failed deployment...\n")
 		// Cleanup on failure
-		_ = cfg.KurtosisClient.DestroyEnclave(ctx, cfg.EnclaveName)
+		destroyEnclave(ctx, cfg)
 		return nil, fmt.Errorf("failed to discover services: %w", err)
 	}
 	fmt.Printf("[ethereum-package-go] Service discovery completed\n")
+	recordDeployMilestones(network.Milestones(), runStarted, enclaveCreated, servicesReady)
 	fmt.Printf("[ethereum-package-go] Found %d execution clients\n", len(network.ExecutionClients().All()))
 	fmt.Printf("[ethereum-package-go] Found %d consensus clients\n", len(network.ConsensusClients().All()))
 	fmt.Printf("[ethereum-package-go] Found %d total services\n", len(network.Services()))
+	reportProgress(cfg, PhaseDiscovery, fmt.Sprintf("Found %d execution clients, %d consensus clients and %d services",
+		len(network.ExecutionClients().All()), len(network.ConsensusClients().All()), len(network.Services())))
+
+	// Deploy the sidecars configured for each node
+	if !cfg.DryRun {
+		if err := deploySidecars(ctx, network, ethConfig); err != nil {
+			fmt.Printf("[ethereum-package-go] ERROR: %v\n", err)
+			fmt.Printf("[ethereum-package-go] Cleaning up failed deployment...\n")
+			destroyEnclave(ctx, cfg)
+			return nil, err
+		}
+	}
+
+	// Hold back late-joining nodes until StartLateJoiners is called
+	if lateJoiners := network.LateJoiners(); len(lateJoiners) > 0 && !cfg.DryRun {
+		fmt.Printf("[ethereum-package-go] Stopping %d late-joiner services...\n", len(lateJoiners))
+		// Stop in reverse start order so validators go down before their beacon node
+		for i := len(lateJoiners) - 1; i >= 0; i-- {
+			if err := cfg.KurtosisClient.StopService(ctx, cfg.EnclaveName, lateJoiners[i]); err != nil {
+				fmt.Printf("[ethereum-package-go] ERROR: Failed to stop late joiner %s: %v\n", lateJoiners[i], err)
+				fmt.Printf("[ethereum-package-go] Cleaning up failed deployment...\n")
+				destroyEnclave(ctx, cfg)
+				return nil, fmt.Errorf("failed to stop late joiner %s: %w", lateJoiners[i], err)
+			}
+		}
+	}
+
+	// Fail on services that are already crash looping
+	if cfg.MaxRestarts >= 0 && !cfg.DryRun {
+		if err := checkCrashLoops(ctx, network, cfg.MaxRestarts); err != nil {
+			fmt.Printf("[ethereum-package-go] ERROR: %v\n", err)
+			fmt.Printf("[ethereum-package-go] Cleaning up failed deployment...\n")
+			destroyEnclave(ctx, cfg)
+			return nil, err
+		}
+	}
 
 	// Wait for genesis if requested
 	if cfg.WaitForGenesis && !cfg.DryRun {
 		fmt.Printf("[ethereum-package-go] Waiting for genesis block...\n")
-		if err := WaitForGenesis(ctx, network); err != nil {
+		reportProgress(cfg, PhaseGenesis, "Waiting for genesis block")
+		report, err := WaitForGenesisWithReport(ctx, network, WithGenesisProgressTimeout(cfg.Timeouts.Genesis))
+		if err != nil {
 			fmt.Printf("[ethereum-package-go] WARNING: Failed to wait for genesis: %v\n", err)
+			if report != nil {
+				fmt.Printf("[ethereum-package-go] Client progress: %s", report)
+			}
 			// Don't cleanup on genesis wait failure - network is already running
 			return network, fmt.Errorf("failed to wait for genesis: %w", err)
 		}
-		fmt.Printf("[ethereum-package-go] Genesis block detected\n")
+		fmt.Printf("[ethereum-package-go] Genesis passed, all clients are producing\n")
+		reportProgress(cfg, PhaseGenesis, "Genesis passed, all clients are producing")
+	}
+
+	// Check the observability wiring once Prometheus had time to scrape
+	if cfg.VerifyMonitoring && !cfg.DryRun {
+		fmt.Printf("[ethereum-package-go] Verifying Prometheus targets...\n")
+		if err := verifyMonitoring(ctx, network, cfg.Timeouts.Readiness); err != nil {
+			fmt.Printf("[ethereum-package-go] WARNING: %v\n", err)
+			// Don't cleanup on monitoring failures - the network itself is running
+			return network, fmt.Errorf("failed to verify monitoring: %w", err)
+		}
 	}
 
 	fmt.Printf("[ethereum-package-go] Network deployment completed successfully!\n")
+	reportProgress(cfg, PhaseComplete, "Network deployment completed")
 	fmt.Printf("[ethereum-package-go] Network name: %s\n", network.Name())
 	fmt.Printf("[ethereum-package-go] Enclave: %s\n", network.EnclaveName())
 	fmt.Printf("[ethereum-package-go] Chain ID: %d\n", network.ChainID())
@@ -255,12 +492,15 @@ func FindOrCreateNetwork(ctx context.Context, enclaveName string, opts ...RunOpt
 	for _, opt := range allOpts {
 		opt(cfg)
 	}
+	if err := resolveRunConfig(cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
 
 	// Initialize Kurtosis client if not provided
 	if cfg.KurtosisClient == nil {
-		client, err := kurtosis.NewKurtosisClient(ctx)
+		client, err := newKurtosisClient(ctx, cfg)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create Kurtosis client: %w", err)
+			return nil, err
 		}
 		cfg.KurtosisClient = client
 	}
@@ -274,7 +514,15 @@ func FindOrCreateNetwork(ctx context.Context, enclaveName string, opts ...RunOpt
 			return nil, fmt.Errorf("failed to build configuration: %w", err)
 		}
 
-		mapper := discovery.NewServiceMapper(cfg.KurtosisClient)
+		configHash, err := config.Hash(ethConfig, packageRef(cfg))
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash configuration: %w", err)
+		}
+		if err := checkConfigHash(ctx, cfg, configHash); err != nil {
+			return nil, err
+		}
+
+		mapper := newServiceMapper(cfg).WithConfigHash(configHash)
 		network, err := mapper.MapToNetwork(ctx, enclaveName, ethConfig, cfg.OrphanOnExit)
 		if err != nil {
 			return nil, fmt.Errorf("failed to map existing network: %w", err)
@@ -287,6 +535,267 @@ func FindOrCreateNetwork(ctx context.Context, enclaveName string, opts ...RunOpt
 	return Run(ctx, allOpts...)
 }
 
+// AttachReadOnly maps a running enclave to a network that cannot change it,
+// safe to hand to teams sharing a long-lived devnet. Cleanup leaves the
+// enclave running, and operations that add, start, stop or exec into
+// services, inject faults or spend the faucet fail with network.ErrReadOnly.
+// The options must describe the configuration the network was deployed with.
+func AttachReadOnly(ctx context.Context, enclaveName string, opts ...RunOption) (network.Network, error) {
+	cfg := defaultRunConfig()
+	for _, opt := range append([]RunOption{WithEnclaveName(enclaveName)}, opts...) {
+		opt(cfg)
+	}
+	if err := resolveRunConfig(cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	if cfg.KurtosisClient == nil {
+		client, err := newKurtosisClient(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		cfg.KurtosisClient = client
+	}
+
+	// Only an absent enclave is reported as not found, engine and context
+	// failures keep their cause
+	services, err := cfg.KurtosisClient.GetServices(ctx, enclaveName)
+	switch {
+	case errors.Is(err, kurtosis.ErrEnclaveNotFound):
+		return nil, err
+	case err != nil:
+		return nil, fmt.Errorf("failed to get services of enclave %s: %w", enclaveName, err)
+	case len(services) == 0:
+		return nil, fmt.Errorf("%w: %s has no services", kurtosis.ErrEnclaveNotFound, enclaveName)
+	}
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build configuration: %w", err)
+	}
+	configHash, err := config.Hash(ethConfig, packageRef(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash configuration: %w", err)
+	}
+	if err := checkConfigHash(ctx, cfg, configHash); err != nil {
+		return nil, err
+	}
+
+	net, err := newServiceMapper(cfg).WithConfigHash(configHash).WithReadOnly().MapToNetwork(ctx, enclaveName, ethConfig, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map network: %w", err)
+	}
+	return net, nil
+}
+
+// checkCrashLoops fails with network.ErrCrashLoop if any service restarted more
+// than maxRestarts times or exited. Inspection failures only warn, as container
+// inspection needs access to the Docker daemon.
+func checkCrashLoops(ctx context.Context, net network.Network, maxRestarts int) error {
+	loops, err := net.CrashLoops(ctx)
+	if err != nil {
+		fmt.Printf("[ethereum-package-go] WARNING: Failed to inspect service containers: %v\n", err)
+		return nil
+	}
+	if len(loops) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(loops))
+	for i, loop := range loops {
+		descriptions[i] = loop.String()
+	}
+	return fmt.Errorf("%w: more than %d restarts or exited: %s",
+		network.ErrCrashLoop, maxRestarts, strings.Join(descriptions, "; "))
+}
+
+// verifyMonitoring retries network.VerifyMonitoring until it passes or the
+// timeout expires, as Prometheus may still be starting and targets report no
+// health before their first scrape
+func verifyMonitoring(ctx context.Context, net network.Network, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		err := net.VerifyMonitoring(ctx)
+		if err == nil || errors.Is(err, network.ErrNoPrometheus) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-ticker.C:
+		}
+	}
+}
+
+// deploySidecars adds the configured sidecars of every node that the enclave
+// does not run yet, e.g. because it is reused
+func deploySidecars(ctx context.Context, net network.Network, ethConfig *config.EthereumPackageConfig) error {
+	nodeSidecars := ethConfig.NodeSidecars()
+	nodes := make([]int, 0, len(nodeSidecars))
+	for node := range nodeSidecars {
+		nodes = append(nodes, node)
+	}
+	sort.Ints(nodes)
+
+	for _, node := range nodes {
+		participant, ok := net.Participant(node)
+		if !ok {
+			return fmt.Errorf("failed to deploy sidecars: node %d not found", node)
+		}
+		for _, sidecar := range nodeSidecars[node] {
+			if _, exists := participant.Sidecar(sidecar.Name); exists {
+				continue
+			}
+			fmt.Printf("[ethereum-package-go] Deploying sidecar %s for node %d...\n", sidecar.Name, node)
+			if _, err := net.AddSidecar(ctx, node, sidecar); err != nil {
+				return fmt.Errorf("failed to deploy sidecar %s for node %d: %w", sidecar.Name, node, err)
+			}
+		}
+	}
+	return nil
+}
+
+// recordDeployMilestones records the milestones Run observes before the network exists
+func recordDeployMilestones(milestones *network.Milestones, runStarted, enclaveCreated, servicesReady time.Time) {
+	milestones.Record(network.MilestoneRunStarted, runStarted)
+	milestones.Record(network.MilestoneEnclaveCreated, enclaveCreated)
+	if !servicesReady.IsZero() {
+		milestones.Record(network.MilestoneServicesReady, servicesReady)
+	}
+}
+
+// packageRef returns the package ID pinned to the configured version, if any
+func packageRef(cfg *RunConfig) string {
+	if cfg.PackageVersion != "" {
+		return fmt.Sprintf("%s@%s", cfg.PackageID, cfg.PackageVersion)
+	}
+	return cfg.PackageID
+}
+
+// checkConfigHash fails with ErrConfigHashMismatch if the enclave already exists and
+// was deployed from a different config. Enclaves that don't exist yet or predate
+// config hashing pass.
+func checkConfigHash(ctx context.Context, cfg *RunConfig, hash string) error {
+	labels, err := cfg.KurtosisClient.EnclaveLabels(ctx, cfg.EnclaveName)
+	if err != nil {
+		return nil
+	}
+	if existing, ok := labels[kurtosis.LabelConfigHash]; ok && existing != hash {
+		return fmt.Errorf("%w: enclave %s was deployed with config hash %s, current config hashes to %s",
+			ErrConfigHashMismatch, cfg.EnclaveName, existing, hash)
+	}
+	return nil
+}
+
+// newKurtosisClient connects to the engine the run config selects: an SDK
+// context, a remote engine or the local one
+func newKurtosisClient(ctx context.Context, cfg *RunConfig) (*kurtosis.KurtosisClient, error) {
+	var client *kurtosis.KurtosisClient
+	var err error
+	switch {
+	case cfg.KurtosisContext != nil:
+		client = kurtosis.NewKurtosisClientFromContext(cfg.KurtosisContext)
+	case cfg.KurtosisEngineHost != "":
+		client, err = kurtosis.NewRemoteKurtosisClient(ctx, cfg.KurtosisEngineHost, cfg.KurtosisEnginePort)
+	default:
+		client, err = kurtosis.NewKurtosisClient(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kurtosis client: %w", err)
+	}
+	if cfg.DockerHostOverride != "" {
+		client = client.WithHostOverride(cfg.DockerHostOverride)
+	}
+	return client, nil
+}
+
+// newServiceMapper creates a service mapper configured from the run options
+func newServiceMapper(cfg *RunConfig) *discovery.ServiceMapper {
+	return discovery.NewServiceMapper(cfg.KurtosisClient).
+		WithFanoutLimit(cfg.FanoutLimit).
+		WithSeed(cfg.RandomSeed).
+		WithHostOverride(cfg.DockerHostOverride).
+		WithTLSConfig(cfg.TLSConfig).
+		WithCredentials(cfg.Credentials).
+		WithClientCredentials(cfg.ClientCredentials).
+		WithArtifacts(cfg.ArtifactsDir, cfg.GrafanaPanels).
+		WithArtifactsRetention(cfg.ArtifactsRetention).
+		WithMaxRestarts(cfg.MaxRestarts).
+		WithLogHealthPatterns(cfg.LogHealthPatterns).
+		WithPolicy(cfg.Policy...).
+		WithRPCTimeout(cfg.Timeouts.RPC).
+		WithCleanupTimeout(cfg.Timeouts.Cleanup)
+}
+
+// resolveRunConfig derives the settings the service mapper needs from the
+// options: the TLS config and, unless WithRandomSeed chose one, a seed
+func resolveRunConfig(cfg *RunConfig) error {
+	if err := resolveTLSConfig(cfg); err != nil {
+		return err
+	}
+	if cfg.RandomSeed == 0 {
+		cfg.RandomSeed = time.Now().UnixNano()
+	}
+	return nil
+}
+
+// resolveTLSConfig builds the TLS config from the CA bundle and verification
+// toggle unless one was given directly
+func resolveTLSConfig(cfg *RunConfig) error {
+	if cfg.TLSConfig != nil || (cfg.TLSCABundle == "" && !cfg.TLSInsecureSkipVerify) {
+		return nil
+	}
+	tlsConfig, err := client.NewTLSConfig(cfg.TLSCABundle, cfg.TLSInsecureSkipVerify)
+	if err != nil {
+		return err
+	}
+	cfg.TLSConfig = tlsConfig
+	return nil
+}
+
+// destroyEnclave tears down a failed deployment, bounded by the cleanup timeout
+func destroyEnclave(ctx context.Context, cfg *RunConfig) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeouts.Cleanup)
+	defer cancel()
+	_ = cfg.KurtosisClient.DestroyEnclave(ctx, cfg.EnclaveName)
+}
+
+// diagnoseResources wraps a deployment failure in a *network.ResourceError when
+// the failure, the containers or the recent logs of the services show Docker
+// running out of memory, disk space or ports, and prints how to remedy it.
+// Otherwise it returns the failure unchanged.
+func diagnoseResources(ctx context.Context, cfg *RunConfig, failure error) error {
+	ctx, cancel := context.WithTimeout(ctx, diagnoseTimeout)
+	defer cancel()
+
+	// Inspection failures leave only the failure's own message to search
+	states, _ := cfg.KurtosisClient.ContainerStates(ctx, cfg.EnclaveName)
+	logs := make(map[string][]string, len(states))
+	for service := range states {
+		if lines, err := cfg.KurtosisClient.ServiceLogs(ctx, cfg.EnclaveName, service, diagnoseLogLines); err == nil {
+			logs[service] = lines
+		}
+	}
+
+	findings := network.DiagnoseResources(failure, states, logs)
+	if len(findings) == 0 {
+		return failure
+	}
+	resourceErr := &network.ResourceError{Findings: findings, Err: failure}
+	for _, finding := range findings {
+		fmt.Printf("[ethereum-package-go] ERROR: Docker ran out of resources: %s\n", finding)
+	}
+	for _, hint := range resourceErr.Hints() {
+		fmt.Printf("[ethereum-package-go] HINT: %s\n", hint)
+	}
+	return resourceErr
+}
+
 // validateRunConfig validates the run configuration
 func validateRunConfig(cfg *RunConfig) error {
 	if cfg.PackageID == "" {
@@ -301,8 +810,8 @@ func validateRunConfig(cfg *RunConfig) error {
 	if err := cfg.ConfigSource.Validate(); err != nil {
 		return fmt.Errorf("invalid config source: %w", err)
 	}
-	if cfg.Timeout <= 0 {
-		return fmt.Errorf("timeout must be positive")
+	if err := cfg.Timeouts.Validate(); err != nil {
+		return fmt.Errorf("invalid timeouts: %w", err)
 	}
 	return nil
 }
@@ -320,6 +829,9 @@ func buildEthereumConfig(cfg *RunConfig) (*config.EthereumPackageConfig, error)
 	case "inline":
 		inline := cfg.ConfigSource.(*config.InlineConfigSource)
 		baseConfig = inline.GetConfig()
+	case "file":
+		file := cfg.ConfigSource.(*config.FileConfigSource)
+		baseConfig, err = file.Load()
 	default:
 		return nil, fmt.Errorf("unsupported config source type: %s", cfg.ConfigSource.Type())
 	}
@@ -328,14 +840,43 @@ func buildEthereumConfig(cfg *RunConfig) (*config.EthereumPackageConfig, error)
 		return nil, err
 	}
 
+	participants := baseConfig.Participants
+	if cfg.ClientMaxCPU > 0 || cfg.ClientMaxMem > 0 {
+		participants = capClientResources(participants, cfg.ClientMaxCPU, cfg.ClientMaxMem)
+	}
+
 	// Apply overrides using ConfigBuilder
-	builder := config.NewConfigBuilder().WithParticipants(baseConfig.Participants)
+	builder := config.NewConfigBuilder().WithParticipants(participants)
+
+	// Settings from an inline config or file are used unless an option overrides them
+	fromConfig := cfg.ConfigSource.Type() == "inline" || cfg.ConfigSource.Type() == "file"
+	if fromConfig {
+		mergeInlineConfig(builder, baseConfig, cfg)
+	}
 
-	// Apply network parameters
+	// Apply network parameters. Options set single fields, so they are merged
+	// onto the network_params of the inline config or file rather than
+	// replacing them. The default chain ID only fills in a missing network ID
+	// of a devnet, WithChainID always applies.
+	var params *config.NetworkParams
+	if fromConfig && baseConfig.NetworkParams != nil {
+		params = &config.NetworkParams{}
+		params.Merge(baseConfig.NetworkParams)
+	}
 	if cfg.NetworkParams != nil {
-		builder.WithNetworkParams(cfg.NetworkParams)
-	} else if cfg.ChainID != 0 {
-		builder.WithNetworkID(fmt.Sprintf("%d", cfg.ChainID))
+		if params == nil {
+			params = &config.NetworkParams{}
+		}
+		params.Merge(cfg.NetworkParams)
+	}
+	if cfg.ChainID != 0 && (cfg.chainIDSet || params == nil || (params.NetworkID == "" && !params.IsPublic())) {
+		if params == nil {
+			params = &config.NetworkParams{}
+		}
+		params.NetworkID = fmt.Sprintf("%d", cfg.ChainID)
+	}
+	if params != nil {
+		builder.WithNetworkParams(params)
 	}
 
 	// Apply MEV configuration
@@ -353,15 +894,115 @@ func buildEthereumConfig(cfg *RunConfig) (*config.EthereumPackageConfig, error)
 		builder.WithDockerCacheParams(cfg.DockerCacheParams)
 	}
 
-	// Apply additional services
+	// Apply additional services, skipping any already set by the inline config or file
 	for _, service := range cfg.AdditionalServices {
+		if fromConfig && hasAdditionalService(baseConfig, service.Name) {
+			continue
+		}
 		builder.WithAdditionalService(service)
 	}
 
-	// Apply global log level
-	if cfg.GlobalLogLevel != "" {
+	// Apply global log level, unless only the default would replace the config's
+	if cfg.GlobalLogLevel != "" && (!fromConfig || baseConfig.GlobalLogLevel == "" || cfg.globalLogLevelSet) {
 		builder.WithGlobalLogLevel(cfg.GlobalLogLevel)
 	}
 
-	return builder.Build()
+	if cfg.MetricsExporter {
+		builder.WithMetricsExporter(true)
+	}
+
+	if cfg.CheckpointSync {
+		builder.WithCheckpointSyncURL(cfg.CheckpointSyncURL).WithLateJoinerCheckpointSync()
+	}
+
+	ethConfig, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.AutoFixValidatorCount && config.FixValidatorCount(ethConfig) {
+		fmt.Printf("[ethereum-package-go] Raised validator counts to %d total to fill committees\n",
+			config.TotalValidatorCount(ethConfig))
+	}
+	if err := config.ValidateValidatorCount(ethConfig); err != nil {
+		return nil, err
+	}
+
+	if cfg.FastDevnet {
+		if err := config.ValidateFastDevnet(ethConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return ethConfig, nil
+}
+
+// mergeInlineConfig seeds the builder with the non-participant settings of an inline
+// or file config
+func mergeInlineConfig(builder *config.ConfigBuilder, base *config.EthereumPackageConfig, cfg *RunConfig) {
+	if base.MEV != nil && cfg.MEV == nil {
+		builder.WithMEV(base.MEV)
+	}
+	if base.PortPublisher != nil && cfg.PortPublisher == nil {
+		portPublisher := *base.PortPublisher
+		builder.WithPortPublisher(&portPublisher)
+	}
+	if base.DockerCacheParams != nil && cfg.DockerCacheParams == nil {
+		builder.WithDockerCacheParams(base.DockerCacheParams)
+	}
+	for _, service := range base.AdditionalServices {
+		builder.WithAdditionalService(service)
+	}
+	if base.EthereumMetricsExporterEnabled {
+		builder.WithMetricsExporter(true)
+	}
+	if base.CheckpointSyncEnabled {
+		builder.WithCheckpointSync(true)
+	}
+	if base.CheckpointSyncURL != "" {
+		builder.WithCheckpointSyncURL(base.CheckpointSyncURL)
+	}
+	if base.FeeRecipient != "" {
+		builder.WithFeeRecipient(base.FeeRecipient)
+	}
+	if base.GlobalLogLevel != "" {
+		builder.WithGlobalLogLevel(base.GlobalLogLevel)
+	}
+	builder.WithGlobalTolerations(base.GlobalTolerations...)
+	for key, value := range base.GlobalNodeSelectors {
+		builder.WithGlobalNodeSelector(key, value)
+	}
+}
+
+// capClientResources returns a copy of the participants with the CPU and
+// memory limits of their execution and consensus clients set to maxCPU and
+// maxMem, unless they set a limit or request more themselves
+func capClientResources(participants []config.ParticipantConfig, maxCPU, maxMem int) []config.ParticipantConfig {
+	capped := make([]config.ParticipantConfig, len(participants))
+	copy(capped, participants)
+	for i := range capped {
+		p := &capped[i]
+		capResource(&p.ELMaxCPU, p.ELMinCPU, maxCPU)
+		capResource(&p.ELMaxMem, p.ELMinMem, maxMem)
+		capResource(&p.CLMaxCPU, p.CLMinCPU, maxCPU)
+		capResource(&p.CLMaxMem, p.CLMinMem, maxMem)
+	}
+	return capped
+}
+
+// capResource sets an unset limit to max when max covers the request
+func capResource(limit *int, request, max int) {
+	if *limit == 0 && max > 0 && request <= max {
+		*limit = max
+	}
+}
+
+// hasAdditionalService reports whether the config already includes the named service
+func hasAdditionalService(cfg *config.EthereumPackageConfig, name string) bool {
+	for _, service := range cfg.AdditionalServices {
+		if service.Name == name {
+			return true
+		}
+	}
+	return false
 }
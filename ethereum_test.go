@@ -2,11 +2,15 @@ package ethereum
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
 	"github.com/ethpandaops/ethereum-package-go/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -23,7 +27,7 @@ func TestDefaultRunConfig(t *testing.T) {
 	assert.False(t, cfg.DryRun)
 	assert.Equal(t, 4, cfg.Parallelism)
 	assert.False(t, cfg.VerboseMode)
-	assert.Equal(t, 10*time.Minute, cfg.Timeout)
+	assert.Equal(t, DefaultTimeouts(), cfg.Timeouts)
 	assert.Equal(t, "info", cfg.GlobalLogLevel)
 }
 
@@ -39,7 +43,7 @@ func TestValidateRunConfig(t *testing.T) {
 				PackageID:    "github.com/ethpandaops/ethereum-package",
 				EnclaveName:  "test-enclave",
 				ConfigSource: config.NewPresetConfigSource(config.PresetMinimal),
-				Timeout:      time.Minute,
+				Timeouts:     DefaultTimeouts(),
 			},
 		},
 		{
@@ -47,7 +51,7 @@ func TestValidateRunConfig(t *testing.T) {
 			cfg: &RunConfig{
 				EnclaveName:  "test-enclave",
 				ConfigSource: config.NewPresetConfigSource(config.PresetMinimal),
-				Timeout:      time.Minute,
+				Timeouts:     DefaultTimeouts(),
 			},
 			wantErr: "package ID is required",
 		},
@@ -56,7 +60,7 @@ func TestValidateRunConfig(t *testing.T) {
 			cfg: &RunConfig{
 				PackageID:    "github.com/ethpandaops/ethereum-package",
 				ConfigSource: config.NewPresetConfigSource(config.PresetMinimal),
-				Timeout:      time.Minute,
+				Timeouts:     DefaultTimeouts(),
 			},
 			wantErr: "enclave name is required",
 		},
@@ -65,7 +69,7 @@ func TestValidateRunConfig(t *testing.T) {
 			cfg: &RunConfig{
 				PackageID:   "github.com/ethpandaops/ethereum-package",
 				EnclaveName: "test-enclave",
-				Timeout:     time.Minute,
+				Timeouts:    DefaultTimeouts(),
 			},
 			wantErr: "config source is required",
 		},
@@ -75,7 +79,7 @@ func TestValidateRunConfig(t *testing.T) {
 				PackageID:    "github.com/ethpandaops/ethereum-package",
 				EnclaveName:  "test-enclave",
 				ConfigSource: config.NewPresetConfigSource(config.PresetMinimal),
-				Timeout:      0,
+				Timeouts:     Timeouts{},
 			},
 			wantErr: "timeout must be positive",
 		},
@@ -132,6 +136,21 @@ func TestBuildEthereumConfig(t *testing.T) {
 				assert.Equal(t, "full", config.MEV.Type)
 			},
 		},
+		{
+			name: "inline kubernetes scheduling",
+			cfg: &RunConfig{
+				ConfigSource: config.NewInlineConfigSource(&config.EthereumPackageConfig{
+					Participants:        []config.ParticipantConfig{{ELType: client.Geth, CLType: client.Lighthouse}},
+					GlobalTolerations:   []config.Toleration{{Key: "dedicated", Value: "ethereum", Effect: "NoSchedule"}},
+					GlobalNodeSelectors: map[string]string{"pool": "ethereum"},
+				}),
+			},
+			validate: func(t *testing.T, config *config.EthereumPackageConfig) {
+				require.Len(t, config.GlobalTolerations, 1)
+				assert.Equal(t, "dedicated", config.GlobalTolerations[0].Key)
+				assert.Equal(t, map[string]string{"pool": "ethereum"}, config.GlobalNodeSelectors)
+			},
+		},
 		{
 			name: "with additional services",
 			cfg: &RunConfig{
@@ -161,6 +180,104 @@ func TestBuildEthereumConfig(t *testing.T) {
 	}
 }
 
+func TestBuildEthereumConfigFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "network.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`participants:
+  - el_type: nethermind
+    cl_type: prysm
+    count: 2
+additional_services:
+  - dora
+global_log_level: warn
+`), 0o644))
+
+	cfg := defaultRunConfig()
+	WithConfigFile(path)(cfg)
+	WithChainID(4242)(cfg)
+	WithAdditionalServices("dora", "prometheus")(cfg)
+	WithMEV(&config.MEVConfig{Type: "mock"})(cfg)
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	require.Len(t, ethConfig.Participants, 1)
+	assert.Equal(t, client.Nethermind, ethConfig.Participants[0].ELType)
+	assert.Equal(t, 2, ethConfig.Participants[0].Count)
+	require.NotNil(t, ethConfig.NetworkParams)
+	assert.Equal(t, "4242", ethConfig.NetworkParams.NetworkID)
+	require.NotNil(t, ethConfig.MEV)
+	assert.Equal(t, "mock", ethConfig.MEV.Type)
+	require.Len(t, ethConfig.AdditionalServices, 2)
+	assert.Equal(t, "dora", ethConfig.AdditionalServices[0].Name)
+	assert.Equal(t, "prometheus", ethConfig.AdditionalServices[1].Name)
+	assert.Equal(t, "warn", ethConfig.GlobalLogLevel)
+
+	require.NoError(t, os.WriteFile(path, []byte("participants:\n  - el_type: geth\n    cl_type: unknown\n"), 0o644))
+	_, err = buildEthereumConfig(cfg)
+	assert.ErrorContains(t, err, "invalid config file "+path)
+
+	WithConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))(cfg)
+	_, err = buildEthereumConfig(cfg)
+	assert.ErrorContains(t, err, "failed to read config file")
+}
+
+func TestBuildEthereumConfigFromFileOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "network.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`participants:
+  - el_type: geth
+    cl_type: lighthouse
+network_params:
+  network_id: "3151908"
+  seconds_per_slot: 6
+global_log_level: debug
+`), 0o644))
+
+	// The defaults keep the file's values
+	cfg := defaultRunConfig()
+	WithConfigFile(path)(cfg)
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ethConfig.NetworkParams)
+	assert.Equal(t, "3151908", ethConfig.NetworkParams.NetworkID)
+	assert.Equal(t, 6, ethConfig.NetworkParams.SecondsPerSlot)
+	assert.Equal(t, "debug", ethConfig.GlobalLogLevel)
+
+	// Options override them field by field
+	cfg = defaultRunConfig()
+	WithConfigFile(path)(cfg)
+	WithChainID(4242)(cfg)
+	WithGlobalLogLevel("error")(cfg)
+	ethConfig, err = buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ethConfig.NetworkParams)
+	assert.Equal(t, "4242", ethConfig.NetworkParams.NetworkID)
+	assert.Equal(t, 6, ethConfig.NetworkParams.SecondsPerSlot)
+	assert.Equal(t, "error", ethConfig.GlobalLogLevel)
+}
+
+func TestBuildEthereumConfigFromFileMergesNetworkParams(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "network.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`participants:
+  - el_type: geth
+    cl_type: lighthouse
+network_params:
+  network_id: "3151908"
+  seconds_per_slot: 6
+  genesis_delay: 120
+  electra_fork_epoch: 1
+`), 0o644))
+
+	cfg := defaultRunConfig()
+	WithConfigFile(path)(cfg)
+	WithGenesisDelay(30)(cfg)
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ethConfig.NetworkParams)
+	assert.Equal(t, 30, ethConfig.NetworkParams.GenesisDelay)
+	assert.Equal(t, "3151908", ethConfig.NetworkParams.NetworkID)
+	assert.Equal(t, 6, ethConfig.NetworkParams.SecondsPerSlot)
+	assert.Equal(t, 1, ethConfig.NetworkParams.ElectraForkEpoch)
+}
+
 func TestRunWithMockClient(t *testing.T) {
 	ctx := context.Background()
 	mockClient := mocks.NewMockKurtosisClient()
@@ -183,6 +300,31 @@ func TestRunWithMockClient(t *testing.T) {
 	assert.True(t, mockClient.LastRunConfig.DryRun)
 }
 
+func TestRunWithRandomSeed(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+
+	network, err := Run(ctx,
+		WithRandomSeed(1234),
+		WithKurtosisClient(mockClient),
+		WithDryRun(true),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234), network.Seed())
+	assert.Equal(t, seededEnclaveName(1234), mockClient.LastRunConfig.EnclaveName)
+	assert.NotEqual(t, seededEnclaveName(1235), seededEnclaveName(1234))
+
+	// An explicit enclave name wins over the seeded one, whatever the option order
+	_, err = Run(ctx,
+		WithEnclaveName("named"),
+		WithRandomSeed(1234),
+		WithKurtosisClient(mockClient),
+		WithDryRun(true),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "named", mockClient.LastRunConfig.EnclaveName)
+}
+
 func TestRunConfigOptions(t *testing.T) {
 	cfg := defaultRunConfig()
 
@@ -216,7 +358,7 @@ func TestRunConfigOptions(t *testing.T) {
 	assert.True(t, cfg.VerboseMode)
 
 	WithTimeout(5 * time.Minute)(cfg)
-	assert.Equal(t, 5*time.Minute, cfg.Timeout)
+	assert.Equal(t, 5*time.Minute, cfg.Timeouts.Readiness)
 }
 
 func TestConvenienceFunctions(t *testing.T) {
@@ -318,3 +460,143 @@ func TestPackageIDConstruction(t *testing.T) {
 		})
 	}
 }
+
+func TestRunWithLateJoiners(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.RunPackageFunc = func(ctx context.Context, cfg kurtosis.RunPackageConfig) (*kurtosis.RunPackageResult, error) {
+		services := make(map[string]*kurtosis.ServiceInfo)
+		for _, name := range []string{
+			"el-1-geth-lighthouse", "cl-1-lighthouse-geth",
+			"el-2-besu-teku", "cl-2-teku-besu", "vc-2-besu-teku",
+		} {
+			services[name] = &kurtosis.ServiceInfo{Name: name, Status: "RUNNING"}
+		}
+		mockClient.Enclaves[cfg.EnclaveName] = &mocks.EnclaveState{Name: cfg.EnclaveName, Services: services, Running: true}
+		return &kurtosis.RunPackageResult{EnclaveName: cfg.EnclaveName}, nil
+	}
+
+	net, err := Run(ctx,
+		WithParticipants([]config.ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse},
+			{ELType: client.Besu, CLType: client.Teku, LateJoin: true},
+		}),
+		WithEnclaveName("test-late-join"),
+		WithKurtosisClient(mockClient),
+		WithOrphanOnExit(),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"el-2-besu-teku", "cl-2-teku-besu", "vc-2-besu-teku"}, net.LateJoiners())
+	assert.Len(t, net.ClientsByTag(network.TagLateJoiner).Execution, 1)
+
+	services := mockClient.Enclaves["test-late-join"].Services
+	assert.Equal(t, "STOPPED", services["el-2-besu-teku"].Status)
+	assert.Equal(t, "STOPPED", services["vc-2-besu-teku"].Status)
+	assert.Equal(t, "RUNNING", services["el-1-geth-lighthouse"].Status)
+
+	require.NoError(t, net.StartLateJoiners(ctx))
+	assert.Equal(t, "RUNNING", services["el-2-besu-teku"].Status)
+	assert.Equal(t, "RUNNING", services["cl-2-teku-besu"].Status)
+	assert.Equal(t, "RUNNING", services["vc-2-besu-teku"].Status)
+
+	// Starting again is a no-op
+	require.NoError(t, net.StartLateJoiners(ctx))
+	assert.Equal(t, 3, mockClient.CallCount["StartService"])
+}
+
+func TestRunWithSidecars(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.RunPackageFunc = func(ctx context.Context, cfg kurtosis.RunPackageConfig) (*kurtosis.RunPackageResult, error) {
+		services := map[string]*kurtosis.ServiceInfo{
+			"el-1-geth-lighthouse": {
+				Name: "el-1-geth-lighthouse", Hostname: "el-1-geth-lighthouse", Status: "RUNNING",
+				Ports: map[string]kurtosis.PortInfo{"rpc": {Number: 32771, PrivateNumber: 8545}},
+			},
+			"cl-1-lighthouse-geth": {
+				Name: "cl-1-lighthouse-geth", Hostname: "cl-1-lighthouse-geth", Status: "RUNNING",
+				Ports: map[string]kurtosis.PortInfo{"http": {Number: 32780, PrivateNumber: 4000}},
+			},
+			"snooper-engine-1-geth-lighthouse": {
+				Name: "snooper-engine-1-geth-lighthouse", Hostname: "snooper-engine-1-geth-lighthouse", Status: "RUNNING",
+				Ports: map[string]kurtosis.PortInfo{"http": {Number: 32790, PrivateNumber: 8561}},
+			},
+		}
+		mockClient.Enclaves[cfg.EnclaveName] = &mocks.EnclaveState{Name: cfg.EnclaveName, Services: services, Running: true}
+		return &kurtosis.RunPackageResult{EnclaveName: cfg.EnclaveName}, nil
+	}
+
+	var specs []network.ServiceSpec
+	mockClient.AddServiceFunc = func(ctx context.Context, enclaveName string, spec network.ServiceSpec) (*kurtosis.ServiceInfo, error) {
+		specs = append(specs, spec)
+		return &kurtosis.ServiceInfo{Name: spec.Name, Hostname: spec.Name, Status: "RUNNING"}, nil
+	}
+
+	net, err := Run(ctx,
+		WithParticipants([]config.ParticipantConfig{{
+			ELType:         client.Geth,
+			CLType:         client.Lighthouse,
+			SnooperEnabled: true,
+			Sidecars: []config.Sidecar{{
+				Name:  "tcpdump",
+				Image: "nicolaka/netshoot",
+				Env:   map[string]string{"BEACON": "{cl_beacon}"},
+				Cmd:   []string{"tcpdump", "-i", "any", "host", "{el_host}"},
+			}},
+		}}),
+		WithEnclaveName("test-sidecars"),
+		WithKurtosisClient(mockClient),
+		WithOrphanOnExit(),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, specs, 1)
+	assert.Equal(t, "sidecar-tcpdump-1-geth-lighthouse", specs[0].Name)
+	assert.Equal(t, map[string]string{"BEACON": "http://cl-1-lighthouse-geth:4000"}, specs[0].Env)
+	assert.Equal(t, []string{"tcpdump", "-i", "any", "host", "el-1-geth-lighthouse"}, specs[0].Cmd)
+
+	// Snoopers are sidecars, not execution clients
+	assert.Len(t, net.ExecutionClients().All(), 1)
+	node, ok := net.Participant(1)
+	require.True(t, ok)
+	require.Len(t, node.Sidecars, 2)
+	snooper, ok := node.Sidecar("snooper-engine")
+	require.True(t, ok)
+	snooperURL, err := snooper.InternalURL("http")
+	require.NoError(t, err)
+	assert.Equal(t, "http://snooper-engine-1-geth-lighthouse:8561", snooperURL)
+	_, ok = node.Sidecar("tcpdump")
+	assert.True(t, ok)
+}
+
+func TestRunWithProgressHandler(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+
+	var events []ProgressEvent
+	_, err := Run(context.Background(),
+		WithKurtosisClient(mockClient),
+		WithOrphanOnExit(),
+		WithProgressHandler(func(event ProgressEvent) {
+			events = append(events, event)
+		}),
+	)
+	require.NoError(t, err)
+
+	var phases []ProgressPhase
+	var deploySteps []int
+	for _, event := range events {
+		assert.False(t, event.Timestamp.IsZero())
+		if len(phases) == 0 || phases[len(phases)-1] != event.Phase {
+			phases = append(phases, event.Phase)
+		}
+		if event.Step > 0 {
+			assert.Equal(t, PhaseDeploy, event.Phase)
+			assert.Equal(t, 5, event.TotalSteps)
+			deploySteps = append(deploySteps, event.Step)
+		}
+	}
+	assert.Equal(t, []ProgressPhase{PhaseConfigure, PhaseDeploy, PhaseReadiness, PhaseDiscovery, PhaseComplete}, phases)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, deploySteps)
+	assert.Equal(t, "Network deployment completed", events[len(events)-1].Message)
+}
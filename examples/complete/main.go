@@ -21,7 +21,7 @@ func main() {
 		ethereum.Minimal(),
 		ethereum.WithChainID(12345),
 		ethereum.WithAdditionalServices("prometheus", "grafana"),
-		ethereum.WithTimeout(5*time.Minute),
+		ethereum.WithTimeouts(ethereum.Timeouts{Readiness: 5 * time.Minute}),
 	)
 	if err != nil {
 		log.Fatalf("Failed to start network: %v", err)
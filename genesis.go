@@ -2,69 +2,284 @@ package ethereum
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
-	eth2client "github.com/attestantio/go-eth2-client"
-	"github.com/attestantio/go-eth2-client/api"
 	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 	"github.com/ethpandaops/ethereum-package-go/pkg/network"
 )
 
-// WaitForGenesis waits until the genesis time of the network
-// It gets the first consensus client, retrieves genesis details via the attestant client,
-// and sleeps until the genesis timestamp
-func WaitForGenesis(ctx context.Context, net network.Network) error {
-	// Get all consensus clients
-	consensusClients := net.ConsensusClients().All()
-	if len(consensusClients) == 0 {
-		return fmt.Errorf("no consensus clients available")
+const (
+	// DefaultGenesisProgressTimeout is how long after genesis every client has to start producing
+	DefaultGenesisProgressTimeout = 2 * time.Minute
+	// DefaultGenesisPollInterval is how often client progress is checked after genesis
+	DefaultGenesisPollInterval = 2 * time.Second
+
+	// genesisRequestTimeout bounds beacon API requests of clients without their own RPC timeout
+	genesisRequestTimeout = 10 * time.Second
+)
+
+// GenesisWaitOption configures WaitForGenesis
+type GenesisWaitOption func(*genesisWaitConfig)
+
+type genesisWaitConfig struct {
+	progressTimeout time.Duration
+	pollInterval    time.Duration
+}
+
+// WithGenesisProgressTimeout sets how long after genesis every client has to
+// reach EL block 1 or CL slot 1
+func WithGenesisProgressTimeout(timeout time.Duration) GenesisWaitOption {
+	return func(cfg *genesisWaitConfig) {
+		cfg.progressTimeout = timeout
+	}
+}
+
+// WithGenesisPollInterval sets how often client progress is checked after genesis
+func WithGenesisPollInterval(interval time.Duration) GenesisWaitOption {
+	return func(cfg *genesisWaitConfig) {
+		cfg.pollInterval = interval
 	}
+}
+
+// NodeProgress records how far a single client got after genesis
+type NodeProgress struct {
+	// Name is the client's service name
+	Name string
+	// Head is the latest EL block number or CL head slot observed
+	Head uint64
+	// Err is the last error seen while querying the client, if any
+	Err error
+}
+
+// Producing reports whether the client moved past genesis
+func (p NodeProgress) Producing() bool {
+	return p.Head >= 1
+}
 
-	// Get the first consensus client
-	firstClient := consensusClients[0]
+// GenesisReport describes the state of every client after waiting for genesis
+type GenesisReport struct {
+	GenesisTime time.Time
+	Execution   []NodeProgress
+	Consensus   []NodeProgress
+}
+
+// Failed returns the clients that did not start producing
+func (r *GenesisReport) Failed() []NodeProgress {
+	var failed []NodeProgress
+	for _, p := range append(append([]NodeProgress{}, r.Execution...), r.Consensus...) {
+		if !p.Producing() {
+			failed = append(failed, p)
+		}
+	}
+	return failed
+}
+
+// String returns a per-client summary of the report
+func (r *GenesisReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "genesis at %s\n", r.GenesisTime.Format(time.RFC3339))
+	for _, p := range r.Execution {
+		fmt.Fprintf(&b, "  %s: block %d%s\n", p.Name, p.Head, progressSuffix(p))
+	}
+	for _, p := range r.Consensus {
+		fmt.Fprintf(&b, "  %s: slot %d%s\n", p.Name, p.Head, progressSuffix(p))
+	}
+	return b.String()
+}
+
+func progressSuffix(p NodeProgress) string {
+	switch {
+	case p.Producing():
+		return ""
+	case p.Err != nil:
+		return fmt.Sprintf(" (not producing: %v)", p.Err)
+	default:
+		return " (not producing)"
+	}
+}
+
+// WaitForGenesis waits until the network's genesis time and then until every
+// execution client reaches block 1 and every consensus client reaches slot 1.
+// Stopped late joiners are skipped.
+func WaitForGenesis(ctx context.Context, net network.Network, opts ...GenesisWaitOption) error {
+	_, err := WaitForGenesisWithReport(ctx, net, opts...)
+	return err
+}
+
+// WaitForGenesisWithReport is WaitForGenesis returning the per-client progress.
+// The report is returned alongside the error when some clients failed to start producing.
+func WaitForGenesisWithReport(ctx context.Context, net network.Network, opts ...GenesisWaitOption) (*GenesisReport, error) {
+	cfg := &genesisWaitConfig{
+		progressTimeout: DefaultGenesisProgressTimeout,
+		pollInterval:    DefaultGenesisPollInterval,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
 
-	// Get attestant client for the consensus client
-	attestantClient, err := client.GetAttestantClient(ctx, firstClient)
+	lateJoiners := net.LateJoiners()
+	executionClients := net.ExecutionClients().Except(lateJoiners...)
+	consensusClients := net.ConsensusClients().Except(lateJoiners...)
+	if len(consensusClients) == 0 {
+		return nil, fmt.Errorf("no consensus clients available")
+	}
+
+	genesisTime, err := fetchGenesisTime(ctx, consensusClients)
 	if err != nil {
-		return fmt.Errorf("failed to get attestant client: %w", err)
+		return nil, err
+	}
+	report := &GenesisReport{GenesisTime: genesisTime}
+
+	if wait := time.Until(genesisTime); wait > 0 {
+		fmt.Printf("[ethereum-package-go] Waiting for genesis at %s (in %s)\n", genesisTime.Format(time.RFC3339), wait.Round(time.Second))
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return report, ctx.Err()
+		}
+	}
+
+	report.Execution = make([]NodeProgress, len(executionClients))
+	for i, c := range executionClients {
+		report.Execution[i].Name = c.Name()
+	}
+	report.Consensus = make([]NodeProgress, len(consensusClients))
+	for i, c := range consensusClients {
+		report.Consensus[i].Name = c.Name()
+	}
+
+	deadline := time.NewTimer(cfg.progressTimeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(cfg.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		pending := false
+		for i, c := range executionClients {
+			if report.Execution[i].Producing() {
+				continue
+			}
+			rpc := client.RPC(c)
+			report.Execution[i].Head, report.Execution[i].Err = rpc.GetBlockNumber(ctx)
+			pending = pending || !report.Execution[i].Producing()
+		}
+		for i, c := range consensusClients {
+			if report.Consensus[i].Producing() {
+				continue
+			}
+			report.Consensus[i].Head, report.Consensus[i].Err = fetchHeadSlot(ctx, c)
+			pending = pending || !report.Consensus[i].Producing()
+		}
+		if !pending {
+			return report, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return report, ctx.Err()
+		case <-deadline.C:
+			failed := report.Failed()
+			names := make([]string, len(failed))
+			for i, p := range failed {
+				names[i] = p.Name
+			}
+			return report, fmt.Errorf("%d clients did not start producing within %s of genesis: %s",
+				len(failed), cfg.progressTimeout, strings.Join(names, ", "))
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetchGenesisTime returns the genesis time reported by the first consensus client that answers
+func fetchGenesisTime(ctx context.Context, clients []client.ConsensusClient) (time.Time, error) {
+	var response struct {
+		Data struct {
+			GenesisTime string `json:"genesis_time"`
+		} `json:"data"`
 	}
 
-	// Type assert to GenesisProvider
-	genesisProvider, ok := attestantClient.(eth2client.GenesisProvider)
-	if !ok {
-		return fmt.Errorf("client does not implement GenesisProvider")
+	var errs []string
+	for _, c := range clients {
+		if err := getBeaconJSON(ctx, c, "/eth/v1/beacon/genesis", &response); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", c.Name(), err))
+			continue
+		}
+		seconds, err := strconv.ParseInt(response.Data.GenesisTime, 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: invalid genesis time %q", c.Name(), response.Data.GenesisTime))
+			continue
+		}
+		return time.Unix(seconds, 0), nil
 	}
 
-	// Get genesis details
-	genesisResponse, err := genesisProvider.Genesis(ctx, &api.GenesisOpts{})
+	return time.Time{}, fmt.Errorf("failed to get genesis time from any consensus client: %s", strings.Join(errs, "; "))
+}
+
+// fetchHeadSlot returns the head slot of a beacon node
+func fetchHeadSlot(ctx context.Context, c client.ConsensusClient) (uint64, error) {
+	var response struct {
+		Data struct {
+			Header struct {
+				Message struct {
+					Slot string `json:"slot"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+
+	if err := getBeaconJSON(ctx, c, "/eth/v1/beacon/headers/head", &response); err != nil {
+		return 0, err
+	}
+
+	slot, err := strconv.ParseUint(response.Data.Header.Message.Slot, 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to get genesis details: %w", err)
+		return 0, fmt.Errorf("invalid head slot %q: %w", response.Data.Header.Message.Slot, err)
 	}
 
-	// Calculate time until genesis
-	genesisTime := genesisResponse.Data.GenesisTime
-	now := time.Now()
+	return slot, nil
+}
 
-	if genesisTime.Before(now) {
-		// Genesis already happened
-		return nil
+// getBeaconJSON performs a GET against a beacon API path of c and decodes the
+// JSON response. Requests use c's TLS settings and credentials, so https
+// beacon endpoints with custom CAs and authenticating proxies work.
+func getBeaconJSON(ctx context.Context, c client.ConsensusClient, path string, out interface{}) error {
+	beaconURL := c.BeaconAPIURL()
+	if beaconURL == "" {
+		return fmt.Errorf("beacon API URL is empty")
 	}
 
-	// Calculate sleep duration
-	sleepDuration := genesisTime.Sub(now)
+	timeout := c.RPCTimeout()
+	if timeout <= 0 {
+		timeout = genesisRequestTimeout
+	}
+	httpClient := c.Credentials().Wrap(client.NewHTTPClient(timeout, c.TLSConfig()))
 
-	// Log the wait time
-	fmt.Printf("Waiting for genesis at %s (in %s)\n", genesisTime.Format(time.RFC3339), sleepDuration)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, beaconURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
 
-	// Sleep until genesis
-	timer := time.NewTimer(sleepDuration)
-	defer timer.Stop()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
 
-	select {
-	case <-timer.C:
-		return nil
-	case <-ctx.Done():
-		return ctx.Err()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("beacon API returned status %d for %s", resp.StatusCode, path)
 	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,143 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newGenesisTestServer serves the beacon and JSON-RPC endpoints used by WaitForGenesis
+func newGenesisTestServer(t *testing.T, genesis time.Time, blockNumber, headSlot uint64) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			fmt.Fprintf(w, `{"data":{"genesis_time":"%d"}}`, genesis.Unix())
+		case "/eth/v1/beacon/headers/head":
+			fmt.Fprintf(w, `{"data":{"header":{"message":{"slot":"%d"}}}}`, headSlot)
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"jsonrpc": "2.0", "id": 1, "result": fmt.Sprintf("0x%x", blockNumber),
+			})
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newGenesisTestNetwork(elURL, clURL string) network.Network {
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", elURL, "", "", "", "", "el-1-geth-lighthouse", "", 0))
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", clURL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+
+	return network.New(network.Config{
+		ExecutionClients: executionClients,
+		ConsensusClients: consensusClients,
+		OrphanOnExit:     true,
+	})
+}
+
+func TestWaitForGenesis(t *testing.T) {
+	server := newGenesisTestServer(t, time.Now().Add(-time.Minute), 5, 3)
+	net := newGenesisTestNetwork(server.URL, server.URL)
+
+	report, err := WaitForGenesisWithReport(context.Background(), net, WithGenesisPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	require.Len(t, report.Execution, 1)
+	require.Len(t, report.Consensus, 1)
+	assert.Equal(t, uint64(5), report.Execution[0].Head)
+	assert.Equal(t, uint64(3), report.Consensus[0].Head)
+	assert.Empty(t, report.Failed())
+}
+
+func TestWaitForGenesis_TLS(t *testing.T) {
+	plain := newGenesisTestServer(t, time.Now().Add(-time.Minute), 5, 3)
+	server := httptest.NewTLSServer(plain.Config.Handler)
+	t.Cleanup(server.Close)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	tlsConfig := &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "", "el-1-geth-lighthouse", "", 0).WithTLSConfig(tlsConfig))
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 0).WithTLSConfig(tlsConfig))
+	net := network.New(network.Config{
+		ExecutionClients: executionClients,
+		ConsensusClients: consensusClients,
+		OrphanOnExit:     true,
+	})
+
+	report, err := WaitForGenesisWithReport(context.Background(), net, WithGenesisPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), report.Consensus[0].Head)
+}
+
+func TestWaitForGenesis_Credentials(t *testing.T) {
+	backend := newGenesisTestServer(t, time.Now().Add(-time.Minute), 5, 3)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		backend.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	credentials := client.BasicAuth("user", "secret")
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "", "el-1-geth-lighthouse", "", 0).WithCredentials(credentials))
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 0).WithCredentials(credentials))
+	net := network.New(network.Config{
+		ExecutionClients: executionClients,
+		ConsensusClients: consensusClients,
+		OrphanOnExit:     true,
+	})
+
+	report, err := WaitForGenesisWithReport(context.Background(), net, WithGenesisPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), report.Consensus[0].Head)
+}
+
+func TestWaitForGenesis_ReportsStalledClients(t *testing.T) {
+	healthy := newGenesisTestServer(t, time.Now().Add(-time.Minute), 0, 2)
+	net := newGenesisTestNetwork(healthy.URL, healthy.URL)
+
+	report, err := WaitForGenesisWithReport(context.Background(), net,
+		WithGenesisProgressTimeout(50*time.Millisecond),
+		WithGenesisPollInterval(10*time.Millisecond),
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "el-1-geth-lighthouse")
+	assert.NotContains(t, err.Error(), "cl-1-lighthouse-geth")
+
+	require.NotNil(t, report)
+	failed := report.Failed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "el-1-geth-lighthouse", failed[0].Name)
+	assert.Contains(t, report.String(), "block 0 (not producing)")
+}
+
+func TestWaitForGenesis_NoConsensusClients(t *testing.T) {
+	net := network.New(network.Config{
+		ExecutionClients: client.NewExecutionClients(),
+		ConsensusClients: client.NewConsensusClients(),
+		OrphanOnExit:     true,
+	})
+
+	err := WaitForGenesis(context.Background(), net)
+	assert.ErrorContains(t, err, "no consensus clients available")
+}
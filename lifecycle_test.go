@@ -6,7 +6,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
 	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
 	"github.com/ethpandaops/ethereum-package-go/pkg/types"
 	"github.com/ethpandaops/ethereum-package-go/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -193,6 +195,39 @@ func TestRun_FailureScenarios(t *testing.T) {
 	}
 }
 
+func TestRun_DiagnosesResourceExhaustion(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.WaitForServicesFunc = func(ctx context.Context, enclaveName string, serviceNames []string, timeout time.Duration) error {
+		return errors.New("timeout waiting for services")
+	}
+	mockClient.ContainerStatesFunc = func(ctx context.Context, enclaveName string) (map[string]network.ContainerState, error) {
+		return map[string]network.ContainerState{
+			"el-1-geth-lighthouse": {Status: "exited", ExitCode: 137, OOMKilled: true},
+			"cl-1-lighthouse-geth": {Status: "running"},
+		}, nil
+	}
+	mockClient.ServiceLogsFunc = func(ctx context.Context, enclaveName, serviceName string, lines int) ([]string, error) {
+		if serviceName == "cl-1-lighthouse-geth" {
+			return []string{"CRIT Failed to write block: No space left on device"}, nil
+		}
+		return nil, nil
+	}
+
+	net, err := Run(context.Background(), Minimal(), WithKurtosisClient(mockClient))
+	assert.Nil(t, net)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, network.ErrOutOfMemory)
+	assert.ErrorIs(t, err, network.ErrDiskFull)
+	assert.NotErrorIs(t, err, network.ErrPortsExhausted)
+	assert.Contains(t, err.Error(), "timeout waiting for services")
+
+	var resourceErr *network.ResourceError
+	require.ErrorAs(t, err, &resourceErr)
+	assert.Len(t, resourceErr.Hints(), 2)
+	// The enclave is destroyed after it was diagnosed
+	assert.Equal(t, 1, mockClient.CallCount["DestroyEnclave"])
+}
+
 func TestRun_DryRunMode(t *testing.T) {
 	ctx := context.Background()
 	mockClient := mocks.NewMockKurtosisClient()
@@ -200,6 +235,9 @@ func TestRun_DryRunMode(t *testing.T) {
 	runResult := &kurtosis.RunPackageResult{
 		EnclaveName:   "dry-run-enclave",
 		ResponseLines: []string{"Dry run completed"},
+		Plan: &network.Plan{Services: []network.PlannedService{
+			{Name: "el-1-geth-lighthouse", Type: network.ServiceTypeExecutionClient, Image: "ethereum/client-go:latest"},
+		}},
 	}
 
 	services := map[string]*kurtosis.ServiceInfo{
@@ -231,6 +269,10 @@ func TestRun_DryRunMode(t *testing.T) {
 
 	// Verify WaitForServices was NOT called (CallCount should be 0)
 	assert.Equal(t, 0, mockClient.CallCount["WaitForServices"])
+
+	// The dry run's plan is available on the network
+	require.NotNil(t, network.Plan())
+	assert.Equal(t, []string{"ethereum/client-go:latest"}, network.Plan().Images())
 }
 
 func TestNetwork_Cleanup(t *testing.T) {
@@ -320,3 +362,221 @@ func TestNetwork_Stop(t *testing.T) {
 	err := network.Stop(ctx)
 	assert.NoError(t, err)
 }
+
+func TestRun_ConfigHash(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+
+	net, err := Run(ctx,
+		Minimal(),
+		WithKurtosisClient(mockClient),
+		WithReuse("hashed-enclave"),
+	)
+	require.NoError(t, err)
+	require.Len(t, net.ConfigHash(), 64)
+	assert.Equal(t, net.ConfigHash(), mockClient.Labels["hashed-enclave"][kurtosis.LabelConfigHash])
+
+	// Rerunning the same config against the enclave is allowed
+	again, err := Run(ctx,
+		Minimal(),
+		WithKurtosisClient(mockClient),
+		WithReuse("hashed-enclave"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, net.ConfigHash(), again.ConfigHash())
+
+	// A different package version changes the hash and is refused before deploying
+	runs := mockClient.CallCount["RunPackage"]
+	_, err = Run(ctx,
+		Minimal(),
+		WithKurtosisClient(mockClient),
+		WithReuse("hashed-enclave"),
+		WithPackageVersion("0.0.1"),
+	)
+	require.ErrorIs(t, err, ErrConfigHashMismatch)
+	assert.Equal(t, runs, mockClient.CallCount["RunPackage"])
+
+	// Mapping the existing enclave with a different config is refused too
+	_, err = FindOrCreateNetwork(ctx, "hashed-enclave",
+		AllClientsMatrix(),
+		WithKurtosisClient(mockClient),
+		WithOrphanOnExit(),
+	)
+	require.ErrorIs(t, err, ErrConfigHashMismatch)
+}
+
+func TestRun_PortConflict(t *testing.T) {
+	stubPreflight(t, nil, nil, 0, 32003)
+	mockClient := mocks.NewMockKurtosisClient()
+	publisher := WithPortPublisher(&config.PortPublisherConfig{
+		EL: &config.PortPublisherComponent{Enabled: true, PublicPortStart: 32000},
+		CL: &config.PortPublisherComponent{Enabled: true, PublicPortStart: 33000},
+	})
+
+	_, err := Run(context.Background(), Minimal(), WithKurtosisClient(mockClient), publisher)
+	require.ErrorIs(t, err, ErrPortConflict)
+	assert.Contains(t, err.Error(), "el: 32003 in use")
+	assert.Zero(t, mockClient.CallCount["RunPackage"])
+
+	// Free ports deploy
+	stubPreflight(t, nil, nil, 0)
+	_, err = Run(context.Background(), Minimal(), WithKurtosisClient(mockClient), publisher)
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockClient.CallCount["RunPackage"])
+}
+
+func TestRun_MaxRestarts(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.ContainerStatesFunc = func(ctx context.Context, enclaveName string) (map[string]network.ContainerState, error) {
+		return map[string]network.ContainerState{
+			"cl-1-geth-lighthouse": {Status: "restarting", RestartCount: 3},
+		}, nil
+	}
+
+	_, err := Run(ctx, Minimal(), WithKurtosisClient(mockClient), WithOrphanOnExit(), WithMaxRestarts(2))
+	require.ErrorIs(t, err, network.ErrCrashLoop)
+	assert.Contains(t, err.Error(), "cl-1-geth-lighthouse restarted 3 times")
+	assert.Equal(t, 1, mockClient.CallCount["DestroyEnclave"])
+
+	// Within the limit the network starts
+	mockClient.Reset()
+	mockClient.ContainerStatesFunc = func(ctx context.Context, enclaveName string) (map[string]network.ContainerState, error) {
+		return map[string]network.ContainerState{
+			"cl-1-geth-lighthouse": {Status: "running", RestartCount: 2},
+		}, nil
+	}
+	_, err = Run(ctx, Minimal(), WithKurtosisClient(mockClient), WithOrphanOnExit(), WithMaxRestarts(2))
+	require.NoError(t, err)
+}
+
+func TestRun_Validation(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+	warning := network.NewValidationResult(config.SeverityWarning, "participants[0].el_extra_params is deprecated")
+	mockClient.RunPackageFunc = func(ctx context.Context, cfg kurtosis.RunPackageConfig) (*kurtosis.RunPackageResult, error) {
+		mockClient.Enclaves[cfg.EnclaveName] = &mocks.EnclaveState{Name: cfg.EnclaveName, Running: true}
+		return &kurtosis.RunPackageResult{EnclaveName: cfg.EnclaveName, Validation: network.ValidationResults{warning}}, nil
+	}
+
+	// Warnings don't stop the run and are kept on the network
+	net, err := Run(ctx, Minimal(), WithKurtosisClient(mockClient), WithOrphanOnExit())
+	require.NoError(t, err)
+	assert.Equal(t, network.ValidationResults{warning}, net.Validation())
+
+	// Ignored warnings are dropped
+	net, err = Run(ctx, Minimal(), WithKurtosisClient(mockClient), WithOrphanOnExit(), WithIgnoreWarnings("participants"))
+	require.NoError(t, err)
+	assert.Empty(t, net.Validation())
+
+	// Errors fail the run with the typed results
+	mockClient.RunPackageFunc = func(ctx context.Context, cfg kurtosis.RunPackageConfig) (*kurtosis.RunPackageResult, error) {
+		return &kurtosis.RunPackageResult{EnclaveName: cfg.EnclaveName, Validation: network.ValidationResults{
+			warning,
+			network.NewValidationResult(config.SeverityError, "Unsupported cl_type 'foo'"),
+		}}, nil
+	}
+	_, err = Run(ctx, Minimal(), WithKurtosisClient(mockClient), WithOrphanOnExit(), WithIgnoreWarnings())
+	var validationErr *network.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Results, 1)
+	assert.Equal(t, "cl_type", validationErr.Results[0].Path)
+}
+
+func TestFindOrCreateNetwork_ExistingUsesRunSettings(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+
+	_, err := Run(ctx, Minimal(), WithKurtosisClient(mockClient), WithReuse("found-enclave"))
+	require.NoError(t, err)
+	runs := mockClient.CallCount["RunPackage"]
+
+	net, err := FindOrCreateNetwork(ctx, "found-enclave",
+		Minimal(),
+		WithKurtosisClient(mockClient),
+		WithOrphanOnExit(),
+		WithTLSInsecureSkipVerify(),
+		WithRandomSeed(42),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, runs, mockClient.CallCount["RunPackage"])
+	assert.Equal(t, int64(42), net.Seed())
+	tlsConfig := net.ConsensusClients().All()[0].TLSConfig()
+	require.NotNil(t, tlsConfig)
+	assert.True(t, tlsConfig.InsecureSkipVerify)
+
+	// Without WithRandomSeed a seed is still picked and reported
+	net, err = FindOrCreateNetwork(ctx, "found-enclave", Minimal(), WithKurtosisClient(mockClient), WithOrphanOnExit())
+	require.NoError(t, err)
+	assert.NotZero(t, net.Seed())
+}
+
+func TestAttachReadOnly(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+
+	_, err := AttachReadOnly(ctx, "shared-devnet", Minimal(), WithKurtosisClient(mockClient))
+	require.ErrorIs(t, err, kurtosis.ErrEnclaveNotFound)
+
+	// Engine failures are not reported as a missing enclave
+	unreachable := errors.New("engine unreachable")
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return nil, unreachable
+	}
+	_, err = AttachReadOnly(ctx, "shared-devnet", Minimal(), WithKurtosisClient(mockClient))
+	require.ErrorIs(t, err, unreachable)
+	assert.NotErrorIs(t, err, kurtosis.ErrEnclaveNotFound)
+	mockClient.GetServicesFunc = nil
+
+	deployed, err := Run(ctx, Minimal(), WithKurtosisClient(mockClient), WithReuse("shared-devnet"))
+	require.NoError(t, err)
+
+	net, err := AttachReadOnly(ctx, "shared-devnet", Minimal(), WithKurtosisClient(mockClient))
+	require.NoError(t, err)
+	assert.True(t, net.ReadOnly())
+	assert.Equal(t, deployed.ConfigHash(), net.ConfigHash())
+	assert.Len(t, net.Services(), len(deployed.Services()))
+
+	_, err = net.AddService(ctx, network.ServiceSpec{Name: "tool", Image: "busybox"})
+	assert.ErrorIs(t, err, network.ErrReadOnly)
+	_, _, _, err = net.ConsensusClients().All()[0].Exec(ctx, []string{"ls", "/data"})
+	assert.ErrorIs(t, err, network.ErrReadOnly)
+	assert.Zero(t, mockClient.CallCount["ExecCommand"])
+	require.NoError(t, net.Cleanup(ctx))
+	assert.Zero(t, mockClient.CallCount["DestroyEnclave"])
+	assert.Zero(t, mockClient.CallCount["AddService"])
+
+	// Attaching with another config is refused like any other mapping
+	_, err = AttachReadOnly(ctx, "shared-devnet", AllClientsMatrix(), WithKurtosisClient(mockClient))
+	assert.ErrorIs(t, err, ErrConfigHashMismatch)
+}
+
+func TestRunWithPolicy(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+
+	net, err := Run(ctx, Minimal(), WithKurtosisClient(mockClient), WithOrphanOnExit(), WithPolicy(network.DenyCleanup, network.DenyServices))
+	require.NoError(t, err)
+
+	_, err = net.AddService(ctx, network.ServiceSpec{Name: "tool", Image: "busybox"})
+	assert.ErrorIs(t, err, network.ErrDenied)
+	assert.ErrorIs(t, net.Cleanup(ctx), network.ErrDenied)
+	assert.Zero(t, mockClient.CallCount["DestroyEnclave"])
+
+	net.Policy().Allow(network.OperationCleanup)
+	require.NoError(t, net.Cleanup(ctx))
+	assert.Equal(t, 1, mockClient.CallCount["DestroyEnclave"])
+}
+
+func TestChaosRestartService(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewMockKurtosisClient()
+
+	net, err := Run(ctx, Minimal(), WithKurtosisClient(mockClient), WithOrphanOnExit())
+	require.NoError(t, err)
+	require.NotEmpty(t, net.Services())
+
+	require.NoError(t, net.Chaos().RestartService(ctx, net.Services()[0].Name))
+	assert.Equal(t, 1, mockClient.CallCount["StopService"])
+	assert.Equal(t, 1, mockClient.CallCount["StartService"])
+}
@@ -1,10 +1,15 @@
 package ethereum
 
 import (
+	"crypto/tls"
 	"time"
 
+	"github.com/ethpandaops/ethereum-package-go/pkg/artifacts"
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 	"github.com/ethpandaops/ethereum-package-go/pkg/config"
 	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 )
 
 // WithPreset sets a predefined configuration preset
@@ -32,6 +37,7 @@ func WithConfig(cfg *config.EthereumPackageConfig) RunOption {
 func WithChainID(chainID uint64) RunOption {
 	return func(cfg *RunConfig) {
 		cfg.ChainID = chainID
+		cfg.chainIDSet = true
 	}
 }
 
@@ -71,6 +77,7 @@ func WithAdditionalService(service config.AdditionalService) RunOption {
 func WithGlobalLogLevel(level string) RunOption {
 	return func(cfg *RunConfig) {
 		cfg.GlobalLogLevel = level
+		cfg.globalLogLevelSet = true
 	}
 }
 
@@ -103,7 +110,8 @@ func WithPackageRepo(repo, version string) RunOption {
 	}
 }
 
-// WithDryRun enables dry run mode (validation only, no actual deployment)
+// WithDryRun enables dry run mode (validation only, no actual deployment).
+// The returned network's Plan lists the services and images that would be deployed.
 func WithDryRun(dryRun bool) RunOption {
 	return func(cfg *RunConfig) {
 		cfg.DryRun = dryRun
@@ -124,20 +132,188 @@ func WithVerbose(verbose bool) RunOption {
 	}
 }
 
-// WithTimeout sets the timeout for network startup
+// WithTimeout sets how long to wait for services to become ready after deployment.
+//
+// Deprecated: use WithTimeouts(Timeouts{Readiness: timeout}).
 func WithTimeout(timeout time.Duration) RunOption {
 	return func(cfg *RunConfig) {
-		cfg.Timeout = timeout
+		cfg.Timeouts.Readiness = timeout
 	}
 }
 
-// WithKurtosisClient injects a custom Kurtosis client (mainly for testing)
+// WithTimeouts sets the deploy, readiness, genesis, RPC and cleanup timeouts.
+// Zero fields keep their current value, see DefaultTimeouts.
+func WithTimeouts(timeouts Timeouts) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.Timeouts = cfg.Timeouts.merge(timeouts)
+	}
+}
+
+// WithMaxRestarts makes Run fail, and Health report, services whose container
+// restarted more than n times or exited with a failure
+func WithMaxRestarts(n int) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.MaxRestarts = n
+	}
+}
+
+// WithLogHealth makes Health report execution, consensus and validator clients
+// as degraded when their recent logs match any of the patterns, even if their
+// health endpoints answer. Without patterns network.DefaultLogPatterns are used.
+func WithLogHealth(patterns ...network.LogPattern) RunOption {
+	return func(cfg *RunConfig) {
+		if len(patterns) == 0 {
+			patterns = network.DefaultLogPatterns
+		}
+		cfg.LogHealthPatterns = patterns
+	}
+}
+
+// WithPolicy makes the network deny or allow classes of operations, e.g.
+// WithPolicy(network.DenyCleanup, network.DenyChaos) before handing the network
+// to plugins. Denied operations fail with network.ErrDenied. Denying cleanup
+// only blocks explicit Cleanup calls, the library still tears the network down
+// on exit; use WithOrphanOnExit to keep the enclave. Rules add to those of
+// earlier WithPolicy options.
+func WithPolicy(rules ...network.Rule) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.Policy = append(cfg.Policy, rules...)
+	}
+}
+
+// WithIgnoreWarnings drops the validation warnings ethereum-package gives about
+// the given configuration fields, such as "participants[0].el_extra_params",
+// or every warning without paths. A path also covers the fields below it.
+// Ignored warnings are neither printed nor kept in Network.Validation.
+func WithIgnoreWarnings(paths ...string) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.IgnoreWarnings = true
+		cfg.IgnoredWarningPaths = paths
+	}
+}
+
+// WithFanoutLimit caps the number of concurrent calls network-wide operations such as
+// PeerIDs, Health and log collection make. The default is client.DefaultFanoutLimit.
+func WithFanoutLimit(limit int) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.FanoutLimit = limit
+	}
+}
+
+// WithClientResourceLimits caps the CPU in millicores and the memory in
+// megabytes of every execution and consensus client that sets no limit of its
+// own, e.g. to fit a multi-client matrix on a shared CI runner. Zero leaves
+// that resource uncapped.
+func WithClientResourceLimits(maxCPU, maxMem int) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.ClientMaxCPU = maxCPU
+		cfg.ClientMaxMem = maxMem
+	}
+}
+
+// WithRandomSeed makes all randomized behavior reproducible: the generated
+// enclave name, funded account keys, Random client choice and proxy faults.
+// Run logs the seed it used, so a flaky run can be repeated with that value.
+func WithRandomSeed(seed int64) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.RandomSeed = seed
+	}
+}
+
+// WithDockerHostOverride reaches every published port through host, for Docker
+// daemons on another machine or in a VM whose ports are not on localhost. Kurtosis
+// reports local addresses in that case, so client URLs, readiness probes and
+// Service.ExternalURL would otherwise point at this machine.
+func WithDockerHostOverride(host string) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.DockerHostOverride = host
+	}
+}
+
+// WithTLSConfig sets the TLS settings used for clients whose endpoints are
+// served over https or wss, e.g. behind an ingress with a private certificate.
+// It takes precedence over WithTLSCABundle and WithTLSInsecureSkipVerify.
+func WithTLSConfig(tlsConfig *tls.Config) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.TLSConfig = tlsConfig
+	}
+}
+
+// WithTLSCABundle trusts the PEM certificates in path, in addition to the
+// system roots, for https and wss client endpoints
+func WithTLSCABundle(path string) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.TLSCABundle = path
+	}
+}
+
+// WithTLSInsecureSkipVerify disables certificate verification of https and wss
+// client endpoints, for devnets with self-signed certificates
+func WithTLSInsecureSkipVerify() RunOption {
+	return func(cfg *RunConfig) {
+		cfg.TLSInsecureSkipVerify = true
+	}
+}
+
+// WithCredentials authenticates every request to client endpoints, for networks
+// reused through an authenticating proxy
+func WithCredentials(credentials *client.Credentials) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.Credentials = credentials
+	}
+}
+
+// WithClientCredentials authenticates requests to the client with the given
+// service name, e.g. "el-1-geth-lighthouse", overriding WithCredentials for it
+func WithClientCredentials(serviceName string, credentials *client.Credentials) RunOption {
+	return func(cfg *RunConfig) {
+		if cfg.ClientCredentials == nil {
+			cfg.ClientCredentials = make(map[string]*client.Credentials)
+		}
+		cfg.ClientCredentials[serviceName] = credentials
+	}
+}
+
+// WithKurtosisClient injects a custom Kurtosis client (mainly for testing). A
+// *kurtosis.KurtosisClient gets the RPC timeout of Timeouts for its readiness
+// probes; other implementations probe services themselves.
 func WithKurtosisClient(client kurtosis.Client) RunOption {
 	return func(cfg *RunConfig) {
 		cfg.KurtosisClient = client
 	}
 }
 
+// WithKurtosisEngine connects to the Kurtosis engine listening on host:port
+// instead of the local one, e.g. a shared engine CI runners use. Port 0 is the
+// engine's default port. Client ports are reached on host unless
+// WithDockerHostOverride says otherwise.
+func WithKurtosisEngine(host string, port uint16) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.KurtosisEngineHost = host
+		cfg.KurtosisEnginePort = port
+	}
+}
+
+// WithKurtosisContext runs on an existing Kurtosis SDK context, such as one
+// connected to Kurtosis Cloud. It takes precedence over WithKurtosisEngine.
+func WithKurtosisContext(kurtosisCtx *kurtosis_context.KurtosisContext) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.KurtosisContext = kurtosisCtx
+	}
+}
+
+// Options combines several options into one, applied in order. Later options
+// override earlier ones, so bundles can be extended with further options.
+func Options(opts ...RunOption) RunOption {
+	return func(cfg *RunConfig) {
+		for _, opt := range opts {
+			if opt != nil {
+				opt(cfg)
+			}
+		}
+	}
+}
+
 // Convenience functions for common configurations
 
 // AllELs returns a preset with all execution layer clients
@@ -160,6 +336,106 @@ func Minimal() RunOption {
 	return WithPreset(config.PresetMinimal)
 }
 
+// FastDevnet returns a minimal network tuned for the fastest possible feedback
+// loop: short slots, a short genesis delay, few validators and no additional
+// services. The resulting configuration is checked for consistency at build time.
+func FastDevnet() RunOption {
+	return func(cfg *RunConfig) {
+		cfg.ConfigSource = config.NewPresetConfigSource(config.PresetMinimal)
+		cfg.NetworkParams = config.FastDevnetNetworkParams()
+		cfg.AdditionalServices = nil
+		cfg.FastDevnet = true
+	}
+}
+
+// Client resource limits of the CI bundle, enough for any client on a fast devnet
+const (
+	ciClientMaxCPU = 2000
+	ciClientMaxMem = 4096
+)
+
+// CI is a bundle for continuous integration: a FastDevnet network with capped
+// client resources and reduced Kurtosis parallelism and fan-out for shared
+// runners, waiting for genesis so clients that fail to start producing are
+// reported before Run returns. It runs no Grafana, so add WithArtifactsDir
+// together with Grafana for dashboard reports.
+func CI() RunOption {
+	return Options(
+		FastDevnet(),
+		WithClientResourceLimits(ciClientMaxCPU, ciClientMaxMem),
+		WithParallelism(2),
+		WithFanoutLimit(4),
+		WithWaitForGenesis(),
+	)
+}
+
+// Interop is a bundle for client interoperability testing: every EL/CL combination
+// with Prometheus, Grafana and Dora, waiting for genesis before Run returns.
+func Interop() RunOption {
+	return Options(
+		AllClientsMatrix(),
+		WithFullObservability(),
+		WithWaitForGenesis(),
+	)
+}
+
+// WithGenesisDelay overrides the delay in seconds between genesis generation and genesis time
+func WithGenesisDelay(seconds int) RunOption {
+	return func(cfg *RunConfig) {
+		if cfg.NetworkParams == nil {
+			cfg.NetworkParams = &config.NetworkParams{}
+		}
+		cfg.NetworkParams.GenesisDelay = seconds
+	}
+}
+
+// WithPreloadedAccounts adds accounts to the genesis allocation, for example the
+// state of an earlier network from StateSnapshot.Alloc
+func WithPreloadedAccounts(accounts map[string]config.PreloadedAccount) RunOption {
+	return func(cfg *RunConfig) {
+		if cfg.NetworkParams == nil {
+			cfg.NetworkParams = &config.NetworkParams{}
+		}
+		if cfg.NetworkParams.AdditionalPreloadedContracts == nil {
+			cfg.NetworkParams.AdditionalPreloadedContracts = make(map[string]config.PreloadedAccount, len(accounts))
+		}
+		for address, account := range accounts {
+			cfg.NetworkParams.AdditionalPreloadedContracts[address] = account
+		}
+	}
+}
+
+// WithSpecPreset selects the consensus spec preset (mainnet or minimal)
+func WithSpecPreset(preset config.SpecPreset) RunOption {
+	return func(cfg *RunConfig) {
+		if cfg.NetworkParams == nil {
+			cfg.NetworkParams = &config.NetworkParams{}
+		}
+		cfg.NetworkParams.Preset = preset
+	}
+}
+
+// WithShadowFork shadow forks a public network such as config.NetworkHoodi at
+// blockHeight, config.ShadowForkLatest or a block number. The nodes start from
+// the public chain data and keep building on it with their own validators.
+func WithShadowFork(network, blockHeight string) RunOption {
+	return func(cfg *RunConfig) {
+		if cfg.NetworkParams == nil {
+			cfg.NetworkParams = &config.NetworkParams{}
+		}
+		cfg.NetworkParams.Network = config.ShadowFork(network)
+		cfg.NetworkParams.ShadowForkBlockHeight = blockHeight
+	}
+}
+
+// WithValidatorCountAutoFix raises per-node validator counts when the configuration
+// has too few validators to fill committees, instead of failing validation
+func WithValidatorCountAutoFix() RunOption {
+	return func(cfg *RunConfig) {
+		cfg.AutoFixValidatorCount = true
+	}
+}
+
 // WithExplorer adds Dora block explorer
 func WithExplorer() RunOption {
 	return WithAdditionalServices("dora")
@@ -170,13 +446,22 @@ func WithFullObservability() RunOption {
 	return WithAdditionalServices("prometheus", "grafana", "dora")
 }
 
-// WithParticipants sets custom participant configurations
+// WithParticipants sets custom participant configurations. The participants
+// replace those of any preset; when combined with WithConfig the remaining
+// inline configuration is kept. Network params, MEV, services and other options
+// are merged in when the configuration is built.
 func WithParticipants(participants []config.ParticipantConfig) RunOption {
 	return func(cfg *RunConfig) {
-		// Create inline config with participants
-		ethConfig := &config.EthereumPackageConfig{
-			Participants: participants,
+		// Copy so later changes to the caller's slice don't leak into the run
+		copied := make([]config.ParticipantConfig, len(participants))
+		copy(copied, participants)
+
+		ethConfig := &config.EthereumPackageConfig{}
+		if inline, ok := cfg.ConfigSource.(*config.InlineConfigSource); ok && inline.GetConfig() != nil {
+			*ethConfig = *inline.GetConfig()
 		}
+		ethConfig.Participants = copied
+
 		cfg.ConfigSource = config.NewInlineConfigSource(ethConfig)
 	}
 }
@@ -242,11 +527,92 @@ func WithWaitForGenesis() RunOption {
 	}
 }
 
+// WithMonitoringCheck makes Run verify that Prometheus has an up target for
+// every client and that enabled metrics exporters are exporting. It needs the
+// prometheus additional service.
+func WithMonitoringCheck() RunOption {
+	return func(cfg *RunConfig) {
+		cfg.VerifyMonitoring = true
+	}
+}
+
+// WithArtifactsDir saves rendered Grafana dashboards to dir as PNGs when the
+// network is cleaned up, as visual evidence of chain health for CI reports.
+// It needs Grafana with its image renderer.
+func WithArtifactsDir(dir string) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.ArtifactsDir = dir
+	}
+}
+
+// WithArtifactsRetention bounds the artifacts directory by age and size. Run
+// prunes it before deploying and the network prunes it again after writing its
+// artifacts, removing the oldest top-level files and run directories first, so
+// CI hosts running many networks do not fill up their disks.
+func WithArtifactsRetention(policy artifacts.RetentionPolicy) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.ArtifactsRetention = policy
+	}
+}
+
+// WithGrafanaPanels limits the Grafana export of WithArtifactsDir to the given
+// dashboards and panels
+func WithGrafanaPanels(panels ...network.GrafanaPanel) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.GrafanaPanels = append(cfg.GrafanaPanels, panels...)
+	}
+}
+
+// WithMetricsExporter deploys an ethereum-metrics-exporter next to every node.
+// It exports sync, peer and fork metrics in the same format for all clients;
+// Network.MetricsExporters reads them. Participants can opt out with
+// SimpleParticipantBuilder.WithMetricsExporter(false).
+func WithMetricsExporter() RunOption {
+	return func(cfg *RunConfig) {
+		cfg.MetricsExporter = true
+	}
+}
+
+// WithProgressHandler receives Run's progress as structured events, e.g. to
+// render progress bars or collect deployment timings in CI. The handler is
+// called synchronously and should return quickly.
+func WithProgressHandler(handler func(ProgressEvent)) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.ProgressHandler = handler
+	}
+}
+
+// WithCheckpointSync has late-joining nodes checkpoint sync from url when
+// Network.StartLateJoiners starts them. An empty url deploys checkpointz and
+// syncs from it, testing checkpoint sync end to end within the enclave.
+func WithCheckpointSync(url string) RunOption {
+	return func(cfg *RunConfig) {
+		cfg.CheckpointSync = true
+		cfg.CheckpointSyncURL = url
+		if url != "" {
+			return
+		}
+		cfg.CheckpointSyncURL = config.CheckpointzURL
+		for _, service := range cfg.AdditionalServices {
+			if service.Name == "checkpointz" {
+				return
+			}
+		}
+		WithAdditionalServices("checkpointz")(cfg)
+	}
+}
+
 // WithSpamoor adds the spamoor service to the network
 func WithSpamoor() RunOption {
 	return WithAdditionalServices("spamoor")
 }
 
+// WithPostMortemServices adds tracoor and forky, which capture beacon states
+// and fork choice snapshots for inspection after a consensus failure
+func WithPostMortemServices() RunOption {
+	return WithAdditionalServices("tracoor", "forky")
+}
+
 // WithOrphanOnExit prevents automatic cleanup when the process exits
 // This is similar to testcontainers' reuse option - the enclave will persist
 // after the program terminates and must be manually cleaned up
@@ -1,10 +1,15 @@
 package ethereum
 
 import (
+	"crypto/tls"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/ethpandaops/ethereum-package-go/pkg/artifacts"
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -224,7 +229,7 @@ func TestWithTimeout(t *testing.T) {
 	opt := WithTimeout(timeout)
 	opt(cfg)
 
-	assert.Equal(t, timeout, cfg.Timeout)
+	assert.Equal(t, timeout, cfg.Timeouts.Readiness)
 }
 
 func TestConvenienceOptions(t *testing.T) {
@@ -277,6 +282,18 @@ func TestConvenienceOptions(t *testing.T) {
 				assert.Equal(t, "dora", cfg.AdditionalServices[0].Name)
 			},
 		},
+		{
+			name:    "WithPostMortemServices",
+			optFunc: WithPostMortemServices(),
+			validate: func(t *testing.T, cfg *RunConfig) {
+				require.Len(t, cfg.AdditionalServices, 2)
+				assert.Equal(t, "tracoor", cfg.AdditionalServices[0].Name)
+				assert.Equal(t, "forky", cfg.AdditionalServices[1].Name)
+
+				_, err := buildEthereumConfig(cfg)
+				require.NoError(t, err)
+			},
+		},
 		{
 			name:    "WithFullObservability",
 			optFunc: WithFullObservability(),
@@ -339,7 +356,7 @@ func TestMultipleOptions(t *testing.T) {
 	assert.Equal(t, uint64(99999), cfg.ChainID)
 	assert.Len(t, cfg.AdditionalServices, 2)
 	assert.True(t, cfg.VerboseMode)
-	assert.Equal(t, 20*time.Minute, cfg.Timeout)
+	assert.Equal(t, 20*time.Minute, cfg.Timeouts.Readiness)
 	assert.Equal(t, "debug", cfg.GlobalLogLevel)
 }
 
@@ -463,3 +480,340 @@ func TestPortPublisherWithOtherOptions(t *testing.T) {
 	assert.True(t, cfg.PortPublisher.EL.Enabled)
 	assert.True(t, cfg.PortPublisher.CL.Enabled)
 }
+
+func TestFastDevnet(t *testing.T) {
+	cfg := defaultRunConfig()
+	WithExplorer()(cfg)
+	FastDevnet()(cfg)
+
+	assert.True(t, cfg.FastDevnet)
+	assert.Empty(t, cfg.AdditionalServices)
+	require.NotNil(t, cfg.NetworkParams)
+	assert.Equal(t, config.FastDevnetSecondsPerSlot, cfg.NetworkParams.SecondsPerSlot)
+	assert.Equal(t, config.FastDevnetGenesisDelay, cfg.NetworkParams.GenesisDelay)
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, config.FastDevnetSecondsPerSlot, ethConfig.NetworkParams.SecondsPerSlot)
+	assert.Equal(t, config.SpecPresetMinimal, ethConfig.NetworkParams.Preset)
+	assert.Equal(t, uint64(8), ethConfig.NetworkParams.SlotsPerEpoch())
+}
+
+func TestFastDevnet_InconsistentOverrides(t *testing.T) {
+	cfg := defaultRunConfig()
+	FastDevnet()(cfg)
+	WithGenesisDelay(300)(cfg)
+
+	_, err := buildEthereumConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "genesis delay 300s exceeds maximum")
+}
+
+func TestWithGenesisDelay(t *testing.T) {
+	cfg := defaultRunConfig()
+	WithGenesisDelay(45)(cfg)
+
+	require.NotNil(t, cfg.NetworkParams)
+	assert.Equal(t, 45, cfg.NetworkParams.GenesisDelay)
+
+	// Existing network params are preserved
+	WithCustomChain("999", 6, 16)(cfg)
+	WithGenesisDelay(30)(cfg)
+	assert.Equal(t, "999", cfg.NetworkParams.NetworkID)
+	assert.Equal(t, 30, cfg.NetworkParams.GenesisDelay)
+}
+
+func TestWithPreloadedAccounts(t *testing.T) {
+	cfg := defaultRunConfig()
+	WithPreloadedAccounts(map[string]config.PreloadedAccount{
+		"0x00000000000000000000000000000000000000aa": {Balance: "100", Nonce: 1},
+	})(cfg)
+	WithPreloadedAccounts(map[string]config.PreloadedAccount{
+		"0x00000000000000000000000000000000000000bb": {Balance: "0", Code: "0x6000"},
+	})(cfg)
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	assert.Len(t, ethConfig.NetworkParams.AdditionalPreloadedContracts, 2)
+
+	yaml, err := config.ToYAML(ethConfig)
+	require.NoError(t, err)
+	assert.Contains(t, yaml, "additional_preloaded_contracts:")
+	assert.Contains(t, yaml, "code: \"0x6000\"")
+}
+
+func TestWithDockerHostOverride(t *testing.T) {
+	cfg := defaultRunConfig()
+	assert.Empty(t, cfg.DockerHostOverride)
+
+	WithDockerHostOverride("docker.example.com")(cfg)
+	assert.Equal(t, "docker.example.com", cfg.DockerHostOverride)
+}
+
+func TestWithTLSOptions(t *testing.T) {
+	cfg := defaultRunConfig()
+	require.NoError(t, resolveTLSConfig(cfg))
+	assert.Nil(t, cfg.TLSConfig)
+
+	WithTLSInsecureSkipVerify()(cfg)
+	require.NoError(t, resolveTLSConfig(cfg))
+	require.NotNil(t, cfg.TLSConfig)
+	assert.True(t, cfg.TLSConfig.InsecureSkipVerify)
+
+	// An explicit config takes precedence over the toggles
+	explicit := &tls.Config{MinVersion: tls.VersionTLS13}
+	cfg = defaultRunConfig()
+	WithTLSConfig(explicit)(cfg)
+	WithTLSCABundle("missing.pem")(cfg)
+	require.NoError(t, resolveTLSConfig(cfg))
+	assert.Same(t, explicit, cfg.TLSConfig)
+
+	cfg = defaultRunConfig()
+	WithTLSCABundle("missing.pem")(cfg)
+	assert.Error(t, resolveTLSConfig(cfg))
+}
+
+func TestWithCredentials(t *testing.T) {
+	cfg := defaultRunConfig()
+	assert.Nil(t, cfg.Credentials)
+	assert.Nil(t, cfg.ClientCredentials)
+
+	shared := client.BearerToken("token")
+	beacon := client.BasicAuth("user", "pass")
+	WithCredentials(shared)(cfg)
+	WithClientCredentials("cl-1-lighthouse-geth", beacon)(cfg)
+	assert.Same(t, shared, cfg.Credentials)
+	assert.Same(t, beacon, cfg.ClientCredentials["cl-1-lighthouse-geth"])
+}
+
+func TestWithMonitoringCheck(t *testing.T) {
+	cfg := defaultRunConfig()
+	assert.False(t, cfg.VerifyMonitoring)
+
+	WithMonitoringCheck()(cfg)
+	assert.True(t, cfg.VerifyMonitoring)
+}
+
+func TestWithLogHealth(t *testing.T) {
+	cfg := defaultRunConfig()
+	assert.Nil(t, cfg.LogHealthPatterns)
+
+	WithLogHealth()(cfg)
+	assert.Equal(t, network.DefaultLogPatterns, cfg.LogHealthPatterns)
+
+	patterns := []network.LogPattern{{Name: "disk full", Pattern: regexp.MustCompile("disk full")}}
+	WithLogHealth(patterns...)(cfg)
+	assert.Equal(t, patterns, cfg.LogHealthPatterns)
+}
+
+func TestWithPolicy(t *testing.T) {
+	cfg := defaultRunConfig()
+	assert.Empty(t, cfg.Policy)
+
+	WithPolicy(network.DenyCleanup)(cfg)
+	WithPolicy(network.DenyChaos, network.AllowServices)(cfg)
+	assert.Equal(t, []network.Rule{network.DenyCleanup, network.DenyChaos, network.AllowServices}, cfg.Policy)
+}
+
+func TestWithArtifactsDir(t *testing.T) {
+	cfg := defaultRunConfig()
+	assert.Empty(t, cfg.ArtifactsDir)
+
+	WithArtifactsDir("artifacts")(cfg)
+	WithGrafanaPanels(network.GrafanaPanel{Dashboard: "beacon", Panel: 4})(cfg)
+	assert.Equal(t, "artifacts", cfg.ArtifactsDir)
+	assert.Equal(t, []network.GrafanaPanel{{Dashboard: "beacon", Panel: 4}}, cfg.GrafanaPanels)
+}
+
+func TestWithArtifactsRetention(t *testing.T) {
+	cfg := defaultRunConfig()
+	assert.False(t, cfg.ArtifactsRetention.Enabled())
+
+	policy := artifacts.RetentionPolicy{MaxAge: 72 * time.Hour, MaxSize: 1 << 30}
+	WithArtifactsRetention(policy)(cfg)
+	assert.Equal(t, policy, cfg.ArtifactsRetention)
+}
+
+func TestWithMetricsExporter(t *testing.T) {
+	cfg := defaultRunConfig()
+	WithMetricsExporter()(cfg)
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	assert.True(t, ethConfig.EthereumMetricsExporterEnabled)
+	assert.True(t, ethConfig.MetricsExporterEnabled(0))
+}
+
+func TestWithCheckpointSync(t *testing.T) {
+	cfg := defaultRunConfig()
+	WithParticipants([]config.ParticipantConfig{
+		config.NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Lighthouse).Build(),
+		config.NewParticipantBuilder().WithEL(client.Besu).WithCL(client.Teku).WithLateJoin().Build(),
+	})(cfg)
+	WithCheckpointSync("")(cfg)
+	WithCheckpointSync("")(cfg)
+
+	require.Len(t, cfg.AdditionalServices, 1)
+	assert.Equal(t, "checkpointz", cfg.AdditionalServices[0].Name)
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, config.CheckpointzURL, ethConfig.CheckpointSyncURL)
+	assert.False(t, ethConfig.CheckpointSync(0))
+	assert.True(t, ethConfig.CheckpointSync(1))
+
+	cfg = defaultRunConfig()
+	WithCheckpointSync("https://checkpoint.example")(cfg)
+	assert.Empty(t, cfg.AdditionalServices)
+	assert.Equal(t, "https://checkpoint.example", cfg.CheckpointSyncURL)
+}
+
+func TestWithSpecPreset(t *testing.T) {
+	cfg := defaultRunConfig()
+	WithSpecPreset(config.SpecPresetMinimal)(cfg)
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, config.SpecPresetMinimal, ethConfig.NetworkParams.Preset)
+	assert.Equal(t, 6, ethConfig.NetworkParams.SecondsPerSlot)
+}
+
+func TestWithShadowFork(t *testing.T) {
+	cfg := defaultRunConfig()
+	WithShadowFork(config.NetworkHoodi, "1200000")(cfg)
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "hoodi-shadowfork", ethConfig.NetworkParams.Network)
+	assert.Equal(t, "560048", ethConfig.NetworkParams.NetworkID)
+	assert.Equal(t, "1200000", ethConfig.NetworkParams.ShadowForkBlockHeight)
+
+	cfg = defaultRunConfig()
+	WithShadowFork("goerli", config.ShadowForkLatest)(cfg)
+	_, err = buildEthereumConfig(cfg)
+	assert.ErrorContains(t, err, "invalid network: goerli-shadowfork")
+}
+
+func TestNetworkParamOptionsKeepChainID(t *testing.T) {
+	tests := []struct {
+		name      string
+		option    RunOption
+		chainID   uint64
+		networkID string
+		// defaultNetworkID is the network ID without WithChainID
+		defaultNetworkID string
+	}{
+		{"genesis delay", WithGenesisDelay(30), 4242, "4242", "12345"},
+		{"fast devnet", FastDevnet(), 4242, "4242", "12345"},
+		{"spec preset", WithSpecPreset(config.SpecPresetMinimal), 4242, "4242", "12345"},
+		{"shadow fork", WithShadowFork(config.NetworkHoodi, config.ShadowForkLatest), 560048, "560048", "560048"},
+		{"preloaded accounts", WithPreloadedAccounts(map[string]config.PreloadedAccount{
+			"0x00000000000000000000000000000000000000aa": {Balance: "100"},
+		}), 4242, "4242", "12345"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := defaultRunConfig()
+			tt.option(cfg)
+			ethConfig, err := buildEthereumConfig(cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.defaultNetworkID, ethConfig.NetworkParams.NetworkID)
+
+			cfg = defaultRunConfig()
+			tt.option(cfg)
+			WithChainID(tt.chainID)(cfg)
+			ethConfig, err = buildEthereumConfig(cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.networkID, ethConfig.NetworkParams.NetworkID)
+		})
+	}
+}
+
+func TestWithValidatorCountAutoFix(t *testing.T) {
+	participants := []config.ParticipantConfig{
+		{ELType: client.Geth, CLType: client.Lighthouse, Count: 2, ValidatorCount: 4},
+	}
+
+	cfg := defaultRunConfig()
+	WithParticipants(participants)(cfg)
+	_, err := buildEthereumConfig(cfg)
+	assert.ErrorIs(t, err, config.ErrInsufficientValidators)
+
+	cfg = defaultRunConfig()
+	WithParticipants(participants)(cfg)
+	WithValidatorCountAutoFix()(cfg)
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 32, config.TotalValidatorCount(ethConfig))
+}
+
+func TestWithFanoutLimit(t *testing.T) {
+	cfg := defaultRunConfig()
+
+	opt := WithFanoutLimit(4)
+	opt(cfg)
+
+	assert.Equal(t, 4, cfg.FanoutLimit)
+}
+
+func TestOptions(t *testing.T) {
+	cfg := defaultRunConfig()
+	Options(
+		WithEnclaveName("first"),
+		nil,
+		Options(WithParallelism(8), WithEnclaveName("second")),
+	)(cfg)
+
+	assert.Equal(t, "second", cfg.EnclaveName)
+	assert.Equal(t, 8, cfg.Parallelism)
+}
+
+func TestCI(t *testing.T) {
+	cfg := defaultRunConfig()
+	Options(CI(), WithParallelism(1))(cfg)
+
+	assert.True(t, cfg.FastDevnet)
+	assert.True(t, cfg.WaitForGenesis)
+	assert.Equal(t, 4, cfg.FanoutLimit)
+	assert.Equal(t, 1, cfg.Parallelism, "options after a bundle override it")
+
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+	for _, p := range ethConfig.Participants {
+		assert.Equal(t, ciClientMaxCPU, p.ELMaxCPU)
+		assert.Equal(t, ciClientMaxMem, p.CLMaxMem)
+	}
+}
+
+func TestWithClientResourceLimits(t *testing.T) {
+	participants := []config.ParticipantConfig{
+		{ELType: client.Geth, CLType: client.Lighthouse},
+		{ELType: client.Besu, CLType: client.Teku, ELMaxMem: 8192, CLMinMem: 6144},
+	}
+
+	cfg := defaultRunConfig()
+	WithParticipants(participants)(cfg)
+	WithClientResourceLimits(1000, 4096)(cfg)
+	ethConfig, err := buildEthereumConfig(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1000, ethConfig.Participants[0].ELMaxCPU)
+	assert.Equal(t, 4096, ethConfig.Participants[0].ELMaxMem)
+	assert.Equal(t, 1000, ethConfig.Participants[0].CLMaxCPU)
+	assert.Equal(t, 4096, ethConfig.Participants[0].CLMaxMem)
+
+	// Own limits and larger requests are kept
+	assert.Equal(t, 8192, ethConfig.Participants[1].ELMaxMem)
+	assert.Zero(t, ethConfig.Participants[1].CLMaxMem)
+	assert.Zero(t, participants[0].ELMaxCPU, "caller's participants must not change")
+}
+
+func TestInterop(t *testing.T) {
+	cfg := defaultRunConfig()
+	Interop()(cfg)
+
+	assert.Equal(t, config.NewPresetConfigSource(config.PresetAllClientsMatrix), cfg.ConfigSource)
+	assert.Len(t, cfg.AdditionalServices, 3)
+	assert.True(t, cfg.WaitForGenesis)
+}
@@ -0,0 +1,132 @@
+// Package artifacts keeps the directory that devnet runs write reports, log
+// dumps, packet captures, snapshots and dashboards to from growing without
+// bound, by pruning its oldest entries by age and total size.
+package artifacts
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// RetentionPolicy bounds what an artifacts directory keeps. Each top-level
+// entry, a file or a run's directory, is kept or removed as a whole. Zero
+// fields disable their limit.
+type RetentionPolicy struct {
+	// MaxAge removes entries last modified longer ago
+	MaxAge time.Duration
+	// MaxSize in bytes removes the oldest entries until the directory fits
+	MaxSize int64
+}
+
+// Enabled reports whether the policy limits anything
+func (p RetentionPolicy) Enabled() bool {
+	return p.MaxAge > 0 || p.MaxSize > 0
+}
+
+// PruneResult describes what a prune removed
+type PruneResult struct {
+	// Removed lists the paths of the removed entries, oldest first
+	Removed []string
+	// Freed is the size of the removed entries in bytes
+	Freed int64
+	// Size is the size of what remains in bytes
+	Size int64
+}
+
+// entry is a top-level file or directory of an artifacts directory
+type entry struct {
+	path     string
+	size     int64
+	files    int
+	modified time.Time
+}
+
+// Prune applies the policy to dir. A missing directory has nothing to prune.
+// Entries that cannot be removed are reported in the error and still count
+// toward the remaining size.
+func Prune(dir string, policy RetentionPolicy) (*PruneResult, error) {
+	result := &PruneResult{}
+	if !policy.Enabled() {
+		return result, nil
+	}
+
+	entries, err := scan(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		result.Size += e.size
+	}
+
+	// Oldest first, so age and size limits both drop the oldest runs
+	sort.Slice(entries, func(i, j int) bool { return entries[i].modified.Before(entries[j].modified) })
+
+	cutoff := time.Now().Add(-policy.MaxAge)
+	var errs []error
+	for _, e := range entries {
+		expired := policy.MaxAge > 0 && e.modified.Before(cutoff)
+		oversized := policy.MaxSize > 0 && result.Size > policy.MaxSize
+		if !expired && !oversized {
+			continue
+		}
+		if err := os.RemoveAll(e.path); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove %s: %w", e.path, err))
+			continue
+		}
+		result.Removed = append(result.Removed, e.path)
+		result.Freed += e.size
+		result.Size -= e.size
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// scan returns the top-level entries of dir with their total size and latest
+// modification time
+func scan(dir string) ([]entry, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifacts directory: %w", err)
+	}
+
+	entries := make([]entry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		e := entry{path: filepath.Join(dir, dirEntry.Name())}
+		err := filepath.WalkDir(e.path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			// Directories only date themselves when they hold no files
+			if d.IsDir() && path != e.path {
+				return nil
+			}
+			if !d.IsDir() {
+				e.size += info.Size()
+				if e.files == 0 || info.ModTime().After(e.modified) {
+					e.modified = info.ModTime()
+				}
+				e.files++
+			} else if e.files == 0 {
+				e.modified = info.ModTime()
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", e.path, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
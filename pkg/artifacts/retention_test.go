@@ -0,0 +1,69 @@
+package artifacts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeArtifact creates path with size bytes, last modified age ago
+func writeArtifact(t *testing.T, path string, size int, age time.Duration) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, make([]byte, size), 0o644))
+	modified := time.Now().Add(-age)
+	require.NoError(t, os.Chtimes(path, modified, modified))
+}
+
+func TestPruneMaxAge(t *testing.T) {
+	dir := t.TempDir()
+	writeArtifact(t, filepath.Join(dir, "old.pcap"), 100, 48*time.Hour)
+	writeArtifact(t, filepath.Join(dir, "run-1", "report.xml"), 10, 30*time.Hour)
+	// A recently touched file keeps its whole run directory
+	writeArtifact(t, filepath.Join(dir, "run-2", "logs.txt"), 10, 30*time.Hour)
+	writeArtifact(t, filepath.Join(dir, "run-2", "snapshot.json"), 10, time.Hour)
+
+	result, err := Prune(dir, RetentionPolicy{MaxAge: 24 * time.Hour})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{filepath.Join(dir, "old.pcap"), filepath.Join(dir, "run-1")}, result.Removed)
+	assert.Equal(t, int64(110), result.Freed)
+	assert.Equal(t, int64(20), result.Size)
+	assert.DirExists(t, filepath.Join(dir, "run-2"))
+	assert.NoDirExists(t, filepath.Join(dir, "run-1"))
+}
+
+func TestPruneMaxSize(t *testing.T) {
+	dir := t.TempDir()
+	writeArtifact(t, filepath.Join(dir, "run-1", "a.png"), 400, 3*time.Hour)
+	writeArtifact(t, filepath.Join(dir, "run-2", "a.png"), 400, 2*time.Hour)
+	writeArtifact(t, filepath.Join(dir, "run-3", "a.png"), 400, time.Hour)
+
+	result, err := Prune(dir, RetentionPolicy{MaxSize: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "run-1")}, result.Removed)
+	assert.Equal(t, int64(800), result.Size)
+
+	// Within the limits nothing is removed
+	result, err = Prune(dir, RetentionPolicy{MaxSize: 1000, MaxAge: 24 * time.Hour})
+	require.NoError(t, err)
+	assert.Empty(t, result.Removed)
+}
+
+func TestPruneDisabled(t *testing.T) {
+	dir := t.TempDir()
+	writeArtifact(t, filepath.Join(dir, "old.pcap"), 100, 48*time.Hour)
+
+	result, err := Prune(dir, RetentionPolicy{})
+	require.NoError(t, err)
+	assert.Empty(t, result.Removed)
+	assert.FileExists(t, filepath.Join(dir, "old.pcap"))
+
+	// A missing directory has nothing to prune
+	result, err = Prune(filepath.Join(dir, "missing"), RetentionPolicy{MaxAge: time.Hour})
+	require.NoError(t, err)
+	assert.Zero(t, result.Size)
+}
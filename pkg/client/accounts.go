@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Balance returns the balance in wei of an address at a block, or at the latest block for nil
+func (b *BaseExecutionClient) Balance(ctx context.Context, address string, block *uint64) (*big.Int, error) {
+	var result string
+	if err := b.call(ctx, "eth_getBalance", []interface{}{address, blockTag(block)}, &result); err != nil {
+		return nil, fmt.Errorf("failed to get balance of %s: %w", address, err)
+	}
+	return parseHexBig(result)
+}
+
+// PendingNonce returns the next nonce of an address, counting pending transactions
+func (b *BaseExecutionClient) PendingNonce(ctx context.Context, address string) (uint64, error) {
+	var result string
+	if err := b.call(ctx, "eth_getTransactionCount", []interface{}{address, "pending"}, &result); err != nil {
+		return 0, fmt.Errorf("failed to get nonce of %s: %w", address, err)
+	}
+	return parseHexUint64(result)
+}
+
+// GasPrice returns the node's suggested gas price in wei
+func (b *BaseExecutionClient) GasPrice(ctx context.Context) (*big.Int, error) {
+	var result string
+	if err := b.call(ctx, "eth_gasPrice", []interface{}{}, &result); err != nil {
+		return nil, fmt.Errorf("failed to get gas price: %w", err)
+	}
+	return parseHexBig(result)
+}
+
+// BlobBaseFee returns the blob base fee of the next block in wei
+func (b *BaseExecutionClient) BlobBaseFee(ctx context.Context) (*big.Int, error) {
+	var result string
+	if err := b.call(ctx, "eth_blobBaseFee", []interface{}{}, &result); err != nil {
+		return nil, fmt.Errorf("failed to get blob base fee: %w", err)
+	}
+	return parseHexBig(result)
+}
+
+// Code returns the code deployed at an address at the latest block, "0x" for none
+func (b *BaseExecutionClient) Code(ctx context.Context, address string) (string, error) {
+	var code string
+	if err := b.call(ctx, "eth_getCode", []interface{}{address, "latest"}, &code); err != nil {
+		return "", fmt.Errorf("failed to get code of %s: %w", address, err)
+	}
+	return code, nil
+}
+
+// EstimateGas estimates the gas a transaction from the address needs. An empty
+// recipient estimates a contract creation.
+func (b *BaseExecutionClient) EstimateGas(ctx context.Context, from string, tx TransactionRequest) (uint64, error) {
+	call := map[string]string{"from": from}
+	if tx.To != "" {
+		call["to"] = tx.To
+	}
+	if tx.Value != nil {
+		call["value"] = fmt.Sprintf("0x%x", tx.Value)
+	}
+	if tx.Data != "" {
+		call["data"] = tx.Data
+	}
+	var result string
+	if err := b.call(ctx, "eth_estimateGas", []interface{}{call}, &result); err != nil {
+		return 0, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	return parseHexUint64(result)
+}
+
+// parseHexBig parses a 0x-prefixed quantity of any size
+func parseHexBig(value string) (*big.Int, error) {
+	digits := strings.TrimPrefix(value, "0x")
+	if digits == "" {
+		return new(big.Int), nil
+	}
+	n, ok := new(big.Int).SetString(digits, 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid quantity %q", value)
+	}
+	return n, nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
 	eth2http "github.com/attestantio/go-eth2-client/http"
@@ -22,15 +21,13 @@ func GetAttestantClient(ctx context.Context, client ConsensusClient) (eth2client
 	}
 
 	// Create HTTP client with reasonable timeout
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
-	}
+	httpClient := client.Credentials().Wrap(NewHTTPClient(client.RPCTimeout(), client.TLSConfig()))
 
 	// Create attestant client
 	attestantClient, err := eth2http.New(ctx,
 		eth2http.WithAddress(beaconURL),
 		eth2http.WithHTTPClient(httpClient),
-		eth2http.WithTimeout(30*time.Second),
+		eth2http.WithTimeout(client.RPCTimeout()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create attestant client: %w", err)
@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// BeaconBlockHeader is the header of a beacon block
+type BeaconBlockHeader struct {
+	Slot          uint64
+	ProposerIndex uint64
+	Root          string
+	ParentRoot    string
+	StateRoot     string
+	BodyRoot      string
+	// Canonical reports whether the block is on the node's canonical chain
+	Canonical bool
+}
+
+// Validator is a validator of the head state
+type Validator struct {
+	Index uint64
+	// Balance and EffectiveBalance are in Gwei
+	Balance          uint64
+	EffectiveBalance uint64
+	// Status is the validator status, e.g. "active_ongoing" or "exited_slashed"
+	Status                     string
+	Pubkey                     string
+	WithdrawalCredentials      string
+	Slashed                    bool
+	ActivationEligibilityEpoch uint64
+	ActivationEpoch            uint64
+	ExitEpoch                  uint64
+	WithdrawableEpoch          uint64
+}
+
+// SyncStatus is the sync state of a beacon node
+type SyncStatus struct {
+	HeadSlot     uint64
+	SyncDistance uint64
+	IsSyncing    bool
+	IsOptimistic bool
+	// ELOffline reports whether the node lost its execution client
+	ELOffline bool
+}
+
+// Spec is the chain configuration of a beacon node, keyed by the consensus
+// spec's constant names. Values that are not strings in the API, such as
+// schedules, are kept as raw JSON.
+type Spec map[string]string
+
+// Uint64 returns the named constant as an integer
+func (s Spec) Uint64(name string) (uint64, error) {
+	value, ok := s[name]
+	if !ok {
+		return 0, fmt.Errorf("spec has no %s", name)
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+	return n, nil
+}
+
+// BeaconBlockHeader returns the header of a block by block ID: "head",
+// "genesis", "finalized", a slot or a 0x-prefixed block root.
+// ErrBlockNotFound is returned if there is no such block.
+func (c *ConsensusClientImpl) BeaconBlockHeader(ctx context.Context, blockID string) (*BeaconBlockHeader, error) {
+	var response struct {
+		Data struct {
+			Root      string `json:"root"`
+			Canonical bool   `json:"canonical"`
+			Header    struct {
+				Message struct {
+					Slot          string `json:"slot"`
+					ProposerIndex string `json:"proposer_index"`
+					ParentRoot    string `json:"parent_root"`
+					StateRoot     string `json:"state_root"`
+					BodyRoot      string `json:"body_root"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/beacon/headers/"+url.PathEscape(blockID), &response); err != nil {
+		var statusErr *beaconStatusError
+		if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrBlockNotFound, blockID)
+		}
+		return nil, err
+	}
+
+	message := response.Data.Header.Message
+	slot, err := strconv.ParseUint(message.Slot, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid slot %q: %w", message.Slot, err)
+	}
+	proposer, err := strconv.ParseUint(message.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposer index %q: %w", message.ProposerIndex, err)
+	}
+
+	return &BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposer,
+		Root:          response.Data.Root,
+		ParentRoot:    message.ParentRoot,
+		StateRoot:     message.StateRoot,
+		BodyRoot:      message.BodyRoot,
+		Canonical:     response.Data.Canonical,
+	}, nil
+}
+
+// Validators returns the validators of the head state with the given indices
+// or 0x-prefixed pubkeys, or every validator when no IDs are given. Unknown
+// IDs are left out.
+func (c *ConsensusClientImpl) Validators(ctx context.Context, ids ...string) ([]Validator, error) {
+	var response struct {
+		Data []struct {
+			Index     string `json:"index"`
+			Balance   string `json:"balance"`
+			Status    string `json:"status"`
+			Validator struct {
+				Pubkey                     string `json:"pubkey"`
+				WithdrawalCredentials      string `json:"withdrawal_credentials"`
+				EffectiveBalance           string `json:"effective_balance"`
+				Slashed                    bool   `json:"slashed"`
+				ActivationEligibilityEpoch string `json:"activation_eligibility_epoch"`
+				ActivationEpoch            string `json:"activation_epoch"`
+				ExitEpoch                  string `json:"exit_epoch"`
+				WithdrawableEpoch          string `json:"withdrawable_epoch"`
+			} `json:"validator"`
+		} `json:"data"`
+	}
+
+	path := "/eth/v1/beacon/states/head/validators"
+	if len(ids) > 0 {
+		path += "?id=" + url.QueryEscape(strings.Join(ids, ","))
+	}
+	if err := c.getBeaconJSON(ctx, path, &response); err != nil {
+		return nil, err
+	}
+
+	validators := make([]Validator, len(response.Data))
+	for i, data := range response.Data {
+		v := &validators[i]
+		v.Status = data.Status
+		v.Pubkey = data.Validator.Pubkey
+		v.WithdrawalCredentials = data.Validator.WithdrawalCredentials
+		v.Slashed = data.Validator.Slashed
+		for _, field := range []struct {
+			name string
+			in   string
+			out  *uint64
+		}{
+			{"validator index", data.Index, &v.Index},
+			{"balance", data.Balance, &v.Balance},
+			{"effective balance", data.Validator.EffectiveBalance, &v.EffectiveBalance},
+			{"activation eligibility epoch", data.Validator.ActivationEligibilityEpoch, &v.ActivationEligibilityEpoch},
+			{"activation epoch", data.Validator.ActivationEpoch, &v.ActivationEpoch},
+			{"exit epoch", data.Validator.ExitEpoch, &v.ExitEpoch},
+			{"withdrawable epoch", data.Validator.WithdrawableEpoch, &v.WithdrawableEpoch},
+		} {
+			n, err := strconv.ParseUint(field.in, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %w", field.name, field.in, err)
+			}
+			*field.out = n
+		}
+	}
+
+	return validators, nil
+}
+
+// SyncStatus returns whether the node is syncing and how far behind it is
+func (c *ConsensusClientImpl) SyncStatus(ctx context.Context) (*SyncStatus, error) {
+	var response struct {
+		Data struct {
+			HeadSlot     string `json:"head_slot"`
+			SyncDistance string `json:"sync_distance"`
+			IsSyncing    bool   `json:"is_syncing"`
+			IsOptimistic bool   `json:"is_optimistic"`
+			ELOffline    bool   `json:"el_offline"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/node/syncing", &response); err != nil {
+		return nil, err
+	}
+
+	headSlot, err := strconv.ParseUint(response.Data.HeadSlot, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid head slot %q: %w", response.Data.HeadSlot, err)
+	}
+	distance, err := strconv.ParseUint(response.Data.SyncDistance, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync distance %q: %w", response.Data.SyncDistance, err)
+	}
+
+	return &SyncStatus{
+		HeadSlot:     headSlot,
+		SyncDistance: distance,
+		IsSyncing:    response.Data.IsSyncing,
+		IsOptimistic: response.Data.IsOptimistic,
+		ELOffline:    response.Data.ELOffline,
+	}, nil
+}
+
+// ForkSchedule returns every fork the node knows of, in activation order
+func (c *ConsensusClientImpl) ForkSchedule(ctx context.Context) ([]Fork, error) {
+	var response struct {
+		Data []struct {
+			PreviousVersion string `json:"previous_version"`
+			CurrentVersion  string `json:"current_version"`
+			Epoch           string `json:"epoch"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/config/fork_schedule", &response); err != nil {
+		return nil, err
+	}
+
+	forks := make([]Fork, len(response.Data))
+	for i, data := range response.Data {
+		epoch, err := strconv.ParseUint(data.Epoch, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fork epoch %q: %w", data.Epoch, err)
+		}
+		forks[i] = Fork{PreviousVersion: data.PreviousVersion, CurrentVersion: data.CurrentVersion, Epoch: epoch}
+	}
+
+	return forks, nil
+}
+
+// Spec returns the node's chain configuration
+func (c *ConsensusClientImpl) Spec(ctx context.Context) (Spec, error) {
+	var response struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/config/spec", &response); err != nil {
+		return nil, err
+	}
+
+	spec := make(Spec, len(response.Data))
+	for name, raw := range response.Data {
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			value = string(raw)
+		}
+		spec[name] = value
+	}
+
+	return spec, nil
+}
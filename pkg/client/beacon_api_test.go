@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBeaconAPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/headers/head":
+			_, _ = w.Write([]byte(`{"data":{"root":"0xaa","canonical":true,"header":{"message":{"slot":"42","proposer_index":"3","parent_root":"0xbb","state_root":"0xcc","body_root":"0xdd"}}}}`))
+		case "/eth/v1/beacon/states/head/validators":
+			assert.Equal(t, "1,0xa1", r.URL.Query().Get("id"))
+			_, _ = w.Write([]byte(`{"data":[{"index":"1","balance":"32000100000","status":"active_ongoing","validator":{"pubkey":"0xa1","withdrawal_credentials":"0x01","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}]}`))
+		case "/eth/v1/node/syncing":
+			_, _ = w.Write([]byte(`{"data":{"head_slot":"42","sync_distance":"2","is_syncing":true,"is_optimistic":false,"el_offline":false}}`))
+		case "/eth/v1/config/fork_schedule":
+			_, _ = w.Write([]byte(`{"data":[{"previous_version":"0x10000038","current_version":"0x10000038","epoch":"0"},{"previous_version":"0x10000038","current_version":"0x40000038","epoch":"2"}]}`))
+		case "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(`{"data":{"SECONDS_PER_SLOT":"12","CONFIG_NAME":"testnet","BLOB_SCHEDULE":[{"EPOCH":"0","MAX_BLOBS_PER_BLOCK":"9"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestConsensusClient_BeaconBlockHeader(t *testing.T) {
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", newBeaconAPIServer(t).URL, "", "", "", "", "", 0)
+
+	header, err := c.BeaconBlockHeader(context.Background(), "head")
+	require.NoError(t, err)
+	assert.Equal(t, &BeaconBlockHeader{
+		Slot:          42,
+		ProposerIndex: 3,
+		Root:          "0xaa",
+		ParentRoot:    "0xbb",
+		StateRoot:     "0xcc",
+		BodyRoot:      "0xdd",
+		Canonical:     true,
+	}, header)
+
+	_, err = c.BeaconBlockHeader(context.Background(), "41")
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+}
+
+func TestConsensusClient_Validators(t *testing.T) {
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", newBeaconAPIServer(t).URL, "", "", "", "", "", 0)
+
+	validators, err := c.Validators(context.Background(), "1", "0xa1")
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	assert.Equal(t, uint64(1), validators[0].Index)
+	assert.Equal(t, uint64(32000100000), validators[0].Balance)
+	assert.Equal(t, uint64(32000000000), validators[0].EffectiveBalance)
+	assert.Equal(t, "active_ongoing", validators[0].Status)
+	assert.Equal(t, uint64(18446744073709551615), validators[0].ExitEpoch)
+}
+
+func TestConsensusClient_SyncStatus(t *testing.T) {
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", newBeaconAPIServer(t).URL, "", "", "", "", "", 0)
+
+	status, err := c.SyncStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &SyncStatus{HeadSlot: 42, SyncDistance: 2, IsSyncing: true}, status)
+}
+
+func TestConsensusClient_ForkScheduleAndSpec(t *testing.T) {
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", newBeaconAPIServer(t).URL, "", "", "", "", "", 0)
+
+	forks, err := c.ForkSchedule(context.Background())
+	require.NoError(t, err)
+	require.Len(t, forks, 2)
+	assert.Equal(t, Fork{PreviousVersion: "0x10000038", CurrentVersion: "0x40000038", Epoch: 2}, forks[1])
+
+	spec, err := c.Spec(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "testnet", spec["CONFIG_NAME"])
+	assert.JSONEq(t, `[{"EPOCH":"0","MAX_BLOBS_PER_BLOCK":"9"}]`, spec["BLOB_SCHEDULE"])
+
+	secondsPerSlot, err := spec.Uint64("SECONDS_PER_SLOT")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), secondsPerSlot)
+	_, err = spec.Uint64("CONFIG_NAME")
+	assert.Error(t, err)
+	_, err = spec.Uint64("MISSING")
+	assert.ErrorContains(t, err, "spec has no MISSING")
+}
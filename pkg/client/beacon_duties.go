@@ -0,0 +1,266 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrBlockNotFound is returned when no block exists for the requested slot
+var ErrBlockNotFound = errors.New("block not found")
+
+// ProposerDuty is a validator's assignment to propose the block of a slot
+type ProposerDuty struct {
+	Pubkey         string
+	ValidatorIndex uint64
+	Slot           uint64
+}
+
+// ProposerDuties returns the proposer duties for every slot in the epoch
+func (c *ConsensusClientImpl) ProposerDuties(ctx context.Context, epoch uint64) ([]ProposerDuty, error) {
+	var response struct {
+		Data []struct {
+			Pubkey         string `json:"pubkey"`
+			ValidatorIndex string `json:"validator_index"`
+			Slot           string `json:"slot"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), &response); err != nil {
+		return nil, err
+	}
+
+	duties := make([]ProposerDuty, len(response.Data))
+	for i, data := range response.Data {
+		validatorIndex, err := strconv.ParseUint(data.ValidatorIndex, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator index %q: %w", data.ValidatorIndex, err)
+		}
+		slot, err := strconv.ParseUint(data.Slot, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slot %q: %w", data.Slot, err)
+		}
+		duties[i] = ProposerDuty{Pubkey: data.Pubkey, ValidatorIndex: validatorIndex, Slot: slot}
+	}
+
+	return duties, nil
+}
+
+// BlockProposer returns the proposer index of the canonical block at the slot.
+// ErrBlockNotFound is returned if the slot was missed.
+func (c *ConsensusClientImpl) BlockProposer(ctx context.Context, slot uint64) (uint64, error) {
+	var response struct {
+		Data struct {
+			Header struct {
+				Message struct {
+					ProposerIndex string `json:"proposer_index"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, fmt.Sprintf("/eth/v1/beacon/headers/%d", slot), &response); err != nil {
+		var statusErr *beaconStatusError
+		if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound {
+			return 0, fmt.Errorf("%w at slot %d", ErrBlockNotFound, slot)
+		}
+		return 0, err
+	}
+
+	proposer, err := strconv.ParseUint(response.Data.Header.Message.ProposerIndex, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid proposer index %q: %w", response.Data.Header.Message.ProposerIndex, err)
+	}
+
+	return proposer, nil
+}
+
+// BlockPayment is who proposed the canonical block of a slot and who its
+// execution payload pays
+type BlockPayment struct {
+	Slot          uint64
+	ProposerIndex uint64
+	// BlockNumber and BlockHash identify the execution payload
+	BlockNumber  uint64
+	BlockHash    string
+	FeeRecipient string
+	// ExtraData is the payload's decoded extra data, which block builders and
+	// execution clients use to sign their blocks
+	ExtraData string
+}
+
+// BlockPayment returns the proposer and fee recipient of the canonical block at the slot.
+// ErrBlockNotFound is returned if the slot was missed.
+func (c *ConsensusClientImpl) BlockPayment(ctx context.Context, slot uint64) (*BlockPayment, error) {
+	var response struct {
+		Data struct {
+			Message struct {
+				ProposerIndex string `json:"proposer_index"`
+				Body          struct {
+					ExecutionPayload *struct {
+						BlockNumber  string `json:"block_number"`
+						BlockHash    string `json:"block_hash"`
+						FeeRecipient string `json:"fee_recipient"`
+						ExtraData    string `json:"extra_data"`
+					} `json:"execution_payload"`
+				} `json:"body"`
+			} `json:"message"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%d", slot), &response); err != nil {
+		var statusErr *beaconStatusError
+		if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w at slot %d", ErrBlockNotFound, slot)
+		}
+		return nil, err
+	}
+
+	message := response.Data.Message
+	proposer, err := strconv.ParseUint(message.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposer index %q: %w", message.ProposerIndex, err)
+	}
+	payload := message.Body.ExecutionPayload
+	if payload == nil {
+		return nil, fmt.Errorf("block at slot %d has no execution payload", slot)
+	}
+	number, err := strconv.ParseUint(payload.BlockNumber, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block number %q: %w", payload.BlockNumber, err)
+	}
+
+	extraData, err := hex.DecodeString(strings.TrimPrefix(payload.ExtraData, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid extra data %q: %w", payload.ExtraData, err)
+	}
+
+	return &BlockPayment{
+		Slot:          slot,
+		ProposerIndex: proposer,
+		BlockNumber:   number,
+		BlockHash:     payload.BlockHash,
+		FeeRecipient:  payload.FeeRecipient,
+		ExtraData:     string(extraData),
+	}, nil
+}
+
+// GenesisTime returns the chain's genesis time
+func (c *ConsensusClientImpl) GenesisTime(ctx context.Context) (time.Time, error) {
+	var response struct {
+		Data struct {
+			GenesisTime string `json:"genesis_time"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/beacon/genesis", &response); err != nil {
+		return time.Time{}, err
+	}
+
+	seconds, err := strconv.ParseInt(response.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid genesis time %q: %w", response.Data.GenesisTime, err)
+	}
+
+	return time.Unix(seconds, 0), nil
+}
+
+// SecondsPerSlot returns the slot duration from the chain spec
+func (c *ConsensusClientImpl) SecondsPerSlot(ctx context.Context) (time.Duration, error) {
+	var response struct {
+		Data struct {
+			SecondsPerSlot string `json:"SECONDS_PER_SLOT"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/config/spec", &response); err != nil {
+		return 0, err
+	}
+
+	seconds, err := strconv.ParseUint(response.Data.SecondsPerSlot, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid SECONDS_PER_SLOT %q: %w", response.Data.SecondsPerSlot, err)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// Attestation is an attestation included in a block
+type Attestation struct {
+	// Slot is the slot being attested to
+	Slot uint64
+	// CommitteeIndex is the attesting committee before Electra
+	CommitteeIndex uint64
+	// CommitteeBits lists the attesting committees from Electra onwards, in ascending order
+	CommitteeBits []uint64
+	// AggregationBits is the SSZ-encoded bitlist of attesting committee members
+	AggregationBits []byte
+}
+
+// Attested reports whether the committee member at position i is set in the aggregation bits
+func (a Attestation) Attested(i int) bool {
+	if i < 0 || i/8 >= len(a.AggregationBits) {
+		return false
+	}
+	return a.AggregationBits[i/8]&(1<<(i%8)) != 0
+}
+
+// BlockAttestations returns the attestations included in the canonical block at the slot.
+// ErrBlockNotFound is returned if the slot was missed.
+func (c *ConsensusClientImpl) BlockAttestations(ctx context.Context, slot uint64) ([]Attestation, error) {
+	var response struct {
+		Data []struct {
+			AggregationBits string `json:"aggregation_bits"`
+			CommitteeBits   string `json:"committee_bits"`
+			Data            struct {
+				Slot  string `json:"slot"`
+				Index string `json:"index"`
+			} `json:"data"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%d/attestations", slot), &response); err != nil {
+		var statusErr *beaconStatusError
+		if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w at slot %d", ErrBlockNotFound, slot)
+		}
+		return nil, err
+	}
+
+	attestations := make([]Attestation, len(response.Data))
+	for i, data := range response.Data {
+		attSlot, err := strconv.ParseUint(data.Data.Slot, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attestation slot %q: %w", data.Data.Slot, err)
+		}
+		index, err := strconv.ParseUint(data.Data.Index, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid committee index %q: %w", data.Data.Index, err)
+		}
+		aggregationBits, err := hex.DecodeString(strings.TrimPrefix(data.AggregationBits, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid aggregation bits %q: %w", data.AggregationBits, err)
+		}
+
+		attestation := Attestation{Slot: attSlot, CommitteeIndex: index, AggregationBits: aggregationBits}
+		if data.CommitteeBits != "" {
+			committeeBits, err := hex.DecodeString(strings.TrimPrefix(data.CommitteeBits, "0x"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid committee bits %q: %w", data.CommitteeBits, err)
+			}
+			for bit := 0; bit < len(committeeBits)*8; bit++ {
+				if committeeBits[bit/8]&(1<<(bit%8)) != 0 {
+					attestation.CommitteeBits = append(attestation.CommitteeBits, uint64(bit))
+				}
+			}
+		}
+		attestations[i] = attestation
+	}
+
+	return attestations, nil
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// HeadEvent is a head event from the beacon node event stream
+type HeadEvent struct {
+	Slot            uint64
+	Block           string
+	State           string
+	EpochTransition bool
+}
+
+// SubscribeHeads streams the head events of the beacon node. Both channels are
+// closed when the context is done or the stream ends; a stream that ends
+// before the context is done reports why on the error channel.
+func (c *ConsensusClientImpl) SubscribeHeads(ctx context.Context) (<-chan HeadEvent, <-chan error) {
+	events := make(chan HeadEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		err := c.streamEvents(ctx, "head", func(data []byte) error {
+			var raw struct {
+				Slot            string `json:"slot"`
+				Block           string `json:"block"`
+				State           string `json:"state"`
+				EpochTransition bool   `json:"epoch_transition"`
+			}
+			if err := json.Unmarshal(data, &raw); err != nil {
+				return fmt.Errorf("invalid head event: %w", err)
+			}
+			slot, err := strconv.ParseUint(raw.Slot, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid head event slot %q: %w", raw.Slot, err)
+			}
+
+			select {
+			case events <- HeadEvent{Slot: slot, Block: raw.Block, State: raw.State, EpochTransition: raw.EpochTransition}:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+
+	return events, errs
+}
+
+// streamEvents subscribes to a topic of the beacon event stream and calls handle
+// with the data of every event until the stream ends or handle fails
+func (c *ConsensusClientImpl) streamEvents(ctx context.Context, topic string, handle func(data []byte) error) error {
+	if c.BeaconAPIURL() == "" {
+		return fmt.Errorf("beacon API URL is empty")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BeaconAPIURL()+"/eth/v1/events?topics="+topic, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	// The stream is long-lived, so only the context bounds it
+	resp, err := c.httpClient(0).Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s events: %w", topic, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("beacon API returned status %d for %s events", resp.StatusCode, topic)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			// A blank line ends an event
+			if data.Len() > 0 {
+				if err := handle([]byte(data.String())); err != nil {
+					return err
+				}
+				data.Reset()
+			}
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%s event stream failed: %w", topic, err)
+	}
+	return fmt.Errorf("%s event stream closed", topic)
+}
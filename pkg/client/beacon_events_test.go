@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusClient_SubscribeHeads(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/events", r.URL.Path)
+		assert.Equal(t, "head", r.URL.Query().Get("topics"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		for slot := 1; slot <= 2; slot++ {
+			fmt.Fprintf(w, "event: head\ndata: {\"slot\":\"%d\",\"block\":\"0x%d\",\"state\":\"0xs%d\",\"epoch_transition\":%t}\n\n", slot, slot, slot, slot == 2)
+		}
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c := NewConsensusClient(Lighthouse, "cl-1", "", server.URL, "", "", "", "cl-1", "", 0)
+	events, errs := c.SubscribeHeads(ctx)
+
+	first := <-events
+	assert.Equal(t, HeadEvent{Slot: 1, Block: "0x1", State: "0xs1"}, first)
+	second := <-events
+	assert.Equal(t, uint64(2), second.Slot)
+	assert.True(t, second.EpochTransition)
+
+	cancel()
+	for range events {
+	}
+	_, open := <-errs
+	assert.False(t, open)
+}
+
+func TestConsensusClient_SubscribeHeadsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no events", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1", "", server.URL, "", "", "", "cl-1", "", 0)
+	events, errs := c.SubscribeHeads(context.Background())
+
+	for range events {
+	}
+	err := <-errs
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 404")
+}
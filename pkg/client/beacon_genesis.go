@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Genesis holds the chain's genesis parameters. The validators root and fork
+// version are inputs to every signing domain.
+type Genesis struct {
+	Time time.Time
+	// ValidatorsRoot is the 0x-prefixed genesis validators root
+	ValidatorsRoot string
+	// ForkVersion is the 0x-prefixed genesis fork version
+	ForkVersion string
+}
+
+// Fork is the fork of the head state
+type Fork struct {
+	PreviousVersion string
+	CurrentVersion  string
+	// Epoch is the epoch the current version activated at
+	Epoch uint64
+}
+
+// Genesis returns the chain's genesis time, validators root and fork version
+func (c *ConsensusClientImpl) Genesis(ctx context.Context) (*Genesis, error) {
+	var response struct {
+		Data struct {
+			GenesisTime           string `json:"genesis_time"`
+			GenesisValidatorsRoot string `json:"genesis_validators_root"`
+			GenesisForkVersion    string `json:"genesis_fork_version"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/beacon/genesis", &response); err != nil {
+		return nil, err
+	}
+
+	seconds, err := strconv.ParseInt(response.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid genesis time %q: %w", response.Data.GenesisTime, err)
+	}
+
+	return &Genesis{
+		Time:           time.Unix(seconds, 0),
+		ValidatorsRoot: response.Data.GenesisValidatorsRoot,
+		ForkVersion:    response.Data.GenesisForkVersion,
+	}, nil
+}
+
+// Fork returns the fork of the head state
+func (c *ConsensusClientImpl) Fork(ctx context.Context) (*Fork, error) {
+	var response struct {
+		Data struct {
+			PreviousVersion string `json:"previous_version"`
+			CurrentVersion  string `json:"current_version"`
+			Epoch           string `json:"epoch"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/beacon/states/head/fork", &response); err != nil {
+		return nil, err
+	}
+
+	epoch, err := strconv.ParseUint(response.Data.Epoch, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fork epoch %q: %w", response.Data.Epoch, err)
+	}
+
+	return &Fork{
+		PreviousVersion: response.Data.PreviousVersion,
+		CurrentVersion:  response.Data.CurrentVersion,
+		Epoch:           epoch,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testValidatorsRoot = "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+
+func newBeaconGenesisServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			_, _ = w.Write([]byte(`{"data":{"genesis_time":"1700000000","genesis_validators_root":"` + testValidatorsRoot + `","genesis_fork_version":"0x10000038"}}`))
+		case "/eth/v1/beacon/states/head/fork":
+			_, _ = w.Write([]byte(`{"data":{"previous_version":"0x40000038","current_version":"0x50000038","epoch":"5"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestConsensusClient_Genesis(t *testing.T) {
+	server := newBeaconGenesisServer(t)
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "", "", 0)
+	genesis, err := c.Genesis(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000000, 0), genesis.Time)
+	assert.Equal(t, testValidatorsRoot, genesis.ValidatorsRoot)
+	assert.Equal(t, "0x10000038", genesis.ForkVersion)
+}
+
+func TestConsensusClient_Fork(t *testing.T) {
+	server := newBeaconGenesisServer(t)
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "", "", 0)
+	fork, err := c.Fork(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Fork{PreviousVersion: "0x40000038", CurrentVersion: "0x50000038", Epoch: 5}, fork)
+}
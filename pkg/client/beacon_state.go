@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Committee is a beacon committee assigned to a slot
+type Committee struct {
+	Index      uint64
+	Slot       uint64
+	Validators []uint64
+}
+
+// SyncCommittee is the sync committee for an epoch
+type SyncCommittee struct {
+	// Validators lists the committee members' validator indices
+	Validators []uint64
+	// ValidatorAggregates splits the members into subcommittees
+	ValidatorAggregates [][]uint64
+}
+
+// FinalityCheckpoints are the justified and finalized checkpoints of a state
+type FinalityCheckpoints struct {
+	PreviousJustified Checkpoint
+	CurrentJustified  Checkpoint
+	Finalized         Checkpoint
+}
+
+// FinalityCheckpoints returns the finality checkpoints of the head state
+func (c *ConsensusClientImpl) FinalityCheckpoints(ctx context.Context) (*FinalityCheckpoints, error) {
+	type checkpoint struct {
+		Epoch string `json:"epoch"`
+		Root  string `json:"root"`
+	}
+	var response struct {
+		Data struct {
+			PreviousJustified checkpoint `json:"previous_justified"`
+			CurrentJustified  checkpoint `json:"current_justified"`
+			Finalized         checkpoint `json:"finalized"`
+		} `json:"data"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", &response); err != nil {
+		return nil, err
+	}
+
+	var checkpoints FinalityCheckpoints
+	for _, field := range []struct {
+		name string
+		in   checkpoint
+		out  *Checkpoint
+	}{
+		{"previous justified", response.Data.PreviousJustified, &checkpoints.PreviousJustified},
+		{"current justified", response.Data.CurrentJustified, &checkpoints.CurrentJustified},
+		{"finalized", response.Data.Finalized, &checkpoints.Finalized},
+	} {
+		epoch, err := strconv.ParseUint(field.in.Epoch, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s epoch %q: %w", field.name, field.in.Epoch, err)
+		}
+		*field.out = Checkpoint{Epoch: epoch, Root: field.in.Root}
+	}
+
+	return &checkpoints, nil
+}
+
+// Committees returns the beacon committees of every slot in the epoch, read from the head state
+func (c *ConsensusClientImpl) Committees(ctx context.Context, epoch uint64) ([]Committee, error) {
+	var response struct {
+		Data []struct {
+			Index      string   `json:"index"`
+			Slot       string   `json:"slot"`
+			Validators []string `json:"validators"`
+		} `json:"data"`
+	}
+
+	path := fmt.Sprintf("/eth/v1/beacon/states/head/committees?epoch=%d", epoch)
+	if err := c.getBeaconJSON(ctx, path, &response); err != nil {
+		return nil, err
+	}
+
+	committees := make([]Committee, len(response.Data))
+	for i, data := range response.Data {
+		index, err := strconv.ParseUint(data.Index, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid committee index %q: %w", data.Index, err)
+		}
+		slot, err := strconv.ParseUint(data.Slot, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid committee slot %q: %w", data.Slot, err)
+		}
+		validators, err := parseValidatorIndices(data.Validators)
+		if err != nil {
+			return nil, err
+		}
+		committees[i] = Committee{Index: index, Slot: slot, Validators: validators}
+	}
+
+	return committees, nil
+}
+
+// SyncCommittee returns the sync committee for the epoch, read from the head state
+func (c *ConsensusClientImpl) SyncCommittee(ctx context.Context, epoch uint64) (*SyncCommittee, error) {
+	var response struct {
+		Data struct {
+			Validators          []string   `json:"validators"`
+			ValidatorAggregates [][]string `json:"validator_aggregates"`
+		} `json:"data"`
+	}
+
+	path := fmt.Sprintf("/eth/v1/beacon/states/head/sync_committees?epoch=%d", epoch)
+	if err := c.getBeaconJSON(ctx, path, &response); err != nil {
+		return nil, err
+	}
+
+	validators, err := parseValidatorIndices(response.Data.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	committee := &SyncCommittee{
+		Validators:          validators,
+		ValidatorAggregates: make([][]uint64, len(response.Data.ValidatorAggregates)),
+	}
+	for i, aggregate := range response.Data.ValidatorAggregates {
+		if committee.ValidatorAggregates[i], err = parseValidatorIndices(aggregate); err != nil {
+			return nil, err
+		}
+	}
+
+	return committee, nil
+}
+
+// ValidatorBalance returns the balance in Gwei of the validator with the given index at the head state
+func (c *ConsensusClientImpl) ValidatorBalance(ctx context.Context, index uint64) (uint64, error) {
+	var response struct {
+		Data []struct {
+			Index   string `json:"index"`
+			Balance string `json:"balance"`
+		} `json:"data"`
+	}
+
+	path := fmt.Sprintf("/eth/v1/beacon/states/head/validator_balances?id=%d", index)
+	if err := c.getBeaconJSON(ctx, path, &response); err != nil {
+		return 0, err
+	}
+
+	if len(response.Data) == 0 {
+		return 0, fmt.Errorf("validator %d not found", index)
+	}
+
+	balance, err := strconv.ParseUint(response.Data[0].Balance, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid balance %q: %w", response.Data[0].Balance, err)
+	}
+
+	return balance, nil
+}
+
+// getBeaconJSON performs a GET against a beacon API path and decodes the JSON response
+func (c *ConsensusClientImpl) getBeaconJSON(ctx context.Context, path string, out interface{}) error {
+	beaconURL := c.BeaconAPIURL()
+	if beaconURL == "" {
+		return fmt.Errorf("beacon API URL is empty")
+	}
+
+	client := c.httpClient(c.rpcTimeout)
+
+	endpoint := beaconURL + path
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request to %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &beaconStatusError{statusCode: resp.StatusCode, endpoint: endpoint}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// beaconStatusError is returned when the beacon API answers with a non-200 status
+type beaconStatusError struct {
+	statusCode int
+	endpoint   string
+}
+
+func (e *beaconStatusError) Error() string {
+	return fmt.Sprintf("beacon API returned status %d for endpoint %s", e.statusCode, e.endpoint)
+}
+
+// parseValidatorIndices converts the beacon API's string-encoded validator indices
+func parseValidatorIndices(values []string) ([]uint64, error) {
+	indices := make([]uint64, len(values))
+	for i, value := range values {
+		index, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator index %q: %w", value, err)
+		}
+		indices[i] = index
+	}
+	return indices, nil
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBeaconStateServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/states/head/committees":
+			assert.Equal(t, "2", r.URL.Query().Get("epoch"))
+			_, _ = w.Write([]byte(`{"data":[{"index":"0","slot":"64","validators":["3","1"]},{"index":"0","slot":"65","validators":["0","2"]}]}`))
+		case "/eth/v1/beacon/states/head/sync_committees":
+			_, _ = w.Write([]byte(`{"data":{"validators":["1","2","3","0"],"validator_aggregates":[["1","2"],["3","0"]]}}`))
+		case "/eth/v1/beacon/states/head/validator_balances":
+			if r.URL.Query().Get("id") == "7" {
+				_, _ = w.Write([]byte(`{"data":[]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[{"index":"1","balance":"32000000000"}]}`))
+		case "/eth/v1/beacon/states/head/finality_checkpoints":
+			_, _ = w.Write([]byte(`{"data":{"previous_justified":{"epoch":"2","root":"0x02"},"current_justified":{"epoch":"3","root":"0x03"},"finalized":{"epoch":"2","root":"0x02"}}}`))
+		case "/eth/v2/beacon/blocks/20":
+			_, _ = w.Write([]byte(`{"data":{"message":{"slot":"20","proposer_index":"5","body":{"execution_payload":{"block_number":"18","block_hash":"0x12","fee_recipient":"0x00000000000000000000000000000000000000aa","extra_data":"0x6765746821"}}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestConsensusClient_Committees(t *testing.T) {
+	server := newBeaconStateServer(t)
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "", "", 0)
+	committees, err := c.Committees(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, committees, 2)
+	assert.Equal(t, Committee{Index: 0, Slot: 64, Validators: []uint64{3, 1}}, committees[0])
+	assert.Equal(t, uint64(65), committees[1].Slot)
+}
+
+func TestConsensusClient_FinalityCheckpoints(t *testing.T) {
+	server := newBeaconStateServer(t)
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "", "", 0)
+	checkpoints, err := c.FinalityCheckpoints(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{Epoch: 3, Root: "0x03"}, checkpoints.CurrentJustified)
+	assert.Equal(t, Checkpoint{Epoch: 2, Root: "0x02"}, checkpoints.Finalized)
+	assert.Equal(t, uint64(2), checkpoints.PreviousJustified.Epoch)
+}
+
+func TestConsensusClient_SyncCommittee(t *testing.T) {
+	server := newBeaconStateServer(t)
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "", "", 0)
+	committee, err := c.SyncCommittee(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3, 0}, committee.Validators)
+	assert.Equal(t, [][]uint64{{1, 2}, {3, 0}}, committee.ValidatorAggregates)
+}
+
+func TestConsensusClient_ValidatorBalance(t *testing.T) {
+	server := newBeaconStateServer(t)
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "", "", 0)
+	balance, err := c.ValidatorBalance(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(32000000000), balance)
+
+	_, err = c.ValidatorBalance(context.Background(), 7)
+	assert.ErrorContains(t, err, "validator 7 not found")
+
+	_, err = NewConsensusClient(Lighthouse, "cl-2", "", "", "", "", "", "", "", 0).ValidatorBalance(context.Background(), 1)
+	assert.ErrorContains(t, err, "beacon API URL is empty")
+}
+
+func TestConsensusClient_BlockProposerMissedSlot(t *testing.T) {
+	server := newBeaconStateServer(t)
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "", "", 0)
+	_, err := c.BlockProposer(context.Background(), 12)
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+}
+
+func TestConsensusClient_BlockPayment(t *testing.T) {
+	server := newBeaconStateServer(t)
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "", "", 0)
+	payment, err := c.BlockPayment(context.Background(), 20)
+	require.NoError(t, err)
+	assert.Equal(t, &BlockPayment{
+		Slot:          20,
+		ProposerIndex: 5,
+		BlockNumber:   18,
+		BlockHash:     "0x12",
+		FeeRecipient:  "0x00000000000000000000000000000000000000aa",
+		ExtraData:     "geth!",
+	}, payment)
+
+	_, err = c.BlockPayment(context.Background(), 12)
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// BlockHeader holds the consensus-critical roots of an execution block
+type BlockHeader struct {
+	Number       uint64
+	Hash         string
+	ParentHash   string
+	StateRoot    string
+	ReceiptsRoot string
+	LogsBloom    string
+	Timestamp    uint64 // unix seconds
+}
+
+// UnmarshalJSON decodes a JSON-RPC block object
+func (h *BlockHeader) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Number       string `json:"number"`
+		Hash         string `json:"hash"`
+		ParentHash   string `json:"parentHash"`
+		StateRoot    string `json:"stateRoot"`
+		ReceiptsRoot string `json:"receiptsRoot"`
+		LogsBloom    string `json:"logsBloom"`
+		Timestamp    string `json:"timestamp"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	number, err := parseHexUint64(raw.Number)
+	if err != nil {
+		return fmt.Errorf("invalid block number: %w", err)
+	}
+	var timestamp uint64
+	if raw.Timestamp != "" {
+		if timestamp, err = parseHexUint64(raw.Timestamp); err != nil {
+			return fmt.Errorf("invalid block timestamp: %w", err)
+		}
+	}
+	*h = BlockHeader{
+		Number:       number,
+		Hash:         raw.Hash,
+		ParentHash:   raw.ParentHash,
+		StateRoot:    raw.StateRoot,
+		ReceiptsRoot: raw.ReceiptsRoot,
+		LogsBloom:    raw.LogsBloom,
+		Timestamp:    timestamp,
+	}
+	return nil
+}
+
+// BlockHeader returns the header of the block with the given number
+func (b *BaseExecutionClient) BlockHeader(ctx context.Context, number uint64) (*BlockHeader, error) {
+	var header *BlockHeader
+	if err := b.call(ctx, "eth_getBlockByNumber", []interface{}{blockTag(&number), false}, &header); err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", number, err)
+	}
+	if header == nil {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+	return header, nil
+}
@@ -1,29 +1,122 @@
 package client
 
-import "sync"
+import (
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
 
-// Collection is a generic collection for any client type
-type Collection[T any] struct {
+// Named is implemented by every client type
+type Named interface {
+	Name() string
+}
+
+var nodeIndexPattern = regexp.MustCompile(`^(el|cl|vc)-(\d+)-`)
+
+// NodeIndex returns the 1-based node index encoded in an ethereum-package service
+// name such as "el-2-geth-lighthouse", or 0 if the name has none
+func NodeIndex(name string) int {
+	matches := nodeIndexPattern.FindStringSubmatch(name)
+	if len(matches) < 3 {
+		return 0
+	}
+	index, _ := strconv.Atoi(matches[2])
+	return index
+}
+
+// nodeLess orders names by node index, then name. Names without an index sort last.
+func nodeLess(aIndex int, aName string, bIndex int, bName string) bool {
+	if aIndex != bIndex {
+		if aIndex == 0 || bIndex == 0 {
+			return bIndex == 0
+		}
+		return aIndex < bIndex
+	}
+	return aName < bName
+}
+
+// byNodeIndex sorts clients with their node indices parsed once up front
+type byNodeIndex[T Named] struct {
+	clients []T
+	indices []int
+}
+
+func (s byNodeIndex[T]) Len() int { return len(s.clients) }
+
+func (s byNodeIndex[T]) Less(i, j int) bool {
+	return nodeLess(s.indices[i], s.clients[i].Name(), s.indices[j], s.clients[j].Name())
+}
+
+func (s byNodeIndex[T]) Swap(i, j int) {
+	s.clients[i], s.clients[j] = s.clients[j], s.clients[i]
+	s.indices[i], s.indices[j] = s.indices[j], s.indices[i]
+}
+
+// sortByNodeIndex orders clients by node index, then name. Clients without an index sort last.
+func sortByNodeIndex[T Named](clients []T) {
+	indices := make([]int, len(clients))
+	for i, client := range clients {
+		indices[i] = NodeIndex(client.Name())
+	}
+	sort.Stable(byNodeIndex[T]{clients: clients, indices: indices})
+}
+
+// Collection is a generic collection of clients. All iteration is ordered by node index.
+type Collection[T Named] struct {
 	clients map[Type][]T
+	limiter *Limiter
 	mu      sync.RWMutex
+
+	randMu sync.Mutex
+	rand   *rand.Rand
 }
 
 // NewCollection creates a new client collection
-func NewCollection[T any]() *Collection[T] {
+func NewCollection[T Named]() *Collection[T] {
 	return &Collection[T]{
 		clients: make(map[Type][]T),
+		limiter: NewLimiter(DefaultFanoutLimit),
 	}
 }
 
+// SetLimiter sets the limiter shared by network-wide operations on the collection
+func (c *Collection[T]) SetLimiter(limiter *Limiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.limiter = limiter
+}
+
+// Limiter returns the limiter used by network-wide operations on the collection
+func (c *Collection[T]) Limiter() *Limiter {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.limiter
+}
+
 // Add adds a client to the collection
 func (c *Collection[T]) Add(clientType Type, client T) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.clients[clientType] = append(c.clients[clientType], client)
+	// Insert in order so the per-type slices stay sorted without re-sorting
+	clients := c.clients[clientType]
+	index, name := NodeIndex(client.Name()), client.Name()
+	pos := sort.Search(len(clients), func(i int) bool {
+		return nodeLess(index, name, NodeIndex(clients[i].Name()), clients[i].Name())
+	})
+	var zero T
+	clients = append(clients, zero)
+	copy(clients[pos+1:], clients[pos:])
+	clients[pos] = client
+	c.clients[clientType] = clients
 }
 
-// All returns all clients in the collection
+// All returns all clients in the collection ordered by node index
 func (c *Collection[T]) All() []T {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -32,11 +125,24 @@ func (c *Collection[T]) All() []T {
 	for _, clients := range c.clients {
 		all = append(all, clients...)
 	}
+	sortByNodeIndex(all)
 
 	return all
 }
 
-// ByType returns all clients of a specific type
+// ByIndex returns the client of the node with the given 1-based index
+func (c *Collection[T]) ByIndex(index int) (T, bool) {
+	for _, client := range c.All() {
+		if NodeIndex(client.Name()) == index {
+			return client, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// ByType returns all clients of a specific type ordered by node index
 func (c *Collection[T]) ByType(clientType Type) []T {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -78,6 +184,93 @@ func (c *Collection[T]) Types() []Type {
 	for clientType := range c.clients {
 		types = append(types, clientType)
 	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
 
 	return types
 }
+
+// Filter returns all clients for which the predicate returns true
+func (c *Collection[T]) Filter(predicate func(T) bool) []T {
+	var result []T
+	for _, client := range c.All() {
+		if predicate(client) {
+			result = append(result, client)
+		}
+	}
+
+	return result
+}
+
+// Random returns a random client from the collection and false if it is empty
+func (c *Collection[T]) Random() (T, bool) {
+	all := c.All()
+	if len(all) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	c.randMu.Lock()
+	defer c.randMu.Unlock()
+	if c.rand == nil {
+		return all[rand.Intn(len(all))], true
+	}
+	return all[c.rand.Intn(len(all))], true
+}
+
+// SetSeed makes the choices of Random reproducible
+func (c *Collection[T]) SetSeed(seed int64) {
+	c.randMu.Lock()
+	defer c.randMu.Unlock()
+	c.rand = rand.New(rand.NewSource(seed))
+}
+
+// findByName returns the client with the given name
+func findByName[T Named](clients []T, name string) (T, bool) {
+	for _, client := range clients {
+		if client.Name() == name {
+			return client, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// filterByNamePrefix returns the clients whose names start with prefix
+func filterByNamePrefix[T Named](clients []T, prefix string) []T {
+	var result []T
+	for _, client := range clients {
+		if strings.HasPrefix(client.Name(), prefix) {
+			result = append(result, client)
+		}
+	}
+
+	return result
+}
+
+// exceptNames returns the clients whose names are not listed
+func exceptNames[T Named](clients []T, names []string) []T {
+	excluded := make(map[string]bool, len(names))
+	for _, name := range names {
+		excluded[name] = true
+	}
+
+	var result []T
+	for _, client := range clients {
+		if !excluded[client.Name()] {
+			result = append(result, client)
+		}
+	}
+
+	return result
+}
+
+// mapByName returns the clients keyed by name
+func mapByName[T Named](clients []T) map[string]T {
+	result := make(map[string]T, len(clients))
+	for _, client := range clients {
+		result[client.Name()] = client
+	}
+
+	return result
+}
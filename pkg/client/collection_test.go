@@ -0,0 +1,133 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestExecutionClients() *ExecutionClients {
+	clients := NewExecutionClients()
+	clients.Add(NewExecutionClient(Geth, "el-1-geth-lighthouse", "", "", "", "", "", "", "el-1-geth-lighthouse", "", 0))
+	clients.Add(NewExecutionClient(Besu, "el-2-besu-teku", "", "", "", "", "", "", "el-2-besu-teku", "", 0))
+	clients.Add(NewExecutionClient(Geth, "el-3-geth-prysm", "", "", "", "", "", "", "el-3-geth-prysm", "", 0))
+	return clients
+}
+
+func names[T Named](clients []T) []string {
+	result := make([]string, len(clients))
+	for i, c := range clients {
+		result[i] = c.Name()
+	}
+	return result
+}
+
+func TestCollection_Filter(t *testing.T) {
+	clients := newTestExecutionClients()
+
+	geth := clients.Filter(func(c ExecutionClient) bool { return c.Type() == Geth })
+	assert.ElementsMatch(t, []string{"el-1-geth-lighthouse", "el-3-geth-prysm"}, names(geth))
+
+	assert.Empty(t, clients.Filter(func(c ExecutionClient) bool { return false }))
+}
+
+func TestCollection_ByName(t *testing.T) {
+	clients := newTestExecutionClients()
+
+	c, ok := clients.ByName("el-2-besu-teku")
+	require.True(t, ok)
+	assert.Equal(t, Besu, c.Type())
+
+	_, ok = clients.ByName("el-9-missing")
+	assert.False(t, ok)
+}
+
+func TestCollection_ByNamePrefix(t *testing.T) {
+	clients := newTestExecutionClients()
+
+	assert.Equal(t, []string{"el-1-geth-lighthouse"}, names(clients.ByNamePrefix("el-1-")))
+	assert.Len(t, clients.ByNamePrefix("el-"), 3)
+	assert.Empty(t, clients.ByNamePrefix("cl-"))
+}
+
+func TestCollection_Except(t *testing.T) {
+	clients := newTestExecutionClients()
+
+	rest := clients.Except("el-1-geth-lighthouse", "el-9-missing")
+	assert.ElementsMatch(t, []string{"el-2-besu-teku", "el-3-geth-prysm"}, names(rest))
+	assert.Len(t, clients.Except(), 3)
+}
+
+func TestCollection_Map(t *testing.T) {
+	consensus := NewConsensusClients()
+	consensus.Add(NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", "", "", "", "", "cl-1-lighthouse-geth", "", 0))
+	consensus.Add(NewConsensusClient(Teku, "cl-2-teku-besu", "", "", "", "", "", "cl-2-teku-besu", "", 0))
+
+	byName := consensus.Map()
+	require.Len(t, byName, 2)
+	assert.Equal(t, Teku, byName["cl-2-teku-besu"].Type())
+}
+
+func TestCollection_Random(t *testing.T) {
+	_, ok := NewConsensusClients().Random()
+	assert.False(t, ok)
+
+	clients := newTestExecutionClients()
+	c, ok := clients.Random()
+	require.True(t, ok)
+	_, found := clients.ByName(c.Name())
+	assert.True(t, found)
+}
+
+func TestCollection_RandomSeeded(t *testing.T) {
+	picks := func(seed int64) []string {
+		clients := newTestExecutionClients()
+		clients.SetSeed(seed)
+		var result []string
+		for i := 0; i < 10; i++ {
+			c, _ := clients.Random()
+			result = append(result, c.Name())
+		}
+		return result
+	}
+
+	assert.Equal(t, picks(42), picks(42))
+	assert.NotEqual(t, picks(42), picks(43))
+}
+
+func TestNodeIndex(t *testing.T) {
+	assert.Equal(t, 1, NodeIndex("el-1-geth-lighthouse"))
+	assert.Equal(t, 12, NodeIndex("cl-12-teku-besu"))
+	assert.Equal(t, 3, NodeIndex("vc-3-lighthouse-geth"))
+	assert.Equal(t, 0, NodeIndex("geth-1"))
+	assert.Equal(t, 0, NodeIndex("prometheus"))
+}
+
+func TestCollection_DeterministicOrder(t *testing.T) {
+	clients := NewConsensusClients()
+	for _, name := range []string{"cl-10-teku-geth", "lighthouse", "cl-2-teku-besu", "cl-1-lighthouse-geth", "cl-3-prysm-geth"} {
+		clientType := Lighthouse
+		if NodeIndex(name) > 1 {
+			clientType = Teku
+		}
+		clients.Add(NewConsensusClient(clientType, name, "", "", "", "", "", name, "", 0))
+	}
+
+	expected := []string{"cl-1-lighthouse-geth", "cl-2-teku-besu", "cl-3-prysm-geth", "cl-10-teku-geth", "lighthouse"}
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, expected, names(clients.All()))
+	}
+	assert.Equal(t, []string{"cl-2-teku-besu", "cl-3-prysm-geth", "cl-10-teku-geth"}, names(clients.ByType(Teku)))
+}
+
+func TestCollection_ByIndex(t *testing.T) {
+	clients := newTestExecutionClients()
+
+	c, ok := clients.ByIndex(2)
+	require.True(t, ok)
+	assert.Equal(t, "el-2-besu-teku", c.Name())
+
+	_, ok = clients.ByIndex(4)
+	assert.False(t, ok)
+}
@@ -2,9 +2,13 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -21,6 +25,7 @@ type ConsensusClient interface {
 
 	// P2P information
 	P2PPort() int
+	P2PURL() string
 	ENR() string
 	PeerID() string
 
@@ -28,8 +33,57 @@ type ConsensusClient interface {
 	ServiceName() string
 	ContainerID() string
 
-	// Live peer ID fetching
+	// Per-request timeout for beacon API calls
+	RPCTimeout() time.Duration
+
+	// TLS settings for https endpoints; nil uses the system defaults
+	TLSConfig() *tls.Config
+
+	// Credentials for a beacon API behind an authenticating proxy; nil sends none
+	Credentials() *Credentials
+
+	// Identity
+	String() string
+	Labels() Labels
+	Features() Features
+
+	// Live identity fetching
 	FetchPeerID(ctx context.Context) (string, error)
+	FetchIdentity(ctx context.Context) (*NodeIdentity, error)
+
+	// Commands in the client's container
+	Exec(ctx context.Context, cmd []string) (int, string, string, error)
+
+	// Beacon state queries
+	Committees(ctx context.Context, epoch uint64) ([]Committee, error)
+	SyncCommittee(ctx context.Context, epoch uint64) (*SyncCommittee, error)
+	ValidatorBalance(ctx context.Context, index uint64) (uint64, error)
+	FinalityCheckpoints(ctx context.Context) (*FinalityCheckpoints, error)
+	Validators(ctx context.Context, ids ...string) ([]Validator, error)
+	BeaconBlockHeader(ctx context.Context, blockID string) (*BeaconBlockHeader, error)
+
+	// Node state
+	SyncStatus(ctx context.Context) (*SyncStatus, error)
+
+	// Chain parameters
+	Genesis(ctx context.Context) (*Genesis, error)
+	Fork(ctx context.Context) (*Fork, error)
+	ForkSchedule(ctx context.Context) ([]Fork, error)
+	Spec(ctx context.Context) (Spec, error)
+
+	// Chain timing and block production
+	GenesisTime(ctx context.Context) (time.Time, error)
+	SecondsPerSlot(ctx context.Context) (time.Duration, error)
+	ProposerDuties(ctx context.Context, epoch uint64) ([]ProposerDuty, error)
+	BlockProposer(ctx context.Context, slot uint64) (uint64, error)
+	BlockAttestations(ctx context.Context, slot uint64) ([]Attestation, error)
+	BlockPayment(ctx context.Context, slot uint64) (*BlockPayment, error)
+
+	// Events
+	SubscribeHeads(ctx context.Context) (<-chan HeadEvent, <-chan error)
+
+	// Debugging
+	ForkChoice(ctx context.Context) (*ForkChoice, error)
 }
 
 // ConsensusClientImpl is a generic implementation of the ConsensusClient interface
@@ -40,10 +94,17 @@ type ConsensusClientImpl struct {
 	beaconAPIURL string
 	metricsURL   string
 	p2pPort      int
+	p2pURL       string
 	enr          string
 	peerID       string
 	serviceName  string
 	containerID  string
+	enclave      string
+	rpcTimeout   time.Duration
+	tlsConfig    *tls.Config
+	credentials  *Credentials
+	features     *Features
+	exec         ExecFunc
 }
 
 func (c *ConsensusClientImpl) Name() string         { return c.name }
@@ -52,6 +113,7 @@ func (c *ConsensusClientImpl) Version() string      { return c.version }
 func (c *ConsensusClientImpl) BeaconAPIURL() string { return c.beaconAPIURL }
 func (c *ConsensusClientImpl) MetricsURL() string   { return c.metricsURL }
 func (c *ConsensusClientImpl) P2PPort() int         { return c.p2pPort }
+func (c *ConsensusClientImpl) P2PURL() string       { return c.p2pURL }
 func (c *ConsensusClientImpl) ENR() string          { return c.enr }
 func (c *ConsensusClientImpl) PeerID() string       { return c.peerID }
 func (c *ConsensusClientImpl) ServiceName() string  { return c.serviceName }
@@ -72,17 +134,61 @@ type NodeIdentityResponse struct {
 	} `json:"data"`
 }
 
-// FetchPeerID fetches the live peer ID from the beacon API using /eth/v1/node/identity
-func (c *ConsensusClientImpl) FetchPeerID(ctx context.Context) (string, error) {
+// NodeIdentity is a beacon node's network identity
+type NodeIdentity struct {
+	PeerID             string
+	ENR                string
+	P2PAddresses       []string
+	DiscoveryAddresses []string
+	// SeqNumber is the sequence number of the node's metadata
+	SeqNumber uint64
+	// Attnets and Syncnets are the 0x-prefixed subnet bitvectors the node
+	// subscribes to
+	Attnets  string
+	Syncnets string
+}
+
+// AttestationSubnets returns the attestation subnets the node subscribes to
+func (i *NodeIdentity) AttestationSubnets() ([]int, error) {
+	return subnetBits(i.Attnets)
+}
+
+// SyncSubnets returns the sync committee subnets the node subscribes to
+func (i *NodeIdentity) SyncSubnets() ([]int, error) {
+	return subnetBits(i.Syncnets)
+}
+
+// subnetBits returns the set bits of an SSZ bitvector, least significant bit
+// of the first byte first
+func subnetBits(bitvector string) ([]int, error) {
+	if bitvector == "" {
+		return nil, nil
+	}
+	raw, err := hex.DecodeString(strings.TrimPrefix(bitvector, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid subnet bitvector %q: %w", bitvector, err)
+	}
+	var subnets []int
+	for i, b := range raw {
+		for bit := 0; bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				subnets = append(subnets, i*8+bit)
+			}
+		}
+	}
+	return subnets, nil
+}
+
+// FetchIdentity fetches the node's live identity from the beacon API using
+// /eth/v1/node/identity
+func (c *ConsensusClientImpl) FetchIdentity(ctx context.Context) (*NodeIdentity, error) {
 	beaconURL := c.BeaconAPIURL()
 	if beaconURL == "" {
-		return "", fmt.Errorf("beacon API URL is empty")
+		return nil, fmt.Errorf("beacon API URL is empty")
 	}
 
 	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
+	client := c.httpClient(c.rpcTimeout)
 
 	// Build the endpoint URL
 	endpoint := fmt.Sprintf("%s/eth/v1/node/identity", beaconURL)
@@ -90,7 +196,7 @@ func (c *ConsensusClientImpl) FetchPeerID(ctx context.Context) (string, error) {
 	// Create the request
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
@@ -100,28 +206,53 @@ func (c *ConsensusClientImpl) FetchPeerID(ctx context.Context) (string, error) {
 	// Make the request
 	resp, err := client.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to make request to %s: %w", endpoint, err)
+		return nil, fmt.Errorf("failed to make request to %s: %w", endpoint, err)
 	}
 	defer resp.Body.Close()
 
 	// Check status code
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("beacon API returned status %d for endpoint %s", resp.StatusCode, endpoint)
+		return nil, fmt.Errorf("beacon API returned status %d for endpoint %s", resp.StatusCode, endpoint)
 	}
 
 	// Parse the response
 	var nodeIdentity NodeIdentityResponse
 	if err := json.NewDecoder(resp.Body).Decode(&nodeIdentity); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	data := nodeIdentity.Data
+	identity := &NodeIdentity{
+		PeerID:             data.PeerID,
+		ENR:                data.ENR,
+		P2PAddresses:       data.P2PAddresses,
+		DiscoveryAddresses: data.DiscoveryAddresses,
+		Attnets:            data.Metadata.Attnets,
+		Syncnets:           data.Metadata.SyncCommitteeNets,
+	}
+	if data.Metadata.SeqNumber != "" {
+		identity.SeqNumber, err = strconv.ParseUint(data.Metadata.SeqNumber, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metadata sequence number %q: %w", data.Metadata.SeqNumber, err)
+		}
+	}
+
+	return identity, nil
+}
+
+// FetchPeerID fetches the live peer ID from the beacon API using /eth/v1/node/identity
+func (c *ConsensusClientImpl) FetchPeerID(ctx context.Context) (string, error) {
+	identity, err := c.FetchIdentity(ctx)
+	if err != nil {
+		return "", err
 	}
 
 	// Extract peer ID
-	peerID := nodeIdentity.Data.PeerID
-	if peerID == "" {
+	if identity.PeerID == "" {
 		return "", fmt.Errorf("peer_id is empty in response")
 	}
 
-	return peerID, nil
+	return identity.PeerID, nil
 }
 
 // NewConsensusClient creates a new generic consensus client instance
@@ -137,9 +268,75 @@ func NewConsensusClient(clientType Type, name, version, beaconAPIURL, metricsURL
 		peerID:       peerID,
 		serviceName:  serviceName,
 		containerID:  containerID,
+		rpcTimeout:   DefaultRPCTimeout,
 	}
 }
 
+// WithP2PURL sets the P2P endpoint URL
+func (c *ConsensusClientImpl) WithP2PURL(p2pURL string) *ConsensusClientImpl {
+	c.p2pURL = p2pURL
+	return c
+}
+
+// WithEnclave sets the enclave the client runs in, used in its identity
+func (c *ConsensusClientImpl) WithEnclave(enclave string) *ConsensusClientImpl {
+	c.enclave = enclave
+	return c
+}
+
+// WithRPCTimeout sets the per-request timeout for beacon API calls. Non-positive values are ignored.
+func (c *ConsensusClientImpl) WithRPCTimeout(timeout time.Duration) *ConsensusClientImpl {
+	if timeout > 0 {
+		c.rpcTimeout = timeout
+	}
+	return c
+}
+
+// RPCTimeout returns the per-request timeout for beacon API calls
+func (c *ConsensusClientImpl) RPCTimeout() time.Duration {
+	return c.rpcTimeout
+}
+
+// WithTLSConfig sets the TLS settings for an https beacon API, e.g. a CA bundle
+// for clients behind an ingress with a private certificate
+func (c *ConsensusClientImpl) WithTLSConfig(tlsConfig *tls.Config) *ConsensusClientImpl {
+	c.tlsConfig = tlsConfig
+	return c
+}
+
+// TLSConfig returns the TLS settings for an https beacon API
+func (c *ConsensusClientImpl) TLSConfig() *tls.Config {
+	return c.tlsConfig
+}
+
+// WithCredentials sets the credentials sent with every beacon API request, for
+// networks exposed through an authenticating proxy
+func (c *ConsensusClientImpl) WithCredentials(credentials *Credentials) *ConsensusClientImpl {
+	c.credentials = credentials
+	return c
+}
+
+// Credentials returns the credentials sent with beacon API requests
+func (c *ConsensusClientImpl) Credentials() *Credentials {
+	return c.credentials
+}
+
+// httpClient returns an HTTP client for the beacon API with the client's TLS
+// settings and credentials
+func (c *ConsensusClientImpl) httpClient(timeout time.Duration) *http.Client {
+	return c.credentials.Wrap(NewHTTPClient(timeout, c.tlsConfig))
+}
+
+// String returns the client's canonical identity, e.g. "my-enclave/lighthouse-1@v5.0.0"
+func (c *ConsensusClientImpl) String() string {
+	return clientIdentity(c.name, c.clientType, c.version, c.enclave)
+}
+
+// Labels returns the labels identifying the client
+func (c *ConsensusClientImpl) Labels() Labels {
+	return clientLabels(c.name, c.clientType, c.version, c.enclave)
+}
+
 // ConsensusClients holds all consensus clients by type
 type ConsensusClients struct {
 	*Collection[ConsensusClient]
@@ -162,34 +359,74 @@ func (cc *ConsensusClients) ByType(clientType Type) []ConsensusClient {
 	return cc.Collection.ByType(clientType)
 }
 
+// ByName returns the consensus client with the given name
+func (cc *ConsensusClients) ByName(name string) (ConsensusClient, bool) {
+	return findByName(cc.All(), name)
+}
+
+// ByNamePrefix returns the consensus clients whose names start with prefix
+func (cc *ConsensusClients) ByNamePrefix(prefix string) []ConsensusClient {
+	return filterByNamePrefix(cc.All(), prefix)
+}
+
+// Except returns the consensus clients whose names are not listed
+func (cc *ConsensusClients) Except(names ...string) []ConsensusClient {
+	return exceptNames(cc.All(), names)
+}
+
+// Map returns the consensus clients keyed by name
+func (cc *ConsensusClients) Map() map[string]ConsensusClient {
+	return mapByName(cc.All())
+}
+
 // PeerIDs fetches peer IDs for all consensus clients in the collection
 func (cc *ConsensusClients) PeerIDs(ctx context.Context) (map[string]string, error) {
-	clients := cc.All()
-	peerIds := make(map[string]string)
+	return cc.fetchPeerIDs(ctx, cc.All())
+}
+
+// PeerIDsByType fetches peer IDs for all consensus clients of a specific type
+func (cc *ConsensusClients) PeerIDsByType(ctx context.Context, clientType Type) (map[string]string, error) {
+	return cc.fetchPeerIDs(ctx, cc.ByType(clientType))
+}
 
-	for _, client := range clients {
+// fetchPeerIDs fetches peer IDs concurrently, bounded by the collection's limiter
+func (cc *ConsensusClients) fetchPeerIDs(ctx context.Context, clients []ConsensusClient) (map[string]string, error) {
+	peerIds := make([]string, len(clients))
+	errs := FanOut(ctx, cc.Limiter(), clients, func(ctx context.Context, i int, client ConsensusClient) error {
 		peerID, err := client.FetchPeerID(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch peer ID for client %s: %w", client.Name(), err)
+		peerIds[i] = peerID
+		return err
+	})
+
+	result := make(map[string]string, len(clients))
+	for i, client := range clients {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("failed to fetch peer ID for client %s: %w", client.Name(), errs[i])
 		}
-		peerIds[client.Name()] = peerID
+		result[client.Name()] = peerIds[i]
 	}
 
-	return peerIds, nil
+	return result, nil
 }
 
-// PeerIDsByType fetches peer IDs for all consensus clients of a specific type
-func (cc *ConsensusClients) PeerIDsByType(ctx context.Context, clientType Type) (map[string]string, error) {
-	clients := cc.ByType(clientType)
-	peerIds := make(map[string]string)
+// Identities fetches the identities of all consensus clients in the
+// collection, concurrently and bounded by the collection's limiter
+func (cc *ConsensusClients) Identities(ctx context.Context) (map[string]*NodeIdentity, error) {
+	clients := cc.All()
+	identities := make([]*NodeIdentity, len(clients))
+	errs := FanOut(ctx, cc.Limiter(), clients, func(ctx context.Context, i int, client ConsensusClient) error {
+		identity, err := client.FetchIdentity(ctx)
+		identities[i] = identity
+		return err
+	})
 
-	for _, client := range clients {
-		peerID, err := client.FetchPeerID(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch peer ID for client %s: %w", client.Name(), err)
+	result := make(map[string]*NodeIdentity, len(clients))
+	for i, client := range clients {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("failed to fetch identity for client %s: %w", client.Name(), errs[i])
 		}
-		peerIds[client.Name()] = peerID
+		result[client.Name()] = identities[i]
 	}
 
-	return peerIds, nil
+	return result, nil
 }
@@ -396,6 +396,54 @@ func TestNodeIdentityResponse_JSON(t *testing.T) {
 }
 
 // BenchmarkConsensusClient_FetchPeerID benchmarks the peer ID fetching
+func TestConsensusClient_FetchIdentity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/node/identity", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{
+			"peer_id":"16Uiu2HAkuVKJJuNnFVhfVjrw1nXJt6c2d1NcmAZqYLbA4Km7KLRZ",
+			"enr":"enr:-MS4QBU9k",
+			"p2p_addresses":["/ip4/172.16.0.12/tcp/9000"],
+			"discovery_addresses":["/ip4/172.16.0.12/udp/9000"],
+			"metadata":{"seq_number":"4","attnets":"0x0300000000000080","syncnets":"0x04"}
+		}}`))
+	}))
+	defer server.Close()
+
+	client := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 9000)
+	identity, err := client.FetchIdentity(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "16Uiu2HAkuVKJJuNnFVhfVjrw1nXJt6c2d1NcmAZqYLbA4Km7KLRZ", identity.PeerID)
+	assert.Equal(t, "enr:-MS4QBU9k", identity.ENR)
+	assert.Equal(t, []string{"/ip4/172.16.0.12/tcp/9000"}, identity.P2PAddresses)
+	assert.Equal(t, []string{"/ip4/172.16.0.12/udp/9000"}, identity.DiscoveryAddresses)
+	assert.Equal(t, uint64(4), identity.SeqNumber)
+
+	attnets, err := identity.AttestationSubnets()
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 63}, attnets)
+	syncnets, err := identity.SyncSubnets()
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, syncnets)
+
+	identities := NewConsensusClients()
+	identities.Add(client)
+	byName, err := identities.Identities(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, identity, byName["cl-1-lighthouse-geth"])
+}
+
+func TestNodeIdentity_InvalidSubnets(t *testing.T) {
+	identity := &NodeIdentity{Attnets: "0xzz"}
+	_, err := identity.AttestationSubnets()
+	assert.ErrorContains(t, err, "invalid subnet bitvector")
+
+	subnets, err := identity.SyncSubnets()
+	require.NoError(t, err)
+	assert.Empty(t, subnets)
+}
+
 func BenchmarkConsensusClient_FetchPeerID(b *testing.B) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		response := NodeIdentityResponse{
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Credentials authenticate requests to client endpoints, for networks exposed
+// through an authenticating proxy. Basic auth takes precedence over the bearer
+// token; Headers are set on every request in addition to either.
+type Credentials struct {
+	Username    string
+	Password    string
+	BearerToken string
+	Headers     map[string]string
+}
+
+// BasicAuth returns credentials for HTTP basic auth
+func BasicAuth(username, password string) *Credentials {
+	return &Credentials{Username: username, Password: password}
+}
+
+// BearerToken returns credentials sending token as a bearer token
+func BearerToken(token string) *Credentials {
+	return &Credentials{BearerToken: token}
+}
+
+// Apply sets the credentials on req. It is a no-op on nil credentials.
+func (c *Credentials) Apply(req *http.Request) {
+	if c == nil {
+		return
+	}
+	for name, value := range c.Headers {
+		req.Header.Set(name, value)
+	}
+	switch {
+	case c.Username != "" || c.Password != "":
+		req.SetBasicAuth(c.Username, c.Password)
+	case c.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+c.BearerToken)
+	}
+}
+
+// Wrap returns a copy of httpClient that applies the credentials to every
+// request, or httpClient itself when there are none
+func (c *Credentials) Wrap(httpClient *http.Client) *http.Client {
+	if c == nil || (c.Username == "" && c.Password == "" && c.BearerToken == "" && len(c.Headers) == 0) {
+		return httpClient
+	}
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	wrapped := *httpClient
+	wrapped.Transport = &credentialsTransport{base: base, credentials: c}
+	return &wrapped
+}
+
+// credentialsTransport applies credentials to each request before sending it
+type credentialsTransport struct {
+	base        http.RoundTripper
+	credentials *Credentials
+}
+
+func (t *credentialsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the caller's request
+	req = req.Clone(req.Context())
+	t.credentials.Apply(req)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAuthServer serves eth_blockNumber and the node identity, rejecting
+// requests whose Authorization header differs from want
+func newAuthServer(t *testing.T, want string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != want {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/eth/v1/node/identity" {
+			_, _ = w.Write([]byte(`{"data":{"peer_id":"16Uiu2HAm"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x2a"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCredentialsApply(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+	(*Credentials)(nil).Apply(req)
+	assert.Empty(t, req.Header.Get("Authorization"))
+
+	BearerToken("token").Apply(req)
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+
+	creds := &Credentials{Username: "user", Password: "pass", BearerToken: "ignored", Headers: map[string]string{"X-Api-Key": "key"}}
+	creds.Apply(req)
+	username, password, ok := req.BasicAuth()
+	require.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass", password)
+	assert.Equal(t, "key", req.Header.Get("X-Api-Key"))
+}
+
+func TestCredentialsWrap(t *testing.T) {
+	httpClient := NewHTTPClient(time.Second, nil)
+	assert.Same(t, httpClient, (*Credentials)(nil).Wrap(httpClient))
+	assert.Same(t, httpClient, (&Credentials{}).Wrap(httpClient))
+
+	wrapped := BearerToken("token").Wrap(httpClient)
+	assert.NotSame(t, httpClient, wrapped)
+	assert.Equal(t, time.Second, wrapped.Timeout)
+	assert.Nil(t, httpClient.Transport)
+}
+
+func TestExecutionClientCredentials(t *testing.T) {
+	server := newAuthServer(t, "Bearer token")
+
+	el := NewExecutionClient(Geth, "el-1-geth", "", server.URL, "", "", "", "", "el-1-geth", "", 0)
+	_, err := RPC(el).GetBlockNumber(context.Background())
+	assert.Error(t, err)
+
+	el.WithCredentials(BearerToken("token"))
+	number, err := RPC(el).GetBlockNumber(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), number)
+}
+
+func TestConsensusClientCredentials(t *testing.T) {
+	creds := BasicAuth("user", "pass")
+	req := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+	creds.Apply(req)
+	server := newAuthServer(t, req.Header.Get("Authorization"))
+
+	cl := NewConsensusClient(Lighthouse, "cl-1-lighthouse", "", server.URL, "", "", "", "cl-1-lighthouse", "", 0).WithCredentials(creds)
+	peerID, err := cl.FetchPeerID(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "16Uiu2HAm", peerID)
+}
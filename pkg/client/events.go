@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultEventPollInterval is how often WatchEvents polls for new logs by default
+const DefaultEventPollInterval = 2 * time.Second
+
+// EventFilter selects logs for eth_getLogs
+type EventFilter struct {
+	// FromBlock and ToBlock bound the block range; nil means latest
+	FromBlock *uint64
+	ToBlock   *uint64
+	// BlockHash selects the logs of a single block instead of a range
+	BlockHash string
+	// Addresses restricts logs to the given contracts
+	Addresses []string
+	// Topics matches topics by position. An empty position matches anything,
+	// several values in a position match any of them.
+	Topics [][]string
+}
+
+// MarshalJSON encodes the filter as an eth_getLogs filter object
+func (f EventFilter) MarshalJSON() ([]byte, error) {
+	obj := make(map[string]interface{})
+	if f.BlockHash != "" {
+		obj["blockHash"] = f.BlockHash
+	} else {
+		obj["fromBlock"] = blockTag(f.FromBlock)
+		obj["toBlock"] = blockTag(f.ToBlock)
+	}
+	if len(f.Addresses) == 1 {
+		obj["address"] = f.Addresses[0]
+	} else if len(f.Addresses) > 1 {
+		obj["address"] = f.Addresses
+	}
+	if len(f.Topics) > 0 {
+		topics := make([]interface{}, len(f.Topics))
+		for i, position := range f.Topics {
+			switch len(position) {
+			case 0:
+				topics[i] = nil
+			case 1:
+				topics[i] = position[0]
+			default:
+				topics[i] = position
+			}
+		}
+		obj["topics"] = topics
+	}
+	return json.Marshal(obj)
+}
+
+// EventLog is a log entry emitted by a contract
+type EventLog struct {
+	Address          string
+	Topics           []string
+	Data             string
+	BlockNumber      uint64
+	BlockHash        string
+	TransactionHash  string
+	TransactionIndex uint64
+	LogIndex         uint64
+	Removed          bool
+}
+
+// UnmarshalJSON decodes a JSON-RPC log object
+func (l *EventLog) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Address          string   `json:"address"`
+		Topics           []string `json:"topics"`
+		Data             string   `json:"data"`
+		BlockNumber      string   `json:"blockNumber"`
+		BlockHash        string   `json:"blockHash"`
+		TransactionHash  string   `json:"transactionHash"`
+		TransactionIndex string   `json:"transactionIndex"`
+		LogIndex         string   `json:"logIndex"`
+		Removed          bool     `json:"removed"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var err error
+	*l = EventLog{
+		Address:         raw.Address,
+		Topics:          raw.Topics,
+		Data:            raw.Data,
+		BlockHash:       raw.BlockHash,
+		TransactionHash: raw.TransactionHash,
+		Removed:         raw.Removed,
+	}
+	if l.BlockNumber, err = parseHexUint64(raw.BlockNumber); err != nil {
+		return fmt.Errorf("invalid blockNumber: %w", err)
+	}
+	if l.TransactionIndex, err = parseHexUint64(raw.TransactionIndex); err != nil {
+		return fmt.Errorf("invalid transactionIndex: %w", err)
+	}
+	if l.LogIndex, err = parseHexUint64(raw.LogIndex); err != nil {
+		return fmt.Errorf("invalid logIndex: %w", err)
+	}
+	return nil
+}
+
+// GetLogs returns the logs matching the filter
+func (b *BaseExecutionClient) GetLogs(ctx context.Context, filter EventFilter) ([]EventLog, error) {
+	var logs []EventLog
+	if err := b.call(ctx, "eth_getLogs", []interface{}{filter}, &logs); err != nil {
+		return nil, fmt.Errorf("failed to get logs: %w", err)
+	}
+	return logs, nil
+}
+
+// WatchEvents polls for logs emitted by the contract with the given first topic,
+// starting after the current block. An empty topic matches any event. Polling works
+// the same on every EL, including those with unreliable WebSocket subscriptions.
+// Both channels are closed when the context is done or polling fails.
+func (b *BaseExecutionClient) WatchEvents(ctx context.Context, contractAddr, topic string) (<-chan EventLog, <-chan error) {
+	events := make(chan EventLog)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		next, err := b.GetBlockNumber(ctx)
+		if err != nil {
+			errs <- err
+			return
+		}
+		next++
+
+		filter := EventFilter{Addresses: []string{contractAddr}}
+		if topic != "" {
+			filter.Topics = [][]string{{topic}}
+		}
+
+		ticker := time.NewTicker(b.eventPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			latest, err := b.GetBlockNumber(ctx)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if latest < next {
+				continue
+			}
+
+			from, to := next, latest
+			filter.FromBlock, filter.ToBlock = &from, &to
+			logs, err := b.GetLogs(ctx, filter)
+			if err != nil {
+				errs <- err
+				return
+			}
+			for _, log := range logs {
+				select {
+				case events <- log:
+				case <-ctx.Done():
+					return
+				}
+			}
+			next = latest + 1
+		}
+	}()
+
+	return events, errs
+}
+
+// call performs a JSON-RPC call and decodes the result into out
+func (b *BaseExecutionClient) call(ctx context.Context, method string, params []interface{}, out interface{}) error {
+	req := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  method,
+		"params":  params,
+		"id":      1,
+	}
+
+	resp, err := b.makeRPCRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Result, out); err != nil {
+		return fmt.Errorf("failed to parse %s result: %w", method, err)
+	}
+	return nil
+}
+
+// blockTag returns the JSON-RPC block parameter for a block number, or "latest" for nil
+func blockTag(block *uint64) string {
+	if block == nil {
+		return "latest"
+	}
+	return fmt.Sprintf("0x%x", *block)
+}
+
+// parseHexUint64 parses a 0x-prefixed quantity; an empty string parses as zero
+func parseHexUint64(value string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(strings.TrimPrefix(value, "0x"), 16, 64)
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventFilter_MarshalJSON(t *testing.T) {
+	from := uint64(16)
+	filter := EventFilter{
+		FromBlock: &from,
+		Addresses: []string{"0xabc"},
+		Topics:    [][]string{{"0xt0"}, nil, {"0xa", "0xb"}},
+	}
+
+	data, err := json.Marshal(filter)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"fromBlock":"0x10","toBlock":"latest","address":"0xabc","topics":["0xt0",null,["0xa","0xb"]]}`, string(data))
+
+	data, err = json.Marshal(EventFilter{BlockHash: "0xhash", Addresses: []string{"0x1", "0x2"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"blockHash":"0xhash","address":["0x1","0x2"]}`, string(data))
+}
+
+// newEventServer serves a chain that grows by one block per eth_blockNumber call
+// and emits one log in every block
+func newEventServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var head int64 = 5
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+
+		switch req.Method {
+		case "eth_blockNumber":
+			n := atomic.AddInt64(&head, 1)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": fmt.Sprintf("0x%x", n)})
+		case "eth_getLogs":
+			var filter struct {
+				FromBlock string `json:"fromBlock"`
+				ToBlock   string `json:"toBlock"`
+				Address   string `json:"address"`
+			}
+			if err := json.Unmarshal(req.Params[0], &filter); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			from, _ := parseHexUint64(filter.FromBlock)
+			to, _ := parseHexUint64(filter.ToBlock)
+			logs := []map[string]interface{}{}
+			for n := from; n <= to; n++ {
+				logs = append(logs, map[string]interface{}{
+					"address":     filter.Address,
+					"topics":      []string{"0xtopic"},
+					"data":        "0x",
+					"blockNumber": fmt.Sprintf("0x%x", n),
+					"logIndex":    "0x0",
+				})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": logs})
+		}
+	}))
+}
+
+func TestBaseExecutionClient_GetLogs(t *testing.T) {
+	server := newEventServer(t)
+	defer server.Close()
+
+	c := NewBaseExecutionClient(ClientConfig{Name: "el-1-geth-lighthouse", RPCURL: server.URL})
+	from, to := uint64(10), uint64(12)
+	logs, err := c.GetLogs(context.Background(), EventFilter{FromBlock: &from, ToBlock: &to, Addresses: []string{"0xabc"}})
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	assert.Equal(t, uint64(10), logs[0].BlockNumber)
+	assert.Equal(t, "0xabc", logs[2].Address)
+	assert.Equal(t, []string{"0xtopic"}, logs[2].Topics)
+}
+
+func TestBaseExecutionClient_WatchEvents(t *testing.T) {
+	server := newEventServer(t)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c := NewBaseExecutionClient(ClientConfig{Name: "el-1-geth-lighthouse", RPCURL: server.URL}).
+		WithEventPollInterval(10 * time.Millisecond)
+	events, errs := c.WatchEvents(ctx, "0xabc", "0xtopic")
+
+	var last uint64
+	for i := 0; i < 3; i++ {
+		select {
+		case event := <-events:
+			assert.Greater(t, event.BlockNumber, last)
+			last = event.BlockNumber
+		case err := <-errs:
+			t.Fatalf("unexpected error: %v", err)
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for events")
+		}
+	}
+	// The first watched block comes after the block current at subscription time
+	assert.GreaterOrEqual(t, last, uint64(9))
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExecFunc runs a command in a client's container and returns its exit code,
+// stdout and stderr
+type ExecFunc func(ctx context.Context, cmd []string) (int, string, string, error)
+
+// Exec runs a command in the client's container, e.g. to inspect its data
+// directory or run the client's admin CLI. A command that exits non-zero
+// returns its exit code without an error.
+func (e *ExecutionClientImpl) Exec(ctx context.Context, cmd []string) (int, string, string, error) {
+	if e.exec == nil {
+		return 0, "", "", fmt.Errorf("%s does not support exec", e.name)
+	}
+	return e.exec(ctx, cmd)
+}
+
+// WithExec sets how Exec runs commands in the client's container
+func (e *ExecutionClientImpl) WithExec(exec ExecFunc) *ExecutionClientImpl {
+	e.exec = exec
+	return e
+}
+
+// Exec runs a command in the client's container, e.g. to inspect its data
+// directory or run the client's admin CLI. A command that exits non-zero
+// returns its exit code without an error.
+func (c *ConsensusClientImpl) Exec(ctx context.Context, cmd []string) (int, string, string, error) {
+	if c.exec == nil {
+		return 0, "", "", fmt.Errorf("%s does not support exec", c.name)
+	}
+	return c.exec(ctx, cmd)
+}
+
+// WithExec sets how Exec runs commands in the client's container
+func (c *ConsensusClientImpl) WithExec(exec ExecFunc) *ConsensusClientImpl {
+	c.exec = exec
+	return c
+}
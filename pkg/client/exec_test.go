@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExec(t *testing.T) {
+	el := NewExecutionClient(Geth, "el-1-geth-lighthouse", "", "", "", "", "", "", "el-1-geth-lighthouse", "", 0)
+	_, _, _, err := el.Exec(context.Background(), []string{"geth", "version"})
+	assert.ErrorContains(t, err, "does not support exec")
+
+	var ran []string
+	el.WithExec(func(ctx context.Context, cmd []string) (int, string, string, error) {
+		ran = cmd
+		return 0, "Geth\nVersion: 1.14.0\n", "", nil
+	})
+	exitCode, stdout, _, err := el.Exec(context.Background(), []string{"geth", "version"})
+	require.NoError(t, err)
+	assert.Zero(t, exitCode)
+	assert.Contains(t, stdout, "1.14.0")
+	assert.Equal(t, []string{"geth", "version"}, ran)
+
+	cl := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", "", "", "", "", "cl-1-lighthouse-geth", "", 0).
+		WithExec(func(ctx context.Context, cmd []string) (int, string, string, error) {
+			return 2, "", "unknown command", nil
+		})
+	lazy := NewLazyConsensusClient(Lighthouse, cl.Name(), cl.ServiceName(), "", func() ConsensusClient { return cl })
+	exitCode, _, stderr, err := lazy.Exec(context.Background(), []string{"lighthouse", "nope"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, exitCode)
+	assert.Equal(t, "unknown command", stderr)
+}
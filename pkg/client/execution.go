@@ -1,5 +1,13 @@
 package client
 
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+)
+
 // ExecutionClient represents a common interface for all execution layer clients
 type ExecutionClient interface {
 	// Basic information
@@ -16,10 +24,41 @@ type ExecutionClient interface {
 	// P2P information
 	Enode() string
 	P2PPort() int
+	P2PURL() string
 
 	// Service information
 	ServiceName() string
 	ContainerID() string
+
+	// Per-request timeout for RPC calls
+	RPCTimeout() time.Duration
+
+	// TLS settings for https and wss endpoints; nil uses the system defaults
+	TLSConfig() *tls.Config
+
+	// Credentials for endpoints behind an authenticating proxy; nil sends none
+	Credentials() *Credentials
+
+	// Identity
+	String() string
+	Labels() Labels
+	Features() Features
+
+	// Live enode fetching
+	FetchEnode(ctx context.Context) (string, error)
+
+	// Commands in the client's container
+	Exec(ctx context.Context, cmd []string) (int, string, string, error)
+
+	// Engine API authentication
+	JWTSecret(ctx context.Context) (string, error)
+
+	// Chain data
+	BlockHeader(ctx context.Context, number uint64) (*BlockHeader, error)
+
+	// Events
+	GetLogs(ctx context.Context, filter EventFilter) ([]EventLog, error)
+	WatchEvents(ctx context.Context, contractAddr, topic string) (<-chan EventLog, <-chan error)
 }
 
 // ExecutionClientImpl is a generic implementation of the ExecutionClient interface
@@ -33,8 +72,20 @@ type ExecutionClientImpl struct {
 	metricsURL  string
 	enode       string
 	p2pPort     int
+	p2pURL      string
 	serviceName string
 	containerID string
+	enclave     string
+	rpcTimeout  time.Duration
+	tlsConfig   *tls.Config
+	credentials *Credentials
+	features    *Features
+	exec        ExecFunc
+	jwtSecret   func(context.Context) (string, error)
+
+	// fetchedEnode caches the enode FetchEnode got from the node
+	enodeMu      sync.RWMutex
+	fetchedEnode string
 }
 
 func (e *ExecutionClientImpl) Name() string        { return e.name }
@@ -44,11 +95,30 @@ func (e *ExecutionClientImpl) RPCURL() string      { return e.rpcURL }
 func (e *ExecutionClientImpl) WSURL() string       { return e.wsURL }
 func (e *ExecutionClientImpl) EngineURL() string   { return e.engineURL }
 func (e *ExecutionClientImpl) MetricsURL() string  { return e.metricsURL }
-func (e *ExecutionClientImpl) Enode() string       { return e.enode }
 func (e *ExecutionClientImpl) P2PPort() int        { return e.p2pPort }
+func (e *ExecutionClientImpl) P2PURL() string      { return e.p2pURL }
 func (e *ExecutionClientImpl) ServiceName() string { return e.serviceName }
 func (e *ExecutionClientImpl) ContainerID() string { return e.containerID }
 
+// Enode returns the node's enode, as fetched by FetchEnode or else as discovered
+func (e *ExecutionClientImpl) Enode() string {
+	e.enodeMu.RLock()
+	defer e.enodeMu.RUnlock()
+	if e.fetchedEnode != "" {
+		return e.fetchedEnode
+	}
+	return e.enode
+}
+
+// RPCTimeout returns the per-request timeout for RPC calls
+func (e *ExecutionClientImpl) RPCTimeout() time.Duration { return e.rpcTimeout }
+
+// TLSConfig returns the TLS settings for https and wss endpoints
+func (e *ExecutionClientImpl) TLSConfig() *tls.Config { return e.tlsConfig }
+
+// Credentials returns the credentials sent to the client's endpoints
+func (e *ExecutionClientImpl) Credentials() *Credentials { return e.credentials }
+
 // NewExecutionClient creates a new generic execution client instance
 func NewExecutionClient(clientType Type, name, version, rpcURL, wsURL, engineURL, metricsURL, enode, serviceName, containerID string, p2pPort int) *ExecutionClientImpl {
 	return &ExecutionClientImpl{
@@ -63,6 +133,7 @@ func NewExecutionClient(clientType Type, name, version, rpcURL, wsURL, engineURL
 		p2pPort:     p2pPort,
 		serviceName: serviceName,
 		containerID: containerID,
+		rpcTimeout:  DefaultRPCTimeout,
 	}
 }
 
@@ -71,6 +142,94 @@ type ExecutionClients struct {
 	*Collection[ExecutionClient]
 }
 
+// WithP2PURL sets the P2P endpoint URL
+func (e *ExecutionClientImpl) WithP2PURL(p2pURL string) *ExecutionClientImpl {
+	e.p2pURL = p2pURL
+	return e
+}
+
+// WithEnclave sets the enclave the client runs in, used in its identity
+func (e *ExecutionClientImpl) WithEnclave(enclave string) *ExecutionClientImpl {
+	e.enclave = enclave
+	return e
+}
+
+// WithCredentials sets the credentials sent with every request to the client's
+// endpoints, for networks exposed through an authenticating proxy
+func (e *ExecutionClientImpl) WithCredentials(credentials *Credentials) *ExecutionClientImpl {
+	e.credentials = credentials
+	return e
+}
+
+// WithTLSConfig sets the TLS settings for https and wss endpoints, e.g. a CA
+// bundle for clients behind an ingress with a private certificate
+func (e *ExecutionClientImpl) WithTLSConfig(tlsConfig *tls.Config) *ExecutionClientImpl {
+	e.tlsConfig = tlsConfig
+	return e
+}
+
+// WithRPCTimeout sets the per-request timeout for RPC calls. Non-positive values are ignored.
+func (e *ExecutionClientImpl) WithRPCTimeout(timeout time.Duration) *ExecutionClientImpl {
+	if timeout > 0 {
+		e.rpcTimeout = timeout
+	}
+	return e
+}
+
+// String returns the client's canonical identity, e.g. "my-enclave/geth-1@v1.14.0"
+func (e *ExecutionClientImpl) String() string {
+	return clientIdentity(e.name, e.clientType, e.version, e.enclave)
+}
+
+// Labels returns the labels identifying the client
+func (e *ExecutionClientImpl) Labels() Labels {
+	return clientLabels(e.name, e.clientType, e.version, e.enclave)
+}
+
+// FetchEnode fetches the live enode from the node using admin_nodeInfo. The
+// result is cached, so later calls and Enode return it without a request.
+func (e *ExecutionClientImpl) FetchEnode(ctx context.Context) (string, error) {
+	e.enodeMu.RLock()
+	cached := e.fetchedEnode
+	e.enodeMu.RUnlock()
+	if cached != "" {
+		return cached, nil
+	}
+
+	info, err := e.rpc().NodeInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	if info.Enode == "" {
+		return "", fmt.Errorf("enode is empty in admin_nodeInfo response")
+	}
+
+	e.enodeMu.Lock()
+	e.fetchedEnode = info.Enode
+	e.enodeMu.Unlock()
+	return info.Enode, nil
+}
+
+// BlockHeader returns the header of the block with the given number
+func (e *ExecutionClientImpl) BlockHeader(ctx context.Context, number uint64) (*BlockHeader, error) {
+	return e.rpc().BlockHeader(ctx, number)
+}
+
+// GetLogs returns the logs matching the filter
+func (e *ExecutionClientImpl) GetLogs(ctx context.Context, filter EventFilter) ([]EventLog, error) {
+	return e.rpc().GetLogs(ctx, filter)
+}
+
+// WatchEvents polls for logs emitted by the contract with the given first topic
+func (e *ExecutionClientImpl) WatchEvents(ctx context.Context, contractAddr, topic string) (<-chan EventLog, <-chan error) {
+	return e.rpc().WatchEvents(ctx, contractAddr, topic)
+}
+
+// rpc returns a JSON-RPC client for the execution client's RPC endpoint
+func (e *ExecutionClientImpl) rpc() *BaseExecutionClient {
+	return RPC(e)
+}
+
 // NewExecutionClients creates a new ExecutionClients collection
 func NewExecutionClients() *ExecutionClients {
 	return &ExecutionClients{
@@ -87,3 +246,45 @@ func (ec *ExecutionClients) Add(client ExecutionClient) {
 func (ec *ExecutionClients) ByType(clientType Type) []ExecutionClient {
 	return ec.Collection.ByType(clientType)
 }
+
+// ByName returns the execution client with the given name
+func (ec *ExecutionClients) ByName(name string) (ExecutionClient, bool) {
+	return findByName(ec.All(), name)
+}
+
+// ByNamePrefix returns the execution clients whose names start with prefix
+func (ec *ExecutionClients) ByNamePrefix(prefix string) []ExecutionClient {
+	return filterByNamePrefix(ec.All(), prefix)
+}
+
+// Except returns the execution clients whose names are not listed
+func (ec *ExecutionClients) Except(names ...string) []ExecutionClient {
+	return exceptNames(ec.All(), names)
+}
+
+// Map returns the execution clients keyed by name
+func (ec *ExecutionClients) Map() map[string]ExecutionClient {
+	return mapByName(ec.All())
+}
+
+// Enodes fetches enodes for all execution clients in the collection,
+// concurrently and bounded by the collection's limiter
+func (ec *ExecutionClients) Enodes(ctx context.Context) (map[string]string, error) {
+	clients := ec.All()
+	enodes := make([]string, len(clients))
+	errs := FanOut(ctx, ec.Limiter(), clients, func(ctx context.Context, i int, client ExecutionClient) error {
+		enode, err := client.FetchEnode(ctx)
+		enodes[i] = enode
+		return err
+	})
+
+	result := make(map[string]string, len(clients))
+	for i, client := range clients {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("failed to fetch enode for client %s: %w", client.Name(), errs[i])
+		}
+		result[client.Name()] = enodes[i]
+	}
+
+	return result, nil
+}
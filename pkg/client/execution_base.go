@@ -3,12 +3,16 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 )
 
+// DefaultRPCTimeout is the per-request timeout for client JSON-RPC and beacon API calls
+const DefaultRPCTimeout = 30 * time.Second
+
 // ClientConfig holds configuration for creating an execution client
 type ClientConfig struct {
 	Name       string
@@ -18,6 +22,10 @@ type ClientConfig struct {
 	P2PURL     string
 	MetricsURL string
 	Enode      string
+	RPCTimeout time.Duration // per-request timeout, DefaultRPCTimeout when zero
+	TLSConfig  *tls.Config   // TLS settings for https endpoints, system defaults when nil
+	// Credentials are sent with every RPC request, for endpoints behind an authenticating proxy
+	Credentials *Credentials
 }
 
 // BaseExecutionClient provides common functionality for all execution clients
@@ -31,24 +39,36 @@ type BaseExecutionClient struct {
 	metricsURL string
 	enode      string
 	httpClient *http.Client
+
+	eventPollInterval time.Duration
 }
 
 // NewBaseExecutionClient creates a new base execution client
 func NewBaseExecutionClient(config ClientConfig) *BaseExecutionClient {
+	timeout := config.RPCTimeout
+	if timeout <= 0 {
+		timeout = DefaultRPCTimeout
+	}
+
 	return &BaseExecutionClient{
-		name:       config.Name,
-		rpcURL:     config.RPCURL,
-		wsURL:      config.WSURL,
-		engineURL:  config.EngineURL,
-		p2pURL:     config.P2PURL,
-		metricsURL: config.MetricsURL,
-		enode:      config.Enode,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		name:              config.Name,
+		rpcURL:            config.RPCURL,
+		wsURL:             config.WSURL,
+		engineURL:         config.EngineURL,
+		p2pURL:            config.P2PURL,
+		metricsURL:        config.MetricsURL,
+		enode:             config.Enode,
+		httpClient:        config.Credentials.Wrap(NewHTTPClient(timeout, config.TLSConfig)),
+		eventPollInterval: DefaultEventPollInterval,
 	}
 }
 
+// WithEventPollInterval sets how often WatchEvents polls for new logs
+func (b *BaseExecutionClient) WithEventPollInterval(interval time.Duration) *BaseExecutionClient {
+	b.eventPollInterval = interval
+	return b
+}
+
 // Name returns the client name
 func (b *BaseExecutionClient) Name() string {
 	return b.name
@@ -221,6 +241,16 @@ func (b *BaseExecutionClient) WaitForSync(ctx context.Context) error {
 	}
 }
 
+// NodeInfo returns the node's identity using admin_nodeInfo, which needs the
+// admin namespace enabled on the RPC endpoint
+func (b *BaseExecutionClient) NodeInfo(ctx context.Context) (*NodeInfo, error) {
+	var info NodeInfo
+	if err := b.call(ctx, "admin_nodeInfo", []interface{}{}, &info); err != nil {
+		return nil, fmt.Errorf("failed to get node info: %w", err)
+	}
+	return &info, nil
+}
+
 // NodeInfo represents node information
 type NodeInfo struct {
 	ID    string                 `json:"id"`
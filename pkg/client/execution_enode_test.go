@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newNodeInfoServer answers admin_nodeInfo with the given enode and counts the calls
+func newNodeInfoServer(t *testing.T, enode string, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		assert.Equal(t, "admin_nodeInfo", req.Method)
+		calls.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": map[string]interface{}{
+			"id":    "abcd",
+			"name":  "Geth/v1.14.0",
+			"enode": enode,
+		}})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExecutionClient_FetchEnode(t *testing.T) {
+	const enode = "enode://abcd@172.16.0.10:30303"
+	var calls atomic.Int32
+	server := newNodeInfoServer(t, enode, &calls)
+
+	el := NewExecutionClient(Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "enode://guessed@10.0.0.1:30303", "el-1-geth-lighthouse", "", 30303)
+	assert.Equal(t, "enode://guessed@10.0.0.1:30303", el.Enode())
+
+	fetched, err := el.FetchEnode(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, enode, fetched)
+	assert.Equal(t, enode, el.Enode(), "the fetched enode replaces the discovered one")
+
+	// Later calls are served from the cache
+	fetched, err = el.FetchEnode(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, enode, fetched)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestExecutionClient_FetchEnodeEmpty(t *testing.T) {
+	var calls atomic.Int32
+	server := newNodeInfoServer(t, "", &calls)
+
+	el := NewExecutionClient(Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "", "el-1-geth-lighthouse", "", 30303)
+	_, err := el.FetchEnode(context.Background())
+	assert.ErrorContains(t, err, "enode is empty")
+}
+
+func TestExecutionClients_Enodes(t *testing.T) {
+	var calls atomic.Int32
+	clients := NewExecutionClients()
+	for _, name := range []string{"el-1-geth-lighthouse", "el-2-besu-teku"} {
+		server := newNodeInfoServer(t, "enode://"+name+"@172.16.0.10:30303", &calls)
+		clients.Add(NewExecutionClient(Geth, name, "", server.URL, "", "", "", "", name, "", 30303))
+	}
+
+	enodes, err := clients.Enodes(context.Background())
+	require.NoError(t, err)
+	require.Len(t, enodes, 2)
+	for name, enode := range enodes {
+		assert.True(t, strings.HasPrefix(enode, "enode://"+name+"@"))
+	}
+
+	// A failing client fails the collection
+	clients.Add(NewExecutionClient(Besu, "el-3-besu-prysm", "", "http://127.0.0.1:1", "", "", "", "", "el-3-besu-prysm", "", 30303))
+	_, err = clients.Enodes(context.Background())
+	assert.ErrorContains(t, err, "failed to fetch enode for client el-3-besu-prysm")
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultFanoutLimit is the number of concurrent requests network-wide operations
+// make when no explicit limit is configured
+const DefaultFanoutLimit = 16
+
+// Limiter bounds the number of concurrent calls made by network-wide operations
+// such as fetching peer IDs, health checks and log collection
+type Limiter struct {
+	slots chan struct{}
+}
+
+// NewLimiter creates a limiter allowing n concurrent calls. Values below 1 use DefaultFanoutLimit.
+func NewLimiter(n int) *Limiter {
+	if n < 1 {
+		n = DefaultFanoutLimit
+	}
+	return &Limiter{slots: make(chan struct{}, n)}
+}
+
+// Limit returns the maximum number of concurrent calls
+func (l *Limiter) Limit() int {
+	return cap(l.slots)
+}
+
+// Acquire blocks until a slot is free or the context is done
+func (l *Limiter) Acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot taken by Acquire
+func (l *Limiter) Release() {
+	<-l.slots
+}
+
+// FanOut calls fn with the index and value of every item, running at most limiter.Limit() calls at once.
+// The returned slice holds the error for each item at the same index. A nil
+// limiter uses a new limiter with DefaultFanoutLimit.
+func FanOut[T any](ctx context.Context, limiter *Limiter, items []T, fn func(ctx context.Context, i int, item T) error) []error {
+	if limiter == nil {
+		limiter = NewLimiter(DefaultFanoutLimit)
+	}
+
+	errs := make([]error, len(items))
+	var wg sync.WaitGroup
+	for i, item := range items {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		if err := limiter.Acquire(ctx); err != nil {
+			errs[i] = err
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, item T) {
+			defer wg.Done()
+			defer limiter.Release()
+			errs[i] = fn(ctx, i, item)
+		}(i, item)
+	}
+	wg.Wait()
+
+	return errs
+}
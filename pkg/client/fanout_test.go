@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLimiter(t *testing.T) {
+	assert.Equal(t, 4, NewLimiter(4).Limit())
+	assert.Equal(t, DefaultFanoutLimit, NewLimiter(0).Limit())
+	assert.Equal(t, DefaultFanoutLimit, NewLimiter(-1).Limit())
+}
+
+func TestFanOut_RespectsLimit(t *testing.T) {
+	limiter := NewLimiter(3)
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+
+	var current, peak int32
+	errs := FanOut(context.Background(), limiter, items, func(ctx context.Context, i int, item int) error {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+
+		if item%5 == 0 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	require.Len(t, errs, len(items))
+	assert.LessOrEqual(t, peak, int32(3))
+	for i, err := range errs {
+		if i%5 == 0 {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestFanOut_ContextCanceled(t *testing.T) {
+	limiter := NewLimiter(1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errs := FanOut(ctx, limiter, []string{"a", "b", "c"}, func(ctx context.Context, i int, item string) error {
+		cancel()
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	})
+
+	// The first call always runs; calls started after cancellation are skipped
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[2], context.Canceled)
+}
+
+func TestCollection_SharedLimiter(t *testing.T) {
+	consensus := NewConsensusClients()
+	execution := NewExecutionClients()
+	assert.Equal(t, DefaultFanoutLimit, consensus.Limiter().Limit())
+
+	limiter := NewLimiter(2)
+	consensus.SetLimiter(limiter)
+	execution.SetLimiter(limiter)
+	assert.Same(t, consensus.Limiter(), execution.Limiter())
+}
@@ -0,0 +1,80 @@
+package client
+
+import "strings"
+
+// Features are the optional capabilities of a client, known from how it was
+// deployed so callers can branch on them without probing
+type Features struct {
+	// WebSocket is set when the execution client exposes a WebSocket RPC endpoint
+	WebSocket bool
+	// EngineAPI is set when the execution client exposes a JWT-authenticated engine API
+	EngineAPI bool
+	// Debug is set when the execution client serves the debug RPC namespace
+	Debug bool
+	// Metrics is set when the client exposes a Prometheus metrics endpoint
+	Metrics bool
+	// EventStream is set when the beacon node serves the server-sent event stream
+	EventStream bool
+}
+
+// String lists the enabled features
+func (f Features) String() string {
+	var enabled []string
+	for _, feature := range []struct {
+		name string
+		on   bool
+	}{
+		{"ws", f.WebSocket},
+		{"engine", f.EngineAPI},
+		{"debug", f.Debug},
+		{"metrics", f.Metrics},
+		{"events", f.EventStream},
+	} {
+		if feature.on {
+			enabled = append(enabled, feature.name)
+		}
+	}
+	if len(enabled) == 0 {
+		return "none"
+	}
+	return strings.Join(enabled, ",")
+}
+
+// Features returns the client's features. Unless overridden with WithFeatures
+// they follow from its endpoints; ethereum-package enables the debug namespace
+// and JWT authentication on every execution client.
+func (e *ExecutionClientImpl) Features() Features {
+	if e.features != nil {
+		return *e.features
+	}
+	return Features{
+		WebSocket: e.wsURL != "",
+		EngineAPI: e.engineURL != "",
+		Debug:     e.clientType.IsExecution() && e.rpcURL != "",
+		Metrics:   e.metricsURL != "",
+	}
+}
+
+// WithFeatures overrides the features derived from the client's endpoints
+func (e *ExecutionClientImpl) WithFeatures(features Features) *ExecutionClientImpl {
+	e.features = &features
+	return e
+}
+
+// Features returns the client's features. Unless overridden with WithFeatures
+// they follow from its endpoints; every beacon API serves the event stream.
+func (c *ConsensusClientImpl) Features() Features {
+	if c.features != nil {
+		return *c.features
+	}
+	return Features{
+		Metrics:     c.metricsURL != "",
+		EventStream: c.beaconAPIURL != "",
+	}
+}
+
+// WithFeatures overrides the features derived from the client's endpoints
+func (c *ConsensusClientImpl) WithFeatures(features Features) *ConsensusClientImpl {
+	c.features = &features
+	return c
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionClientFeatures(t *testing.T) {
+	el := NewExecutionClient(Geth, "el-1-geth", "", "http://rpc", "ws://ws", "http://engine", "", "", "el-1-geth", "", 0)
+	assert.Equal(t, Features{WebSocket: true, EngineAPI: true, Debug: true}, el.Features())
+	assert.Equal(t, "ws,engine,debug", el.Features().String())
+
+	el.WithFeatures(Features{Metrics: true})
+	assert.Equal(t, "metrics", el.Features().String())
+
+	lazy := NewLazyExecutionClient(Reth, "el-2-reth", "el-2-reth", "", func() ExecutionClient {
+		return NewExecutionClient(Reth, "el-2-reth", "", "http://rpc", "", "", "http://metrics", "", "el-2-reth", "", 0)
+	})
+	assert.Equal(t, Features{Debug: true, Metrics: true}, lazy.Features())
+}
+
+func TestConsensusClientFeatures(t *testing.T) {
+	cl := NewConsensusClient(Teku, "cl-1-teku", "", "http://beacon", "", "", "", "cl-1-teku", "", 0)
+	assert.Equal(t, Features{EventStream: true}, cl.Features())
+
+	cl = NewConsensusClient(Teku, "cl-1-teku", "", "", "", "", "", "cl-1-teku", "", 0)
+	assert.Equal(t, "none", cl.Features().String())
+}
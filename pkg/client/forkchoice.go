@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// Checkpoint is a finality checkpoint
+type Checkpoint struct {
+	Epoch uint64
+	Root  string
+}
+
+// ForkChoiceNode is a block in a consensus client's fork choice store
+type ForkChoiceNode struct {
+	Slot           uint64
+	BlockRoot      string
+	ParentRoot     string
+	JustifiedEpoch uint64
+	FinalizedEpoch uint64
+	Weight         uint64
+	Validity       string
+}
+
+// ForkChoice is a dump of a consensus client's fork choice store
+type ForkChoice struct {
+	JustifiedCheckpoint Checkpoint
+	FinalizedCheckpoint Checkpoint
+	Nodes               []ForkChoiceNode
+}
+
+// Head returns the leaf with the greatest weight, preferring the higher slot on ties.
+// It returns false if the store is empty.
+func (f *ForkChoice) Head() (ForkChoiceNode, bool) {
+	hasChildren := make(map[string]bool, len(f.Nodes))
+	for _, node := range f.Nodes {
+		hasChildren[node.ParentRoot] = true
+	}
+
+	var head ForkChoiceNode
+	found := false
+	for _, node := range f.Nodes {
+		if hasChildren[node.BlockRoot] {
+			continue
+		}
+		if !found || node.Weight > head.Weight || (node.Weight == head.Weight && node.Slot > head.Slot) {
+			head = node
+			found = true
+		}
+	}
+
+	return head, found
+}
+
+// ForkChoice dumps the client's fork choice store via the debug API
+func (c *ConsensusClientImpl) ForkChoice(ctx context.Context) (*ForkChoice, error) {
+	type checkpoint struct {
+		Epoch string `json:"epoch"`
+		Root  string `json:"root"`
+	}
+	var response struct {
+		JustifiedCheckpoint checkpoint `json:"justified_checkpoint"`
+		FinalizedCheckpoint checkpoint `json:"finalized_checkpoint"`
+		ForkChoiceNodes     []struct {
+			Slot           string `json:"slot"`
+			BlockRoot      string `json:"block_root"`
+			ParentRoot     string `json:"parent_root"`
+			JustifiedEpoch string `json:"justified_epoch"`
+			FinalizedEpoch string `json:"finalized_epoch"`
+			Weight         string `json:"weight"`
+			Validity       string `json:"validity"`
+		} `json:"fork_choice_nodes"`
+	}
+
+	if err := c.getBeaconJSON(ctx, "/eth/v1/debug/fork_choice", &response); err != nil {
+		return nil, err
+	}
+
+	parse := func(field, value string) (uint64, error) {
+		if value == "" {
+			return 0, nil
+		}
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q: %w", field, value, err)
+		}
+		return n, nil
+	}
+
+	forkChoice := &ForkChoice{Nodes: make([]ForkChoiceNode, len(response.ForkChoiceNodes))}
+	var err error
+	if forkChoice.JustifiedCheckpoint.Epoch, err = parse("justified epoch", response.JustifiedCheckpoint.Epoch); err != nil {
+		return nil, err
+	}
+	forkChoice.JustifiedCheckpoint.Root = response.JustifiedCheckpoint.Root
+	if forkChoice.FinalizedCheckpoint.Epoch, err = parse("finalized epoch", response.FinalizedCheckpoint.Epoch); err != nil {
+		return nil, err
+	}
+	forkChoice.FinalizedCheckpoint.Root = response.FinalizedCheckpoint.Root
+
+	for i, data := range response.ForkChoiceNodes {
+		node := ForkChoiceNode{BlockRoot: data.BlockRoot, ParentRoot: data.ParentRoot, Validity: data.Validity}
+		if node.Slot, err = parse("slot", data.Slot); err != nil {
+			return nil, err
+		}
+		if node.JustifiedEpoch, err = parse("justified epoch", data.JustifiedEpoch); err != nil {
+			return nil, err
+		}
+		if node.FinalizedEpoch, err = parse("finalized epoch", data.FinalizedEpoch); err != nil {
+			return nil, err
+		}
+		if node.Weight, err = parse("weight", data.Weight); err != nil {
+			return nil, err
+		}
+		forkChoice.Nodes[i] = node
+	}
+
+	return forkChoice, nil
+}
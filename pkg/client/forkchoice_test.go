@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusClient_ForkChoice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/debug/fork_choice", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"justified_checkpoint": {"epoch": "1", "root": "0xj"},
+			"finalized_checkpoint": {"epoch": "0", "root": "0xf"},
+			"fork_choice_nodes": [
+				{"slot": "10", "block_root": "0xa", "parent_root": "0x0", "justified_epoch": "1", "finalized_epoch": "0", "weight": "300", "validity": "valid"},
+				{"slot": "11", "block_root": "0xb", "parent_root": "0xa", "justified_epoch": "1", "finalized_epoch": "0", "weight": "200", "validity": "valid"},
+				{"slot": "12", "block_root": "0xc", "parent_root": "0xa", "justified_epoch": "1", "finalized_epoch": "0", "weight": "100", "validity": "optimistic"}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	c := NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "", "", 0)
+	forkChoice, err := c.ForkChoice(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, Checkpoint{Epoch: 1, Root: "0xj"}, forkChoice.JustifiedCheckpoint)
+	assert.Equal(t, Checkpoint{Epoch: 0, Root: "0xf"}, forkChoice.FinalizedCheckpoint)
+	require.Len(t, forkChoice.Nodes, 3)
+	assert.Equal(t, "optimistic", forkChoice.Nodes[2].Validity)
+
+	head, ok := forkChoice.Head()
+	require.True(t, ok)
+	assert.Equal(t, "0xb", head.BlockRoot)
+
+	_, ok = (&ForkChoice{}).Head()
+	assert.False(t, ok)
+}
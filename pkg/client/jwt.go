@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// jwtHeader is the base64url-encoded {"alg":"HS256","typ":"JWT"} header of engine API tokens
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// JWTSecret returns the hex-encoded secret the client authenticates engine API
+// calls with, as shared by every client of the network
+func (e *ExecutionClientImpl) JWTSecret(ctx context.Context) (string, error) {
+	if e.jwtSecret == nil {
+		return "", fmt.Errorf("%s does not expose its JWT secret", e.name)
+	}
+	secret, err := e.jwtSecret(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get JWT secret: %w", err)
+	}
+	secret = strings.TrimSpace(secret)
+	if _, err := decodeJWTSecret(secret); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// WithJWTSecret sets how JWTSecret reads the engine API secret
+func (e *ExecutionClientImpl) WithJWTSecret(jwtSecret func(context.Context) (string, error)) *ExecutionClientImpl {
+	e.jwtSecret = jwtSecret
+	return e
+}
+
+// EngineToken returns the bearer token for an engine API call made at
+// issuedAt, signed with the hex-encoded JWT secret. Clients reject tokens
+// issued more than a minute from their clock, so make one per call:
+//
+//	req.Header.Set("Authorization", "Bearer "+token)
+func EngineToken(secret string, issuedAt time.Time) (string, error) {
+	key, err := decodeJWTSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"iat":%d}`, issuedAt.Unix())))
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(jwtHeader + "." + claims))
+	return jwtHeader + "." + claims + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// decodeJWTSecret decodes a 32-byte hex secret, with or without 0x prefix
+func decodeJWTSecret(secret string) ([]byte, error) {
+	key, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(secret), "0x"))
+	if err != nil || len(key) != 32 {
+		return nil, fmt.Errorf("invalid JWT secret: want 32 hex-encoded bytes")
+	}
+	return key, nil
+}
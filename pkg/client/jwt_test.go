@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJWTSecret = "0xdc49981516e8e72b401a63e6405495a32dafc3939b5d6d83cc319ac0388bca1b"
+
+func TestJWTSecret(t *testing.T) {
+	el := NewExecutionClient(Geth, "el-1-geth-lighthouse", "", "", "", "", "", "", "el-1-geth-lighthouse", "", 0)
+	_, err := el.JWTSecret(context.Background())
+	assert.ErrorContains(t, err, "does not expose its JWT secret")
+
+	el.WithJWTSecret(func(ctx context.Context) (string, error) { return testJWTSecret + "\n", nil })
+	secret, err := el.JWTSecret(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testJWTSecret, secret)
+
+	el.WithJWTSecret(func(ctx context.Context) (string, error) { return "0x1234", nil })
+	_, err = el.JWTSecret(context.Background())
+	assert.ErrorContains(t, err, "invalid JWT secret")
+}
+
+func TestEngineToken(t *testing.T) {
+	token, err := EngineToken(testJWTSecret, time.Unix(1700000000, 0))
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"alg":"HS256","typ":"JWT"}`, string(header))
+	claims, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"iat":1700000000}`, string(claims))
+
+	key, _ := hex.DecodeString(strings.TrimPrefix(testJWTSecret, "0x"))
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2])
+
+	// The prefix is optional
+	unprefixed, err := EngineToken(strings.TrimPrefix(testJWTSecret, "0x"), time.Unix(1700000000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, token, unprefixed)
+
+	_, err = EngineToken("not hex", time.Now())
+	assert.Error(t, err)
+}
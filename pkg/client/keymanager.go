@@ -0,0 +1,175 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// KeymanagerClient talks to a validator client's keymanager API, which manages
+// the keys it validates with at runtime
+type KeymanagerClient struct {
+	url       string
+	token     string
+	timeout   time.Duration
+	tlsConfig *tls.Config
+}
+
+// NewKeymanagerClient creates a client for the keymanager API at url,
+// authenticating with the bearer token
+func NewKeymanagerClient(url, token string) *KeymanagerClient {
+	return &KeymanagerClient{
+		url:     strings.TrimSuffix(url, "/"),
+		token:   token,
+		timeout: DefaultRPCTimeout,
+	}
+}
+
+// WithRPCTimeout sets the per-request timeout; zero keeps the default
+func (k *KeymanagerClient) WithRPCTimeout(timeout time.Duration) *KeymanagerClient {
+	if timeout > 0 {
+		k.timeout = timeout
+	}
+	return k
+}
+
+// WithTLSConfig sets the TLS settings for an https endpoint
+func (k *KeymanagerClient) WithTLSConfig(tlsConfig *tls.Config) *KeymanagerClient {
+	k.tlsConfig = tlsConfig
+	return k
+}
+
+// URL returns the keymanager API's base URL
+func (k *KeymanagerClient) URL() string { return k.url }
+
+// Keystore is a key the validator client validates with
+type Keystore struct {
+	Pubkey         string `json:"validating_pubkey"`
+	DerivationPath string `json:"derivation_path,omitempty"`
+	Readonly       bool   `json:"readonly"`
+}
+
+// KeystoreStatus is the outcome of importing or deleting one keystore, e.g.
+// "imported", "duplicate", "deleted", "not_active" or "error"
+type KeystoreStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// ListKeystores returns the keystores the validator client holds
+func (k *KeymanagerClient) ListKeystores(ctx context.Context) ([]Keystore, error) {
+	var resp struct {
+		Data []Keystore `json:"data"`
+	}
+	if err := k.do(ctx, http.MethodGet, "/eth/v1/keystores", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to list keystores: %w", err)
+	}
+	return resp.Data, nil
+}
+
+// ImportKeystores imports EIP-2335 keystores with their passwords. The optional
+// EIP-3076 slashing protection interchange is imported along with them.
+func (k *KeymanagerClient) ImportKeystores(ctx context.Context, keystores, passwords []string, slashingProtection string) ([]KeystoreStatus, error) {
+	if len(keystores) != len(passwords) {
+		return nil, fmt.Errorf("got %d keystores but %d passwords", len(keystores), len(passwords))
+	}
+
+	body := map[string]interface{}{
+		"keystores": keystores,
+		"passwords": passwords,
+	}
+	if slashingProtection != "" {
+		body["slashing_protection"] = slashingProtection
+	}
+
+	var resp struct {
+		Data []KeystoreStatus `json:"data"`
+	}
+	if err := k.do(ctx, http.MethodPost, "/eth/v1/keystores", body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to import keystores: %w", err)
+	}
+	return resp.Data, nil
+}
+
+// DeleteKeystores removes the keys and returns their statuses along with the
+// slashing protection interchange to import them elsewhere
+func (k *KeymanagerClient) DeleteKeystores(ctx context.Context, pubkeys []string) ([]KeystoreStatus, string, error) {
+	var resp struct {
+		Data               []KeystoreStatus `json:"data"`
+		SlashingProtection string           `json:"slashing_protection"`
+	}
+	if err := k.do(ctx, http.MethodDelete, "/eth/v1/keystores", map[string]interface{}{"pubkeys": pubkeys}, &resp); err != nil {
+		return nil, "", fmt.Errorf("failed to delete keystores: %w", err)
+	}
+	return resp.Data, resp.SlashingProtection, nil
+}
+
+// FeeRecipient returns the fee recipient of the validator's proposals
+func (k *KeymanagerClient) FeeRecipient(ctx context.Context, pubkey string) (string, error) {
+	var resp struct {
+		Data struct {
+			EthAddress string `json:"ethaddress"`
+		} `json:"data"`
+	}
+	if err := k.do(ctx, http.MethodGet, "/eth/v1/validator/"+pubkey+"/feerecipient", nil, &resp); err != nil {
+		return "", fmt.Errorf("failed to get fee recipient of %s: %w", pubkey, err)
+	}
+	return resp.Data.EthAddress, nil
+}
+
+// SetFeeRecipient sets the fee recipient of the validator's proposals
+func (k *KeymanagerClient) SetFeeRecipient(ctx context.Context, pubkey, address string) error {
+	if err := k.do(ctx, http.MethodPost, "/eth/v1/validator/"+pubkey+"/feerecipient", map[string]string{"ethaddress": address}, nil); err != nil {
+		return fmt.Errorf("failed to set fee recipient of %s: %w", pubkey, err)
+	}
+	return nil
+}
+
+// do sends an authenticated request and decodes the response into v unless it is nil
+func (k *KeymanagerClient) do(ctx context.Context, method, path string, body, v interface{}) error {
+	if k.url == "" {
+		return fmt.Errorf("keymanager URL is empty")
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, k.url+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+k.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := NewHTTPClient(k.timeout, k.tlsConfig).Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("keymanager returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+	if v == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
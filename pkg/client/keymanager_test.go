@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeymanagerClient(t *testing.T) {
+	const pubkey = "0xa99a"
+	var imported map[string]interface{}
+	var deleted map[string][]string
+	feeRecipient := "0x0000000000000000000000000000000000000001"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"invalid token"}`))
+			return
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "GET /eth/v1/keystores":
+			_, _ = w.Write([]byte(`{"data":[{"validating_pubkey":"0xa99a","derivation_path":"m/12381/3600/0/0/0","readonly":false}]}`))
+		case "POST /eth/v1/keystores":
+			if err := json.NewDecoder(r.Body).Decode(&imported); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[{"status":"imported"}]}`))
+		case "DELETE /eth/v1/keystores":
+			if err := json.NewDecoder(r.Body).Decode(&deleted); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[{"status":"deleted"}],"slashing_protection":"{}"}`))
+		case "GET /eth/v1/validator/0xa99a/feerecipient":
+			_, _ = w.Write([]byte(`{"data":{"pubkey":"0xa99a","ethaddress":"` + feeRecipient + `"}}`))
+		case "POST /eth/v1/validator/0xa99a/feerecipient":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			feeRecipient = body["ethaddress"]
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	keymanager := NewKeymanagerClient(server.URL+"/", "secret")
+	assert.Equal(t, server.URL, keymanager.URL())
+
+	keystores, err := keymanager.ListKeystores(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Keystore{{Pubkey: pubkey, DerivationPath: "m/12381/3600/0/0/0"}}, keystores)
+
+	statuses, err := keymanager.ImportKeystores(ctx, []string{`{"version":4}`}, []string{"password"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, []KeystoreStatus{{Status: "imported"}}, statuses)
+	assert.Equal(t, []interface{}{"password"}, imported["passwords"])
+	assert.NotContains(t, imported, "slashing_protection")
+
+	_, err = keymanager.ImportKeystores(ctx, []string{`{}`}, nil, "")
+	assert.ErrorContains(t, err, "got 1 keystores but 0 passwords")
+
+	statuses, slashingProtection, err := keymanager.DeleteKeystores(ctx, []string{pubkey})
+	require.NoError(t, err)
+	assert.Equal(t, []KeystoreStatus{{Status: "deleted"}}, statuses)
+	assert.Equal(t, "{}", slashingProtection)
+	assert.Equal(t, []string{pubkey}, deleted["pubkeys"])
+
+	require.NoError(t, keymanager.SetFeeRecipient(ctx, pubkey, "0x0000000000000000000000000000000000000002"))
+	recipient, err := keymanager.FeeRecipient(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, "0x0000000000000000000000000000000000000002", recipient)
+
+	_, err = NewKeymanagerClient(server.URL, "wrong").ListKeystores(ctx)
+	assert.ErrorContains(t, err, `keymanager returned status 401: {"message":"invalid token"}`)
+}
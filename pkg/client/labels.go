@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Label keys describing a client's identity
+const (
+	LabelName    = "name"
+	LabelType    = "type"
+	LabelIndex   = "index"
+	LabelVersion = "version"
+	LabelEnclave = "enclave"
+)
+
+// Labels identify a client across networks, e.g. in logs and health reports
+type Labels map[string]string
+
+// String returns the labels as sorted key=value pairs
+func (l Labels) String() string {
+	keys := make([]string, 0, len(l))
+	for key := range l {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + l[key]
+	}
+	return strings.Join(pairs, " ")
+}
+
+// clientLabels builds the labels of a client, omitting empty values
+func clientLabels(name string, clientType Type, version, enclave string) Labels {
+	labels := Labels{
+		LabelName: name,
+		LabelType: string(clientType),
+	}
+	if index := NodeIndex(name); index > 0 {
+		labels[LabelIndex] = strconv.Itoa(index)
+	}
+	if version != "" {
+		labels[LabelVersion] = version
+	}
+	if enclave != "" {
+		labels[LabelEnclave] = enclave
+	}
+	return labels
+}
+
+// clientIdentity returns the canonical identity string of a client, formatted as
+// [enclave/]type-index[@version], falling back to the name when it has no node index
+func clientIdentity(name string, clientType Type, version, enclave string) string {
+	id := name
+	if index := NodeIndex(name); index > 0 {
+		id = fmt.Sprintf("%s-%d", clientType, index)
+	}
+	if version != "" {
+		id += "@" + version
+	}
+	if enclave != "" {
+		id = enclave + "/" + id
+	}
+	return id
+}
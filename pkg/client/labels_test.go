@@ -0,0 +1,30 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIdentity(t *testing.T) {
+	execClient := NewExecutionClient(Geth, "el-1-geth-lighthouse", "v1.14.0", "", "", "", "", "", "el-1-geth-lighthouse", "", 0).
+		WithEnclave("devnet-a")
+	assert.Equal(t, "devnet-a/geth-1@v1.14.0", execClient.String())
+	assert.Equal(t, Labels{
+		LabelName:    "el-1-geth-lighthouse",
+		LabelType:    "geth",
+		LabelIndex:   "1",
+		LabelVersion: "v1.14.0",
+		LabelEnclave: "devnet-a",
+	}, execClient.Labels())
+
+	consClient := NewConsensusClient(Teku, "teku-custom", "", "", "", "", "", "teku-custom", "", 0)
+	assert.Equal(t, "teku-custom", consClient.String())
+	assert.Equal(t, Labels{LabelName: "teku-custom", LabelType: "teku"}, consClient.Labels())
+}
+
+func TestLabels_String(t *testing.T) {
+	labels := Labels{LabelType: "lighthouse", LabelEnclave: "devnet-b", LabelIndex: "2"}
+	assert.Equal(t, "enclave=devnet-b index=2 type=lighthouse", labels.String())
+	assert.Equal(t, "", Labels{}.String())
+}
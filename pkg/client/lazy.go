@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LazyExecutionClient is an ExecutionClient whose endpoints and metadata are resolved
+// on first use and memoized. Name, type, service name and container ID are known
+// upfront so collections can index the client without resolving it.
+type LazyExecutionClient struct {
+	name        string
+	clientType  Type
+	serviceName string
+	containerID string
+
+	once     sync.Once
+	done     atomic.Bool
+	resolve  func() ExecutionClient
+	resolved ExecutionClient
+}
+
+// NewLazyExecutionClient creates an execution client that calls resolve on first use
+func NewLazyExecutionClient(clientType Type, name, serviceName, containerID string, resolve func() ExecutionClient) *LazyExecutionClient {
+	return &LazyExecutionClient{
+		name:        name,
+		clientType:  clientType,
+		serviceName: serviceName,
+		containerID: containerID,
+		resolve:     resolve,
+	}
+}
+
+// Resolved reports whether the client's endpoints have been resolved
+func (l *LazyExecutionClient) Resolved() bool {
+	return l.done.Load()
+}
+
+func (l *LazyExecutionClient) get() ExecutionClient {
+	l.once.Do(func() {
+		l.resolved = l.resolve()
+		l.done.Store(true)
+	})
+	return l.resolved
+}
+
+func (l *LazyExecutionClient) Name() string              { return l.name }
+func (l *LazyExecutionClient) Type() Type                { return l.clientType }
+func (l *LazyExecutionClient) ServiceName() string       { return l.serviceName }
+func (l *LazyExecutionClient) ContainerID() string       { return l.containerID }
+func (l *LazyExecutionClient) Version() string           { return l.get().Version() }
+func (l *LazyExecutionClient) RPCURL() string            { return l.get().RPCURL() }
+func (l *LazyExecutionClient) WSURL() string             { return l.get().WSURL() }
+func (l *LazyExecutionClient) EngineURL() string         { return l.get().EngineURL() }
+func (l *LazyExecutionClient) MetricsURL() string        { return l.get().MetricsURL() }
+func (l *LazyExecutionClient) Enode() string             { return l.get().Enode() }
+func (l *LazyExecutionClient) P2PPort() int              { return l.get().P2PPort() }
+func (l *LazyExecutionClient) P2PURL() string            { return l.get().P2PURL() }
+func (l *LazyExecutionClient) String() string            { return l.get().String() }
+func (l *LazyExecutionClient) RPCTimeout() time.Duration { return l.get().RPCTimeout() }
+func (l *LazyExecutionClient) TLSConfig() *tls.Config    { return l.get().TLSConfig() }
+func (l *LazyExecutionClient) Credentials() *Credentials { return l.get().Credentials() }
+func (l *LazyExecutionClient) Labels() Labels            { return l.get().Labels() }
+func (l *LazyExecutionClient) Features() Features        { return l.get().Features() }
+
+func (l *LazyExecutionClient) FetchEnode(ctx context.Context) (string, error) {
+	return l.get().FetchEnode(ctx)
+}
+
+func (l *LazyExecutionClient) Exec(ctx context.Context, cmd []string) (int, string, string, error) {
+	return l.get().Exec(ctx, cmd)
+}
+
+func (l *LazyExecutionClient) JWTSecret(ctx context.Context) (string, error) {
+	return l.get().JWTSecret(ctx)
+}
+
+func (l *LazyExecutionClient) BlockHeader(ctx context.Context, number uint64) (*BlockHeader, error) {
+	return l.get().BlockHeader(ctx, number)
+}
+
+func (l *LazyExecutionClient) GetLogs(ctx context.Context, filter EventFilter) ([]EventLog, error) {
+	return l.get().GetLogs(ctx, filter)
+}
+
+func (l *LazyExecutionClient) WatchEvents(ctx context.Context, contractAddr, topic string) (<-chan EventLog, <-chan error) {
+	return l.get().WatchEvents(ctx, contractAddr, topic)
+}
+
+// LazyConsensusClient is a ConsensusClient whose endpoints and metadata are resolved
+// on first use and memoized. Name, type, service name and container ID are known
+// upfront so collections can index the client without resolving it.
+type LazyConsensusClient struct {
+	name        string
+	clientType  Type
+	serviceName string
+	containerID string
+
+	once     sync.Once
+	done     atomic.Bool
+	resolve  func() ConsensusClient
+	resolved ConsensusClient
+}
+
+// NewLazyConsensusClient creates a consensus client that calls resolve on first use
+func NewLazyConsensusClient(clientType Type, name, serviceName, containerID string, resolve func() ConsensusClient) *LazyConsensusClient {
+	return &LazyConsensusClient{
+		name:        name,
+		clientType:  clientType,
+		serviceName: serviceName,
+		containerID: containerID,
+		resolve:     resolve,
+	}
+}
+
+// Resolved reports whether the client's endpoints have been resolved
+func (l *LazyConsensusClient) Resolved() bool {
+	return l.done.Load()
+}
+
+func (l *LazyConsensusClient) get() ConsensusClient {
+	l.once.Do(func() {
+		l.resolved = l.resolve()
+		l.done.Store(true)
+	})
+	return l.resolved
+}
+
+func (l *LazyConsensusClient) Name() string              { return l.name }
+func (l *LazyConsensusClient) Type() Type                { return l.clientType }
+func (l *LazyConsensusClient) ServiceName() string       { return l.serviceName }
+func (l *LazyConsensusClient) ContainerID() string       { return l.containerID }
+func (l *LazyConsensusClient) Version() string           { return l.get().Version() }
+func (l *LazyConsensusClient) BeaconAPIURL() string      { return l.get().BeaconAPIURL() }
+func (l *LazyConsensusClient) MetricsURL() string        { return l.get().MetricsURL() }
+func (l *LazyConsensusClient) P2PPort() int              { return l.get().P2PPort() }
+func (l *LazyConsensusClient) P2PURL() string            { return l.get().P2PURL() }
+func (l *LazyConsensusClient) ENR() string               { return l.get().ENR() }
+func (l *LazyConsensusClient) PeerID() string            { return l.get().PeerID() }
+func (l *LazyConsensusClient) String() string            { return l.get().String() }
+func (l *LazyConsensusClient) RPCTimeout() time.Duration { return l.get().RPCTimeout() }
+func (l *LazyConsensusClient) TLSConfig() *tls.Config    { return l.get().TLSConfig() }
+func (l *LazyConsensusClient) Credentials() *Credentials { return l.get().Credentials() }
+func (l *LazyConsensusClient) Labels() Labels            { return l.get().Labels() }
+func (l *LazyConsensusClient) Features() Features        { return l.get().Features() }
+
+func (l *LazyConsensusClient) FetchPeerID(ctx context.Context) (string, error) {
+	return l.get().FetchPeerID(ctx)
+}
+
+func (l *LazyConsensusClient) FetchIdentity(ctx context.Context) (*NodeIdentity, error) {
+	return l.get().FetchIdentity(ctx)
+}
+
+func (l *LazyConsensusClient) Exec(ctx context.Context, cmd []string) (int, string, string, error) {
+	return l.get().Exec(ctx, cmd)
+}
+
+func (l *LazyConsensusClient) Committees(ctx context.Context, epoch uint64) ([]Committee, error) {
+	return l.get().Committees(ctx, epoch)
+}
+
+func (l *LazyConsensusClient) SyncCommittee(ctx context.Context, epoch uint64) (*SyncCommittee, error) {
+	return l.get().SyncCommittee(ctx, epoch)
+}
+
+func (l *LazyConsensusClient) ValidatorBalance(ctx context.Context, index uint64) (uint64, error) {
+	return l.get().ValidatorBalance(ctx, index)
+}
+
+func (l *LazyConsensusClient) FinalityCheckpoints(ctx context.Context) (*FinalityCheckpoints, error) {
+	return l.get().FinalityCheckpoints(ctx)
+}
+
+func (l *LazyConsensusClient) Validators(ctx context.Context, ids ...string) ([]Validator, error) {
+	return l.get().Validators(ctx, ids...)
+}
+
+func (l *LazyConsensusClient) BeaconBlockHeader(ctx context.Context, blockID string) (*BeaconBlockHeader, error) {
+	return l.get().BeaconBlockHeader(ctx, blockID)
+}
+
+func (l *LazyConsensusClient) SyncStatus(ctx context.Context) (*SyncStatus, error) {
+	return l.get().SyncStatus(ctx)
+}
+
+func (l *LazyConsensusClient) SubscribeHeads(ctx context.Context) (<-chan HeadEvent, <-chan error) {
+	return l.get().SubscribeHeads(ctx)
+}
+
+func (l *LazyConsensusClient) Genesis(ctx context.Context) (*Genesis, error) {
+	return l.get().Genesis(ctx)
+}
+
+func (l *LazyConsensusClient) Fork(ctx context.Context) (*Fork, error) {
+	return l.get().Fork(ctx)
+}
+
+func (l *LazyConsensusClient) ForkSchedule(ctx context.Context) ([]Fork, error) {
+	return l.get().ForkSchedule(ctx)
+}
+
+func (l *LazyConsensusClient) Spec(ctx context.Context) (Spec, error) {
+	return l.get().Spec(ctx)
+}
+
+func (l *LazyConsensusClient) GenesisTime(ctx context.Context) (time.Time, error) {
+	return l.get().GenesisTime(ctx)
+}
+
+func (l *LazyConsensusClient) SecondsPerSlot(ctx context.Context) (time.Duration, error) {
+	return l.get().SecondsPerSlot(ctx)
+}
+
+func (l *LazyConsensusClient) ProposerDuties(ctx context.Context, epoch uint64) ([]ProposerDuty, error) {
+	return l.get().ProposerDuties(ctx, epoch)
+}
+
+func (l *LazyConsensusClient) BlockProposer(ctx context.Context, slot uint64) (uint64, error) {
+	return l.get().BlockProposer(ctx, slot)
+}
+
+func (l *LazyConsensusClient) BlockAttestations(ctx context.Context, slot uint64) ([]Attestation, error) {
+	return l.get().BlockAttestations(ctx, slot)
+}
+
+func (l *LazyConsensusClient) BlockPayment(ctx context.Context, slot uint64) (*BlockPayment, error) {
+	return l.get().BlockPayment(ctx, slot)
+}
+
+func (l *LazyConsensusClient) ForkChoice(ctx context.Context) (*ForkChoice, error) {
+	return l.get().ForkChoice(ctx)
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazyExecutionClient(t *testing.T) {
+	calls := 0
+	lazy := NewLazyExecutionClient(Geth, "el-1-geth-lighthouse", "el-1-geth-lighthouse", "uuid-1", func() ExecutionClient {
+		calls++
+		return NewExecutionClient(Geth, "el-1-geth-lighthouse", "v1.14.0", "http://rpc", "ws://ws", "http://engine", "http://metrics", "enode://x", "el-1-geth-lighthouse", "uuid-1", 30303)
+	})
+
+	// Identity is available without resolving
+	assert.Equal(t, "el-1-geth-lighthouse", lazy.Name())
+	assert.Equal(t, Geth, lazy.Type())
+	assert.Equal(t, "el-1-geth-lighthouse", lazy.ServiceName())
+	assert.Equal(t, "uuid-1", lazy.ContainerID())
+	assert.False(t, lazy.Resolved())
+	assert.Equal(t, 0, calls)
+
+	assert.Equal(t, "http://rpc", lazy.RPCURL())
+	assert.Equal(t, "v1.14.0", lazy.Version())
+	assert.Equal(t, 30303, lazy.P2PPort())
+	assert.True(t, lazy.Resolved())
+	assert.Equal(t, 1, calls)
+}
+
+func TestLazyConsensusClientResolvesOnce(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	lazy := NewLazyConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "cl-1-lighthouse-geth", "uuid-2", func() ConsensusClient {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return NewConsensusClient(Lighthouse, "cl-1-lighthouse-geth", "v5.0.0", "http://beacon", "http://metrics", "enr:-x", "16Uiu2", "cl-1-lighthouse-geth", "uuid-2", 9000)
+	})
+
+	assert.Equal(t, Lighthouse, lazy.Type())
+	assert.False(t, lazy.Resolved())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, "http://beacon", lazy.BeaconAPIURL())
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, "16Uiu2", lazy.PeerID())
+	assert.Equal(t, 1, calls)
+}
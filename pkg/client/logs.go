@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"time"
 
@@ -26,6 +27,12 @@ type LogFilter struct {
 	includeRegex  string
 	excludeRegex  string
 	caseSensitive bool
+	labels        Labels
+
+	// Compiled include and exclude regexes, see compile
+	compiled bool
+	include  *regexp.Regexp
+	exclude  *regexp.Regexp
 }
 
 // LogOption is a functional option for configuring log filters
@@ -45,7 +52,11 @@ func WithGrep(pattern string) LogOption {
 	}
 }
 
-// WithSince filters logs since the specified duration ago
+// WithSince keeps the log lines written within the specified duration. Kurtosis
+// does not report when a line was logged, so the time is read from the
+// timestamp the line starts with; lines without one, such as stack traces,
+// share the time of the line before them, and lines before the first
+// timestamp are kept.
 func WithSince(duration time.Duration) LogOption {
 	return func(f *LogFilter) {
 		f.since = duration
@@ -59,14 +70,17 @@ func WithFollow(follow bool) LogOption {
 	}
 }
 
-// WithIncludeRegex includes only lines matching the regex pattern
+// WithIncludeRegex includes only lines matching the regular expression. It is
+// case-insensitive unless WithCaseSensitive is set. An invalid expression makes
+// the log calls fail.
 func WithIncludeRegex(pattern string) LogOption {
 	return func(f *LogFilter) {
 		f.includeRegex = pattern
 	}
 }
 
-// WithExcludeRegex excludes lines matching the regex pattern
+// WithExcludeRegex excludes lines matching the regular expression, with the
+// same case sensitivity as WithIncludeRegex
 func WithExcludeRegex(pattern string) LogOption {
 	return func(f *LogFilter) {
 		f.excludeRegex = pattern
@@ -80,6 +94,101 @@ func WithCaseSensitive(caseSensitive bool) LogOption {
 	}
 }
 
+// WithLabels prefixes every returned line with the given client labels, so logs
+// collected from several clients or networks stay attributable
+func WithLabels(labels Labels) LogOption {
+	return func(f *LogFilter) {
+		f.labels = labels
+	}
+}
+
+// compile compiles the include and exclude regexes once per filter
+func (f *LogFilter) compile() error {
+	if f.compiled {
+		return nil
+	}
+	f.compiled = true
+
+	var err error
+	if f.include, err = f.compilePattern(f.includeRegex); err != nil {
+		return fmt.Errorf("invalid include regex %q: %w", f.includeRegex, err)
+	}
+	if f.exclude, err = f.compilePattern(f.excludeRegex); err != nil {
+		return fmt.Errorf("invalid exclude regex %q: %w", f.excludeRegex, err)
+	}
+	return nil
+}
+
+func (f *LogFilter) compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	if !f.caseSensitive {
+		pattern = "(?i)" + pattern
+	}
+	return regexp.Compile(pattern)
+}
+
+// logTimestampPattern matches a date and time in RFC 3339 or
+// "2006-01-02 15:04:05" form
+var logTimestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:Z|[+-]\d{2}:?\d{2})?`)
+
+// logTimestampPrefix is how far into a line its timestamp is looked for, which
+// covers level prefixes and leading key=value or JSON fields
+const logTimestampPrefix = 64
+
+// logTimestamp returns the time at the start of a log line; times without a
+// zone are UTC
+func logTimestamp(line string) (time.Time, bool) {
+	if len(line) > logTimestampPrefix {
+		line = line[:logTimestampPrefix]
+	}
+	match := logTimestampPattern.FindStringSubmatch(line)
+	if match == nil {
+		return time.Time{}, false
+	}
+	value := strings.Replace(match[0], " ", "T", 1)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999Z0700", "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// sinceFilter drops the lines logged before a cutoff, carrying each line's
+// timestamp over to the untimestamped lines that follow it
+type sinceFilter struct {
+	cutoff time.Time
+	last   time.Time
+}
+
+func newSinceFilter(since time.Duration) *sinceFilter {
+	if since <= 0 {
+		return nil
+	}
+	return &sinceFilter{cutoff: time.Now().Add(-since)}
+}
+
+// keep reports whether the line was logged after the cutoff
+func (s *sinceFilter) keep(line string) bool {
+	if s == nil {
+		return true
+	}
+	if t, ok := logTimestamp(line); ok {
+		s.last = t
+	}
+	return s.last.IsZero() || !s.last.Before(s.cutoff)
+}
+
+// labelPrefix returns the prefix added to each line when labels are set
+func (f *LogFilter) labelPrefix() string {
+	if len(f.labels) == 0 {
+		return ""
+	}
+	return "[" + f.labels.String() + "] "
+}
+
 // LogsClient provides log retrieval functionality for services
 type LogsClient struct {
 	kurtosisCtx       *kurtosis_context.KurtosisContext
@@ -104,6 +213,9 @@ func (lc *LogsClient) Logs(ctx context.Context, service ServiceWithLogs, options
 	for _, option := range options {
 		option(filter)
 	}
+	if err := filter.compile(); err != nil {
+		return nil, err
+	}
 
 	// Create LogLineFilter
 	var logLineFilter *kurtosis_context.LogLineFilter
@@ -185,6 +297,11 @@ func (lc *LogsClient) LogsStream(ctx context.Context, service ServiceWithLogs, o
 		for _, option := range options {
 			option(filter)
 		}
+		if err := filter.compile(); err != nil {
+			errChan <- err
+			return
+		}
+		since := newSinceFilter(filter.since)
 
 		// Create LogLineFilter
 		var logLineFilter *kurtosis_context.LogLineFilter
@@ -224,9 +341,9 @@ func (lc *LogsClient) LogsStream(ctx context.Context, service ServiceWithLogs, o
 				_ = serviceUUID // Service UUID for reference
 				for _, logLine := range serviceLogs {
 					line := logLine.GetContent()
-					if lc.matchesFilter(line, filter) {
+					if since.keep(line) && lc.matchesFilter(line, filter) {
 						select {
-						case logChan <- line:
+						case logChan <- filter.labelPrefix() + line:
 						case <-ctx.Done():
 							return
 						}
@@ -243,8 +360,9 @@ func (lc *LogsClient) LogsStream(ctx context.Context, service ServiceWithLogs, o
 func (lc *LogsClient) applyFilters(lines []string, filter *LogFilter) []string {
 	var filtered []string
 
+	since := newSinceFilter(filter.since)
 	for _, line := range lines {
-		if lc.matchesFilter(line, filter) {
+		if since.keep(line) && lc.matchesFilter(line, filter) {
 			filtered = append(filtered, line)
 		}
 	}
@@ -254,6 +372,12 @@ func (lc *LogsClient) applyFilters(lines []string, filter *LogFilter) []string {
 		filtered = filtered[len(filtered)-filter.lines:]
 	}
 
+	if prefix := filter.labelPrefix(); prefix != "" {
+		for i, line := range filtered {
+			filtered[i] = prefix + line
+		}
+	}
+
 	return filtered
 }
 
@@ -276,28 +400,13 @@ func (lc *LogsClient) matchesFilter(line string, filter *LogFilter) bool {
 		}
 	}
 
-	// Apply include regex if specified
-	if filter.includeRegex != "" {
-		// This would need a regex implementation
-		// For now, we'll use simple string matching
-		includePattern := filter.includeRegex
-		if !filter.caseSensitive {
-			includePattern = strings.ToLower(includePattern)
-		}
-		if !strings.Contains(searchLine, includePattern) {
-			return false
-		}
+	// Invalid expressions were already reported by Logs and LogsStream
+	_ = filter.compile()
+	if filter.include != nil && !filter.include.MatchString(line) {
+		return false
 	}
-
-	// Apply exclude regex if specified
-	if filter.excludeRegex != "" {
-		excludePattern := filter.excludeRegex
-		if !filter.caseSensitive {
-			excludePattern = strings.ToLower(excludePattern)
-		}
-		if strings.Contains(searchLine, excludePattern) {
-			return false
-		}
+	if filter.exclude != nil && filter.exclude.MatchString(line) {
+		return false
 	}
 
 	return true
@@ -330,14 +439,19 @@ func (lc *LogsClient) ExecutionClientLogs(ctx context.Context, client ExecutionC
 // AllConsensusClientLogs retrieves logs for all consensus clients
 func (lc *LogsClient) AllConsensusClientLogs(ctx context.Context, clients *ConsensusClients, options ...LogOption) (map[string][]string, error) {
 	allClients := clients.All()
-	logs := make(map[string][]string)
-
-	for _, client := range allClients {
-		clientLogs, err := lc.ConsensusClientLogs(ctx, client, options...)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get logs for consensus client %s: %w", client.Name(), err)
+	clientLogs := make([][]string, len(allClients))
+	errs := FanOut(ctx, clients.Limiter(), allClients, func(ctx context.Context, i int, client ConsensusClient) error {
+		var err error
+		clientLogs[i], err = lc.ConsensusClientLogs(ctx, client, options...)
+		return err
+	})
+
+	logs := make(map[string][]string, len(allClients))
+	for i, client := range allClients {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("failed to get logs for consensus client %s: %w", client, errs[i])
 		}
-		logs[client.Name()] = clientLogs
+		logs[client.Name()] = clientLogs[i]
 	}
 
 	return logs, nil
@@ -346,14 +460,19 @@ func (lc *LogsClient) AllConsensusClientLogs(ctx context.Context, clients *Conse
 // AllExecutionClientLogs retrieves logs for all execution clients
 func (lc *LogsClient) AllExecutionClientLogs(ctx context.Context, clients *ExecutionClients, options ...LogOption) (map[string][]string, error) {
 	allClients := clients.All()
-	logs := make(map[string][]string)
-
-	for _, client := range allClients {
-		clientLogs, err := lc.ExecutionClientLogs(ctx, client, options...)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get logs for execution client %s: %w", client.Name(), err)
+	clientLogs := make([][]string, len(allClients))
+	errs := FanOut(ctx, clients.Limiter(), allClients, func(ctx context.Context, i int, client ExecutionClient) error {
+		var err error
+		clientLogs[i], err = lc.ExecutionClientLogs(ctx, client, options...)
+		return err
+	})
+
+	logs := make(map[string][]string, len(allClients))
+	for i, client := range allClients {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("failed to get logs for execution client %s: %w", client, errs[i])
 		}
-		logs[client.Name()] = clientLogs
+		logs[client.Name()] = clientLogs[i]
 	}
 
 	return logs, nil
@@ -182,6 +182,24 @@ func TestLogsClient_matchesFilter(t *testing.T) {
 			filter:   &LogFilter{excludeRegex: "debug", caseSensitive: false},
 			expected: true,
 		},
+		{
+			name:     "include regex alternation",
+			line:     "WARN peer disconnected",
+			filter:   &LogFilter{includeRegex: "^ERROR|WARN", caseSensitive: true},
+			expected: true,
+		},
+		{
+			name:     "include regex anchored no match",
+			line:     "level=info msg=ERROR count is 0",
+			filter:   &LogFilter{includeRegex: "^ERROR", caseSensitive: true},
+			expected: false,
+		},
+		{
+			name:     "exclude regex character class",
+			line:     "Peer count: 42",
+			filter:   &LogFilter{excludeRegex: `peer count: \d+`},
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -239,6 +257,13 @@ func TestLogsClient_applyFilters(t *testing.T) {
 				"2023-01-01 INFO: Processing request",
 			},
 		},
+		{
+			name:   "labels prefix",
+			filter: &LogFilter{grep: "WARN", labels: Labels{LabelType: "geth", LabelIndex: "1"}},
+			expected: []string{
+				"[index=1 type=geth] 2023-01-01 WARN: Deprecated API used",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -249,6 +274,49 @@ func TestLogsClient_applyFilters(t *testing.T) {
 	}
 }
 
+func TestLogFilter_InvalidRegex(t *testing.T) {
+	filter := &LogFilter{includeRegex: "(unclosed"}
+	assert.ErrorContains(t, filter.compile(), `invalid include regex "(unclosed"`)
+
+	filter = &LogFilter{excludeRegex: "[z-a]"}
+	assert.ErrorContains(t, filter.compile(), "invalid exclude regex")
+}
+
+func TestLogsClient_applyFiltersSince(t *testing.T) {
+	lc := &LogsClient{}
+	now := time.Now().UTC()
+	format := func(t time.Time) string { return t.Format("2006-01-02 15:04:05.000") }
+
+	lines := []string{
+		"starting up",
+		format(now.Add(-time.Hour)) + " INFO old line",
+		"    at stack frame of old line",
+		`time="` + now.Add(-time.Minute).Format(time.RFC3339) + `" level=info msg="recent line"`,
+		"    at stack frame of recent line",
+		format(now.Add(-30*time.Second)) + " WARN newest line",
+	}
+
+	result := lc.applyFilters(lines, &LogFilter{since: 10 * time.Minute})
+	assert.Equal(t, []string{lines[0], lines[3], lines[4], lines[5]}, result)
+}
+
+func TestLogTimestamp(t *testing.T) {
+	for line, expected := range map[string]string{
+		"2024-10-16T12:00:00Z INFO started":                           "2024-10-16T12:00:00Z",
+		"2024-10-16 12:00:00.123 INFO started":                        "2024-10-16T12:00:00.123Z",
+		`time="2024-10-16T12:00:00+02:00" level=info`:                 "2024-10-16T10:00:00Z",
+		`{"lvl":"INF","ts":"2024-10-16 12:00:00.000+00:00","msg":""}`: "2024-10-16T12:00:00Z",
+	} {
+		ts, ok := logTimestamp(line)
+		if assert.True(t, ok, line) {
+			assert.Equal(t, expected, ts.UTC().Format(time.RFC3339Nano), line)
+		}
+	}
+
+	_, ok := logTimestamp("INFO [10-16|12:00:00.000] no year")
+	assert.False(t, ok)
+}
+
 // TestTailLogs tests the convenience function for tailing logs
 func TestTailLogs(t *testing.T) {
 	options := TailLogs(50, "error")
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// MetricSample is one sample of a Prometheus metric
+type MetricSample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// MetricsExporter reads an ethereum-metrics-exporter, which exports the sync,
+// peer and fork metrics of one node in the same format for every client
+type MetricsExporter struct {
+	name        string
+	url         string
+	timeout     time.Duration
+	tlsConfig   *tls.Config
+	credentials *Credentials
+}
+
+// NewMetricsExporter creates a client for the exporter serving at url
+func NewMetricsExporter(name, url string) *MetricsExporter {
+	return &MetricsExporter{
+		name:    name,
+		url:     url,
+		timeout: DefaultRPCTimeout,
+	}
+}
+
+// WithRPCTimeout sets the per-request timeout; zero keeps the default
+func (m *MetricsExporter) WithRPCTimeout(timeout time.Duration) *MetricsExporter {
+	if timeout > 0 {
+		m.timeout = timeout
+	}
+	return m
+}
+
+// WithTLSConfig sets the TLS settings for an https endpoint
+func (m *MetricsExporter) WithTLSConfig(tlsConfig *tls.Config) *MetricsExporter {
+	m.tlsConfig = tlsConfig
+	return m
+}
+
+// WithCredentials sets the credentials sent with every metrics request, for
+// networks exposed through an authenticating proxy
+func (m *MetricsExporter) WithCredentials(credentials *Credentials) *MetricsExporter {
+	m.credentials = credentials
+	return m
+}
+
+// Credentials returns the credentials sent with metrics requests
+func (m *MetricsExporter) Credentials() *Credentials { return m.credentials }
+
+// Name returns the exporter's service name
+func (m *MetricsExporter) Name() string { return m.name }
+
+// URL returns the exporter's base URL
+func (m *MetricsExporter) URL() string { return m.url }
+
+// MetricsURL returns the URL the exporter serves its metrics on
+func (m *MetricsExporter) MetricsURL() string { return m.url + "/metrics" }
+
+// Samples fetches every sample the exporter serves, ordered by metric name.
+// Histograms and summaries are reported by their sum and count.
+func (m *MetricsExporter) Samples(ctx context.Context) ([]MetricSample, error) {
+	if m.url == "" {
+		return nil, fmt.Errorf("metrics exporter URL is empty")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.MetricsURL(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", string(expfmt.FmtText))
+
+	resp, err := m.credentials.Wrap(NewHTTPClient(m.timeout, m.tlsConfig)).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch metrics from %s: %w", m.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metrics exporter %s returned status %d", m.name, resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics from %s: %w", m.name, err)
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var samples []MetricSample
+	for _, name := range names {
+		for _, metric := range families[name].GetMetric() {
+			samples = append(samples, metricSamples(name, metric)...)
+		}
+	}
+	return samples, nil
+}
+
+// Value returns the value of the first sample of the named metric whose labels
+// include all given labels
+func (m *MetricsExporter) Value(ctx context.Context, name string, labels map[string]string) (float64, error) {
+	samples, err := m.Samples(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, sample := range samples {
+		if sample.Name == name && hasLabels(sample.Labels, labels) {
+			return sample.Value, nil
+		}
+	}
+	return 0, fmt.Errorf("metric %s not exported by %s", name, m.name)
+}
+
+// metricSamples flattens a metric into samples
+func metricSamples(name string, metric *dto.Metric) []MetricSample {
+	labels := make(map[string]string, len(metric.GetLabel()))
+	for _, label := range metric.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+
+	switch {
+	case metric.Gauge != nil:
+		return []MetricSample{{Name: name, Labels: labels, Value: metric.GetGauge().GetValue()}}
+	case metric.Counter != nil:
+		return []MetricSample{{Name: name, Labels: labels, Value: metric.GetCounter().GetValue()}}
+	case metric.Untyped != nil:
+		return []MetricSample{{Name: name, Labels: labels, Value: metric.GetUntyped().GetValue()}}
+	case metric.Histogram != nil:
+		return []MetricSample{
+			{Name: name + "_sum", Labels: labels, Value: metric.GetHistogram().GetSampleSum()},
+			{Name: name + "_count", Labels: labels, Value: float64(metric.GetHistogram().GetSampleCount())},
+		}
+	case metric.Summary != nil:
+		return []MetricSample{
+			{Name: name + "_sum", Labels: labels, Value: metric.GetSummary().GetSampleSum()},
+			{Name: name + "_count", Labels: labels, Value: float64(metric.GetSummary().GetSampleCount())},
+		}
+	}
+	return nil
+}
+
+// hasLabels reports whether labels include every entry of want
+func hasLabels(labels, want map[string]string) bool {
+	for name, value := range want {
+		if labels[name] != value {
+			return false
+		}
+	}
+	return true
+}
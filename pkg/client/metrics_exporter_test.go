@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exporterMetrics = `# HELP eth_exe_sync_is_syncing Whether the node is syncing.
+# TYPE eth_exe_sync_is_syncing gauge
+eth_exe_sync_is_syncing 0
+# HELP eth_con_beacon_slot The slot of the beacon block.
+# TYPE eth_con_beacon_slot gauge
+eth_con_beacon_slot{block_id="head"} 42
+eth_con_beacon_slot{block_id="finalized"} 32
+# HELP eth_exe_rpc_duration_seconds RPC request durations.
+# TYPE eth_exe_rpc_duration_seconds histogram
+eth_exe_rpc_duration_seconds_bucket{le="1"} 3
+eth_exe_rpc_duration_seconds_bucket{le="+Inf"} 4
+eth_exe_rpc_duration_seconds_sum 2.5
+eth_exe_rpc_duration_seconds_count 4
+`
+
+func TestMetricsExporterSamples(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(exporterMetrics))
+	}))
+	defer server.Close()
+
+	exporter := NewMetricsExporter("ethereum-metrics-exporter-1-lighthouse-geth", server.URL)
+	assert.Equal(t, server.URL+"/metrics", exporter.MetricsURL())
+
+	samples, err := exporter.Samples(context.Background())
+	require.NoError(t, err)
+	require.Len(t, samples, 5)
+	assert.Equal(t, "eth_con_beacon_slot", samples[0].Name)
+	assert.Equal(t, MetricSample{Name: "eth_exe_rpc_duration_seconds_count", Labels: map[string]string{}, Value: 4}, samples[3])
+
+	head, err := exporter.Value(context.Background(), "eth_con_beacon_slot", map[string]string{"block_id": "head"})
+	require.NoError(t, err)
+	assert.Equal(t, float64(42), head)
+
+	finalized, err := exporter.Value(context.Background(), "eth_con_beacon_slot", map[string]string{"block_id": "finalized"})
+	require.NoError(t, err)
+	assert.Equal(t, float64(32), finalized)
+
+	_, err = exporter.Value(context.Background(), "eth_exe_net_peer_count", nil)
+	assert.ErrorContains(t, err, "metric eth_exe_net_peer_count not exported")
+}
+
+func TestMetricsExporterCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(exporterMetrics))
+	}))
+	defer server.Close()
+
+	exporter := NewMetricsExporter("exporter", server.URL)
+	_, err := exporter.Samples(context.Background())
+	assert.ErrorContains(t, err, "returned status 401")
+
+	samples, err := exporter.WithCredentials(BearerToken("token")).Samples(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, samples, 5)
+}
+
+func TestMetricsExporterErrors(t *testing.T) {
+	_, err := NewMetricsExporter("exporter", "").Samples(context.Background())
+	assert.ErrorContains(t, err, "URL is empty")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err = NewMetricsExporter("exporter", server.URL).Samples(context.Background())
+	assert.ErrorContains(t, err, "returned status 503")
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
+
+// DumpedAccount is one account of a state dump. Storage maps slots to values,
+// both as 0x-prefixed 32 byte words.
+type DumpedAccount struct {
+	Address string            `json:"address"`
+	Balance string            `json:"balance"`
+	Nonce   uint64            `json:"nonce,omitempty"`
+	Code    string            `json:"code,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+// AccountRange is one page of a state dump
+type AccountRange struct {
+	Root     string
+	Accounts []DumpedAccount
+	// Skipped counts accounts whose address the client could not recover
+	// because it does not keep hash preimages
+	Skipped int
+	// Next is the start key of the following page, empty on the last page
+	Next string
+}
+
+// AccountRange returns up to max accounts of the state at a block, starting at
+// the account hash start ("" for the first page), with debug_accountRange.
+// Only geth serves it, and it reports addresses and storage slots only when
+// started with --cache.preimages.
+func (b *BaseExecutionClient) AccountRange(ctx context.Context, block uint64, start string, max int) (*AccountRange, error) {
+	if start == "" {
+		start = "0x"
+	}
+	var raw struct {
+		Root     string `json:"root"`
+		Accounts map[string]struct {
+			Balance string            `json:"balance"`
+			Nonce   uint64            `json:"nonce"`
+			Code    string            `json:"code"`
+			Storage map[string]string `json:"storage"`
+			Address string            `json:"address"`
+		} `json:"accounts"`
+		Next []byte `json:"next"`
+	}
+	params := []interface{}{blockTag(&block), start, max, false, false, false}
+	if err := b.call(ctx, "debug_accountRange", params, &raw); err != nil {
+		return nil, fmt.Errorf("failed to dump accounts at block %d: %w", block, err)
+	}
+
+	page := &AccountRange{Root: raw.Root}
+	for _, account := range raw.Accounts {
+		if account.Address == "" {
+			page.Skipped++
+			continue
+		}
+		dumped := DumpedAccount{
+			Address: account.Address,
+			Balance: account.Balance,
+			Nonce:   account.Nonce,
+			Code:    account.Code,
+		}
+		if len(account.Storage) > 0 {
+			dumped.Storage = make(map[string]string, len(account.Storage))
+			for slot, value := range account.Storage {
+				word, err := storageWord(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid storage of %s at %s: %w", account.Address, slot, err)
+				}
+				dumped.Storage[slot] = word
+			}
+		}
+		page.Accounts = append(page.Accounts, dumped)
+	}
+	sort.Slice(page.Accounts, func(i, j int) bool { return page.Accounts[i].Address < page.Accounts[j].Address })
+	if len(raw.Next) > 0 {
+		page.Next = "0x" + hex.EncodeToString(raw.Next)
+	}
+	return page, nil
+}
+
+// storageWord left-pads a dumped storage value, which geth reports as trimmed
+// hex without a prefix, to a 0x-prefixed 32 byte word
+func storageWord(value string) (string, error) {
+	if len(value) >= 2 && value[:2] == "0x" {
+		value = value[2:]
+	}
+	if len(value)%2 == 1 {
+		value = "0" + value
+	}
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+	if len(decoded) > 32 {
+		return "", fmt.Errorf("value is %d bytes", len(decoded))
+	}
+	word := make([]byte, 32)
+	copy(word[32-len(decoded):], decoded)
+	return "0x" + hex.EncodeToString(word), nil
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountRange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		assert.Equal(t, "debug_accountRange", req.Method)
+		assert.Equal(t, []interface{}{"0x10", "0x", float64(2), false, false, false}, req.Params)
+
+		result := map[string]interface{}{
+			"root": "0xroot",
+			"accounts": map[string]interface{}{
+				"0x00000000000000000000000000000000000000bb": map[string]interface{}{
+					"balance": "1000",
+					"nonce":   3,
+					"code":    "0x6000",
+					"storage": map[string]string{
+						"0x0000000000000000000000000000000000000000000000000000000000000001": "2a",
+					},
+					"address": "0x00000000000000000000000000000000000000bb",
+				},
+				"0x00000000000000000000000000000000000000aa": map[string]interface{}{
+					"balance": "5",
+					"address": "0x00000000000000000000000000000000000000aa",
+				},
+				"pre(0x1234)": map[string]interface{}{"balance": "7"},
+			},
+			"next": []byte{0xab, 0xcd},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+	defer server.Close()
+
+	rpc := NewBaseExecutionClient(ClientConfig{Name: "el-1-geth", RPCURL: server.URL})
+	page, err := rpc.AccountRange(context.Background(), 16, "", 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, "0xroot", page.Root)
+	assert.Equal(t, 1, page.Skipped)
+	assert.Equal(t, "0xabcd", page.Next)
+	require.Len(t, page.Accounts, 2)
+	assert.Equal(t, DumpedAccount{Address: "0x00000000000000000000000000000000000000aa", Balance: "5"}, page.Accounts[0])
+	assert.Equal(t, uint64(3), page.Accounts[1].Nonce)
+	assert.Equal(t, "0x6000", page.Accounts[1].Code)
+	assert.Equal(t, map[string]string{
+		"0x0000000000000000000000000000000000000000000000000000000000000001": "0x000000000000000000000000000000000000000000000000000000000000002a",
+	}, page.Accounts[1].Storage)
+}
+
+func TestStorageWord(t *testing.T) {
+	word, err := storageWord("abc")
+	require.NoError(t, err)
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000abc", word)
+
+	_, err = storageWord("zz")
+	assert.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// System contract addresses predeployed by the Dencun and Pectra forks
+const (
+	// BeaconRootsAddress is the EIP-4788 beacon block root contract
+	BeaconRootsAddress = "0x000F3df6D732807Ef1319fB7B8bB8522d0Beac02"
+	// HistoryStorageAddress is the EIP-2935 historical block hash contract
+	HistoryStorageAddress = "0x0000F90827F1C53a10cb7A02335B175320002935"
+	// WithdrawalRequestAddress is the EIP-7002 execution layer withdrawal request contract
+	WithdrawalRequestAddress = "0x00000961Ef480Eb55e80D19ad83579A64c007002"
+	// ConsolidationRequestAddress is the EIP-7251 consolidation request contract
+	ConsolidationRequestAddress = "0x0000BBdDc7CE488642fb579F8B00f3a590007251"
+)
+
+const blsPubkeyLength = 48
+
+// ErrSystemContractNotDeployed is returned when a system contract has no code, usually
+// because the fork introducing it is not active on the chain yet
+var ErrSystemContractNotDeployed = errors.New("system contract not deployed")
+
+// TransactionRequest is an unsigned transaction for the caller to sign and submit
+// with SendRawTransaction
+type TransactionRequest struct {
+	To    string
+	Value *big.Int
+	Data  string
+}
+
+// RPC returns a JSON-RPC client for any execution client's RPC endpoint
+func RPC(c ExecutionClient) *BaseExecutionClient {
+	return NewBaseExecutionClient(ClientConfig{Name: c.Name(), RPCURL: c.RPCURL(), RPCTimeout: c.RPCTimeout(), TLSConfig: c.TLSConfig(), Credentials: c.Credentials()})
+}
+
+// BeaconRoot returns the parent beacon block root the EIP-4788 contract stored for the
+// block with the given timestamp
+func (b *BaseExecutionClient) BeaconRoot(ctx context.Context, timestamp uint64) (string, error) {
+	return b.callSystemContract(ctx, BeaconRootsAddress, uint256Word(timestamp))
+}
+
+// HistoricalBlockHash returns the block hash the EIP-2935 contract stored for the block number
+func (b *BaseExecutionClient) HistoricalBlockHash(ctx context.Context, number uint64) (string, error) {
+	return b.callSystemContract(ctx, HistoryStorageAddress, uint256Word(number))
+}
+
+// WithdrawalRequestFee returns the current EIP-7002 withdrawal request fee in wei
+func (b *BaseExecutionClient) WithdrawalRequestFee(ctx context.Context) (*big.Int, error) {
+	return b.systemContractFee(ctx, WithdrawalRequestAddress)
+}
+
+// ConsolidationRequestFee returns the current EIP-7251 consolidation request fee in wei
+func (b *BaseExecutionClient) ConsolidationRequestFee(ctx context.Context) (*big.Int, error) {
+	return b.systemContractFee(ctx, ConsolidationRequestAddress)
+}
+
+// PrepareWithdrawalRequest builds an EIP-7002 withdrawal request for the validator.
+// An amount of zero requests a full exit. The fee is read from the contract.
+func (b *BaseExecutionClient) PrepareWithdrawalRequest(ctx context.Context, validatorPubkey string, amountGwei uint64) (*TransactionRequest, error) {
+	pubkey, err := decodePubkey(validatorPubkey)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := b.WithdrawalRequestFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := make([]byte, 8)
+	binary.BigEndian.PutUint64(amount, amountGwei)
+	return &TransactionRequest{
+		To:    WithdrawalRequestAddress,
+		Value: fee,
+		Data:  "0x" + hex.EncodeToString(append(pubkey, amount...)),
+	}, nil
+}
+
+// PrepareConsolidationRequest builds an EIP-7251 request consolidating the source
+// validator into the target. The fee is read from the contract.
+func (b *BaseExecutionClient) PrepareConsolidationRequest(ctx context.Context, sourcePubkey, targetPubkey string) (*TransactionRequest, error) {
+	source, err := decodePubkey(sourcePubkey)
+	if err != nil {
+		return nil, err
+	}
+	target, err := decodePubkey(targetPubkey)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := b.ConsolidationRequestFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransactionRequest{
+		To:    ConsolidationRequestAddress,
+		Value: fee,
+		Data:  "0x" + hex.EncodeToString(append(source, target...)),
+	}, nil
+}
+
+// SendRawTransaction submits a signed transaction and returns its hash
+func (b *BaseExecutionClient) SendRawTransaction(ctx context.Context, rawTx string) (string, error) {
+	var hash string
+	if err := b.call(ctx, "eth_sendRawTransaction", []interface{}{rawTx}, &hash); err != nil {
+		return "", fmt.Errorf("failed to send transaction: %w", err)
+	}
+	return hash, nil
+}
+
+// callSystemContract checks that the contract is deployed and performs an eth_call
+func (b *BaseExecutionClient) callSystemContract(ctx context.Context, address, data string) (string, error) {
+	code, err := b.Code(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	if code == "" || code == "0x" {
+		return "", fmt.Errorf("%w: %s", ErrSystemContractNotDeployed, address)
+	}
+
+	call := map[string]string{"to": address}
+	if data != "" {
+		call["data"] = data
+	}
+	var result string
+	if err := b.call(ctx, "eth_call", []interface{}{call, "latest"}, &result); err != nil {
+		return "", fmt.Errorf("failed to call %s: %w", address, err)
+	}
+	return result, nil
+}
+
+// systemContractFee reads the current request fee of an EIP-7685 request contract
+func (b *BaseExecutionClient) systemContractFee(ctx context.Context, address string) (*big.Int, error) {
+	result, err := b.callSystemContract(ctx, address, "")
+	if err != nil {
+		return nil, err
+	}
+	fee, ok := new(big.Int).SetString(strings.TrimPrefix(result, "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid fee %q from %s", result, address)
+	}
+	return fee, nil
+}
+
+// uint256Word encodes a number as a 32-byte big-endian calldata word
+func uint256Word(n uint64) string {
+	word := make([]byte, 32)
+	binary.BigEndian.PutUint64(word[24:], n)
+	return "0x" + hex.EncodeToString(word)
+}
+
+// decodePubkey decodes a hex BLS public key
+func decodePubkey(pubkey string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(pubkey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator pubkey %q: %w", pubkey, err)
+	}
+	if len(decoded) != blsPubkeyLength {
+		return nil, fmt.Errorf("invalid validator pubkey length %d, expected %d", len(decoded), blsPubkeyLength)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSystemContractServer serves a chain where every system contract except the
+// consolidation contract is deployed
+func newSystemContractServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var result interface{}
+		switch req.Method {
+		case "eth_getCode":
+			var address string
+			if err := json.Unmarshal(req.Params[0], &address); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			result = "0x6000"
+			if address == ConsolidationRequestAddress {
+				result = "0x"
+			}
+		case "eth_call":
+			var call map[string]string
+			if err := json.Unmarshal(req.Params[0], &call); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			switch call["to"] {
+			case BeaconRootsAddress:
+				assert.Equal(t, "0x"+strings.Repeat("0", 56)+"65f0a000", call["data"])
+				result = "0xroot"
+			case WithdrawalRequestAddress:
+				assert.Empty(t, call["data"])
+				result = "0x" + strings.Repeat("0", 63) + "1"
+			}
+		case "eth_sendRawTransaction":
+			result = "0xtxhash"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+}
+
+func TestSystemContracts(t *testing.T) {
+	server := newSystemContractServer(t)
+	defer server.Close()
+
+	ctx := context.Background()
+	c := NewBaseExecutionClient(ClientConfig{Name: "el-1-geth-lighthouse", RPCURL: server.URL})
+
+	root, err := c.BeaconRoot(ctx, 0x65f0a000)
+	require.NoError(t, err)
+	assert.Equal(t, "0xroot", root)
+
+	pubkey := "0x" + strings.Repeat("ab", 48)
+	tx, err := c.PrepareWithdrawalRequest(ctx, pubkey, 1000000000)
+	require.NoError(t, err)
+	assert.Equal(t, WithdrawalRequestAddress, tx.To)
+	assert.Equal(t, int64(1), tx.Value.Int64())
+	assert.Equal(t, "0x"+strings.Repeat("ab", 48)+"000000003b9aca00", tx.Data)
+
+	_, err = c.PrepareWithdrawalRequest(ctx, "0x1234", 0)
+	assert.ErrorContains(t, err, "invalid validator pubkey length")
+
+	_, err = c.PrepareConsolidationRequest(ctx, pubkey, pubkey)
+	assert.ErrorIs(t, err, ErrSystemContractNotDeployed)
+
+	hash, err := c.SendRawTransaction(ctx, "0x02f8")
+	require.NoError(t, err)
+	assert.Equal(t, "0xtxhash", hash)
+}
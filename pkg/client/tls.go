@@ -0,0 +1,60 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// transports holds one transport per TLS config, so clients sharing a config
+// share connection pools instead of leaking one per request
+var transports sync.Map // map[*tls.Config]*http.Transport
+
+// NewHTTPClient returns an HTTP client with the timeout for reaching client
+// endpoints. A non-nil TLS config is used for https and wss endpoints, such as
+// clients fronted by an ingress; nil uses the default transport. A zero timeout
+// leaves requests bounded only by their context.
+func NewHTTPClient(timeout time.Duration, tlsConfig *tls.Config) *http.Client {
+	if tlsConfig == nil {
+		return &http.Client{Timeout: timeout}
+	}
+	transport, ok := transports.Load(tlsConfig)
+	if !ok {
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.TLSClientConfig = tlsConfig.Clone()
+		transport, _ = transports.LoadOrStore(tlsConfig, t)
+	}
+	return &http.Client{Timeout: timeout, Transport: transport.(*http.Transport)}
+}
+
+// NewTLSConfig returns a TLS config that trusts the PEM certificates in
+// caBundle in addition to the system roots. An empty caBundle trusts only the
+// system roots. insecureSkipVerify disables certificate verification for
+// self-signed devnet certificates and must not be used elsewhere.
+func NewTLSConfig(caBundle string, insecureSkipVerify bool) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+	if caBundle == "" {
+		return cfg, nil
+	}
+
+	pem, err := os.ReadFile(caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in CA bundle %s", caBundle)
+	}
+	cfg.RootCAs = pool
+	return cfg, nil
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTLSRPCServer serves eth_blockNumber over https with a self-signed certificate
+func newTLSRPCServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x2a"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// writeCABundle writes the server's certificate to a PEM file and returns its path
+func writeCABundle(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestNewTLSConfigCABundle(t *testing.T) {
+	server := newTLSRPCServer(t)
+
+	tlsConfig, err := NewTLSConfig(writeCABundle(t, server), false)
+	require.NoError(t, err)
+
+	resp, err := NewHTTPClient(time.Second, tlsConfig).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Without the bundle the self-signed certificate is rejected
+	_, err = NewHTTPClient(time.Second, nil).Get(server.URL)
+	assert.Error(t, err)
+}
+
+func TestNewTLSConfigInsecureSkipVerify(t *testing.T) {
+	server := newTLSRPCServer(t)
+
+	tlsConfig, err := NewTLSConfig("", true)
+	require.NoError(t, err)
+
+	resp, err := NewHTTPClient(time.Second, tlsConfig).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewTLSConfigInvalidBundle(t *testing.T) {
+	_, err := NewTLSConfig(filepath.Join(t.TempDir(), "missing.pem"), false)
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "empty.pem")
+	require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0o600))
+	_, err = NewTLSConfig(path, false)
+	assert.ErrorContains(t, err, "no certificates found")
+}
+
+func TestNewHTTPClientSharesTransport(t *testing.T) {
+	tlsConfig, err := NewTLSConfig("", true)
+	require.NoError(t, err)
+
+	a := NewHTTPClient(time.Second, tlsConfig)
+	b := NewHTTPClient(0, tlsConfig)
+	assert.Same(t, a.Transport, b.Transport)
+	assert.Nil(t, NewHTTPClient(time.Second, nil).Transport)
+}
+
+func TestExecutionClientTLSConfig(t *testing.T) {
+	server := newTLSRPCServer(t)
+	tlsConfig, err := NewTLSConfig(writeCABundle(t, server), false)
+	require.NoError(t, err)
+
+	el := NewExecutionClient(Geth, "el-1-geth", "", server.URL, "", "", "", "", "el-1-geth", "", 0).WithTLSConfig(tlsConfig)
+	assert.Same(t, tlsConfig, el.TLSConfig())
+
+	number, err := RPC(el).GetBlockNumber(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), number)
+}
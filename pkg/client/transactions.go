@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// HasTransaction reports whether the node knows the transaction, either from its
+// transaction pool or because it was included in a block
+func (b *BaseExecutionClient) HasTransaction(ctx context.Context, hash string) (bool, error) {
+	var tx json.RawMessage
+	if err := b.call(ctx, "eth_getTransactionByHash", []interface{}{hash}, &tx); err != nil {
+		return false, fmt.Errorf("failed to get transaction %s: %w", hash, err)
+	}
+	return len(tx) > 0 && string(tx) != "null", nil
+}
+
+// TraceTransaction replays a transaction with debug_traceTransaction and returns
+// the raw trace. A nil config uses the client's default struct logger.
+func (b *BaseExecutionClient) TraceTransaction(ctx context.Context, hash string, config map[string]interface{}) (json.RawMessage, error) {
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	var trace json.RawMessage
+	if err := b.call(ctx, "debug_traceTransaction", []interface{}{hash, config}, &trace); err != nil {
+		return nil, fmt.Errorf("failed to trace transaction %s: %w", hash, err)
+	}
+	return trace, nil
+}
@@ -0,0 +1,94 @@
+package client
+
+// ValidatorClient is a standalone validator client process
+type ValidatorClient interface {
+	Name() string
+	Type() Type
+	ServiceName() string
+	ContainerID() string
+
+	// APIURL is the keymanager API, empty unless the keymanager is enabled
+	APIURL() string
+	MetricsURL() string
+
+	// KeyCount is the number of genesis validator keys the client runs
+	KeyCount() int
+}
+
+// ValidatorClientImpl is a generic implementation of the ValidatorClient interface
+type ValidatorClientImpl struct {
+	clientType  Type
+	name        string
+	serviceName string
+	containerID string
+	apiURL      string
+	metricsURL  string
+	keyCount    int
+}
+
+// NewValidatorClient creates a new validator client
+func NewValidatorClient(clientType Type, name, serviceName, containerID, apiURL, metricsURL string, keyCount int) *ValidatorClientImpl {
+	return &ValidatorClientImpl{
+		clientType:  clientType,
+		name:        name,
+		serviceName: serviceName,
+		containerID: containerID,
+		apiURL:      apiURL,
+		metricsURL:  metricsURL,
+		keyCount:    keyCount,
+	}
+}
+
+func (v *ValidatorClientImpl) Name() string        { return v.name }
+func (v *ValidatorClientImpl) Type() Type          { return v.clientType }
+func (v *ValidatorClientImpl) ServiceName() string { return v.serviceName }
+func (v *ValidatorClientImpl) ContainerID() string { return v.containerID }
+func (v *ValidatorClientImpl) APIURL() string      { return v.apiURL }
+func (v *ValidatorClientImpl) MetricsURL() string  { return v.metricsURL }
+func (v *ValidatorClientImpl) KeyCount() int       { return v.keyCount }
+
+// ValidatorClients holds all validator clients by type
+type ValidatorClients struct {
+	*Collection[ValidatorClient]
+}
+
+// NewValidatorClients creates a new ValidatorClients collection
+func NewValidatorClients() *ValidatorClients {
+	return &ValidatorClients{
+		Collection: NewCollection[ValidatorClient](),
+	}
+}
+
+// Add adds a validator client to the collection
+func (vc *ValidatorClients) Add(client ValidatorClient) {
+	vc.Collection.Add(client.Type(), client)
+}
+
+// ByName returns the validator client with the given name
+func (vc *ValidatorClients) ByName(name string) (ValidatorClient, bool) {
+	return findByName(vc.All(), name)
+}
+
+// ByNamePrefix returns the validator clients whose names start with prefix
+func (vc *ValidatorClients) ByNamePrefix(prefix string) []ValidatorClient {
+	return filterByNamePrefix(vc.All(), prefix)
+}
+
+// Except returns the validator clients whose names are not listed
+func (vc *ValidatorClients) Except(names ...string) []ValidatorClient {
+	return exceptNames(vc.All(), names)
+}
+
+// Map returns the validator clients keyed by name
+func (vc *ValidatorClients) Map() map[string]ValidatorClient {
+	return mapByName(vc.All())
+}
+
+// KeyCount returns the number of validator keys run by all clients in the collection
+func (vc *ValidatorClients) KeyCount() int {
+	total := 0
+	for _, client := range vc.All() {
+		total += client.KeyCount()
+	}
+	return total
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorClients(t *testing.T) {
+	clients := NewValidatorClients()
+	clients.Add(NewValidatorClient(Teku, "vc-2-besu-teku", "vc-2-besu-teku", "uuid-2", "", "http://vc-2:8080", 64))
+	clients.Add(NewValidatorClient(Lighthouse, "vc-1-geth-lighthouse", "vc-1-geth-lighthouse", "uuid-1", "http://vc-1:7500", "http://vc-1:8080", 128))
+
+	assert.Equal(t, []string{"vc-1-geth-lighthouse", "vc-2-besu-teku"}, names(clients.All()))
+	assert.Equal(t, 192, clients.KeyCount())
+	assert.Equal(t, []string{"vc-2-besu-teku"}, names(clients.ByType(Teku)))
+	assert.Equal(t, []string{"vc-2-besu-teku"}, names(clients.Except("vc-1-geth-lighthouse")))
+
+	vc, ok := clients.ByName("vc-1-geth-lighthouse")
+	require.True(t, ok)
+	assert.Equal(t, Lighthouse, vc.Type())
+	assert.Equal(t, "http://vc-1:7500", vc.APIURL())
+	assert.Equal(t, "http://vc-1:8080", vc.MetricsURL())
+	assert.Equal(t, "uuid-1", vc.ContainerID())
+	assert.Equal(t, 128, vc.KeyCount())
+
+	vc, ok = clients.ByIndex(2)
+	require.True(t, ok)
+	assert.Equal(t, "vc-2-besu-teku", vc.Name())
+	assert.Empty(t, vc.APIURL())
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"time"
@@ -67,12 +68,14 @@ func (h *HTTPWaitStrategy) WithInterval(interval time.Duration) *HTTPWaitStrateg
 // WaitUntilReady waits for the HTTP endpoint to be ready
 func (h *HTTPWaitStrategy) WaitUntilReady(ctx context.Context, target interface{}) error {
 	var url string
+	var tlsConfig *tls.Config
+	var credentials *Credentials
 
 	switch t := target.(type) {
 	case ExecutionClient:
-		url = t.RPCURL()
+		url, tlsConfig, credentials = t.RPCURL(), t.TLSConfig(), t.Credentials()
 	case ConsensusClient:
-		url = t.BeaconAPIURL()
+		url, tlsConfig, credentials = t.BeaconAPIURL(), t.TLSConfig(), t.Credentials()
 	case string:
 		url = t
 	default:
@@ -83,9 +86,7 @@ func (h *HTTPWaitStrategy) WaitUntilReady(ctx context.Context, target interface{
 		return fmt.Errorf("no URL available for waiting")
 	}
 
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
+	client := credentials.Wrap(NewHTTPClient(10*time.Second, tlsConfig))
 
 	timeout := time.After(h.Timeout)
 	ticker := time.NewTicker(h.Interval)
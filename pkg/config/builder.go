@@ -7,6 +7,8 @@ import (
 // ConfigBuilder helps build ethereum-package configurations
 type ConfigBuilder struct {
 	config *EthereumPackageConfig
+
+	lateJoinerCheckpointSync bool
 }
 
 // NewConfigBuilder creates a new configuration builder
@@ -64,6 +66,57 @@ func (b *ConfigBuilder) WithGlobalLogLevel(level string) *ConfigBuilder {
 	return b
 }
 
+// WithMetricsExporter toggles the ethereum-metrics-exporter for every participant
+// that does not set its own value
+func (b *ConfigBuilder) WithMetricsExporter(enabled bool) *ConfigBuilder {
+	b.config.EthereumMetricsExporterEnabled = enabled
+	return b
+}
+
+// WithCheckpointSync toggles checkpoint sync for every participant that does
+// not set its own value
+func (b *ConfigBuilder) WithCheckpointSync(enabled bool) *ConfigBuilder {
+	b.config.CheckpointSyncEnabled = enabled
+	return b
+}
+
+// WithCheckpointSyncURL sets the URL beacon nodes checkpoint sync from
+func (b *ConfigBuilder) WithCheckpointSyncURL(url string) *ConfigBuilder {
+	b.config.CheckpointSyncURL = url
+	return b
+}
+
+// WithLateJoinerCheckpointSync enables checkpoint sync for late-joining
+// participants that do not set their own value. Nodes present at genesis have
+// no checkpoint to sync from.
+func (b *ConfigBuilder) WithLateJoinerCheckpointSync() *ConfigBuilder {
+	b.lateJoinerCheckpointSync = true
+	return b
+}
+
+// WithFeeRecipient pays the proposals of every participant that does not set
+// its own fee recipient to address
+func (b *ConfigBuilder) WithFeeRecipient(address string) *ConfigBuilder {
+	b.config.FeeRecipient = address
+	return b
+}
+
+// WithGlobalTolerations adds tolerations to every pod of the network on Kubernetes.
+func (b *ConfigBuilder) WithGlobalTolerations(tolerations ...Toleration) *ConfigBuilder {
+	b.config.GlobalTolerations = append(b.config.GlobalTolerations, tolerations...)
+	return b
+}
+
+// WithGlobalNodeSelector schedules every pod of the network on Kubernetes nodes
+// carrying the label.
+func (b *ConfigBuilder) WithGlobalNodeSelector(key, value string) *ConfigBuilder {
+	if b.config.GlobalNodeSelectors == nil {
+		b.config.GlobalNodeSelectors = make(map[string]string)
+	}
+	b.config.GlobalNodeSelectors[key] = value
+	return b
+}
+
 // WithPortPublisher sets the port publisher configuration.
 func (b *ConfigBuilder) WithPortPublisher(portPublisher *PortPublisherConfig) *ConfigBuilder {
 	b.config.PortPublisher = portPublisher
@@ -80,6 +133,18 @@ func (b *ConfigBuilder) WithDockerCacheParams(dockerCache *DockerCacheParams) *C
 
 // Build returns the built configuration
 func (b *ConfigBuilder) Build() (*EthereumPackageConfig, error) {
+	if b.lateJoinerCheckpointSync {
+		// Copy the participants, which may be shared with the caller
+		participants := append([]ParticipantConfig(nil), b.config.Participants...)
+		for i := range participants {
+			if participants[i].IsLateJoiner() && participants[i].CheckpointSyncEnabled == nil {
+				enabled := true
+				participants[i].CheckpointSyncEnabled = &enabled
+			}
+		}
+		b.config.Participants = participants
+	}
+
 	// Apply defaults
 	b.config.ApplyDefaults()
 
@@ -131,6 +196,73 @@ func (p *SimpleParticipantBuilder) WithCLVersion(version string) *SimpleParticip
 	return p
 }
 
+// WithELImage overrides the execution layer client image
+func (p *SimpleParticipantBuilder) WithELImage(image string) *SimpleParticipantBuilder {
+	p.participant.ELImage = image
+	return p
+}
+
+// WithCLImage overrides the consensus layer client image
+func (p *SimpleParticipantBuilder) WithCLImage(image string) *SimpleParticipantBuilder {
+	p.participant.CLImage = image
+	return p
+}
+
+// WithVCImage overrides the validator client image
+func (p *SimpleParticipantBuilder) WithVCImage(image string) *SimpleParticipantBuilder {
+	p.participant.VCImage = image
+	return p
+}
+
+// WithELExtraParams adds extra command line flags for the execution layer client
+func (p *SimpleParticipantBuilder) WithELExtraParams(params ...string) *SimpleParticipantBuilder {
+	p.participant.ELExtraParams = append(p.participant.ELExtraParams, params...)
+	return p
+}
+
+// WithCLExtraParams adds extra command line flags for the consensus layer client
+func (p *SimpleParticipantBuilder) WithCLExtraParams(params ...string) *SimpleParticipantBuilder {
+	p.participant.CLExtraParams = append(p.participant.CLExtraParams, params...)
+	return p
+}
+
+// WithVCExtraParams adds extra command line flags for the validator client
+func (p *SimpleParticipantBuilder) WithVCExtraParams(params ...string) *SimpleParticipantBuilder {
+	p.participant.VCExtraParams = append(p.participant.VCExtraParams, params...)
+	return p
+}
+
+// WithELSyncMode sets the execution client's sync mode
+func (p *SimpleParticipantBuilder) WithELSyncMode(mode SyncMode) *SimpleParticipantBuilder {
+	p.participant.ELSyncMode = mode
+	return p
+}
+
+// WithLogLevels sets the execution, consensus and validator client log levels.
+// Empty levels fall back to the global log level.
+func (p *SimpleParticipantBuilder) WithLogLevels(el, cl, vc string) *SimpleParticipantBuilder {
+	p.participant.ELLogLevel = el
+	p.participant.CLLogLevel = cl
+	p.participant.VCLogLevel = vc
+	return p
+}
+
+// WithELResources sets the execution client's CPU request and limit in
+// millicores and memory request and limit in megabytes. Zero keeps the default.
+func (p *SimpleParticipantBuilder) WithELResources(minCPU, maxCPU, minMem, maxMem int) *SimpleParticipantBuilder {
+	p.participant.ELMinCPU, p.participant.ELMaxCPU = minCPU, maxCPU
+	p.participant.ELMinMem, p.participant.ELMaxMem = minMem, maxMem
+	return p
+}
+
+// WithCLResources sets the consensus client's CPU request and limit in
+// millicores and memory request and limit in megabytes. Zero keeps the default.
+func (p *SimpleParticipantBuilder) WithCLResources(minCPU, maxCPU, minMem, maxMem int) *SimpleParticipantBuilder {
+	p.participant.CLMinCPU, p.participant.CLMaxCPU = minCPU, maxCPU
+	p.participant.CLMinMem, p.participant.CLMaxMem = minMem, maxMem
+	return p
+}
+
 // WithCount sets the number of nodes
 func (p *SimpleParticipantBuilder) WithCount(count int) *SimpleParticipantBuilder {
 	p.participant.Count = count
@@ -143,6 +275,57 @@ func (p *SimpleParticipantBuilder) WithValidatorCount(count int) *SimpleParticip
 	return p
 }
 
+// WithTags adds runtime tags to the participant's nodes
+func (p *SimpleParticipantBuilder) WithTags(tags ...string) *SimpleParticipantBuilder {
+	p.participant.Tags = append(p.participant.Tags, tags...)
+	return p
+}
+
+// WithSnooper proxies the participant's engine and beacon API traffic through snoopers
+func (p *SimpleParticipantBuilder) WithSnooper() *SimpleParticipantBuilder {
+	p.participant.SnooperEnabled = true
+	return p
+}
+
+// WithKeymanager exposes the keymanager API of the participant's validator clients
+func (p *SimpleParticipantBuilder) WithKeymanager() *SimpleParticipantBuilder {
+	p.participant.KeymanagerEnabled = true
+	return p
+}
+
+// WithMetricsExporter toggles the ethereum-metrics-exporter for the participant's
+// nodes, overriding the network-wide setting
+func (p *SimpleParticipantBuilder) WithMetricsExporter(enabled bool) *SimpleParticipantBuilder {
+	p.participant.EthereumMetricsExporterEnabled = &enabled
+	return p
+}
+
+// WithCheckpointSync toggles checkpoint sync for the participant's beacon nodes,
+// overriding the network-wide setting
+func (p *SimpleParticipantBuilder) WithCheckpointSync(enabled bool) *SimpleParticipantBuilder {
+	p.participant.CheckpointSyncEnabled = &enabled
+	return p
+}
+
+// WithFeeRecipient pays the proposals of the participant's validators to
+// address, overriding the network-wide setting
+func (p *SimpleParticipantBuilder) WithFeeRecipient(address string) *SimpleParticipantBuilder {
+	p.participant.FeeRecipient = address
+	return p
+}
+
+// WithSidecar deploys a sidecar next to each of the participant's nodes
+func (p *SimpleParticipantBuilder) WithSidecar(sidecar Sidecar) *SimpleParticipantBuilder {
+	p.participant.Sidecars = append(p.participant.Sidecars, sidecar)
+	return p
+}
+
+// WithLateJoin keeps the participant's nodes stopped until the network starts late joiners
+func (p *SimpleParticipantBuilder) WithLateJoin() *SimpleParticipantBuilder {
+	p.participant.LateJoin = true
+	return p
+}
+
 // Build returns the built participant configuration
 func (p *SimpleParticipantBuilder) Build() ParticipantConfig {
 	return p.participant
@@ -468,3 +468,126 @@ func TestEthereumPackageConfig_PortPublisherValidation(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "port publisher el: public_port_start must be between 1024 and 65535")
 }
+
+func TestMetricsExporterToggle(t *testing.T) {
+	cfg, err := NewConfigBuilder().
+		WithParticipant(NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Lighthouse).Build()).
+		WithParticipant(NewParticipantBuilder().WithEL(client.Besu).WithCL(client.Teku).WithMetricsExporter(false).Build()).
+		WithMetricsExporter(true).
+		Build()
+	require.NoError(t, err)
+
+	assert.True(t, cfg.MetricsExporterEnabled(0))
+	assert.False(t, cfg.MetricsExporterEnabled(1))
+
+	yamlConfig, err := ToYAML(cfg)
+	require.NoError(t, err)
+	assert.Contains(t, yamlConfig, "\nethereum_metrics_exporter_enabled: true")
+	assert.Contains(t, yamlConfig, "  ethereum_metrics_exporter_enabled: false")
+
+	parsed, err := FromYAML(yamlConfig)
+	require.NoError(t, err)
+	assert.True(t, parsed.MetricsExporterEnabled(0))
+	assert.False(t, parsed.MetricsExporterEnabled(1))
+}
+
+func TestLateJoinerCheckpointSync(t *testing.T) {
+	participants := []ParticipantConfig{
+		NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Lighthouse).Build(),
+		NewParticipantBuilder().WithEL(client.Besu).WithCL(client.Teku).WithLateJoin().Build(),
+		NewParticipantBuilder().WithEL(client.Nethermind).WithCL(client.Prysm).WithLateJoin().WithCheckpointSync(false).Build(),
+	}
+	cfg, err := NewConfigBuilder().
+		WithParticipants(participants).
+		WithCheckpointSyncURL(CheckpointzURL).
+		WithLateJoinerCheckpointSync().
+		Build()
+	require.NoError(t, err)
+
+	assert.False(t, cfg.CheckpointSync(0))
+	assert.True(t, cfg.CheckpointSync(1))
+	assert.False(t, cfg.CheckpointSync(2))
+	assert.Nil(t, participants[1].CheckpointSyncEnabled, "caller's participants must not change")
+
+	yamlConfig, err := ToYAML(cfg)
+	require.NoError(t, err)
+	assert.Contains(t, yamlConfig, "\ncheckpoint_sync_url: http://checkpointz:5555")
+	assert.Contains(t, yamlConfig, "  checkpoint_sync_enabled: true")
+	assert.NotContains(t, yamlConfig, "\ncheckpoint_sync_enabled:")
+}
+
+func TestGlobalSchedulingConfig(t *testing.T) {
+	seconds := int64(300)
+	cfg, err := NewConfigBuilder().
+		WithParticipant(NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Lighthouse).Build()).
+		WithGlobalTolerations(
+			Toleration{Key: "dedicated", Value: "ethereum", Effect: "NoSchedule"},
+			Toleration{Operator: "Exists", Effect: "NoExecute", TolerationSeconds: &seconds},
+		).
+		WithGlobalNodeSelector("pool", "ethereum").
+		Build()
+	require.NoError(t, err)
+
+	yamlConfig, err := ToYAML(cfg)
+	require.NoError(t, err)
+	assert.Contains(t, yamlConfig, "global_tolerations:\n    - key: dedicated\n      value: ethereum\n      effect: NoSchedule\n")
+	assert.Contains(t, yamlConfig, "toleration_seconds: 300")
+	assert.Contains(t, yamlConfig, "global_node_selectors:\n    pool: ethereum\n")
+
+	parsed, err := FromYAML(yamlConfig)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.GlobalTolerations, parsed.GlobalTolerations)
+	assert.Equal(t, cfg.GlobalNodeSelectors, parsed.GlobalNodeSelectors)
+}
+
+func TestTolerationValidate(t *testing.T) {
+	seconds := int64(60)
+	tests := []struct {
+		name       string
+		toleration Toleration
+		wantErr    string
+	}{
+		{"equal", Toleration{Key: "dedicated", Value: "ethereum"}, ""},
+		{"exists without key", Toleration{Operator: "Exists"}, ""},
+		{"equal without key", Toleration{Value: "ethereum"}, "key is required unless operator is Exists"},
+		{"exists with value", Toleration{Key: "dedicated", Operator: "Exists", Value: "ethereum"}, "value must be empty"},
+		{"bad operator", Toleration{Key: "dedicated", Operator: "In"}, `invalid operator "In"`},
+		{"bad effect", Toleration{Key: "dedicated", Effect: "Never"}, `invalid effect "Never"`},
+		{"seconds without NoExecute", Toleration{Key: "dedicated", Effect: "NoSchedule", TolerationSeconds: &seconds}, "toleration_seconds requires effect NoExecute"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.toleration.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := NewConfigBuilder().
+		WithParticipant(NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Lighthouse).Build()).
+		WithGlobalTolerations(Toleration{Operator: "In"}).
+		Build()
+	assert.ErrorContains(t, err, "global toleration 0: invalid operator")
+
+	_, err = NewConfigBuilder().
+		WithParticipant(NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Lighthouse).Build()).
+		WithGlobalNodeSelector("", "x").
+		Build()
+	assert.ErrorContains(t, err, `invalid global node selector key ""`)
+}
+
+func TestKeymanagerEnabled(t *testing.T) {
+	cfg, err := NewConfigBuilder().
+		WithParticipant(NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Lighthouse).WithKeymanager().Build()).
+		Build()
+	require.NoError(t, err)
+	assert.True(t, cfg.Participants[0].KeymanagerEnabled)
+
+	yamlConfig, err := ToYAML(cfg)
+	require.NoError(t, err)
+	assert.Contains(t, yamlConfig, "  keymanager_enabled: true")
+}
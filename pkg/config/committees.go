@@ -0,0 +1,174 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DefaultNumValidatorKeysPerNode is the number of validator keys ethereum-package
+// assigns to each node when neither the participant nor the network params override it
+const DefaultNumValidatorKeysPerNode = 64
+
+// ErrInsufficientValidators is returned when a configuration has too few validators
+// for the chosen spec preset to fill its committees
+var ErrInsufficientValidators = errors.New("insufficient validators")
+
+// MinValidatorCount returns the minimum number of genesis validators required for
+// every slot of an epoch to have a non-empty beacon committee
+func MinValidatorCount(preset SpecPreset) int {
+	return int(preset.SlotsPerEpoch())
+}
+
+// validatorsPerNode returns the effective validator key count for a participant node
+func validatorsPerNode(p ParticipantConfig, params *NetworkParams) int {
+	if p.ValidatorCount > 0 {
+		return p.ValidatorCount
+	}
+	if params != nil && params.NumValidatorKeysPerNode > 0 {
+		return params.NumValidatorKeysPerNode
+	}
+	return DefaultNumValidatorKeysPerNode
+}
+
+// nodeCount returns the number of nodes a participant expands to
+func nodeCount(p ParticipantConfig) int {
+	if p.Count == 0 {
+		return 1
+	}
+	return p.Count
+}
+
+// TotalValidatorCount returns the number of genesis validators the configuration produces
+func TotalValidatorCount(cfg *EthereumPackageConfig) int {
+	if cfg == nil {
+		return 0
+	}
+
+	total := 0
+	for _, p := range cfg.Participants {
+		total += nodeCount(p) * validatorsPerNode(p, cfg.NetworkParams)
+	}
+	if cfg.NetworkParams != nil {
+		total += cfg.NetworkParams.PreregisteredValidatorCount
+	}
+
+	return total
+}
+
+// specPreset returns the spec preset of a configuration, defaulting to mainnet
+func specPreset(cfg *EthereumPackageConfig) SpecPreset {
+	if cfg.NetworkParams != nil && cfg.NetworkParams.Preset != "" {
+		return cfg.NetworkParams.Preset
+	}
+	return SpecPresetMainnet
+}
+
+// ValidateValidatorCount checks that the configuration has enough validators to fill
+// the committees of its spec preset. Undersized devnets otherwise fail deep inside
+// ethereum-package genesis generation with errors that are hard to trace back.
+func ValidateValidatorCount(cfg *EthereumPackageConfig) error {
+	if cfg == nil {
+		return ErrNilConfig
+	}
+
+	preset := specPreset(cfg)
+	total := TotalValidatorCount(cfg)
+	minimum := MinValidatorCount(preset)
+	if total < minimum {
+		return fmt.Errorf("%w: %d validators configured, %s preset needs at least %d (one per slot in an epoch)",
+			ErrInsufficientValidators, total, preset, minimum)
+	}
+
+	return nil
+}
+
+// FixValidatorCount raises per-node validator counts so the configuration meets
+// MinValidatorCount for its spec preset. It returns true if the config was changed.
+func FixValidatorCount(cfg *EthereumPackageConfig) bool {
+	if cfg == nil || len(cfg.Participants) == 0 {
+		return false
+	}
+
+	deficit := MinValidatorCount(specPreset(cfg)) - TotalValidatorCount(cfg)
+	if deficit <= 0 {
+		return false
+	}
+
+	nodes := 0
+	for _, p := range cfg.Participants {
+		nodes += nodeCount(p)
+	}
+
+	// Spread the deficit evenly across nodes, rounding up
+	extra := (deficit + nodes - 1) / nodes
+	for i, p := range cfg.Participants {
+		cfg.Participants[i].ValidatorCount = validatorsPerNode(p, cfg.NetworkParams) + extra
+	}
+
+	return true
+}
+
+// ValidatorRange is a half-open range [Start, End) of genesis validator indices
+type ValidatorRange struct {
+	Start uint64
+	End   uint64
+}
+
+// Contains reports whether the validator index falls in the range
+func (r ValidatorRange) Contains(index uint64) bool {
+	return index >= r.Start && index < r.End
+}
+
+// Count returns the number of validators in the range
+func (r ValidatorRange) Count() int {
+	return int(r.End - r.Start)
+}
+
+// ValidatorRanges returns the genesis validator indices run by each node, keyed by
+// 1-based node index. ethereum-package assigns keys to nodes in participant order.
+func (c *EthereumPackageConfig) ValidatorRanges() map[int]ValidatorRange {
+	ranges := make(map[int]ValidatorRange)
+	c.forEachNode(func(node, _ int, r ValidatorRange) {
+		ranges[node] = r
+	})
+	return ranges
+}
+
+// ParticipantValidatorRanges returns the validator ranges of each node of the participant
+// at the given 0-based index, in node order. A participant with Count 3 and ValidatorCount
+// 64 gets three consecutive 64-key ranges.
+func (c *EthereumPackageConfig) ParticipantValidatorRanges(participant int) []ValidatorRange {
+	var ranges []ValidatorRange
+	c.forEachNode(func(_, p int, r ValidatorRange) {
+		if p == participant {
+			ranges = append(ranges, r)
+		}
+	})
+	return ranges
+}
+
+// NodeForValidator returns the 1-based index of the node running the genesis validator
+func (c *EthereumPackageConfig) NodeForValidator(index uint64) (int, bool) {
+	found := 0
+	c.forEachNode(func(node, _ int, r ValidatorRange) {
+		if found == 0 && r.Contains(index) {
+			found = node
+		}
+	})
+	return found, found > 0
+}
+
+// forEachNode calls fn with the 1-based node index, 0-based participant index and
+// validator range of every node, in node order
+func (c *EthereumPackageConfig) forEachNode(fn func(node, participant int, r ValidatorRange)) {
+	node := 1
+	var next uint64
+	for i, p := range c.Participants {
+		perNode := uint64(validatorsPerNode(p, c.NetworkParams))
+		for j := 0; j < nodeCount(p); j++ {
+			fn(node, i, ValidatorRange{Start: next, End: next + perNode})
+			next += perNode
+			node++
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotalValidatorCount(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants: []ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse, Count: 2},
+			{ELType: client.Besu, CLType: client.Teku, ValidatorCount: 10},
+		},
+	}
+	assert.Equal(t, 2*DefaultNumValidatorKeysPerNode+10, TotalValidatorCount(cfg))
+
+	cfg.NetworkParams = &NetworkParams{NumValidatorKeysPerNode: 8, PreregisteredValidatorCount: 5}
+	assert.Equal(t, 2*8+10+5, TotalValidatorCount(cfg))
+	assert.Equal(t, 0, TotalValidatorCount(nil))
+}
+
+func TestValidateValidatorCount(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants: []ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse, Count: 3, ValidatorCount: 3},
+		},
+	}
+	assert.ErrorIs(t, ValidateValidatorCount(cfg), ErrInsufficientValidators)
+
+	cfg.NetworkParams = &NetworkParams{Preset: SpecPresetMinimal}
+	assert.NoError(t, ValidateValidatorCount(cfg))
+
+	assert.ErrorIs(t, ValidateValidatorCount(nil), ErrNilConfig)
+}
+
+func TestFixValidatorCount(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants: []ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse, Count: 2, ValidatorCount: 5},
+			{ELType: client.Besu, CLType: client.Teku, ValidatorCount: 1},
+		},
+	}
+	assert.True(t, FixValidatorCount(cfg))
+	assert.NoError(t, ValidateValidatorCount(cfg))
+	assert.Equal(t, 12, cfg.Participants[0].ValidatorCount)
+	assert.Equal(t, 8, cfg.Participants[1].ValidatorCount)
+
+	assert.False(t, FixValidatorCount(cfg))
+	assert.False(t, FixValidatorCount(nil))
+}
+
+func TestValidatorRanges(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants: []ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse, Count: 2, ValidatorCount: 8},
+			{ELType: client.Besu, CLType: client.Teku},
+		},
+		NetworkParams: &NetworkParams{NumValidatorKeysPerNode: 16},
+	}
+
+	ranges := cfg.ValidatorRanges()
+	require.Len(t, ranges, 3)
+	assert.Equal(t, ValidatorRange{Start: 0, End: 8}, ranges[1])
+	assert.Equal(t, ValidatorRange{Start: 8, End: 16}, ranges[2])
+	assert.Equal(t, ValidatorRange{Start: 16, End: 32}, ranges[3])
+	assert.True(t, ranges[3].Contains(16))
+	assert.False(t, ranges[3].Contains(32))
+	assert.Equal(t, 16, ranges[3].Count())
+}
+
+func TestParticipantValidatorRanges(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants: []ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse},
+			{ELType: client.Besu, CLType: client.Teku, Count: 3, ValidatorCount: 10},
+		},
+	}
+
+	assert.Equal(t, []ValidatorRange{{Start: 0, End: 64}}, cfg.ParticipantValidatorRanges(0))
+	assert.Equal(t, []ValidatorRange{
+		{Start: 64, End: 74},
+		{Start: 74, End: 84},
+		{Start: 84, End: 94},
+	}, cfg.ParticipantValidatorRanges(1))
+	assert.Empty(t, cfg.ParticipantValidatorRanges(2))
+
+	node, ok := cfg.NodeForValidator(80)
+	require.True(t, ok)
+	assert.Equal(t, 3, node)
+
+	node, ok = cfg.NodeForValidator(0)
+	require.True(t, ok)
+	assert.Equal(t, 1, node)
+
+	_, ok = cfg.NodeForValidator(94)
+	assert.False(t, ok)
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// feeRecipientFlags are the validator flags setting the default fee recipient
+// of each consensus client's proposals
+var feeRecipientFlags = map[client.Type]string{
+	client.Lighthouse: "--suggested-fee-recipient",
+	client.Teku:       "--validators-proposer-default-fee-recipient",
+	client.Prysm:      "--suggested-fee-recipient",
+	client.Nimbus:     "--suggested-fee-recipient",
+	client.Lodestar:   "--suggestedFeeRecipient",
+	client.Grandine:   "--suggested-fee-recipient",
+}
+
+// defaultCLType is the consensus client ethereum-package runs when cl_type is empty
+const defaultCLType = client.Lighthouse
+
+// FeeRecipientFlag returns the validator flag paying the proposals of the
+// consensus client's validators to address, or an error if the client or
+// address is not supported. An empty clType means ethereum-package's default.
+func FeeRecipientFlag(clType client.Type, address string) (string, error) {
+	if err := ValidateFeeRecipient(address); err != nil {
+		return "", err
+	}
+	if clType == "" {
+		clType = defaultCLType
+	}
+	flag, ok := feeRecipientFlags[clType]
+	if !ok {
+		return "", fmt.Errorf("fee recipient is not supported for %s", clType)
+	}
+	return flag + "=" + address, nil
+}
+
+// ValidateFeeRecipient checks that address is a 0x-prefixed 20-byte hex address
+func ValidateFeeRecipient(address string) error {
+	raw, ok := strings.CutPrefix(address, "0x")
+	if !ok || len(raw) != 40 {
+		return fmt.Errorf("invalid fee recipient %q, must be a 0x-prefixed 20-byte address", address)
+	}
+	if _, err := hex.DecodeString(raw); err != nil {
+		return fmt.Errorf("invalid fee recipient %q, must be a 0x-prefixed 20-byte address", address)
+	}
+	return nil
+}
+
+// FeeRecipientFor returns the fee recipient of the validators of the participant
+// at the 0-based index, or "" if ethereum-package's default applies
+func (c *EthereumPackageConfig) FeeRecipientFor(participant int) string {
+	if participant >= 0 && participant < len(c.Participants) {
+		if recipient := c.Participants[participant].FeeRecipient; recipient != "" {
+			return recipient
+		}
+	}
+	return c.FeeRecipient
+}
+
+// NodeFeeRecipients returns the configured fee recipient of each node keyed by
+// its 1-based node index. Nodes using ethereum-package's default are omitted.
+func (c *EthereumPackageConfig) NodeFeeRecipients() map[int]string {
+	recipients := make(map[int]string)
+	c.forEachNode(func(node, participant int, _ ValidatorRange) {
+		if recipient := c.FeeRecipientFor(participant); recipient != "" {
+			recipients[node] = recipient
+		}
+	})
+	return recipients
+}
+
+// MarshalYAML hands the network-wide fee recipient to every participant that
+// does not set its own, as ethereum-package has no field for either
+func (c EthereumPackageConfig) MarshalYAML() (interface{}, error) {
+	type plain EthereumPackageConfig
+	out := plain(c)
+	if c.FeeRecipient != "" {
+		out.Participants = append([]ParticipantConfig(nil), c.Participants...)
+		for i := range out.Participants {
+			if out.Participants[i].FeeRecipient == "" {
+				out.Participants[i].FeeRecipient = c.FeeRecipient
+			}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRecipient  = "0x00000000000000000000000000000000000000aa"
+	otherRecipient = "0x00000000000000000000000000000000000000bb"
+)
+
+func TestFeeRecipientFlag(t *testing.T) {
+	flag, err := FeeRecipientFlag(client.Teku, testRecipient)
+	require.NoError(t, err)
+	assert.Equal(t, "--validators-proposer-default-fee-recipient="+testRecipient, flag)
+
+	flag, err = FeeRecipientFlag(client.Lodestar, testRecipient)
+	require.NoError(t, err)
+	assert.Equal(t, "--suggestedFeeRecipient="+testRecipient, flag)
+
+	flag, err = FeeRecipientFlag("", testRecipient)
+	require.NoError(t, err)
+	assert.Equal(t, "--suggested-fee-recipient="+testRecipient, flag)
+
+	_, err = FeeRecipientFlag(client.Geth, testRecipient)
+	assert.EqualError(t, err, "fee recipient is not supported for geth")
+
+	for _, address := range []string{"", "00000000000000000000000000000000000000aa", "0x00aa", "0xzz000000000000000000000000000000000000aa"} {
+		assert.Error(t, ValidateFeeRecipient(address), address)
+	}
+}
+
+func TestFeeRecipientConfig(t *testing.T) {
+	cfg, err := NewConfigBuilder().
+		WithParticipant(NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Lighthouse).WithCount(2).Build()).
+		WithParticipant(NewParticipantBuilder().WithEL(client.Besu).WithCL(client.Teku).WithFeeRecipient(otherRecipient).WithCLExtraParams("--log=DEBUG").Build()).
+		WithFeeRecipient(testRecipient).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, testRecipient, cfg.FeeRecipientFor(0))
+	assert.Equal(t, otherRecipient, cfg.FeeRecipientFor(1))
+	assert.Equal(t, map[int]string{1: testRecipient, 2: testRecipient, 3: otherRecipient}, cfg.NodeFeeRecipients())
+
+	yamlStr, err := ToYAML(cfg)
+	require.NoError(t, err)
+	assert.Contains(t, yamlStr, "vc_extra_params:\n        - --suggested-fee-recipient="+testRecipient)
+	assert.Contains(t, yamlStr, "cl_extra_params:\n        - --validators-proposer-default-fee-recipient="+otherRecipient+"\n        - --log=DEBUG")
+	assert.NotContains(t, yamlStr, "fee_recipient:")
+
+	// The default does not leak into the participants
+	assert.Empty(t, cfg.Participants[0].FeeRecipient)
+
+	cfg.FeeRecipient = "0x01"
+	assert.ErrorContains(t, cfg.Validate(), `invalid fee recipient "0x01"`)
+
+	participant := NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Prysm).WithFeeRecipient("0x01").Build()
+	assert.Equal(t, "participant 0: fee_recipient: "+`invalid fee recipient "0x01", must be a 0x-prefixed 20-byte address`, participant.Check(0).Err().Error())
+}
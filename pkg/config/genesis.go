@@ -0,0 +1,220 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrGenesisMismatch is returned when the generated genesis differs from the requested config
+var ErrGenesisMismatch = errors.New("genesis does not match config")
+
+// GenesisSource serves the network config files generated by ethereum-package.
+// A network's ApacheConfig() server satisfies it.
+type GenesisSource interface {
+	ConfigYAMLURL() string
+	GenesisJSONURL() string
+}
+
+// GenesisExpectations are the values the generated genesis must have. Zero values
+// are not checked.
+type GenesisExpectations struct {
+	ChainID                uint64
+	DepositContractAddress string
+	GasLimit               uint64
+	SecondsPerSlot         int
+	Preset                 SpecPreset
+	// ForkEpochs maps config.yaml fork names (e.g. "ELECTRA") to their epoch
+	ForkEpochs map[string]int
+}
+
+// ExpectedGenesis returns the genesis values a config requests. Fork epochs left
+// at zero are not expected, as zero also means the package default.
+func ExpectedGenesis(config *EthereumPackageConfig) (GenesisExpectations, error) {
+	expectations := GenesisExpectations{ForkEpochs: make(map[string]int)}
+	if config == nil || config.NetworkParams == nil {
+		return expectations, nil
+	}
+	params := config.NetworkParams
+
+	if params.NetworkID != "" {
+		chainID, err := strconv.ParseUint(params.NetworkID, 10, 64)
+		if err != nil {
+			return expectations, fmt.Errorf("invalid network ID %q: %w", params.NetworkID, err)
+		}
+		expectations.ChainID = chainID
+	}
+	expectations.DepositContractAddress = params.DepositContractAddress
+	expectations.GasLimit = params.GenesisGasLimit
+	expectations.SecondsPerSlot = params.SecondsPerSlot
+	expectations.Preset = params.Preset
+
+	forks := map[string]int{
+		"ALTAIR":    params.AltairForkEpoch,
+		"BELLATRIX": params.BellatrixForkEpoch,
+		"CAPELLA":   params.CapellaForkEpoch,
+		"DENEB":     params.DenebForkEpoch,
+		"ELECTRA":   params.ElectraForkEpoch,
+		"FULU":      params.FuluForkEpoch,
+		"GLOAS":     params.GloasForkEpoch,
+		"EIP7732":   params.EIP7732ForkEpoch,
+		"EIP7805":   params.EIP7805ForkEpoch,
+	}
+	for name, epoch := range forks {
+		if epoch != 0 {
+			expectations.ForkEpochs[name] = epoch
+		}
+	}
+	return expectations, nil
+}
+
+// GenesisMismatch is a genesis value that differs from the expectation
+type GenesisMismatch struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+// String describes the mismatch
+func (m GenesisMismatch) String() string {
+	return fmt.Sprintf("%s: expected %s, got %s", m.Field, m.Expected, m.Actual)
+}
+
+// VerifyGenesis downloads the generated config.yaml and genesis.json and checks
+// them against the expectations. Mismatches are returned wrapped in ErrGenesisMismatch.
+func VerifyGenesis(ctx context.Context, source GenesisSource, expectations GenesisExpectations) error {
+	if source == nil {
+		return fmt.Errorf("no genesis source available")
+	}
+	configYAML, err := download(ctx, source.ConfigYAMLURL())
+	if err != nil {
+		return err
+	}
+	genesisJSON, err := download(ctx, source.GenesisJSONURL())
+	if err != nil {
+		return err
+	}
+
+	mismatches, err := CompareGenesis(configYAML, genesisJSON, expectations)
+	if err != nil {
+		return err
+	}
+	if len(mismatches) > 0 {
+		descriptions := make([]string, len(mismatches))
+		for i, mismatch := range mismatches {
+			descriptions[i] = mismatch.String()
+		}
+		return fmt.Errorf("%w: %s", ErrGenesisMismatch, strings.Join(descriptions, "; "))
+	}
+	return nil
+}
+
+// CompareGenesis checks a consensus config.yaml and execution genesis.json against
+// the expectations and returns the mismatches sorted by field
+func CompareGenesis(configYAML, genesisJSON []byte, expectations GenesisExpectations) ([]GenesisMismatch, error) {
+	// Decode to nodes so values keep their literal form, e.g. long hex addresses
+	var nodes map[string]yaml.Node
+	if err := yaml.Unmarshal(configYAML, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to parse config.yaml: %w", err)
+	}
+	spec := make(map[string]string, len(nodes))
+	for key, node := range nodes {
+		if node.Kind == yaml.ScalarNode {
+			spec[key] = node.Value
+		}
+	}
+
+	var genesis struct {
+		Config struct {
+			ChainID uint64 `json:"chainId"`
+		} `json:"config"`
+		GasLimit json.RawMessage `json:"gasLimit"`
+	}
+	if err := json.Unmarshal(genesisJSON, &genesis); err != nil {
+		return nil, fmt.Errorf("failed to parse genesis.json: %w", err)
+	}
+
+	var mismatches []GenesisMismatch
+	check := func(field, expected, actual string) {
+		if !strings.EqualFold(expected, actual) {
+			mismatches = append(mismatches, GenesisMismatch{Field: field, Expected: expected, Actual: actual})
+		}
+	}
+
+	if expectations.ChainID != 0 {
+		expected := strconv.FormatUint(expectations.ChainID, 10)
+		check("DEPOSIT_CHAIN_ID", expected, spec["DEPOSIT_CHAIN_ID"])
+		check("genesis chainId", expected, strconv.FormatUint(genesis.Config.ChainID, 10))
+	}
+	if expectations.DepositContractAddress != "" {
+		check("DEPOSIT_CONTRACT_ADDRESS", expectations.DepositContractAddress, spec["DEPOSIT_CONTRACT_ADDRESS"])
+	}
+	if expectations.GasLimit != 0 {
+		gasLimit, err := parseQuantity(genesis.GasLimit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis gasLimit: %w", err)
+		}
+		check("genesis gasLimit", strconv.FormatUint(expectations.GasLimit, 10), gasLimit)
+	}
+	if expectations.SecondsPerSlot != 0 {
+		check("SECONDS_PER_SLOT", strconv.Itoa(expectations.SecondsPerSlot), spec["SECONDS_PER_SLOT"])
+	}
+	if expectations.Preset != "" {
+		check("PRESET_BASE", string(expectations.Preset), spec["PRESET_BASE"])
+	}
+	for name, epoch := range expectations.ForkEpochs {
+		field := name + "_FORK_EPOCH"
+		check(field, strconv.Itoa(epoch), spec[field])
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool { return mismatches[i].Field < mismatches[j].Field })
+	return mismatches, nil
+}
+
+// parseQuantity parses a JSON number or hex string quantity into its decimal form
+func parseQuantity(raw json.RawMessage) (string, error) {
+	var hexValue string
+	if err := json.Unmarshal(raw, &hexValue); err != nil {
+		var number json.Number
+		if err := json.Unmarshal(raw, &number); err != nil {
+			return "", err
+		}
+		return number.String(), nil
+	}
+
+	value, ok := new(big.Int).SetString(strings.TrimPrefix(hexValue, "0x"), 16)
+	if !ok {
+		return "", fmt.Errorf("invalid quantity %q", hexValue)
+	}
+	return value.String(), nil
+}
+
+// download fetches a file from the config server
+func download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: status %d", url, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", url, err)
+	}
+	return body, nil
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigYAML = `PRESET_BASE: 'minimal'
+CONFIG_NAME: testnet
+SECONDS_PER_SLOT: 6
+DEPOSIT_CHAIN_ID: 3151908
+DEPOSIT_NETWORK_ID: 3151908
+DEPOSIT_CONTRACT_ADDRESS: 0x00000000219ab540356cBB839Cbe05303d7705Fa
+DENEB_FORK_EPOCH: 0
+ELECTRA_FORK_EPOCH: 2
+BLOB_SCHEDULE:
+  - EPOCH: 2
+    MAX_BLOBS_PER_BLOCK: 9
+`
+
+const testGenesisJSON = `{"config":{"chainId":3151908},"gasLimit":"0x3938700"}`
+
+func TestExpectedGenesis(t *testing.T) {
+	cfg := &EthereumPackageConfig{NetworkParams: &NetworkParams{
+		Preset:           SpecPresetMinimal,
+		ElectraForkEpoch: 2,
+	}}
+	cfg.NetworkParams.ApplyDefaults()
+
+	expectations, err := ExpectedGenesis(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3151908), expectations.ChainID)
+	assert.Equal(t, uint64(60000000), expectations.GasLimit)
+	assert.Equal(t, 6, expectations.SecondsPerSlot)
+	assert.Equal(t, map[string]int{"ELECTRA": 2}, expectations.ForkEpochs)
+
+	_, err = ExpectedGenesis(&EthereumPackageConfig{NetworkParams: &NetworkParams{NetworkID: "devnet"}})
+	assert.Error(t, err)
+}
+
+func TestCompareGenesis(t *testing.T) {
+	expectations := GenesisExpectations{
+		ChainID:                3151908,
+		DepositContractAddress: "0x00000000219ab540356cbb839cbe05303d7705fa",
+		GasLimit:               60000000,
+		SecondsPerSlot:         6,
+		Preset:                 SpecPresetMinimal,
+		ForkEpochs:             map[string]int{"DENEB": 0, "ELECTRA": 2},
+	}
+
+	mismatches, err := CompareGenesis([]byte(testConfigYAML), []byte(testGenesisJSON), expectations)
+	require.NoError(t, err)
+	assert.Empty(t, mismatches)
+
+	expectations.GasLimit = 30000000
+	expectations.ForkEpochs["ELECTRA"] = 1
+	expectations.ForkEpochs["FULU"] = 3
+	mismatches, err = CompareGenesis([]byte(testConfigYAML), []byte(testGenesisJSON), expectations)
+	require.NoError(t, err)
+	assert.Equal(t, []GenesisMismatch{
+		{Field: "ELECTRA_FORK_EPOCH", Expected: "1", Actual: "2"},
+		{Field: "FULU_FORK_EPOCH", Expected: "3", Actual: ""},
+		{Field: "genesis gasLimit", Expected: "30000000", Actual: "60000000"},
+	}, mismatches)
+}
+
+type testGenesisSource struct{ url string }
+
+func (s testGenesisSource) ConfigYAMLURL() string  { return s.url + "/network-configs/config.yaml" }
+func (s testGenesisSource) GenesisJSONURL() string { return s.url + "/network-configs/genesis.json" }
+
+func TestVerifyGenesis(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/network-configs/config.yaml":
+			_, _ = w.Write([]byte(testConfigYAML))
+		case "/network-configs/genesis.json":
+			_, _ = w.Write([]byte(testGenesisJSON))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	source := testGenesisSource{url: server.URL}
+	require.NoError(t, VerifyGenesis(context.Background(), source, GenesisExpectations{ChainID: 3151908}))
+
+	err := VerifyGenesis(context.Background(), source, GenesisExpectations{ChainID: 1})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrGenesisMismatch))
+	assert.Contains(t, err.Error(), "DEPOSIT_CHAIN_ID: expected 1, got 3151908")
+
+	err = VerifyGenesis(context.Background(), testGenesisSource{url: server.URL + "/missing"}, GenesisExpectations{})
+	assert.Contains(t, err.Error(), "status 404")
+}
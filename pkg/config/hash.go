@@ -0,0 +1,22 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Hash returns a deterministic SHA-256 of the config's YAML form and the package
+// reference it is deployed with (e.g. "github.com/ethpandaops/ethereum-package@4.0.0").
+// Two runs with the same hash deploy the same network.
+func Hash(config *EthereumPackageConfig, packageRef string) (string, error) {
+	yamlConfig, err := ToYAML(config)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "package: %s\n", packageRef)
+	h.Write([]byte(yamlConfig))
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,153 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Networks NetworkParams.Network selects
+const (
+	// NetworkKurtosis is a devnet started from a genesis ethereum-package generates
+	NetworkKurtosis = "kurtosis"
+	NetworkMainnet  = "mainnet"
+	NetworkSepolia  = "sepolia"
+	NetworkHolesky  = "holesky"
+	NetworkHoodi    = "hoodi"
+)
+
+// ShadowForkSuffix turns a public network into a shadow fork of it, e.g.
+// "holesky-shadowfork": the nodes start from the public network's state and
+// build their own chain on top of it with the package's validators
+const ShadowForkSuffix = "-shadowfork"
+
+// ShadowForkLatest forks off the public network's latest block
+const ShadowForkLatest = "latest"
+
+// PublicNetwork is a public network the package can join or shadow fork
+type PublicNetwork struct {
+	ChainID                string
+	DepositContractAddress string
+	// CheckpointSyncURL is the public checkpoint sync endpoint ethPandaOps runs
+	CheckpointSyncURL string
+}
+
+// PublicNetworks are the public networks by name
+var PublicNetworks = map[string]PublicNetwork{
+	NetworkMainnet: {"1", DefaultDepositContractAddress, "https://checkpoint-sync.mainnet.ethpandaops.io"},
+	NetworkSepolia: {"11155111", "0x7f02C3E3c98b133055B8B348B2Ac625669Ed295D", "https://checkpoint-sync.sepolia.ethpandaops.io"},
+	NetworkHolesky: {"17000", "0x4242424242424242424242424242424242424242", "https://checkpoint-sync.holesky.ethpandaops.io"},
+	NetworkHoodi:   {"560048", DefaultDepositContractAddress, "https://checkpoint-sync.hoodi.ethpandaops.io"},
+}
+
+// ShadowFork returns the network name of a shadow fork of a public network
+func ShadowFork(network string) string {
+	return network + ShadowForkSuffix
+}
+
+// IsShadowFork reports whether the network is a shadow fork of a public network
+func (n *NetworkParams) IsShadowFork() bool {
+	return strings.HasSuffix(n.Network, ShadowForkSuffix)
+}
+
+// BaseNetwork returns the network without the shadow fork suffix
+func (n *NetworkParams) BaseNetwork() string {
+	return strings.TrimSuffix(n.Network, ShadowForkSuffix)
+}
+
+// IsPublic reports whether the nodes join or shadow fork a public network
+// rather than starting a devnet of their own
+func (n *NetworkParams) IsPublic() bool {
+	_, ok := PublicNetworks[n.BaseNetwork()]
+	return ok
+}
+
+// publicNetworkNames lists the public networks for error messages
+func publicNetworkNames() string {
+	names := make([]string, 0, len(PublicNetworks))
+	for name := range PublicNetworks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// validateNetwork checks that the network is known and that only the
+// parameters that apply to its type are set. A public network's genesis is
+// fixed, so fields that shape the genesis are rejected; a shadow fork keeps the
+// public state but may schedule its own forks.
+func (n *NetworkParams) validateNetwork() error {
+	if n.Network == "" || n.Network == NetworkKurtosis {
+		switch {
+		case n.NetworkSyncBaseURL != "":
+			return fmt.Errorf("network sync base URL only applies to public networks and shadow forks")
+		case n.ForceSnapshotSync:
+			return fmt.Errorf("force snapshot sync only applies to public networks and shadow forks")
+		case n.ShadowForkBlockHeight != "":
+			return fmt.Errorf("shadow fork block height only applies to shadow forks")
+		}
+		return nil
+	}
+
+	public, ok := PublicNetworks[n.BaseNetwork()]
+	if !ok {
+		return fmt.Errorf("invalid network: %s, must be %s, one of: %s, or a shadow fork such as %s",
+			n.Network, NetworkKurtosis, publicNetworkNames(), ShadowFork(NetworkHoodi))
+	}
+	if n.NetworkID != "" && n.NetworkID != public.ChainID {
+		return fmt.Errorf("network %s has network ID %s, got %s", n.Network, public.ChainID, n.NetworkID)
+	}
+	if n.DepositContractAddress != "" && !strings.EqualFold(n.DepositContractAddress, public.DepositContractAddress) {
+		return fmt.Errorf("network %s has deposit contract %s, got %s", n.Network, public.DepositContractAddress, n.DepositContractAddress)
+	}
+	if n.Preset != "" && n.Preset != SpecPresetMainnet {
+		return fmt.Errorf("network %s uses the mainnet preset, got %s", n.Network, n.Preset)
+	}
+	if n.PreregisteredValidatorCount != 0 || len(n.AdditionalPreloadedContracts) > 0 {
+		return fmt.Errorf("network %s starts from public state: preregistered validators and preloaded contracts are not supported", n.Network)
+	}
+	if n.NetworkSyncBaseURL != "" {
+		if u, err := url.Parse(n.NetworkSyncBaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("network sync base URL must be an http(s) URL, got %q", n.NetworkSyncBaseURL)
+		}
+	}
+
+	if !n.IsShadowFork() {
+		if n.ShadowForkBlockHeight != "" {
+			return fmt.Errorf("shadow fork block height only applies to shadow forks, use %s", ShadowFork(n.Network))
+		}
+		forkEpochs := []int{n.AltairForkEpoch, n.BellatrixForkEpoch, n.CapellaForkEpoch, n.DenebForkEpoch,
+			n.ElectraForkEpoch, n.FuluForkEpoch, n.GloasForkEpoch, n.EIP7732ForkEpoch, n.EIP7805ForkEpoch}
+		for _, epoch := range forkEpochs {
+			if epoch != 0 {
+				return fmt.Errorf("network %s follows the public fork schedule, fork epochs cannot be set; shadow fork it with %s",
+					n.Network, ShadowFork(n.Network))
+			}
+		}
+		return nil
+	}
+
+	if height := n.ShadowForkBlockHeight; height != "" && height != ShadowForkLatest {
+		if _, err := strconv.ParseUint(height, 10, 64); err != nil {
+			return fmt.Errorf("shadow fork block height must be %q or a block number, got %q", ShadowForkLatest, height)
+		}
+	}
+	return nil
+}
+
+// applyNetworkDefaults fills in the chain ID and deposit contract of a public
+// network
+func (n *NetworkParams) applyNetworkDefaults() {
+	public, ok := PublicNetworks[n.BaseNetwork()]
+	if !ok {
+		return
+	}
+	if n.NetworkID == "" {
+		n.NetworkID = public.ChainID
+	}
+	if n.DepositContractAddress == "" {
+		n.DepositContractAddress = public.DepositContractAddress
+	}
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNetworkParamsPublicNetworks(t *testing.T) {
+	params := &NetworkParams{Network: NetworkSepolia}
+	params.ApplyDefaults()
+	require.NoError(t, params.Validate())
+	assert.True(t, params.IsPublic())
+	assert.False(t, params.IsShadowFork())
+	assert.Equal(t, "11155111", params.NetworkID)
+	assert.Equal(t, "0x7f02C3E3c98b133055B8B348B2Ac625669Ed295D", params.DepositContractAddress)
+
+	params = &NetworkParams{Network: ShadowFork(NetworkHolesky), ShadowForkBlockHeight: ShadowForkLatest, ElectraForkEpoch: 10}
+	params.ApplyDefaults()
+	require.NoError(t, params.Validate())
+	assert.True(t, params.IsShadowFork())
+	assert.Equal(t, NetworkHolesky, params.BaseNetwork())
+	assert.Equal(t, "17000", params.NetworkID)
+
+	params = &NetworkParams{}
+	params.ApplyDefaults()
+	assert.False(t, params.IsPublic())
+	assert.Equal(t, "3151908", params.NetworkID)
+}
+
+func TestNetworkParamsValidateNetwork(t *testing.T) {
+	tests := []struct {
+		name   string
+		params NetworkParams
+		errMsg string
+	}{
+		{"unknown network", NetworkParams{Network: "goerli"}, "invalid network: goerli"},
+		{"sync base on kurtosis", NetworkParams{NetworkSyncBaseURL: "https://snapshots.ethpandaops.io"}, "network sync base URL only applies"},
+		{"fork height on kurtosis", NetworkParams{ShadowForkBlockHeight: ShadowForkLatest}, "shadow fork block height only applies"},
+		{"fork height on public network", NetworkParams{Network: NetworkHoodi, ShadowForkBlockHeight: "100"}, "use hoodi-shadowfork"},
+		{"fork epoch on public network", NetworkParams{Network: NetworkMainnet, FuluForkEpoch: 5}, "follows the public fork schedule"},
+		{"wrong network ID", NetworkParams{Network: NetworkMainnet, NetworkID: "3151908"}, "has network ID 1"},
+		{"wrong deposit contract", NetworkParams{Network: NetworkHolesky, DepositContractAddress: DefaultDepositContractAddress}, "has deposit contract"},
+		{"minimal preset", NetworkParams{Network: ShadowFork(NetworkSepolia), Preset: SpecPresetMinimal}, "uses the mainnet preset"},
+		{"preloaded contracts", NetworkParams{Network: ShadowFork(NetworkHoodi), AdditionalPreloadedContracts: map[string]PreloadedAccount{"0x01": {Balance: "1"}}}, "preloaded contracts are not supported"},
+		{"bad sync base", NetworkParams{Network: NetworkHoodi, NetworkSyncBaseURL: "snapshots.ethpandaops.io"}, "must be an http(s) URL"},
+		{"bad fork height", NetworkParams{Network: ShadowFork(NetworkMainnet), ShadowForkBlockHeight: "head"}, `got "head"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			params.ApplyDefaults()
+			assert.ErrorContains(t, params.Validate(), tt.errMsg)
+		})
+	}
+}
+
+func TestPublicNetworkCheckpointSync(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants:          []ParticipantConfig{{ELType: "geth", CLType: "lighthouse"}},
+		NetworkParams:         &NetworkParams{Network: NetworkHoodi, NetworkSyncBaseURL: "https://snapshots.ethpandaops.io/", ForceSnapshotSync: true},
+		CheckpointSyncEnabled: true,
+	}
+	cfg.ApplyDefaults()
+	assert.Equal(t, "https://checkpoint-sync.hoodi.ethpandaops.io", cfg.CheckpointSyncURL)
+
+	out, err := yaml.Marshal(cfg.NetworkParams)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "network: hoodi\n")
+	assert.Contains(t, string(out), "network_sync_base_url: https://snapshots.ethpandaops.io/\n")
+	assert.Contains(t, string(out), "force_snapshot_sync: true\n")
+}
@@ -0,0 +1,254 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Severity distinguishes configuration issues that block a run from suspicious ones
+type Severity string
+
+const (
+	// SeverityWarning marks settings that are likely mistakes but still deploy
+	SeverityWarning Severity = "warning"
+	// SeverityError marks settings that would fail or misconfigure the deployment
+	SeverityError Severity = "error"
+)
+
+// Issue is a cross-field problem found in a participant configuration
+type Issue struct {
+	Participant int
+	Field       string
+	Severity    Severity
+	Message     string
+}
+
+// String formats the issue like participant validation errors
+func (i Issue) String() string {
+	return fmt.Sprintf("participant %d: %s: %s", i.Participant, i.Field, i.Message)
+}
+
+// Issues is a list of configuration issues
+type Issues []Issue
+
+// Errors returns the issues that block a run
+func (is Issues) Errors() Issues {
+	return is.bySeverity(SeverityError)
+}
+
+// Warnings returns the issues that don't block a run
+func (is Issues) Warnings() Issues {
+	return is.bySeverity(SeverityWarning)
+}
+
+func (is Issues) bySeverity(severity Severity) Issues {
+	var result Issues
+	for _, issue := range is {
+		if issue.Severity == severity {
+			result = append(result, issue)
+		}
+	}
+	return result
+}
+
+// Err joins the error issues into a single error, or returns nil if there are none
+func (is Issues) Err() error {
+	var errs []error
+	for _, issue := range is.Errors() {
+		errs = append(errs, errors.New(issue.String()))
+	}
+	return errors.Join(errs...)
+}
+
+// managedFlags are the command line flags ethereum-package sets itself for each
+// client layer. Overriding them through extra params breaks the wiring between
+// clients or conflicts with the generated genesis.
+var managedFlags = map[string][]string{
+	"el": {
+		"--datadir", "--data-dir", "--data-path",
+		"--networkid", "--network-id",
+		"--authrpc.jwtsecret", "--authrpc.port", "--authrpc.addr",
+		"--engine-jwt-secret", "--engine-rpc-port", "--engine-host-allowlist",
+		"--http.port", "--rpc-http-port",
+		"--port", "--p2p-port",
+		"--bootnodes",
+		"--override.genesis", "--genesis-file", "--chain",
+	},
+	"cl": {
+		"--datadir", "--data-dir", "--data-path",
+		"--execution-endpoint", "--execution-endpoints", "--ee-endpoint", "--el",
+		"--execution-jwt", "--jwt-secret", "--ee-jwt-secret-file",
+		"--testnet-dir", "--network", "--genesis-state",
+		"--http-port", "--rest-api-port", "--rest-port",
+		"--port", "--p2p-port", "--tcp-port", "--udp-port",
+		"--bootnodes", "--boot-nodes", "--p2p-discovery-bootnodes",
+	},
+	"vc": {
+		"--datadir", "--data-dir", "--data-path",
+		"--beacon-node", "--beacon-nodes", "--beacon-node-api-endpoint", "--beacon-rpc-provider",
+		"--validators-dir", "--secrets-dir", "--keystores", "--validator-keys",
+		"--testnet-dir", "--network",
+	},
+}
+
+// logLevelAliases maps common misspellings to the log level that was likely meant
+var logLevelAliases = map[string]string{
+	"warning":     "warn",
+	"err":         "error",
+	"information": "info",
+	"dbg":         "debug",
+	"critical":    "fatal",
+	"crit":        "fatal",
+}
+
+// Check returns the cross-field issues of the participant configuration: image
+// overrides without a client type, unsupported sync modes and fee recipients,
+// extra params that clash with flags ethereum-package manages, invalid sidecars
+// and invalid per-client log levels.
+func (p *ParticipantConfig) Check(index int) Issues {
+	var issues Issues
+	add := func(field string, severity Severity, format string, args ...interface{}) {
+		issues = append(issues, Issue{
+			Participant: index,
+			Field:       field,
+			Severity:    severity,
+			Message:     fmt.Sprintf(format, args...),
+		})
+	}
+
+	if p.ELImage != "" && p.ELType == "" {
+		add("el_image", SeverityError, "image %q is set but el_type is empty, set el_type to the client the image runs", p.ELImage)
+	}
+	if p.CLImage != "" && p.CLType == "" {
+		add("cl_image", SeverityError, "image %q is set but cl_type is empty, set cl_type to the client the image runs", p.CLImage)
+	}
+	if p.ELImage != "" && p.ELVersion != "" {
+		add("el_version", SeverityWarning, "version %q is ignored because el_image is set", p.ELVersion)
+	}
+	if p.CLImage != "" && p.CLVersion != "" {
+		add("cl_version", SeverityWarning, "version %q is ignored because cl_image is set", p.CLVersion)
+	}
+
+	if p.ELSyncMode != "" && p.ELType != "" {
+		if _, err := SyncModeFlags(p.ELType, p.ELSyncMode); err != nil {
+			add("el_sync_mode", SeverityError, "%v", err)
+		}
+	}
+
+	if p.FeeRecipient != "" {
+		if _, err := FeeRecipientFlag(p.CLType, p.FeeRecipient); err != nil {
+			add("fee_recipient", SeverityError, "%v", err)
+		}
+	}
+
+	for _, layer := range []struct {
+		prefix string
+		params []string
+	}{
+		{"el", p.ELExtraParams},
+		{"cl", p.CLExtraParams},
+		{"vc", p.VCExtraParams},
+	} {
+		field := layer.prefix + "_extra_params"
+		seen := make(map[string]bool)
+		for _, param := range layer.params {
+			flag := flagName(param)
+			switch {
+			case flag == "":
+				add(field, SeverityError, "empty parameter")
+				continue
+			case !strings.HasPrefix(flag, "-"):
+				add(field, SeverityWarning, "%q is not a flag and is passed to the client as a positional argument", param)
+				continue
+			case isManagedFlag(layer.prefix, flag):
+				add(field, SeverityError, "%s is managed by ethereum-package and cannot be overridden", flag)
+			case seen[flag]:
+				add(field, SeverityWarning, "%s is set more than once", flag)
+			}
+			seen[flag] = true
+		}
+	}
+
+	sidecars := make(map[string]bool, len(p.Sidecars))
+	for _, sidecar := range p.Sidecars {
+		if err := sidecar.Validate(); err != nil {
+			add("sidecars", SeverityError, "%v", err)
+			continue
+		}
+		if sidecars[sidecar.Name] {
+			add("sidecars", SeverityError, "sidecar %s is defined more than once", sidecar.Name)
+		}
+		sidecars[sidecar.Name] = true
+	}
+
+	for _, resource := range []struct {
+		prefix, unit string
+		min, max     int
+	}{
+		{"el", "cpu", p.ELMinCPU, p.ELMaxCPU},
+		{"el", "mem", p.ELMinMem, p.ELMaxMem},
+		{"cl", "cpu", p.CLMinCPU, p.CLMaxCPU},
+		{"cl", "mem", p.CLMinMem, p.CLMaxMem},
+	} {
+		minField := resource.prefix + "_min_" + resource.unit
+		maxField := resource.prefix + "_max_" + resource.unit
+		switch {
+		case resource.min < 0:
+			add(minField, SeverityError, "cannot be negative, got %d", resource.min)
+		case resource.max < 0:
+			add(maxField, SeverityError, "cannot be negative, got %d", resource.max)
+		case resource.max > 0 && resource.min > resource.max:
+			add(minField, SeverityError, "%d exceeds %s of %d", resource.min, maxField, resource.max)
+		}
+	}
+
+	for _, level := range []struct {
+		field string
+		value string
+	}{
+		{"el_log_level", p.ELLogLevel},
+		{"cl_log_level", p.CLLogLevel},
+		{"vc_log_level", p.VCLogLevel},
+	} {
+		if level.value == "" || isValidLogLevel(level.value) {
+			continue
+		}
+		if suggestion, ok := logLevelAliases[strings.ToLower(level.value)]; ok {
+			add(level.field, SeverityError, "invalid log level %q, did you mean %q?", level.value, suggestion)
+			continue
+		}
+		add(level.field, SeverityError, "invalid log level %q, must be one of: debug, info, warn, error, fatal", level.value)
+	}
+
+	return issues
+}
+
+// CheckParticipants returns the cross-field issues of every participant
+func (c *EthereumPackageConfig) CheckParticipants() Issues {
+	var issues Issues
+	for i := range c.Participants {
+		issues = append(issues, c.Participants[i].Check(i)...)
+	}
+	return issues
+}
+
+// flagName returns the flag of an extra parameter, e.g. "--foo" for "--foo=bar"
+func flagName(param string) string {
+	fields := strings.Fields(param)
+	if len(fields) == 0 {
+		return ""
+	}
+	name, _, _ := strings.Cut(fields[0], "=")
+	return name
+}
+
+// isManagedFlag reports whether ethereum-package sets the flag for the client layer
+func isManagedFlag(layer, flag string) bool {
+	for _, managed := range managedFlags[layer] {
+		if flag == managed {
+			return true
+		}
+	}
+	return false
+}
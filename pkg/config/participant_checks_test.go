@@ -0,0 +1,151 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParticipantConfigCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		participant ParticipantConfig
+		errors      []string
+		warnings    []string
+	}{
+		{
+			name: "valid overrides",
+			participant: ParticipantConfig{
+				ELType:        client.Geth,
+				CLType:        client.Lighthouse,
+				ELImage:       "ethereum/client-go:latest",
+				ELExtraParams: []string{"--syncmode=full", "--gcmode archive"},
+				CLLogLevel:    "DEBUG",
+			},
+		},
+		{
+			name:        "image without type",
+			participant: ParticipantConfig{CLType: client.Lighthouse, ELImage: "ghcr.io/paradigmxyz/reth"},
+			errors:      []string{`participant 0: el_image: image "ghcr.io/paradigmxyz/reth" is set but el_type is empty`},
+		},
+		{
+			name: "image overrides version",
+			participant: ParticipantConfig{
+				ELType: client.Geth, CLType: client.Teku,
+				CLImage: "consensys/teku:develop", CLVersion: "24.1.0",
+			},
+			warnings: []string{`participant 0: cl_version: version "24.1.0" is ignored because cl_image is set`},
+		},
+		{
+			name: "managed and duplicate flags",
+			participant: ParticipantConfig{
+				ELType: client.Geth, CLType: client.Lighthouse,
+				ELExtraParams: []string{"--networkid=1", "--cache=1024", "--cache=2048", "verbose"},
+				VCExtraParams: []string{"--beacon-nodes http://localhost:5052"},
+			},
+			errors: []string{
+				"participant 0: el_extra_params: --networkid is managed by ethereum-package",
+				"participant 0: vc_extra_params: --beacon-nodes is managed by ethereum-package",
+			},
+			warnings: []string{
+				"participant 0: el_extra_params: --cache is set more than once",
+				`participant 0: el_extra_params: "verbose" is not a flag`,
+			},
+		},
+		{
+			name: "log level typos",
+			participant: ParticipantConfig{
+				ELType: client.Geth, CLType: client.Lighthouse,
+				ELLogLevel: "warning", VCLogLevel: "loud",
+			},
+			errors: []string{
+				`participant 0: el_log_level: invalid log level "warning", did you mean "warn"?`,
+				`participant 0: vc_log_level: invalid log level "loud", must be one of`,
+			},
+		},
+		{
+			name: "resources",
+			participant: ParticipantConfig{
+				ELType: client.Geth, CLType: client.Lighthouse,
+				ELMinCPU: 500, ELMaxCPU: 2000, ELMaxMem: 4096,
+				CLMinMem: 8192, CLMaxMem: 4096, CLMaxCPU: -1,
+			},
+			errors: []string{
+				"participant 0: cl_max_cpu: cannot be negative, got -1",
+				"participant 0: cl_min_mem: 8192 exceeds cl_max_mem of 4096",
+			},
+		},
+		{
+			name:        "fee recipient with default cl type",
+			participant: ParticipantConfig{ELType: client.Geth, FeeRecipient: "0x00aa"},
+			errors:      []string{`participant 0: fee_recipient: invalid fee recipient "0x00aa"`},
+		},
+		{
+			name:        "valid fee recipient with default cl type",
+			participant: ParticipantConfig{ELType: client.Geth, FeeRecipient: "0x00000000000000000000000000000000000000aa"},
+		},
+		{
+			name: "invalid sidecars",
+			participant: ParticipantConfig{
+				ELType: client.Geth, CLType: client.Lighthouse,
+				Sidecars: []Sidecar{
+					{Name: "tcpdump", Image: "nicolaka/netshoot"},
+					{Name: "tcpdump", Image: "nicolaka/netshoot"},
+					{Name: "Engine-Proxy", Image: "proxy"},
+					{Name: "metrics", Image: "exporter", Ports: map[string]int{"http": 0}},
+				},
+			},
+			errors: []string{
+				"participant 0: sidecars: sidecar tcpdump is defined more than once",
+				`participant 0: sidecars: invalid sidecar name "Engine-Proxy"`,
+				`participant 0: sidecars: sidecar metrics: port "http" has invalid number 0`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := tt.participant.Check(0)
+			assertIssues(t, tt.errors, issues.Errors())
+			assertIssues(t, tt.warnings, issues.Warnings())
+		})
+	}
+}
+
+func assertIssues(t *testing.T, expected []string, issues Issues) {
+	t.Helper()
+	require.Len(t, issues, len(expected), "issues: %v", issues)
+	for i, prefix := range expected {
+		assert.Contains(t, issues[i].String(), prefix)
+	}
+}
+
+func TestParticipantConfigValidateRejectsCheckErrors(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants: []ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse},
+			{ELType: client.Besu, CLType: client.Teku, CLExtraParams: []string{"--data-dir=/tmp"}},
+		},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "participant 1: cl_extra_params: --data-dir is managed by ethereum-package")
+
+	// Warnings alone don't fail validation
+	cfg.Participants[1].CLExtraParams = []string{"--foo", "--foo"}
+	require.NoError(t, cfg.Validate())
+	assert.Len(t, cfg.CheckParticipants().Warnings(), 1)
+}
+
+func TestParticipantConfigImagesDistinguishDuplicates(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants: []ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse},
+			{ELType: client.Geth, CLType: client.Lighthouse, ELImage: "ethereum/client-go:unstable"},
+		},
+	}
+	assert.NoError(t, cfg.Validate())
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Public ports ethereum-package reserves per node or additional service from
+// each port publisher component's public_port_start
+const (
+	PublishedELPorts      = 5
+	PublishedCLPorts      = 5
+	PublishedVCPorts      = 3
+	PublishedServicePorts = 2
+)
+
+// maxPort is the highest TCP port
+const maxPort = 65535
+
+// PortRange is a range of host ports a port publisher component publishes on
+type PortRange struct {
+	Component string
+	Start     int
+	// End is exclusive
+	End int
+}
+
+// String formats the range, e.g. "el 32000-32009"
+func (r PortRange) String() string {
+	return fmt.Sprintf("%s %d-%d", r.Component, r.Start, r.End-1)
+}
+
+// PublicPortRanges returns the host ports each enabled port publisher component
+// publishes on, sized for the config's nodes and additional services
+func (c *EthereumPackageConfig) PublicPortRanges() []PortRange {
+	publisher := c.PortPublisher
+	if publisher == nil {
+		return nil
+	}
+	nodes := 0
+	for _, p := range c.Participants {
+		nodes += max(p.Count, 1)
+	}
+
+	var ranges []PortRange
+	for _, component := range []struct {
+		name  string
+		comp  *PortPublisherComponent
+		ports int
+	}{
+		{"el", publisher.EL, nodes * PublishedELPorts},
+		{"cl", publisher.CL, nodes * PublishedCLPorts},
+		{"vc", publisher.VC, nodes * PublishedVCPorts},
+		{"additional_services", publisher.AdditionalServices, len(c.AdditionalServices) * PublishedServicePorts},
+	} {
+		if component.comp != nil && component.comp.Enabled && component.ports > 0 {
+			start := component.comp.PublicPortStart
+			ranges = append(ranges, PortRange{Component: component.name, Start: start, End: start + component.ports})
+		}
+	}
+	return ranges
+}
+
+// checkPortRanges fails when a range runs past the highest port or into the
+// range of another component, i.e. its public_port_start leaves too few ports
+// for the participant count
+func checkPortRanges(ranges []PortRange) error {
+	sorted := append([]PortRange(nil), ranges...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+	for i, r := range sorted {
+		if r.End-1 > maxPort {
+			return fmt.Errorf("port publisher %s: needs ports %d-%d, past %d", r.Component, r.Start, r.End-1, maxPort)
+		}
+		if i+1 < len(sorted) && r.End > sorted[i+1].Start {
+			next := sorted[i+1]
+			return fmt.Errorf("port publisher %s: needs ports %d-%d, overlapping %s from %d; move public_port_start apart",
+				r.Component, r.Start, r.End-1, next.Component, next.Start)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicPortRanges(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants:       []ParticipantConfig{{Count: 2}, {}},
+		AdditionalServices: []AdditionalService{{Name: "dora"}},
+		PortPublisher: &PortPublisherConfig{
+			EL:                 &PortPublisherComponent{Enabled: true, PublicPortStart: 32000},
+			CL:                 &PortPublisherComponent{Enabled: false, PublicPortStart: 33000},
+			VC:                 &PortPublisherComponent{Enabled: true, PublicPortStart: 34000},
+			AdditionalServices: &PortPublisherComponent{Enabled: true, PublicPortStart: 35000},
+		},
+	}
+
+	ranges := cfg.PublicPortRanges()
+	require.Len(t, ranges, 3)
+	assert.Equal(t, PortRange{Component: "el", Start: 32000, End: 32015}, ranges[0])
+	assert.Equal(t, "vc 34000-34008", ranges[1].String())
+	assert.Equal(t, PortRange{Component: "additional_services", Start: 35000, End: 35002}, ranges[2])
+
+	assert.Empty(t, (&EthereumPackageConfig{}).PublicPortRanges())
+}
+
+func TestCheckPortRanges(t *testing.T) {
+	assert.NoError(t, checkPortRanges([]PortRange{{"el", 32000, 32010}, {"cl", 32010, 32020}}))
+
+	err := checkPortRanges([]PortRange{{"cl", 32005, 32015}, {"el", 32000, 32010}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "el: needs ports 32000-32009, overlapping cl from 32005")
+
+	err = checkPortRanges([]PortRange{{"el", 65530, 65540}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "past 65535")
+}
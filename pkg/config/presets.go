@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+
 	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 )
 
@@ -204,3 +206,92 @@ func (p *PresetBuilder) Build() (*EthereumPackageConfig, error) {
 
 	return p.config, nil
 }
+
+// Fast devnet tuning values
+const (
+	// FastDevnetSecondsPerSlot is the slot time used by the fast devnet tuning
+	FastDevnetSecondsPerSlot = 4
+	// FastDevnetGenesisDelay is the genesis delay in seconds used by the fast devnet tuning
+	FastDevnetGenesisDelay = 10
+	// FastDevnetValidatorKeysPerNode is the validator key count used by the fast devnet tuning
+	FastDevnetValidatorKeysPerNode = 32
+
+	// fastDevnetMaxSecondsPerSlot is the slowest slot time still considered fast
+	fastDevnetMaxSecondsPerSlot = 6
+	// fastDevnetMinSecondsPerSlot is the fastest slot time clients reliably keep up with
+	fastDevnetMinSecondsPerSlot = 2
+	// fastDevnetMaxGenesisDelay is the longest genesis delay still considered fast
+	fastDevnetMaxGenesisDelay = 60
+	// fastDevnetMaxValidatorsPerNode bounds validators per node so duties stay cheap
+	fastDevnetMaxValidatorsPerNode = 64
+	// fastDevnetMaxNodes bounds the node count so startup stays quick
+	fastDevnetMaxNodes = 4
+)
+
+// FastDevnetNetworkParams returns network parameters tuned for the fastest
+// possible feedback loop: the minimal spec preset's short epochs, short slots,
+// a short genesis delay and few validators
+func FastDevnetNetworkParams() *NetworkParams {
+	return &NetworkParams{
+		Preset:                  SpecPresetMinimal,
+		SecondsPerSlot:          FastDevnetSecondsPerSlot,
+		GenesisDelay:            FastDevnetGenesisDelay,
+		NumValidatorKeysPerNode: FastDevnetValidatorKeysPerNode,
+	}
+}
+
+// ValidateFastDevnet checks that a configuration is consistent with the fast
+// devnet tuning. It is stricter than Validate: the minimal spec preset is
+// required, and slot time, genesis delay, validator and node counts must all
+// stay small enough for quick startup.
+func ValidateFastDevnet(cfg *EthereumPackageConfig) error {
+	if cfg == nil {
+		return ErrNilConfig
+	}
+
+	params := cfg.NetworkParams
+	if params == nil {
+		return fmt.Errorf("fast devnet: network params are required")
+	}
+
+	if params.Preset != SpecPresetMinimal {
+		return fmt.Errorf("fast devnet: spec preset must be %s for short epochs, got %q", SpecPresetMinimal, params.Preset)
+	}
+
+	if params.SecondsPerSlot < fastDevnetMinSecondsPerSlot || params.SecondsPerSlot > fastDevnetMaxSecondsPerSlot {
+		return fmt.Errorf("fast devnet: seconds per slot must be between %d and %d, got %d",
+			fastDevnetMinSecondsPerSlot, fastDevnetMaxSecondsPerSlot, params.SecondsPerSlot)
+	}
+
+	if params.GenesisDelay < params.SecondsPerSlot {
+		return fmt.Errorf("fast devnet: genesis delay %ds must be at least one slot (%ds)",
+			params.GenesisDelay, params.SecondsPerSlot)
+	}
+	if params.GenesisDelay > fastDevnetMaxGenesisDelay {
+		return fmt.Errorf("fast devnet: genesis delay %ds exceeds maximum of %ds",
+			params.GenesisDelay, fastDevnetMaxGenesisDelay)
+	}
+
+	if params.NumValidatorKeysPerNode > fastDevnetMaxValidatorsPerNode {
+		return fmt.Errorf("fast devnet: %d validator keys per node exceeds maximum of %d",
+			params.NumValidatorKeysPerNode, fastDevnetMaxValidatorsPerNode)
+	}
+
+	nodes := 0
+	for i, p := range cfg.Participants {
+		if p.ValidatorCount > fastDevnetMaxValidatorsPerNode {
+			return fmt.Errorf("fast devnet: participant %d: validator count %d exceeds maximum of %d",
+				i, p.ValidatorCount, fastDevnetMaxValidatorsPerNode)
+		}
+		count := p.Count
+		if count == 0 {
+			count = 1
+		}
+		nodes += count
+	}
+	if nodes > fastDevnetMaxNodes {
+		return fmt.Errorf("fast devnet: %d nodes exceeds maximum of %d", nodes, fastDevnetMaxNodes)
+	}
+
+	return nil
+}
@@ -227,3 +227,69 @@ func TestPresetConsistency(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFastDevnet(t *testing.T) {
+	minimal := func() *EthereumPackageConfig {
+		cfg := getMinimalConfig()
+		cfg.NetworkParams = FastDevnetNetworkParams()
+		return cfg
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*EthereumPackageConfig)
+		wantErr string
+	}{
+		{
+			name:   "defaults are consistent",
+			modify: func(c *EthereumPackageConfig) {},
+		},
+		{
+			name:    "nil network params",
+			modify:  func(c *EthereumPackageConfig) { c.NetworkParams = nil },
+			wantErr: "network params are required",
+		},
+		{
+			name:    "mainnet preset",
+			modify:  func(c *EthereumPackageConfig) { c.NetworkParams.Preset = SpecPresetMainnet },
+			wantErr: `spec preset must be minimal for short epochs, got "mainnet"`,
+		},
+		{
+			name:    "slot time too long",
+			modify:  func(c *EthereumPackageConfig) { c.NetworkParams.SecondsPerSlot = 12 },
+			wantErr: "seconds per slot must be between 2 and 6",
+		},
+		{
+			name:    "genesis delay shorter than a slot",
+			modify:  func(c *EthereumPackageConfig) { c.NetworkParams.GenesisDelay = 2 },
+			wantErr: "must be at least one slot",
+		},
+		{
+			name:    "too many validators per node",
+			modify:  func(c *EthereumPackageConfig) { c.NetworkParams.NumValidatorKeysPerNode = 128 },
+			wantErr: "validator keys per node exceeds maximum",
+		},
+		{
+			name:    "too many nodes",
+			modify:  func(c *EthereumPackageConfig) { c.Participants[0].Count = 5 },
+			wantErr: "5 nodes exceeds maximum of 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := minimal()
+			tt.modify(cfg)
+
+			err := ValidateFastDevnet(cfg)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	assert.ErrorIs(t, ValidateFastDevnet(nil), ErrNilConfig)
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// Footprint is the approximate CPU, memory and disk use of one container
+type Footprint struct {
+	CPUMillicores int
+	MemoryMB      int
+	// DiskMB covers the image and the data a devnet writes in its first hours
+	DiskMB int
+}
+
+// ClientFootprints are the footprints of each client on a fresh devnet. Clients
+// on the JVM or .NET need more memory than the others.
+var ClientFootprints = map[client.Type]Footprint{
+	client.Geth:       {CPUMillicores: 1000, MemoryMB: 1024, DiskMB: 2048},
+	client.Besu:       {CPUMillicores: 1000, MemoryMB: 2048, DiskMB: 2048},
+	client.Nethermind: {CPUMillicores: 1000, MemoryMB: 2048, DiskMB: 2048},
+	client.Erigon:     {CPUMillicores: 1000, MemoryMB: 1536, DiskMB: 3072},
+	client.Reth:       {CPUMillicores: 1000, MemoryMB: 1024, DiskMB: 2048},
+	client.Lighthouse: {CPUMillicores: 1000, MemoryMB: 1024, DiskMB: 1024},
+	client.Teku:       {CPUMillicores: 1000, MemoryMB: 2048, DiskMB: 1024},
+	client.Prysm:      {CPUMillicores: 1000, MemoryMB: 1024, DiskMB: 1024},
+	client.Nimbus:     {CPUMillicores: 500, MemoryMB: 512, DiskMB: 1024},
+	client.Lodestar:   {CPUMillicores: 1000, MemoryMB: 1536, DiskMB: 1024},
+	client.Grandine:   {CPUMillicores: 1000, MemoryMB: 1024, DiskMB: 1024},
+}
+
+// Footprints of containers without a client entry
+var (
+	DefaultELFootprint         = Footprint{CPUMillicores: 1000, MemoryMB: 1536, DiskMB: 2048}
+	DefaultCLFootprint         = Footprint{CPUMillicores: 1000, MemoryMB: 1024, DiskMB: 1024}
+	ValidatorFootprint         = Footprint{CPUMillicores: 250, MemoryMB: 256, DiskMB: 256}
+	AdditionalServiceFootprint = Footprint{CPUMillicores: 500, MemoryMB: 512, DiskMB: 512}
+)
+
+// ResourceEstimate is the approximate total footprint of a config's containers
+type ResourceEstimate struct {
+	Nodes         int
+	Containers    int
+	CPUMillicores int
+	MemoryMB      int
+	DiskMB        int
+}
+
+// String summarizes the estimate, e.g. "3 containers: 2.2 CPUs, 2304 MB memory, 3328 MB disk"
+func (e ResourceEstimate) String() string {
+	return fmt.Sprintf("%d containers: %.1f CPUs, %d MB memory, %d MB disk",
+		e.Containers, float64(e.CPUMillicores)/1000, e.MemoryMB, e.DiskMB)
+}
+
+func (e *ResourceEstimate) add(f Footprint, count int) {
+	e.Containers += count
+	e.CPUMillicores += count * f.CPUMillicores
+	e.MemoryMB += count * f.MemoryMB
+	e.DiskMB += count * f.DiskMB
+}
+
+// EstimateResources adds up the approximate CPU, memory and disk the config's
+// nodes and additional services use, from ClientFootprints and each node
+// running an execution, consensus and validator client. A participant's
+// el_max_cpu, el_max_mem, cl_max_cpu and cl_max_mem replace the footprint's
+// CPU and memory. It is meant for capacity planning, not as a guarantee.
+func EstimateResources(cfg *EthereumPackageConfig) ResourceEstimate {
+	var estimate ResourceEstimate
+	for _, p := range cfg.Participants {
+		count := p.Count
+		if count < 1 {
+			count = 1
+		}
+		estimate.Nodes += count
+
+		el := footprint(p.ELType, DefaultELFootprint)
+		if p.ELMaxCPU > 0 {
+			el.CPUMillicores = p.ELMaxCPU
+		}
+		if p.ELMaxMem > 0 {
+			el.MemoryMB = p.ELMaxMem
+		}
+		cl := footprint(p.CLType, DefaultCLFootprint)
+		if p.CLMaxCPU > 0 {
+			cl.CPUMillicores = p.CLMaxCPU
+		}
+		if p.CLMaxMem > 0 {
+			cl.MemoryMB = p.CLMaxMem
+		}
+
+		estimate.add(el, count)
+		estimate.add(cl, count)
+		estimate.add(ValidatorFootprint, count)
+	}
+	estimate.add(AdditionalServiceFootprint, len(cfg.AdditionalServices))
+	return estimate
+}
+
+// footprint returns the client's footprint, or fallback for unlisted clients
+func footprint(clientType client.Type, fallback Footprint) Footprint {
+	if f, ok := ClientFootprints[clientType]; ok {
+		return f
+	}
+	return fallback
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateResources(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants: []ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse, Count: 2},
+			{ELType: client.Besu, CLType: client.Teku, ELMaxMem: 4096, CLMaxCPU: 2000},
+			{ELType: "ethrex", CLType: client.Nimbus},
+		},
+		AdditionalServices: []AdditionalService{{Name: "dora"}},
+	}
+
+	estimate := EstimateResources(cfg)
+	assert.Equal(t, 4, estimate.Nodes)
+	assert.Equal(t, 13, estimate.Containers)
+	// 2x geth+lighthouse, besu+teku with overrides, an unlisted EL with nimbus,
+	// a validator client per node and dora
+	assert.Equal(t, 2*(1000+1000)+(1000+2000)+(1000+500)+4*250+500, estimate.CPUMillicores)
+	assert.Equal(t, 2*(1024+1024)+(4096+2048)+(1536+512)+4*256+512, estimate.MemoryMB)
+	assert.Equal(t, 2*(2048+1024)+(2048+1024)+(2048+1024)+4*256+512, estimate.DiskMB)
+	assert.Equal(t, "13 containers: 10.0 CPUs, 13824 MB memory, 13824 MB disk", estimate.String())
+}
+
+func TestEstimateResourcesEmpty(t *testing.T) {
+	assert.Equal(t, ResourceEstimate{}, EstimateResources(&EthereumPackageConfig{}))
+}
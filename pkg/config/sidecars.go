@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// Sidecar is an extra container deployed next to each of a participant's nodes,
+// such as a packet capture or an API proxy. Its service is named after the
+// sidecar and the node, e.g. "sidecar-tcpdump-1-geth-lighthouse".
+type Sidecar struct {
+	// Name identifies the sidecar on its node: lowercase letters and digits
+	Name  string
+	Image string
+	// Ports are the container ports to expose, by name
+	Ports map[string]int
+	// Env, Entrypoint and Cmd may refer to the node through the placeholders
+	// {node}, {el_host}, {cl_host}, {el_rpc} and {cl_beacon}
+	Env        map[string]string
+	Entrypoint []string
+	Cmd        []string
+}
+
+// sidecarNamePattern keeps sidecar names unambiguous inside service names
+var sidecarNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// Validate checks that the sidecar can be deployed
+func (s Sidecar) Validate() error {
+	if !sidecarNamePattern.MatchString(s.Name) {
+		return fmt.Errorf("invalid sidecar name %q, must be lowercase letters and digits", s.Name)
+	}
+	if s.Image == "" {
+		return fmt.Errorf("sidecar %s: image is required", s.Name)
+	}
+	for _, name := range sortedPortNames(s.Ports) {
+		if number := s.Ports[name]; number < 1 || number > 65535 {
+			return fmt.Errorf("sidecar %s: port %q has invalid number %d", s.Name, name, number)
+		}
+	}
+	return nil
+}
+
+// NodeSidecars returns the sidecars of every node keyed by 1-based node index
+func (c *EthereumPackageConfig) NodeSidecars() map[int][]Sidecar {
+	sidecars := make(map[int][]Sidecar)
+	index := 1
+	for _, p := range c.Participants {
+		for i := 0; i < nodeCount(p); i++ {
+			if len(p.Sidecars) > 0 {
+				sidecars[index] = p.Sidecars
+			}
+			index++
+		}
+	}
+	return sidecars
+}
+
+// sortedPortNames returns the port names in order
+func sortedPortNames(ports map[string]int) []string {
+	names := make([]string, 0, len(ports))
+	for name := range ports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
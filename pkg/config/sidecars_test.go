@@ -0,0 +1,38 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeSidecars(t *testing.T) {
+	tcpdump := Sidecar{Name: "tcpdump", Image: "nicolaka/netshoot", Cmd: []string{"tcpdump", "-i", "any"}}
+	cfg, err := NewConfigBuilder().
+		WithParticipant(NewParticipantBuilder().WithEL(client.Geth).WithCL(client.Lighthouse).WithCount(2).WithSidecar(tcpdump).Build()).
+		WithParticipant(NewParticipantBuilder().WithEL(client.Besu).WithCL(client.Teku).Build()).
+		Build()
+	require.NoError(t, err)
+
+	sidecars := cfg.NodeSidecars()
+	assert.Equal(t, map[int][]Sidecar{1: {tcpdump}, 2: {tcpdump}}, sidecars)
+}
+
+func TestSidecarsAreNotPassedToEthereumPackage(t *testing.T) {
+	cfg, err := NewConfigBuilder().
+		WithParticipant(NewParticipantBuilder().
+			WithEL(client.Geth).
+			WithCL(client.Lighthouse).
+			WithSnooper().
+			WithSidecar(Sidecar{Name: "tcpdump", Image: "nicolaka/netshoot"}).
+			Build()).
+		Build()
+	require.NoError(t, err)
+
+	yamlConfig, err := ToYAML(cfg)
+	require.NoError(t, err)
+	assert.Contains(t, yamlConfig, "snooper_enabled: true")
+	assert.NotContains(t, yamlConfig, "tcpdump")
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// SyncMode is how an execution client syncs and how much state it keeps
+type SyncMode string
+
+const (
+	// SyncModeSnap downloads a recent state snapshot and keeps recent state only
+	SyncModeSnap SyncMode = "snap"
+	// SyncModeFull executes every block and prunes old state
+	SyncModeFull SyncMode = "full"
+	// SyncModeArchive executes every block and keeps the state of every block
+	SyncModeArchive SyncMode = "archive"
+)
+
+// syncModeFlags are the execution client flags selecting each sync mode. A
+// missing mode is not supported by the client; an empty list is its default.
+var syncModeFlags = map[client.Type]map[SyncMode][]string{
+	client.Geth: {
+		SyncModeSnap:    {"--syncmode=snap"},
+		SyncModeFull:    {"--syncmode=full"},
+		SyncModeArchive: {"--syncmode=full", "--gcmode=archive"},
+	},
+	client.Besu: {
+		SyncModeSnap:    {"--sync-mode=SNAP"},
+		SyncModeFull:    {"--sync-mode=FULL"},
+		SyncModeArchive: {"--sync-mode=FULL", "--data-storage-format=FOREST"},
+	},
+	client.Nethermind: {
+		SyncModeSnap:    {"--Sync.SnapSync=true"},
+		SyncModeFull:    {"--Sync.FastSync=false", "--Sync.SnapSync=false"},
+		SyncModeArchive: {"--Sync.FastSync=false", "--Sync.SnapSync=false", "--Pruning.Mode=None"},
+	},
+	client.Erigon: {
+		SyncModeFull:    {"--prune.mode=full"},
+		SyncModeArchive: {"--prune.mode=archive"},
+	},
+	client.Reth: {
+		SyncModeFull:    {"--full"},
+		SyncModeArchive: {},
+	},
+}
+
+// SyncModeFlags returns the command line flags selecting the sync mode on the
+// execution client, or an error if the client does not support it
+func SyncModeFlags(elType client.Type, mode SyncMode) ([]string, error) {
+	modes, ok := syncModeFlags[elType]
+	if !ok {
+		return nil, fmt.Errorf("sync mode is not supported for %s", elType)
+	}
+	flags, ok := modes[mode]
+	if !ok {
+		return nil, fmt.Errorf("%s does not support sync mode %q", elType, mode)
+	}
+	return flags, nil
+}
+
+// MarshalYAML adds the flags of the typed client settings to the participant's
+// extra params, as ethereum-package has no fields for them. Teku and Nimbus run
+// their validators in the beacon node unless a separate validator client is
+// used, so the fee recipient goes to both.
+func (p ParticipantConfig) MarshalYAML() (interface{}, error) {
+	type plain ParticipantConfig
+	out := plain(p)
+	if p.ELSyncMode != "" {
+		flags, err := SyncModeFlags(p.ELType, p.ELSyncMode)
+		if err != nil {
+			return nil, err
+		}
+		out.ELExtraParams = append(append([]string(nil), flags...), p.ELExtraParams...)
+	}
+	if p.FeeRecipient != "" {
+		flag, err := FeeRecipientFlag(p.CLType, p.FeeRecipient)
+		if err != nil {
+			return nil, err
+		}
+		out.VCExtraParams = append([]string{flag}, p.VCExtraParams...)
+		if p.CLType == client.Teku || p.CLType == client.Nimbus {
+			out.CLExtraParams = append([]string{flag}, p.CLExtraParams...)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncModeFlags(t *testing.T) {
+	flags, err := SyncModeFlags(client.Geth, SyncModeArchive)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--syncmode=full", "--gcmode=archive"}, flags)
+
+	flags, err = SyncModeFlags(client.Reth, SyncModeArchive)
+	require.NoError(t, err)
+	assert.Empty(t, flags)
+
+	_, err = SyncModeFlags(client.Erigon, SyncModeSnap)
+	assert.EqualError(t, err, `erigon does not support sync mode "snap"`)
+	_, err = SyncModeFlags(client.Lighthouse, SyncModeFull)
+	assert.Error(t, err)
+}
+
+func TestParticipantConfigSyncMode(t *testing.T) {
+	participant := NewParticipantBuilder().
+		WithEL(client.Nethermind).
+		WithCL(client.Teku).
+		WithELSyncMode(SyncModeFull).
+		WithELExtraParams("--log=DEBUG").
+		Build()
+	assert.Empty(t, participant.Check(0))
+
+	yamlStr, err := ToYAML(&EthereumPackageConfig{Participants: []ParticipantConfig{participant}})
+	require.NoError(t, err)
+	assert.Contains(t, yamlStr, "el_extra_params:\n        - --Sync.FastSync=false\n        - --Sync.SnapSync=false\n        - --log=DEBUG")
+	assert.NotContains(t, yamlStr, "sync_mode")
+
+	// The typed setting does not leak into the participant's own extra params
+	assert.Equal(t, []string{"--log=DEBUG"}, participant.ELExtraParams)
+
+	participant.ELType = client.Reth
+	participant.ELSyncMode = SyncModeSnap
+	assert.Equal(t, `participant 0: el_sync_mode: reth does not support sync mode "snap"`, participant.Check(0).Err().Error())
+}
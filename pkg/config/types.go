@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/ethpandaops/ethereum-package-go/pkg/client"
@@ -29,6 +30,43 @@ const (
 	PresetMinimal Preset = "minimal"
 )
 
+// SpecPreset represents the consensus spec preset used by ethereum-package
+type SpecPreset string
+
+const (
+	// SpecPresetMainnet uses the mainnet consensus spec (32 slots per epoch)
+	SpecPresetMainnet SpecPreset = "mainnet"
+	// SpecPresetMinimal uses the minimal consensus spec (8 slots per epoch)
+	SpecPresetMinimal SpecPreset = "minimal"
+)
+
+// IsValid returns true if the spec preset is known or empty (package default)
+func (s SpecPreset) IsValid() bool {
+	switch s {
+	case "", SpecPresetMainnet, SpecPresetMinimal:
+		return true
+	default:
+		return false
+	}
+}
+
+// SlotsPerEpoch returns the number of slots per epoch for the spec preset.
+// An empty preset resolves to mainnet, matching ethereum-package's default.
+func (s SpecPreset) SlotsPerEpoch() uint64 {
+	if s == SpecPresetMinimal {
+		return 8
+	}
+	return 32
+}
+
+// SyncCommitteeSize returns the sync committee size for the spec preset
+func (s SpecPreset) SyncCommitteeSize() uint64 {
+	if s == SpecPresetMinimal {
+		return 32
+	}
+	return 512
+}
+
 // ParticipantConfig represents configuration for a network participant
 type ParticipantConfig struct {
 	// Client names
@@ -39,15 +77,99 @@ type ParticipantConfig struct {
 	ELVersion string `yaml:"el_version,omitempty"`
 	CLVersion string `yaml:"cl_version,omitempty"`
 
+	// Image overrides
+	ELImage string `yaml:"el_image,omitempty"`
+	CLImage string `yaml:"cl_image,omitempty"`
+	VCImage string `yaml:"vc_image,omitempty"`
+
+	// Extra command line flags passed to the clients
+	ELExtraParams []string `yaml:"el_extra_params,omitempty"`
+	CLExtraParams []string `yaml:"cl_extra_params,omitempty"`
+	VCExtraParams []string `yaml:"vc_extra_params,omitempty"`
+
+	// ELSyncMode selects the execution client's sync mode through its command
+	// line flags. Empty keeps ethereum-package's default.
+	ELSyncMode SyncMode `yaml:"-"`
+
+	// Per-client log levels, overriding the global log level
+	ELLogLevel string `yaml:"el_log_level,omitempty"`
+	CLLogLevel string `yaml:"cl_log_level,omitempty"`
+	VCLogLevel string `yaml:"vc_log_level,omitempty"`
+
+	// Resource requests and limits of the clients' containers, in millicores
+	// and megabytes as ethereum-package takes them. Zero keeps its defaults.
+	ELMinCPU int `yaml:"el_min_cpu,omitempty"`
+	ELMaxCPU int `yaml:"el_max_cpu,omitempty"`
+	ELMinMem int `yaml:"el_min_mem,omitempty"`
+	ELMaxMem int `yaml:"el_max_mem,omitempty"`
+	CLMinCPU int `yaml:"cl_min_cpu,omitempty"`
+	CLMaxCPU int `yaml:"cl_max_cpu,omitempty"`
+	CLMinMem int `yaml:"cl_min_mem,omitempty"`
+	CLMaxMem int `yaml:"cl_max_mem,omitempty"`
+
 	// Node count
 	Count int `yaml:"count,omitempty"`
 
 	// Validator configuration
 	ValidatorCount int `yaml:"validator_count,omitempty"`
+
+	// Tags label the participant's nodes for filtering at runtime (e.g. "bootnode").
+	// They are not passed to ethereum-package.
+	Tags []string `yaml:"-"`
+
+	// LateJoin keeps the participant's nodes stopped after deployment until
+	// Network.StartLateJoiners is called. Implies the LateJoinerTag tag.
+	LateJoin bool `yaml:"-"`
+
+	// SnooperEnabled has ethereum-package proxy the participant's engine and
+	// beacon API traffic through snooper services that log every request
+	SnooperEnabled bool `yaml:"snooper_enabled,omitempty"`
+
+	// KeymanagerEnabled exposes the keymanager API of the participant's
+	// validator clients, read with Network.Keymanager
+	KeymanagerEnabled bool `yaml:"keymanager_enabled,omitempty"`
+
+	// EthereumMetricsExporterEnabled deploys an ethereum-metrics-exporter next to
+	// each of the participant's nodes. Nil follows the network-wide setting.
+	EthereumMetricsExporterEnabled *bool `yaml:"ethereum_metrics_exporter_enabled,omitempty"`
+
+	// CheckpointSyncEnabled has the participant's beacon nodes sync from the
+	// checkpoint sync URL instead of genesis. Nil follows the network-wide setting.
+	CheckpointSyncEnabled *bool `yaml:"checkpoint_sync_enabled,omitempty"`
+
+	// FeeRecipient is the address the participant's validators' proposals pay,
+	// set through validator flags. Empty follows the network-wide setting.
+	FeeRecipient string `yaml:"-"`
+
+	// Sidecars are deployed next to each of the participant's nodes after
+	// discovery. They are not passed to ethereum-package.
+	Sidecars []Sidecar `yaml:"-"`
+}
+
+// LateJoinerTag is the tag carried by nodes of late-joining participants
+const LateJoinerTag = "late-joiner"
+
+// IsLateJoiner reports whether the participant's nodes start after genesis
+func (p *ParticipantConfig) IsLateJoiner() bool {
+	return p.LateJoin || containsTag(p.Tags, LateJoinerTag)
+}
+
+// containsTag reports whether tags includes tag
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
 }
 
 // Validate validates the participant configuration
 func (p *ParticipantConfig) Validate(index int) error {
+	if err := p.Check(index).Err(); err != nil {
+		return err
+	}
+
 	if p.ELType == "" {
 		return fmt.Errorf("participant %d: execution layer type is required", index)
 	}
@@ -77,6 +199,12 @@ func (p *ParticipantConfig) Validate(index int) error {
 		return fmt.Errorf("participant %d: validator count cannot exceed 1000000", index)
 	}
 
+	for _, tag := range p.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return fmt.Errorf("participant %d: tags cannot be empty", index)
+		}
+	}
+
 	return nil
 }
 
@@ -89,20 +217,179 @@ func (p *ParticipantConfig) ApplyDefaults() {
 
 // NetworkParams represents network-wide parameters
 type NetworkParams struct {
-	Network                     string `yaml:"network,omitempty"`
-	NetworkID                   string `yaml:"network_id,omitempty"`
-	DepositContractAddress      string `yaml:"deposit_contract_address,omitempty"`
-	SecondsPerSlot              int    `yaml:"seconds_per_slot,omitempty"`
-	NumValidatorKeysPerNode     int    `yaml:"num_validator_keys_per_node,omitempty"`
-	PreregisteredValidatorCount int    `yaml:"preregistered_validator_count,omitempty"`
-	GenesisDelay                int    `yaml:"genesis_delay,omitempty"`
-	GenesisGasLimit             uint64 `yaml:"genesis_gaslimit,omitempty"`
-	AltairForkEpoch             int    `yaml:"altair_fork_epoch,omitempty"`
-	BellatrixForkEpoch          int    `yaml:"bellatrix_fork_epoch,omitempty"`
-	CapellaForkEpoch            int    `yaml:"capella_fork_epoch,omitempty"`
-	DenebForkEpoch              int    `yaml:"deneb_fork_epoch,omitempty"`
-	ElectraForkEpoch            int    `yaml:"electra_fork_epoch,omitempty"`
-	FuluForkEpoch               int    `yaml:"fulu_fork_epoch,omitempty"`
+	// Network is NetworkKurtosis, a public network such as NetworkHoodi, or a
+	// shadow fork of one, see ShadowFork
+	Network                     string     `yaml:"network,omitempty"`
+	NetworkID                   string     `yaml:"network_id,omitempty"`
+	Preset                      SpecPreset `yaml:"preset,omitempty"`
+	DepositContractAddress      string     `yaml:"deposit_contract_address,omitempty"`
+	SecondsPerSlot              int        `yaml:"seconds_per_slot,omitempty"`
+	NumValidatorKeysPerNode     int        `yaml:"num_validator_keys_per_node,omitempty"`
+	PreregisteredValidatorCount int        `yaml:"preregistered_validator_count,omitempty"`
+	GenesisDelay                int        `yaml:"genesis_delay,omitempty"`
+	GenesisGasLimit             uint64     `yaml:"genesis_gaslimit,omitempty"`
+	AltairForkEpoch             int        `yaml:"altair_fork_epoch,omitempty"`
+	BellatrixForkEpoch          int        `yaml:"bellatrix_fork_epoch,omitempty"`
+	CapellaForkEpoch            int        `yaml:"capella_fork_epoch,omitempty"`
+	DenebForkEpoch              int        `yaml:"deneb_fork_epoch,omitempty"`
+	ElectraForkEpoch            int        `yaml:"electra_fork_epoch,omitempty"`
+	FuluForkEpoch               int        `yaml:"fulu_fork_epoch,omitempty"`
+	GloasForkEpoch              int        `yaml:"gloas_fork_epoch,omitempty"`
+
+	// EIP activation epochs for features tested ahead of a named fork
+	EIP7732ForkEpoch int `yaml:"eip7732_fork_epoch,omitempty"`
+	EIP7805ForkEpoch int `yaml:"eip7805_fork_epoch,omitempty"`
+
+	// Electra blob parameters
+	TargetBlobsPerBlockElectra    int `yaml:"target_blobs_per_block_electra,omitempty"`
+	MaxBlobsPerBlockElectra       int `yaml:"max_blobs_per_block_electra,omitempty"`
+	BaseFeeUpdateFractionElectra  int `yaml:"base_fee_update_fraction_electra,omitempty"`
+	MaxRequestBlobSidecarsElectra int `yaml:"max_request_blob_sidecars_electra,omitempty"`
+
+	// Fulu (PeerDAS) parameters
+	DataColumnSidecarSubnetCount int `yaml:"data_column_sidecar_subnet_count,omitempty"`
+	SamplesPerSlot               int `yaml:"samples_per_slot,omitempty"`
+	CustodyRequirement           int `yaml:"custody_requirement,omitempty"`
+
+	// Blob parameter only (BPO) fork schedule, see SetBPOFork and BPOSchedule
+	BPO1Epoch       int `yaml:"bpo_1_epoch,omitempty"`
+	BPO1MaxBlobs    int `yaml:"bpo_1_max_blobs,omitempty"`
+	BPO1TargetBlobs int `yaml:"bpo_1_target_blobs,omitempty"`
+	BPO2Epoch       int `yaml:"bpo_2_epoch,omitempty"`
+	BPO2MaxBlobs    int `yaml:"bpo_2_max_blobs,omitempty"`
+	BPO2TargetBlobs int `yaml:"bpo_2_target_blobs,omitempty"`
+	BPO3Epoch       int `yaml:"bpo_3_epoch,omitempty"`
+	BPO3MaxBlobs    int `yaml:"bpo_3_max_blobs,omitempty"`
+	BPO3TargetBlobs int `yaml:"bpo_3_target_blobs,omitempty"`
+	BPO4Epoch       int `yaml:"bpo_4_epoch,omitempty"`
+	BPO4MaxBlobs    int `yaml:"bpo_4_max_blobs,omitempty"`
+	BPO4TargetBlobs int `yaml:"bpo_4_target_blobs,omitempty"`
+	BPO5Epoch       int `yaml:"bpo_5_epoch,omitempty"`
+	BPO5MaxBlobs    int `yaml:"bpo_5_max_blobs,omitempty"`
+	BPO5TargetBlobs int `yaml:"bpo_5_target_blobs,omitempty"`
+
+	// Accounts added to the genesis allocation, keyed by address
+	AdditionalPreloadedContracts map[string]PreloadedAccount `yaml:"additional_preloaded_contracts,omitempty"`
+
+	// Public networks and shadow forks: where nodes download the chain data
+	// snapshots from, whether to use them even when a node could sync itself,
+	// and the block a shadow fork forks off, ShadowForkLatest or a number
+	NetworkSyncBaseURL    string `yaml:"network_sync_base_url,omitempty"`
+	ForceSnapshotSync     bool   `yaml:"force_snapshot_sync,omitempty"`
+	ShadowForkBlockHeight string `yaml:"shadowfork_block_height,omitempty"`
+}
+
+// DefaultDepositContractAddress is the deposit contract address used unless
+// the network parameters set another one
+const DefaultDepositContractAddress = "0x00000000219ab540356cBB839Cbe05303d7705Fa"
+
+// PreloadedAccount is a genesis allocation entry. Balance is in wei.
+type PreloadedAccount struct {
+	Balance string            `yaml:"balance" json:"balance"`
+	Nonce   uint64            `yaml:"nonce,omitempty" json:"nonce,omitempty"`
+	Code    string            `yaml:"code,omitempty" json:"code,omitempty"`
+	Storage map[string]string `yaml:"storage,omitempty" json:"storage,omitempty"`
+}
+
+// MaxBPOForks is the number of blob parameter only forks supported by ethereum-package
+const MaxBPOForks = 5
+
+// BPOFork describes a blob parameter only fork
+type BPOFork struct {
+	Epoch       int
+	MaxBlobs    int
+	TargetBlobs int
+}
+
+// bpoFields returns pointers to the fields backing the BPO fork at index (1-based)
+func (n *NetworkParams) bpoFields(index int) (epoch, maxBlobs, targetBlobs *int) {
+	switch index {
+	case 1:
+		return &n.BPO1Epoch, &n.BPO1MaxBlobs, &n.BPO1TargetBlobs
+	case 2:
+		return &n.BPO2Epoch, &n.BPO2MaxBlobs, &n.BPO2TargetBlobs
+	case 3:
+		return &n.BPO3Epoch, &n.BPO3MaxBlobs, &n.BPO3TargetBlobs
+	case 4:
+		return &n.BPO4Epoch, &n.BPO4MaxBlobs, &n.BPO4TargetBlobs
+	case 5:
+		return &n.BPO5Epoch, &n.BPO5MaxBlobs, &n.BPO5TargetBlobs
+	default:
+		return nil, nil, nil
+	}
+}
+
+// SetBPOFork sets the blob parameter only fork at index (1-based)
+func (n *NetworkParams) SetBPOFork(index int, fork BPOFork) error {
+	epoch, maxBlobs, targetBlobs := n.bpoFields(index)
+	if epoch == nil {
+		return fmt.Errorf("BPO fork index must be between 1 and %d, got %d", MaxBPOForks, index)
+	}
+
+	*epoch = fork.Epoch
+	*maxBlobs = fork.MaxBlobs
+	*targetBlobs = fork.TargetBlobs
+
+	return nil
+}
+
+// BPOSchedule returns the configured blob parameter only forks in index order.
+// Forks with no parameters set are skipped.
+func (n *NetworkParams) BPOSchedule() []BPOFork {
+	var schedule []BPOFork
+	for i := 1; i <= MaxBPOForks; i++ {
+		epoch, maxBlobs, targetBlobs := n.bpoFields(i)
+		if *epoch == 0 && *maxBlobs == 0 && *targetBlobs == 0 {
+			continue
+		}
+		schedule = append(schedule, BPOFork{Epoch: *epoch, MaxBlobs: *maxBlobs, TargetBlobs: *targetBlobs})
+	}
+	return schedule
+}
+
+// validateBlobParams validates Electra blob parameters and the BPO schedule
+func (n *NetworkParams) validateBlobParams() error {
+	if n.TargetBlobsPerBlockElectra < 0 || n.MaxBlobsPerBlockElectra < 0 {
+		return fmt.Errorf("electra blob counts cannot be negative")
+	}
+	if n.MaxBlobsPerBlockElectra > 0 && n.TargetBlobsPerBlockElectra > n.MaxBlobsPerBlockElectra {
+		return fmt.Errorf("target blobs per block electra (%d) cannot exceed max blobs per block electra (%d)",
+			n.TargetBlobsPerBlockElectra, n.MaxBlobsPerBlockElectra)
+	}
+
+	if n.DataColumnSidecarSubnetCount < 0 || n.SamplesPerSlot < 0 || n.CustodyRequirement < 0 {
+		return fmt.Errorf("fulu data availability parameters cannot be negative")
+	}
+	if n.DataColumnSidecarSubnetCount > 0 && n.CustodyRequirement > n.DataColumnSidecarSubnetCount {
+		return fmt.Errorf("custody requirement (%d) cannot exceed data column sidecar subnet count (%d)",
+			n.CustodyRequirement, n.DataColumnSidecarSubnetCount)
+	}
+
+	lastEpoch := -1
+	for i := 1; i <= MaxBPOForks; i++ {
+		epoch, maxBlobs, targetBlobs := n.bpoFields(i)
+		if *epoch == 0 && *maxBlobs == 0 && *targetBlobs == 0 {
+			continue
+		}
+		if *epoch < 0 || *maxBlobs < 0 || *targetBlobs < 0 {
+			return fmt.Errorf("bpo %d: values cannot be negative", i)
+		}
+		if *maxBlobs == 0 {
+			return fmt.Errorf("bpo %d: max blobs is required", i)
+		}
+		if *targetBlobs > *maxBlobs {
+			return fmt.Errorf("bpo %d: target blobs (%d) cannot exceed max blobs (%d)", i, *targetBlobs, *maxBlobs)
+		}
+		if *epoch < n.FuluForkEpoch {
+			return fmt.Errorf("bpo %d: epoch %d is before the fulu fork epoch %d", i, *epoch, n.FuluForkEpoch)
+		}
+		if *epoch <= lastEpoch {
+			return fmt.Errorf("bpo %d: epochs must be strictly increasing", i)
+		}
+		lastEpoch = *epoch
+	}
+
+	return nil
 }
 
 // Validate validates the network parameters
@@ -119,37 +406,133 @@ func (n *NetworkParams) Validate() error {
 		return fmt.Errorf("genesis delay cannot be negative")
 	}
 
+	if !n.Preset.IsValid() {
+		return fmt.Errorf("invalid preset: %s, must be one of: mainnet, minimal", n.Preset)
+	}
+
+	if err := n.validateNetwork(); err != nil {
+		return err
+	}
+
 	// Validate fork epochs ordering
 	if n.AltairForkEpoch < 0 || n.BellatrixForkEpoch < 0 || n.CapellaForkEpoch < 0 ||
-		n.DenebForkEpoch < 0 || n.ElectraForkEpoch < 0 || n.FuluForkEpoch < 0 {
+		n.DenebForkEpoch < 0 || n.ElectraForkEpoch < 0 || n.FuluForkEpoch < 0 ||
+		n.GloasForkEpoch < 0 || n.EIP7732ForkEpoch < 0 || n.EIP7805ForkEpoch < 0 {
 		return fmt.Errorf("fork epochs cannot be negative")
 	}
 
 	// Fork epochs should be in order
 	forkEpochs := []int{n.AltairForkEpoch, n.BellatrixForkEpoch, n.CapellaForkEpoch,
-		n.DenebForkEpoch, n.ElectraForkEpoch, n.FuluForkEpoch}
+		n.DenebForkEpoch, n.ElectraForkEpoch, n.FuluForkEpoch, n.GloasForkEpoch}
 	for i := 1; i < len(forkEpochs); i++ {
 		if forkEpochs[i] != 0 && forkEpochs[i] < forkEpochs[i-1] {
 			return fmt.Errorf("fork epochs must be in chronological order")
 		}
 	}
 
-	return nil
+	return n.validateBlobParams()
+}
+
+// SlotsPerEpoch returns the number of slots per epoch implied by the spec preset
+func (n *NetworkParams) SlotsPerEpoch() uint64 {
+	return n.Preset.SlotsPerEpoch()
+}
+
+// EpochDuration returns the duration of an epoch in seconds
+func (n *NetworkParams) EpochDuration() int {
+	return n.SecondsPerSlot * int(n.SlotsPerEpoch())
+}
+
+// Merge overlays the fields that are set in other onto the parameters.
+// Preloaded accounts are added to the existing ones rather than replacing them.
+func (n *NetworkParams) Merge(other *NetworkParams) {
+	if other == nil {
+		return
+	}
+	mergeField(&n.Network, other.Network)
+	mergeField(&n.NetworkID, other.NetworkID)
+	mergeField(&n.Preset, other.Preset)
+	mergeField(&n.DepositContractAddress, other.DepositContractAddress)
+	mergeField(&n.SecondsPerSlot, other.SecondsPerSlot)
+	mergeField(&n.NumValidatorKeysPerNode, other.NumValidatorKeysPerNode)
+	mergeField(&n.PreregisteredValidatorCount, other.PreregisteredValidatorCount)
+	mergeField(&n.GenesisDelay, other.GenesisDelay)
+	mergeField(&n.GenesisGasLimit, other.GenesisGasLimit)
+	mergeField(&n.AltairForkEpoch, other.AltairForkEpoch)
+	mergeField(&n.BellatrixForkEpoch, other.BellatrixForkEpoch)
+	mergeField(&n.CapellaForkEpoch, other.CapellaForkEpoch)
+	mergeField(&n.DenebForkEpoch, other.DenebForkEpoch)
+	mergeField(&n.ElectraForkEpoch, other.ElectraForkEpoch)
+	mergeField(&n.FuluForkEpoch, other.FuluForkEpoch)
+	mergeField(&n.GloasForkEpoch, other.GloasForkEpoch)
+	mergeField(&n.EIP7732ForkEpoch, other.EIP7732ForkEpoch)
+	mergeField(&n.EIP7805ForkEpoch, other.EIP7805ForkEpoch)
+	mergeField(&n.TargetBlobsPerBlockElectra, other.TargetBlobsPerBlockElectra)
+	mergeField(&n.MaxBlobsPerBlockElectra, other.MaxBlobsPerBlockElectra)
+	mergeField(&n.BaseFeeUpdateFractionElectra, other.BaseFeeUpdateFractionElectra)
+	mergeField(&n.MaxRequestBlobSidecarsElectra, other.MaxRequestBlobSidecarsElectra)
+	mergeField(&n.DataColumnSidecarSubnetCount, other.DataColumnSidecarSubnetCount)
+	mergeField(&n.SamplesPerSlot, other.SamplesPerSlot)
+	mergeField(&n.CustodyRequirement, other.CustodyRequirement)
+	mergeField(&n.BPO1Epoch, other.BPO1Epoch)
+	mergeField(&n.BPO1MaxBlobs, other.BPO1MaxBlobs)
+	mergeField(&n.BPO1TargetBlobs, other.BPO1TargetBlobs)
+	mergeField(&n.BPO2Epoch, other.BPO2Epoch)
+	mergeField(&n.BPO2MaxBlobs, other.BPO2MaxBlobs)
+	mergeField(&n.BPO2TargetBlobs, other.BPO2TargetBlobs)
+	mergeField(&n.BPO3Epoch, other.BPO3Epoch)
+	mergeField(&n.BPO3MaxBlobs, other.BPO3MaxBlobs)
+	mergeField(&n.BPO3TargetBlobs, other.BPO3TargetBlobs)
+	mergeField(&n.BPO4Epoch, other.BPO4Epoch)
+	mergeField(&n.BPO4MaxBlobs, other.BPO4MaxBlobs)
+	mergeField(&n.BPO4TargetBlobs, other.BPO4TargetBlobs)
+	mergeField(&n.BPO5Epoch, other.BPO5Epoch)
+	mergeField(&n.BPO5MaxBlobs, other.BPO5MaxBlobs)
+	mergeField(&n.BPO5TargetBlobs, other.BPO5TargetBlobs)
+	mergeField(&n.NetworkSyncBaseURL, other.NetworkSyncBaseURL)
+	mergeField(&n.ForceSnapshotSync, other.ForceSnapshotSync)
+	mergeField(&n.ShadowForkBlockHeight, other.ShadowForkBlockHeight)
+
+	if len(other.AdditionalPreloadedContracts) > 0 {
+		accounts := make(map[string]PreloadedAccount, len(n.AdditionalPreloadedContracts)+len(other.AdditionalPreloadedContracts))
+		for address, account := range n.AdditionalPreloadedContracts {
+			accounts[address] = account
+		}
+		for address, account := range other.AdditionalPreloadedContracts {
+			accounts[address] = account
+		}
+		n.AdditionalPreloadedContracts = accounts
+	}
+}
+
+// mergeField sets *field to value unless value is the zero value
+func mergeField[T comparable](field *T, value T) {
+	var zero T
+	if value != zero {
+		*field = value
+	}
 }
 
 // ApplyDefaults applies default values to network parameters
 func (n *NetworkParams) ApplyDefaults() {
 	if n.Network == "" {
-		n.Network = "kurtosis"
+		n.Network = NetworkKurtosis
 	}
+	n.applyNetworkDefaults()
 	if n.NetworkID == "" {
 		n.NetworkID = "3151908"
 	}
+	if n.Preset == "" {
+		n.Preset = SpecPresetMainnet
+	}
 	if n.DepositContractAddress == "" {
-		n.DepositContractAddress = "0x00000000219ab540356cBB839Cbe05303d7705Fa"
+		n.DepositContractAddress = DefaultDepositContractAddress
 	}
 	if n.SecondsPerSlot == 0 {
 		n.SecondsPerSlot = 12
+		if n.Preset == SpecPresetMinimal {
+			n.SecondsPerSlot = 6
+		}
 	}
 	if n.NumValidatorKeysPerNode == 0 {
 		n.NumValidatorKeysPerNode = 64
@@ -210,6 +593,43 @@ type DockerCacheParams struct {
 	URL     string `yaml:"url,omitempty"`
 }
 
+// Toleration lets the network's pods schedule onto Kubernetes nodes with a
+// matching taint.
+type Toleration struct {
+	Key               string `yaml:"key,omitempty"`
+	Operator          string `yaml:"operator,omitempty"` // Equal (default) or Exists
+	Value             string `yaml:"value,omitempty"`
+	Effect            string `yaml:"effect,omitempty"` // NoSchedule, PreferNoSchedule or NoExecute; empty matches all
+	TolerationSeconds *int64 `yaml:"toleration_seconds,omitempty"`
+}
+
+// Validate validates the toleration following Kubernetes' rules.
+func (t Toleration) Validate() error {
+	switch t.Operator {
+	case "", "Equal":
+		if t.Key == "" {
+			return fmt.Errorf("key is required unless operator is Exists")
+		}
+	case "Exists":
+		if t.Value != "" {
+			return fmt.Errorf("value must be empty when operator is Exists")
+		}
+	default:
+		return fmt.Errorf("invalid operator %q, must be Equal or Exists", t.Operator)
+	}
+
+	switch t.Effect {
+	case "", "NoSchedule", "PreferNoSchedule", "NoExecute":
+	default:
+		return fmt.Errorf("invalid effect %q, must be NoSchedule, PreferNoSchedule or NoExecute", t.Effect)
+	}
+	if t.TolerationSeconds != nil && t.Effect != "NoExecute" {
+		return fmt.Errorf("toleration_seconds requires effect NoExecute")
+	}
+
+	return nil
+}
+
 // PortPublisherComponent represents port publishing configuration for a component.
 type PortPublisherComponent struct {
 	Enabled         bool `yaml:"enabled"`
@@ -292,6 +712,59 @@ type EthereumPackageConfig struct {
 
 	// Global client settings
 	GlobalLogLevel string `yaml:"global_log_level,omitempty"`
+
+	// EthereumMetricsExporterEnabled deploys an ethereum-metrics-exporter next to
+	// every node whose participant does not set its own value
+	EthereumMetricsExporterEnabled bool `yaml:"ethereum_metrics_exporter_enabled,omitempty"`
+
+	// CheckpointSyncEnabled has every beacon node whose participant does not set
+	// its own value sync from CheckpointSyncURL instead of genesis
+	CheckpointSyncEnabled bool   `yaml:"checkpoint_sync_enabled,omitempty"`
+	CheckpointSyncURL     string `yaml:"checkpoint_sync_url,omitempty"`
+
+	// FeeRecipient is the address the proposals of every participant that does
+	// not set its own pay. Empty keeps ethereum-package's default.
+	FeeRecipient string `yaml:"-"`
+
+	// Kubernetes scheduling for every pod of the network. Ignored on Docker.
+	GlobalTolerations   []Toleration      `yaml:"global_tolerations,omitempty"`
+	GlobalNodeSelectors map[string]string `yaml:"global_node_selectors,omitempty"`
+}
+
+// KeymanagerTokenPath is where ethereum-package mounts the keymanager API token
+// in validator client containers
+const KeymanagerTokenPath = "/keymanager/keymanager.txt"
+
+// The files artifact ethereum-package stores the engine API JWT secret in, and
+// the secret's file within it
+const (
+	JWTSecretArtifact = "jwt_file"
+	JWTSecretFile     = "jwtsecret"
+)
+
+// CheckpointzURL is the address of the checkpointz additional service inside the enclave
+const CheckpointzURL = "http://checkpointz:5555"
+
+// MetricsExporterEnabled reports whether the nodes of the participant at the
+// 0-based index run an ethereum-metrics-exporter
+func (c *EthereumPackageConfig) MetricsExporterEnabled(participant int) bool {
+	if participant >= 0 && participant < len(c.Participants) {
+		if enabled := c.Participants[participant].EthereumMetricsExporterEnabled; enabled != nil {
+			return *enabled
+		}
+	}
+	return c.EthereumMetricsExporterEnabled
+}
+
+// CheckpointSync reports whether the beacon nodes of the participant at the
+// 0-based index sync from the checkpoint sync URL
+func (c *EthereumPackageConfig) CheckpointSync(participant int) bool {
+	if participant >= 0 && participant < len(c.Participants) {
+		if enabled := c.Participants[participant].CheckpointSyncEnabled; enabled != nil {
+			return *enabled
+		}
+	}
+	return c.CheckpointSyncEnabled
 }
 
 // Validate validates the EthereumPackageConfig
@@ -311,6 +784,35 @@ func (c *EthereumPackageConfig) Validate() error {
 		}
 	}
 
+	// Identical EL/CL combos should be expressed with count instead
+	type combo struct {
+		elType, clType       client.Type
+		elVersion, clVersion string
+		elImage, clImage     string
+	}
+	seen := make(map[combo]int)
+	for i, p := range c.Participants {
+		key := combo{p.ELType, p.CLType, p.ELVersion, p.CLVersion, p.ELImage, p.CLImage}
+		if first, ok := seen[key]; ok {
+			return fmt.Errorf("participant %d: duplicates participant %d (%s/%s), increase count instead",
+				i, first, p.ELType, p.CLType)
+		}
+		seen[key] = i
+	}
+
+	if c.FeeRecipient != "" {
+		if err := ValidateFeeRecipient(c.FeeRecipient); err != nil {
+			return err
+		}
+		for i, p := range c.Participants {
+			if p.FeeRecipient == "" {
+				if _, err := FeeRecipientFlag(p.CLType, c.FeeRecipient); err != nil {
+					return fmt.Errorf("participant %d: %w", i, err)
+				}
+			}
+		}
+	}
+
 	// Validate network params
 	if c.NetworkParams != nil {
 		if err := c.NetworkParams.Validate(); err != nil {
@@ -330,6 +832,9 @@ func (c *EthereumPackageConfig) Validate() error {
 		if err := c.PortPublisher.Validate(); err != nil {
 			return err
 		}
+		if err := checkPortRanges(c.PublicPortRanges()); err != nil {
+			return err
+		}
 	}
 
 	// Validate additional services
@@ -345,17 +850,32 @@ func (c *EthereumPackageConfig) Validate() error {
 
 		// Validate known service names
 		validServices := map[string]bool{
-			"prometheus": true,
-			"grafana":    true,
-			"dora":       true,
-			"spamoor":    true,
-			"blockscout": true,
+			"prometheus":  true,
+			"grafana":     true,
+			"dora":        true,
+			"spamoor":     true,
+			"blockscout":  true,
+			"tracoor":     true,
+			"forky":       true,
+			"checkpointz": true,
 		}
 		if !validServices[service.Name] {
 			return fmt.Errorf("invalid additional service name: %s", service.Name)
 		}
 	}
 
+	// Validate Kubernetes scheduling
+	for i, toleration := range c.GlobalTolerations {
+		if err := toleration.Validate(); err != nil {
+			return fmt.Errorf("global toleration %d: %w", i, err)
+		}
+	}
+	for key := range c.GlobalNodeSelectors {
+		if key == "" || strings.ContainsAny(key, " \t\n") {
+			return fmt.Errorf("invalid global node selector key %q", key)
+		}
+	}
+
 	// Validate global log level
 	if c.GlobalLogLevel != "" && !isValidLogLevel(c.GlobalLogLevel) {
 		return fmt.Errorf("invalid global log level: %s, must be one of: debug, info, warn, error, fatal", c.GlobalLogLevel)
@@ -364,6 +884,27 @@ func (c *EthereumPackageConfig) Validate() error {
 	return nil
 }
 
+// NodeTags returns the tags of each node keyed by its 1-based node index, matching
+// the index ethereum-package uses in service names (e.g. el-2-geth-lighthouse).
+// Nodes without tags are omitted.
+func (c *EthereumPackageConfig) NodeTags() map[int][]string {
+	tags := make(map[int][]string)
+	index := 1
+	for _, p := range c.Participants {
+		nodeTags := p.Tags
+		if p.LateJoin && !containsTag(p.Tags, LateJoinerTag) {
+			nodeTags = append(append([]string{}, p.Tags...), LateJoinerTag)
+		}
+		for i := 0; i < nodeCount(p); i++ {
+			if len(nodeTags) > 0 {
+				tags[index] = nodeTags
+			}
+			index++
+		}
+	}
+	return tags
+}
+
 // ApplyDefaults applies default values to the configuration
 func (c *EthereumPackageConfig) ApplyDefaults() {
 	if c == nil {
@@ -378,6 +919,9 @@ func (c *EthereumPackageConfig) ApplyDefaults() {
 	// Apply defaults to network params
 	if c.NetworkParams != nil {
 		c.NetworkParams.ApplyDefaults()
+		if public, ok := PublicNetworks[c.NetworkParams.BaseNetwork()]; ok && c.CheckpointSyncEnabled && c.CheckpointSyncURL == "" {
+			c.CheckpointSyncURL = public.CheckpointSyncURL
+		}
 	}
 
 	// Apply defaults to port publisher config
@@ -448,6 +992,22 @@ func (f *FileConfigSource) GetPath() string {
 	return f.path
 }
 
+// Load reads, parses and validates the configuration file
+func (f *FileConfigSource) Load() (*EthereumPackageConfig, error) {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	cfg, err := FromYAML(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", f.path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", f.path, err)
+	}
+	return cfg, nil
+}
+
 // InlineConfigSource uses inline configuration
 type InlineConfigSource struct {
 	config *EthereumPackageConfig
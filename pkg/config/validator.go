@@ -109,6 +109,9 @@ func isValidServiceName(name string) bool {
 		"ethereum_metrics_exporter",
 		"explorer",
 		"forkmon",
+		"tracoor",
+		"forky",
+		"checkpointz",
 	}
 	for _, valid := range validServices {
 		if name == valid {
@@ -5,6 +5,8 @@ import (
 
 	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestValidatorValidConfig(t *testing.T) {
@@ -222,3 +224,128 @@ func TestValidatorHelperFunctions(t *testing.T) {
 	config.GlobalLogLevel = "invalid"
 	assert.NotNil(t, config.Validate())
 }
+
+func TestSpecPreset(t *testing.T) {
+	tests := []struct {
+		preset        SpecPreset
+		valid         bool
+		slotsPerEpoch uint64
+		syncCommittee uint64
+	}{
+		{preset: "", valid: true, slotsPerEpoch: 32, syncCommittee: 512},
+		{preset: SpecPresetMainnet, valid: true, slotsPerEpoch: 32, syncCommittee: 512},
+		{preset: SpecPresetMinimal, valid: true, slotsPerEpoch: 8, syncCommittee: 32},
+		{preset: "gnosis", valid: false, slotsPerEpoch: 32, syncCommittee: 512},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.preset), func(t *testing.T) {
+			assert.Equal(t, tt.valid, tt.preset.IsValid())
+			assert.Equal(t, tt.slotsPerEpoch, tt.preset.SlotsPerEpoch())
+			assert.Equal(t, tt.syncCommittee, tt.preset.SyncCommitteeSize())
+		})
+	}
+}
+
+func TestNetworkParamsSpecPreset(t *testing.T) {
+	minimal := &NetworkParams{Preset: SpecPresetMinimal}
+	minimal.ApplyDefaults()
+	assert.Equal(t, 6, minimal.SecondsPerSlot)
+	assert.Equal(t, uint64(8), minimal.SlotsPerEpoch())
+	assert.Equal(t, 48, minimal.EpochDuration())
+	assert.NoError(t, minimal.Validate())
+
+	mainnet := &NetworkParams{}
+	mainnet.ApplyDefaults()
+	assert.Equal(t, SpecPresetMainnet, mainnet.Preset)
+	assert.Equal(t, 12, mainnet.SecondsPerSlot)
+	assert.Equal(t, 384, mainnet.EpochDuration())
+
+	invalid := &NetworkParams{Preset: "gnosis"}
+	invalid.ApplyDefaults()
+	err := invalid.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid preset: gnosis")
+}
+
+func TestNetworkParamsBlobParams(t *testing.T) {
+	params := &NetworkParams{
+		Network:                    "kurtosis",
+		NetworkID:                  "12345",
+		SecondsPerSlot:             12,
+		NumValidatorKeysPerNode:    64,
+		FuluForkEpoch:              1,
+		TargetBlobsPerBlockElectra: 6,
+		MaxBlobsPerBlockElectra:    9,
+	}
+	require.NoError(t, params.SetBPOFork(1, BPOFork{Epoch: 2, MaxBlobs: 12, TargetBlobs: 8}))
+	require.NoError(t, params.SetBPOFork(3, BPOFork{Epoch: 4, MaxBlobs: 18, TargetBlobs: 12}))
+	assert.NoError(t, params.Validate())
+	assert.Equal(t, []BPOFork{
+		{Epoch: 2, MaxBlobs: 12, TargetBlobs: 8},
+		{Epoch: 4, MaxBlobs: 18, TargetBlobs: 12},
+	}, params.BPOSchedule())
+
+	assert.Error(t, params.SetBPOFork(0, BPOFork{}))
+	assert.Error(t, params.SetBPOFork(MaxBPOForks+1, BPOFork{}))
+
+	tests := []struct {
+		name   string
+		modify func(p *NetworkParams)
+		errMsg string
+	}{
+		{"electra target above max", func(p *NetworkParams) { p.TargetBlobsPerBlockElectra = 10 }, "cannot exceed max blobs per block electra"},
+		{"bpo before fulu", func(p *NetworkParams) { p.BPO1Epoch = 0 }, "before the fulu fork epoch"},
+		{"bpo out of order", func(p *NetworkParams) { p.BPO3Epoch = 2 }, "strictly increasing"},
+		{"bpo missing max", func(p *NetworkParams) { p.BPO1MaxBlobs = 0 }, "max blobs is required"},
+		{"bpo target above max", func(p *NetworkParams) { p.BPO1TargetBlobs = 13 }, "target blobs (13) cannot exceed"},
+		{"custody above subnets", func(p *NetworkParams) {
+			p.DataColumnSidecarSubnetCount = 64
+			p.CustodyRequirement = 128
+		}, "custody requirement"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := *params
+			tt.modify(&p)
+			assert.ErrorContains(t, p.Validate(), tt.errMsg)
+		})
+	}
+}
+
+func TestNetworkParamsBlobParamsYAML(t *testing.T) {
+	params := &NetworkParams{MaxBlobsPerBlockElectra: 9, EIP7732ForkEpoch: 5}
+	require.NoError(t, params.SetBPOFork(2, BPOFork{Epoch: 3, MaxBlobs: 12, TargetBlobs: 8}))
+
+	out, err := yaml.Marshal(params)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "max_blobs_per_block_electra: 9")
+	assert.Contains(t, string(out), "eip7732_fork_epoch: 5")
+	assert.Contains(t, string(out), "bpo_2_epoch: 3")
+	assert.Contains(t, string(out), "bpo_2_max_blobs: 12")
+	assert.NotContains(t, string(out), "bpo_1_epoch")
+}
+
+func TestParticipantTags(t *testing.T) {
+	cfg := &EthereumPackageConfig{
+		Participants: []ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse, Count: 2, Tags: []string{"bootnode"}},
+			{ELType: client.Besu, CLType: client.Teku},
+			{ELType: client.Nethermind, CLType: client.Prysm, Tags: []string{"late-joiner"}},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, map[int][]string{
+		1: {"bootnode"},
+		2: {"bootnode"},
+		4: {"late-joiner"},
+	}, cfg.NodeTags())
+
+	// Tags are wrapper-only and never reach ethereum-package
+	out, err := yaml.Marshal(cfg)
+	require.NoError(t, err)
+	assert.NotContains(t, string(out), "bootnode")
+
+	cfg.Participants[1].Tags = []string{" "}
+	assert.ErrorContains(t, cfg.Validate(), "participant 1: tags cannot be empty")
+}
@@ -33,3 +33,14 @@ func FromYAML(yamlStr string) (*EthereumPackageConfig, error) {
 
 	return &config, nil
 }
+
+// UnmarshalYAML also accepts a bare service name, as ethereum-package's own
+// configuration files list additional services
+func (s *AdditionalService) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		s.Name = node.Value
+		return nil
+	}
+	type plain AdditionalService
+	return node.Decode((*plain)(s))
+}
@@ -516,3 +516,75 @@ func TestDockerCacheParamsRoundTrip(t *testing.T) {
 	assert.Equal(t, original.DockerCacheParams.Enabled, parsed.DockerCacheParams.Enabled)
 	assert.Equal(t, original.DockerCacheParams.URL, parsed.DockerCacheParams.URL)
 }
+
+func TestParticipantOverridesRoundTrip(t *testing.T) {
+	participant := NewParticipantBuilder().
+		WithEL(client.Reth).
+		WithCL(client.Teku).
+		WithELImage("ghcr.io/paradigmxyz/reth:latest").
+		WithCLExtraParams("--Xlog-include-validator-duties-enabled=true").
+		WithLogLevels("debug", "", "warn").
+		WithELResources(0, 2000, 0, 4096).
+		WithCLResources(500, 0, 1024, 0).
+		Build()
+
+	yamlStr, err := ToYAML(&EthereumPackageConfig{Participants: []ParticipantConfig{participant}})
+	require.NoError(t, err)
+	assert.Contains(t, yamlStr, "el_image: ghcr.io/paradigmxyz/reth:latest")
+	assert.Contains(t, yamlStr, "cl_extra_params:")
+	assert.Contains(t, yamlStr, "el_log_level: debug")
+	assert.NotContains(t, yamlStr, "cl_log_level")
+	assert.Contains(t, yamlStr, "el_max_mem: 4096")
+	assert.Contains(t, yamlStr, "cl_min_cpu: 500")
+	assert.NotContains(t, yamlStr, "el_min_cpu")
+
+	parsed, err := FromYAML(yamlStr)
+	require.NoError(t, err)
+	assert.Equal(t, participant, parsed.Participants[0])
+}
+
+func TestHash(t *testing.T) {
+	newConfig := func() *EthereumPackageConfig {
+		return &EthereumPackageConfig{
+			Participants: []ParticipantConfig{
+				{ELType: client.Geth, CLType: client.Lighthouse, Count: 1},
+			},
+			NetworkParams: &NetworkParams{NetworkID: "12345"},
+		}
+	}
+
+	hash, err := Hash(newConfig(), "github.com/ethpandaops/ethereum-package@1.0.0")
+	require.NoError(t, err)
+	assert.Len(t, hash, 64)
+
+	// Equal configs hash equally
+	again, err := Hash(newConfig(), "github.com/ethpandaops/ethereum-package@1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, hash, again)
+
+	// The package version is part of the hash
+	otherVersion, err := Hash(newConfig(), "github.com/ethpandaops/ethereum-package@2.0.0")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherVersion)
+
+	changed := newConfig()
+	changed.Participants[0].Count = 2
+	otherConfig, err := Hash(changed, "github.com/ethpandaops/ethereum-package@1.0.0")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherConfig)
+
+	_, err = Hash(nil, "")
+	assert.Error(t, err)
+}
+
+func TestFromYAMLAdditionalServiceNames(t *testing.T) {
+	config, err := FromYAML(`participants:
+  - el_type: geth
+    cl_type: lighthouse
+additional_services:
+  - dora
+  - name: prometheus
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []AdditionalService{{Name: "dora"}, {Name: "prometheus"}}, config.AdditionalServices)
+}
@@ -0,0 +1,390 @@
+package conformance
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Names of the standard checks
+const (
+	CheckNodeVersion      = "node_version"
+	CheckNodeSyncing      = "node_syncing"
+	CheckNodeIdentity     = "node_identity"
+	CheckNodeHealth       = "node_health"
+	CheckGenesis          = "beacon_genesis"
+	CheckSpec             = "config_spec"
+	CheckStateFork        = "state_fork"
+	CheckHeaderMatchBlock = "header_matches_block"
+	CheckFinality         = "finality"
+	// CheckChainConsistency compares the genesis and spec of every client
+	CheckChainConsistency = "chain_consistency"
+)
+
+// StandardChecks returns the checks run on every client by default
+func StandardChecks() []Check {
+	return []Check{
+		{Name: CheckNodeVersion, Run: checkNodeVersion},
+		{Name: CheckNodeSyncing, Run: checkNodeSyncing},
+		{Name: CheckNodeIdentity, Run: checkNodeIdentity},
+		{Name: CheckNodeHealth, Run: checkNodeHealth},
+		{Name: CheckGenesis, Run: checkGenesis},
+		{Name: CheckSpec, Run: checkSpec},
+		{Name: CheckStateFork, Run: checkStateFork},
+		{Name: CheckHeaderMatchBlock, Run: checkHeaderMatchesBlock},
+		{Name: CheckFinality, Run: checkFinality},
+	}
+}
+
+func checkNodeVersion(ctx context.Context, api *API) error {
+	var resp struct {
+		Data struct {
+			Version string `json:"version"`
+		} `json:"data"`
+	}
+	if err := api.GetJSON(ctx, "/eth/v1/node/version", &resp); err != nil {
+		return err
+	}
+	if resp.Data.Version == "" {
+		return fmt.Errorf("data.version is missing")
+	}
+	return nil
+}
+
+func checkNodeSyncing(ctx context.Context, api *API) error {
+	var resp struct {
+		Data struct {
+			HeadSlot     string `json:"head_slot"`
+			SyncDistance string `json:"sync_distance"`
+			IsSyncing    *bool  `json:"is_syncing"`
+		} `json:"data"`
+	}
+	if err := api.GetJSON(ctx, "/eth/v1/node/syncing", &resp); err != nil {
+		return err
+	}
+	if _, err := parseUint("data.head_slot", resp.Data.HeadSlot); err != nil {
+		return err
+	}
+	if _, err := parseUint("data.sync_distance", resp.Data.SyncDistance); err != nil {
+		return err
+	}
+	if resp.Data.IsSyncing == nil {
+		return fmt.Errorf("data.is_syncing is missing")
+	}
+	return nil
+}
+
+func checkNodeIdentity(ctx context.Context, api *API) error {
+	var resp struct {
+		Data struct {
+			PeerID       string   `json:"peer_id"`
+			ENR          string   `json:"enr"`
+			P2PAddresses []string `json:"p2p_addresses"`
+		} `json:"data"`
+	}
+	if err := api.GetJSON(ctx, "/eth/v1/node/identity", &resp); err != nil {
+		return err
+	}
+	if resp.Data.PeerID == "" {
+		return fmt.Errorf("data.peer_id is missing")
+	}
+	if !strings.HasPrefix(resp.Data.ENR, "enr:") {
+		return fmt.Errorf("data.enr %q is not an ENR", resp.Data.ENR)
+	}
+	return nil
+}
+
+func checkNodeHealth(ctx context.Context, api *API) error {
+	status, _, err := api.Get(ctx, "/eth/v1/node/health")
+	if err != nil {
+		return err
+	}
+	// 206 means the node is syncing but serving requests
+	if status != http.StatusOK && status != http.StatusPartialContent {
+		return fmt.Errorf("GET /eth/v1/node/health returned status %d", status)
+	}
+	return nil
+}
+
+// genesisResponse is the body of /eth/v1/beacon/genesis
+type genesisResponse struct {
+	Data struct {
+		GenesisTime           string `json:"genesis_time"`
+		GenesisValidatorsRoot string `json:"genesis_validators_root"`
+		GenesisForkVersion    string `json:"genesis_fork_version"`
+	} `json:"data"`
+}
+
+func checkGenesis(ctx context.Context, api *API) error {
+	var resp genesisResponse
+	if err := api.GetJSON(ctx, "/eth/v1/beacon/genesis", &resp); err != nil {
+		return err
+	}
+	if _, err := parseUint("data.genesis_time", resp.Data.GenesisTime); err != nil {
+		return err
+	}
+	if err := checkHex("data.genesis_validators_root", resp.Data.GenesisValidatorsRoot, 32); err != nil {
+		return err
+	}
+	return checkHex("data.genesis_fork_version", resp.Data.GenesisForkVersion, 4)
+}
+
+// specResponse is the body of /eth/v1/config/spec
+type specResponse struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+// specUint returns a numeric spec value, which the API encodes as a string
+func (s specResponse) specUint(key string) (uint64, error) {
+	value, ok := s.Data[key].(string)
+	if !ok {
+		return 0, fmt.Errorf("data.%s is missing or not a string", key)
+	}
+	return parseUint("data."+key, value)
+}
+
+func checkSpec(ctx context.Context, api *API) error {
+	var resp specResponse
+	if err := api.GetJSON(ctx, "/eth/v1/config/spec", &resp); err != nil {
+		return err
+	}
+	for _, key := range []string{"SECONDS_PER_SLOT", "SLOTS_PER_EPOCH", "DEPOSIT_CHAIN_ID"} {
+		if _, err := resp.specUint(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkStateFork(ctx context.Context, api *API) error {
+	var resp struct {
+		Data struct {
+			PreviousVersion string `json:"previous_version"`
+			CurrentVersion  string `json:"current_version"`
+			Epoch           string `json:"epoch"`
+		} `json:"data"`
+	}
+	if err := api.GetJSON(ctx, "/eth/v1/beacon/states/head/fork", &resp); err != nil {
+		return err
+	}
+	if err := checkHex("data.previous_version", resp.Data.PreviousVersion, 4); err != nil {
+		return err
+	}
+	if err := checkHex("data.current_version", resp.Data.CurrentVersion, 4); err != nil {
+		return err
+	}
+	_, err := parseUint("data.epoch", resp.Data.Epoch)
+	return err
+}
+
+// blockFields are the fields a block header shares with its block
+type blockFields struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+}
+
+func checkHeaderMatchesBlock(ctx context.Context, api *API) error {
+	var header struct {
+		Data struct {
+			Root   string `json:"root"`
+			Header struct {
+				Message struct {
+					blockFields
+					BodyRoot string `json:"body_root"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+	if err := api.GetJSON(ctx, "/eth/v1/beacon/headers/head", &header); err != nil {
+		return err
+	}
+	root := header.Data.Root
+	if err := checkHex("data.root", root, 32); err != nil {
+		return err
+	}
+	message := header.Data.Header.Message
+	if _, err := parseUint("data.header.message.slot", message.Slot); err != nil {
+		return err
+	}
+	if err := checkHex("data.header.message.body_root", message.BodyRoot, 32); err != nil {
+		return err
+	}
+
+	// Query the block by root so a new head in between does not matter
+	var block struct {
+		Version string `json:"version"`
+		Data    struct {
+			Message blockFields `json:"message"`
+		} `json:"data"`
+	}
+	if err := api.GetJSON(ctx, "/eth/v2/beacon/blocks/"+root, &block); err != nil {
+		return err
+	}
+	if block.Version == "" {
+		return fmt.Errorf("block version is missing")
+	}
+	if block.Data.Message != message.blockFields {
+		return fmt.Errorf("header %+v does not match block %+v", message.blockFields, block.Data.Message)
+	}
+
+	var blockRoot struct {
+		Data struct {
+			Root string `json:"root"`
+		} `json:"data"`
+	}
+	if err := api.GetJSON(ctx, "/eth/v1/beacon/blocks/"+root+"/root", &blockRoot); err != nil {
+		return err
+	}
+	if !strings.EqualFold(blockRoot.Data.Root, root) {
+		return fmt.Errorf("block root %s does not match header root %s", blockRoot.Data.Root, root)
+	}
+	return nil
+}
+
+// checkpointsResponse is the body of the finality checkpoints of a state
+type checkpointsResponse struct {
+	Data struct {
+		PreviousJustified checkpoint `json:"previous_justified"`
+		CurrentJustified  checkpoint `json:"current_justified"`
+		Finalized         checkpoint `json:"finalized"`
+	} `json:"data"`
+}
+
+// checkpoint is an epoch and block root
+type checkpoint struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+// finality returns the previous justified, current justified and finalized epochs
+func finality(ctx context.Context, api *API) ([3]uint64, error) {
+	var resp checkpointsResponse
+	var epochs [3]uint64
+	if err := api.GetJSON(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", &resp); err != nil {
+		return epochs, err
+	}
+	for i, c := range []struct {
+		field string
+		checkpoint
+	}{
+		{"data.previous_justified", resp.Data.PreviousJustified},
+		{"data.current_justified", resp.Data.CurrentJustified},
+		{"data.finalized", resp.Data.Finalized},
+	} {
+		epoch, err := parseUint(c.field+".epoch", c.Epoch)
+		if err != nil {
+			return epochs, err
+		}
+		if err := checkHex(c.field+".root", c.Root, 32); err != nil {
+			return epochs, err
+		}
+		epochs[i] = epoch
+	}
+	return epochs, nil
+}
+
+func checkFinality(ctx context.Context, api *API) error {
+	before, err := finality(ctx, api)
+	if err != nil {
+		return err
+	}
+	previousJustified, currentJustified, finalized := before[0], before[1], before[2]
+	if finalized > currentJustified {
+		return fmt.Errorf("finalized epoch %d is after current justified epoch %d", finalized, currentJustified)
+	}
+	if previousJustified > currentJustified {
+		return fmt.Errorf("previous justified epoch %d is after current justified epoch %d", previousJustified, currentJustified)
+	}
+
+	var spec specResponse
+	if err := api.GetJSON(ctx, "/eth/v1/config/spec", &spec); err != nil {
+		return err
+	}
+	slotsPerEpoch, err := spec.specUint("SLOTS_PER_EPOCH")
+	if err != nil {
+		return err
+	}
+	var syncing struct {
+		Data struct {
+			HeadSlot string `json:"head_slot"`
+		} `json:"data"`
+	}
+	if err := api.GetJSON(ctx, "/eth/v1/node/syncing", &syncing); err != nil {
+		return err
+	}
+	headSlot, err := parseUint("data.head_slot", syncing.Data.HeadSlot)
+	if err != nil {
+		return err
+	}
+	if slotsPerEpoch > 0 && currentJustified > headSlot/slotsPerEpoch {
+		return fmt.Errorf("current justified epoch %d is after head epoch %d", currentJustified, headSlot/slotsPerEpoch)
+	}
+
+	after, err := finality(ctx, api)
+	if err != nil {
+		return err
+	}
+	if after[2] < finalized {
+		return fmt.Errorf("finalized epoch went back from %d to %d", finalized, after[2])
+	}
+	if after[1] < currentJustified {
+		return fmt.Errorf("current justified epoch went back from %d to %d", currentJustified, after[1])
+	}
+	return nil
+}
+
+// chainIdentity describes the genesis and spec of the client's chain, so
+// clients of the same network return the same value
+func (a *API) chainIdentity(ctx context.Context) (string, error) {
+	var genesis genesisResponse
+	if err := a.GetJSON(ctx, "/eth/v1/beacon/genesis", &genesis); err != nil {
+		return "", err
+	}
+	var spec specResponse
+	if err := a.GetJSON(ctx, "/eth/v1/config/spec", &spec); err != nil {
+		return "", err
+	}
+	secondsPerSlot, err := spec.specUint("SECONDS_PER_SLOT")
+	if err != nil {
+		return "", err
+	}
+	slotsPerEpoch, err := spec.specUint("SLOTS_PER_EPOCH")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("genesis_validators_root=%s genesis_time=%s seconds_per_slot=%d slots_per_epoch=%d",
+		strings.ToLower(genesis.Data.GenesisValidatorsRoot), genesis.Data.GenesisTime, secondsPerSlot, slotsPerEpoch), nil
+}
+
+// parseUint parses a decimal quantity, which the beacon API encodes as a string
+func parseUint(field, value string) (uint64, error) {
+	if value == "" {
+		return 0, fmt.Errorf("%s is missing", field)
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s %q is not a decimal quantity", field, value)
+	}
+	return n, nil
+}
+
+// checkHex checks that value is 0x-prefixed hex of the given byte length
+func checkHex(field, value string, length int) error {
+	if value == "" {
+		return fmt.Errorf("%s is missing", field)
+	}
+	raw, ok := strings.CutPrefix(value, "0x")
+	if !ok {
+		return fmt.Errorf("%s %q is not 0x-prefixed", field, value)
+	}
+	decoded, err := hex.DecodeString(raw)
+	if err != nil || len(decoded) != length {
+		return fmt.Errorf("%s %q is not %d bytes of hex", field, value, length)
+	}
+	return nil
+}
@@ -0,0 +1,313 @@
+// Package conformance smoke-checks the APIs of the clients in a network.
+// Checker requires well-formed, consistent beacon API responses from every
+// consensus client; ExecutionChecker compares the JSON-RPC responses of the
+// execution clients and reports where they diverge.
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/ethpandaops/ethereum-package-go/pkg/results"
+)
+
+// DefaultRequestTimeout bounds each beacon API request of a check
+const DefaultRequestTimeout = 10 * time.Second
+
+// Check is a conformance check run against one consensus client
+type Check struct {
+	Name string
+	Run  func(ctx context.Context, api *API) error
+}
+
+// Result is the outcome of one check on one client
+type Result struct {
+	Check string
+	// Err is nil when the check passed
+	Err      error
+	Start    time.Time
+	Duration time.Duration
+}
+
+// ClientReport is the outcome of every check on one client
+type ClientReport struct {
+	Client  string
+	Type    client.Type
+	Version string
+	Results []Result
+}
+
+// Passed reports whether every check passed on the client
+func (c ClientReport) Passed() bool {
+	return len(c.Failures()) == 0
+}
+
+// Failures returns the checks that failed on the client
+func (c ClientReport) Failures() []Result {
+	var failures []Result
+	for _, result := range c.Results {
+		if result.Err != nil {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// Report is the outcome of a conformance run, with one entry per client
+type Report struct {
+	Clients []ClientReport
+}
+
+// Passed reports whether every check passed on every client
+func (r *Report) Passed() bool {
+	for _, c := range r.Clients {
+		if !c.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Client returns the report of the named client
+func (r *Report) Client(name string) (ClientReport, bool) {
+	for _, c := range r.Clients {
+		if c.Client == name {
+			return c, true
+		}
+	}
+	return ClientReport{}, false
+}
+
+// String summarizes the run with one line per client and one per failure
+func (r *Report) String() string {
+	var b strings.Builder
+	for _, c := range r.Clients {
+		failures := c.Failures()
+		status := "PASS"
+		if len(failures) > 0 {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&b, "%s %s (%s): %d/%d checks passed\n", status, c.Client, c.Type, len(c.Results)-len(failures), len(c.Results))
+		for _, failure := range failures {
+			fmt.Fprintf(&b, "  %s: %v\n", failure.Check, failure.Err)
+		}
+	}
+	return b.String()
+}
+
+// Suite converts the report for export, with one case per client and check
+// named "<client>/<check>"
+func (r *Report) Suite(name string) results.Suite {
+	suite := results.Suite{Name: name, ClientVersions: make(map[string]string)}
+	for _, c := range r.Clients {
+		suite.ClientVersions[c.Client] = c.Version
+		for _, result := range c.Results {
+			testCase := results.Case{
+				Name:   c.Client + "/" + result.Check,
+				Client: c.Client,
+				Start:  result.Start,
+				End:    result.Start.Add(result.Duration),
+				Passed: result.Err == nil,
+			}
+			if result.Err != nil {
+				testCase.Details = result.Err.Error()
+			}
+			suite.Cases = append(suite.Cases, testCase)
+		}
+	}
+	return suite
+}
+
+// Checker runs conformance checks against the consensus clients of a network
+type Checker struct {
+	net            network.Network
+	requestTimeout time.Duration
+	clientTypes    []client.Type
+	checks         []Check
+}
+
+// NewChecker creates a checker running the standard checks
+func NewChecker(net network.Network) *Checker {
+	return &Checker{
+		net:            net,
+		requestTimeout: DefaultRequestTimeout,
+		checks:         StandardChecks(),
+	}
+}
+
+// WithRequestTimeout sets the timeout of each beacon API request. Non-positive values are ignored.
+func (c *Checker) WithRequestTimeout(timeout time.Duration) *Checker {
+	if timeout > 0 {
+		c.requestTimeout = timeout
+	}
+	return c
+}
+
+// WithClientTypes limits the run to consensus clients of the given types, e.g.
+// to gate a new image of one client
+func (c *Checker) WithClientTypes(types ...client.Type) *Checker {
+	c.clientTypes = append(c.clientTypes, types...)
+	return c
+}
+
+// WithChecks adds custom checks to the standard ones
+func (c *Checker) WithChecks(checks ...Check) *Checker {
+	c.checks = append(c.checks, checks...)
+	return c
+}
+
+// Run checks every consensus client with the default settings
+func Run(ctx context.Context, net network.Network) (*Report, error) {
+	return NewChecker(net).Run(ctx)
+}
+
+// Run checks every running consensus client concurrently. Late joiners that
+// were not started are skipped. Clients that disagree with the majority on the
+// genesis or the chain spec fail the consistency check.
+func (c *Checker) Run(ctx context.Context) (*Report, error) {
+	beacons := c.beacons()
+	if len(beacons) == 0 {
+		return nil, fmt.Errorf("no consensus clients to check")
+	}
+
+	report := &Report{Clients: make([]ClientReport, len(beacons))}
+	genesis := make([]string, len(beacons))
+	var wg sync.WaitGroup
+	for i, beacon := range beacons {
+		wg.Add(1)
+		go func(i int, beacon client.ConsensusClient) {
+			defer wg.Done()
+			api := newAPI(beacon, c.requestTimeout)
+			clientReport := ClientReport{Client: beacon.Name(), Type: beacon.Type(), Version: beacon.Version()}
+			for _, check := range c.checks {
+				start := time.Now()
+				err := check.Run(ctx, api)
+				clientReport.Results = append(clientReport.Results, Result{Check: check.Name, Err: err, Start: start, Duration: time.Since(start)})
+			}
+			report.Clients[i] = clientReport
+			genesis[i], _ = api.chainIdentity(ctx)
+		}(i, beacon)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if len(beacons) > 1 {
+		compared := time.Now()
+		majority := majorityValue(genesis)
+		for i := range report.Clients {
+			var err error
+			switch {
+			case genesis[i] == "":
+				err = fmt.Errorf("genesis and spec unavailable")
+			case genesis[i] != majority:
+				err = fmt.Errorf("genesis or spec differs from the majority of clients: %s", genesis[i])
+			}
+			report.Clients[i].Results = append(report.Clients[i].Results, Result{Check: CheckChainConsistency, Err: err, Start: compared})
+		}
+	}
+	return report, nil
+}
+
+// beacons returns the consensus clients to check
+func (c *Checker) beacons() []client.ConsensusClient {
+	if c.net.ConsensusClients() == nil {
+		return nil
+	}
+	all := c.net.ConsensusClients().Except(c.net.LateJoiners()...)
+	if len(c.clientTypes) == 0 {
+		return all
+	}
+	var beacons []client.ConsensusClient
+	for _, beacon := range all {
+		for _, clientType := range c.clientTypes {
+			if beacon.Type() == clientType {
+				beacons = append(beacons, beacon)
+				break
+			}
+		}
+	}
+	return beacons
+}
+
+// majorityValue returns the most common non-empty value, preferring the
+// smallest on ties so the result is deterministic
+func majorityValue(values []string) string {
+	counts := make(map[string]int)
+	for _, value := range values {
+		if value != "" {
+			counts[value]++
+		}
+	}
+	candidates := make([]string, 0, len(counts))
+	for value := range counts {
+		candidates = append(candidates, value)
+	}
+	sort.Strings(candidates)
+	majority := ""
+	for _, value := range candidates {
+		if majority == "" || counts[value] > counts[majority] {
+			majority = value
+		}
+	}
+	return majority
+}
+
+// API makes beacon API requests to one consensus client
+type API struct {
+	Client     client.ConsensusClient
+	httpClient *http.Client
+}
+
+// newAPI creates an API for the client with the given request timeout
+func newAPI(beacon client.ConsensusClient, timeout time.Duration) *API {
+	return &API{Client: beacon, httpClient: beacon.Credentials().Wrap(client.NewHTTPClient(timeout, beacon.TLSConfig()))}
+}
+
+// Get requests the path and returns the status code and body
+func (a *API) Get(ctx context.Context, path string) (int, []byte, error) {
+	if a.Client.BeaconAPIURL() == "" {
+		return 0, nil, fmt.Errorf("beacon API URL is empty")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.Client.BeaconAPIURL()+path, nil)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("GET %s failed: %w", path, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, fmt.Errorf("GET %s: failed to read response: %w", path, err)
+	}
+	return resp.StatusCode, body, nil
+}
+
+// GetJSON requests the path, requires a 200 response and decodes its body into out
+func (a *API) GetJSON(ctx context.Context, path string, out interface{}) error {
+	status, body, err := a.Get(ctx, path)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("GET %s returned status %d", path, status)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("GET %s returned invalid JSON: %w", path, err)
+	}
+	return nil
+}
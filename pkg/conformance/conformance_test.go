@@ -0,0 +1,201 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	headRoot      = "0xab000000000000000000000000000000000000000000000000000000000000cd"
+	zeroRoot      = "0x0000000000000000000000000000000000000000000000000000000000000000"
+	validatorRoot = "0x1100000000000000000000000000000000000000000000000000000000000022"
+)
+
+// newBeaconServer serves a conformant beacon API, with responses overridden by path
+func newBeaconServer(t *testing.T, overrides map[string]interface{}) *httptest.Server {
+	t.Helper()
+
+	blockFields := map[string]string{"slot": "40", "proposer_index": "7", "parent_root": zeroRoot, "state_root": zeroRoot}
+	headerMessage := map[string]string{"body_root": zeroRoot}
+	for k, v := range blockFields {
+		headerMessage[k] = v
+	}
+	responses := map[string]interface{}{
+		"/eth/v1/node/version":  map[string]interface{}{"data": map[string]string{"version": "Lighthouse/v5.0.0"}},
+		"/eth/v1/node/syncing":  map[string]interface{}{"data": map[string]interface{}{"head_slot": "40", "sync_distance": "0", "is_syncing": false}},
+		"/eth/v1/node/identity": map[string]interface{}{"data": map[string]interface{}{"peer_id": "16Uiu2", "enr": "enr:-abc", "p2p_addresses": []string{}}},
+		"/eth/v1/node/health":   nil,
+		"/eth/v1/beacon/genesis": map[string]interface{}{"data": map[string]string{
+			"genesis_time": "1700000000", "genesis_validators_root": validatorRoot, "genesis_fork_version": "0x10000038",
+		}},
+		"/eth/v1/config/spec": map[string]interface{}{"data": map[string]string{
+			"SECONDS_PER_SLOT": "12", "SLOTS_PER_EPOCH": "32", "DEPOSIT_CHAIN_ID": "3151908",
+		}},
+		"/eth/v1/beacon/states/head/fork": map[string]interface{}{"data": map[string]string{
+			"previous_version": "0x40000038", "current_version": "0x50000038", "epoch": "0",
+		}},
+		"/eth/v1/beacon/headers/head": map[string]interface{}{"data": map[string]interface{}{
+			"root": headRoot, "header": map[string]interface{}{"message": headerMessage},
+		}},
+		"/eth/v2/beacon/blocks/" + headRoot: map[string]interface{}{
+			"version": "deneb", "data": map[string]interface{}{"message": blockFields},
+		},
+		"/eth/v1/beacon/blocks/" + headRoot + "/root": map[string]interface{}{"data": map[string]string{"root": headRoot}},
+		"/eth/v1/beacon/states/head/finality_checkpoints": map[string]interface{}{"data": map[string]interface{}{
+			"previous_justified": map[string]string{"epoch": "0", "root": zeroRoot},
+			"current_justified":  map[string]string{"epoch": "1", "root": zeroRoot},
+			"finalized":          map[string]string{"epoch": "0", "root": zeroRoot},
+		}},
+	}
+	for path, resp := range overrides {
+		responses[path] = resp
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if resp == nil {
+			return
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestNetwork creates a network of consensus clients served by the given servers
+func newTestNetwork(servers map[string]*httptest.Server) network.Network {
+	clients := client.NewConsensusClients()
+	for name, server := range servers {
+		clientType := client.Type(strings.Split(name, "-")[2])
+		clients.Add(client.NewConsensusClient(clientType, name, "", server.URL, "", "", "", name, "", 9000))
+	}
+	return network.New(network.Config{ConsensusClients: clients, OrphanOnExit: true})
+}
+
+func TestRunConformant(t *testing.T) {
+	net := newTestNetwork(map[string]*httptest.Server{
+		"cl-1-lighthouse-geth": newBeaconServer(t, nil),
+		"cl-2-teku-besu":       newBeaconServer(t, nil),
+	})
+
+	report, err := Run(context.Background(), net)
+	require.NoError(t, err)
+	assert.True(t, report.Passed(), report.String())
+	require.Len(t, report.Clients, 2)
+	assert.Equal(t, "cl-1-lighthouse-geth", report.Clients[0].Client)
+	assert.Len(t, report.Clients[0].Results, len(StandardChecks())+1)
+	assert.Contains(t, report.String(), "PASS cl-2-teku-besu (teku): 10/10 checks passed")
+}
+
+func TestRunReportsNonConformantClient(t *testing.T) {
+	otherRoot := "0x" + strings.Repeat("ee", 32)
+	net := newTestNetwork(map[string]*httptest.Server{
+		"cl-1-lighthouse-geth": newBeaconServer(t, nil),
+		"cl-2-teku-besu":       newBeaconServer(t, nil),
+		"cl-3-prysm-geth": newBeaconServer(t, map[string]interface{}{
+			// Block disagrees with the head header
+			"/eth/v2/beacon/blocks/" + headRoot: map[string]interface{}{
+				"version": "deneb",
+				"data": map[string]interface{}{"message": map[string]string{
+					"slot": "41", "proposer_index": "7", "parent_root": zeroRoot, "state_root": zeroRoot,
+				}},
+			},
+			// Slot encoded as a number instead of a string
+			"/eth/v1/node/syncing": map[string]interface{}{"data": map[string]interface{}{"head_slot": 40, "sync_distance": "0", "is_syncing": false}},
+			// Different chain
+			"/eth/v1/beacon/genesis": map[string]interface{}{"data": map[string]string{
+				"genesis_time": "1700000000", "genesis_validators_root": otherRoot, "genesis_fork_version": "0x10000038",
+			}},
+		}),
+	})
+
+	report, err := Run(context.Background(), net)
+	require.NoError(t, err)
+	assert.False(t, report.Passed())
+
+	lighthouse, ok := report.Client("cl-1-lighthouse-geth")
+	require.True(t, ok)
+	assert.True(t, lighthouse.Passed(), report.String())
+
+	prysm, ok := report.Client("cl-3-prysm-geth")
+	require.True(t, ok)
+	failed := make(map[string]string)
+	for _, failure := range prysm.Failures() {
+		failed[failure.Check] = failure.Err.Error()
+	}
+	assert.Contains(t, failed[CheckHeaderMatchBlock], "does not match block")
+	assert.Contains(t, failed[CheckNodeSyncing], "invalid JSON")
+	assert.Contains(t, failed[CheckChainConsistency], "differs from the majority")
+	assert.Contains(t, report.String(), "FAIL cl-3-prysm-geth (prysm)")
+
+	suite := report.Suite("beacon-api")
+	assert.Len(t, suite.Cases, 3*(len(StandardChecks())+1))
+	assert.Equal(t, len(prysm.Failures()), suite.Failures())
+	assert.Contains(t, suite.ClientVersions, "cl-3-prysm-geth")
+	for _, c := range suite.Cases {
+		if c.Name == "cl-3-prysm-geth/"+CheckHeaderMatchBlock {
+			assert.False(t, c.Passed)
+			assert.Equal(t, "cl-3-prysm-geth", c.Client)
+			assert.Contains(t, c.Details, "does not match block")
+			assert.False(t, c.Start.IsZero())
+		}
+	}
+}
+
+func TestRunFinalityRules(t *testing.T) {
+	net := newTestNetwork(map[string]*httptest.Server{
+		"cl-1-lighthouse-geth": newBeaconServer(t, map[string]interface{}{
+			"/eth/v1/beacon/states/head/finality_checkpoints": map[string]interface{}{"data": map[string]interface{}{
+				"previous_justified": map[string]string{"epoch": "1", "root": zeroRoot},
+				"current_justified":  map[string]string{"epoch": "1", "root": zeroRoot},
+				"finalized":          map[string]string{"epoch": "2", "root": zeroRoot},
+			}},
+		}),
+	})
+
+	report, err := NewChecker(net).WithClientTypes(client.Lighthouse).Run(context.Background())
+	require.NoError(t, err)
+	failures := report.Clients[0].Failures()
+	require.Len(t, failures, 1)
+	assert.Equal(t, CheckFinality, failures[0].Check)
+	assert.Contains(t, failures[0].Err.Error(), "finalized epoch 2 is after current justified epoch 1")
+}
+
+func TestRunCustomChecksAndFilters(t *testing.T) {
+	net := newTestNetwork(map[string]*httptest.Server{
+		"cl-1-lighthouse-geth": newBeaconServer(t, nil),
+	})
+
+	called := false
+	report, err := NewChecker(net).WithChecks(Check{
+		Name: "blob_sidecars",
+		Run: func(ctx context.Context, api *API) error {
+			called = true
+			status, _, err := api.Get(ctx, "/eth/v1/beacon/blob_sidecars/head")
+			if err != nil {
+				return err
+			}
+			assert.Equal(t, http.StatusNotFound, status)
+			return nil
+		},
+	}).Run(context.Background())
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.True(t, report.Passed(), report.String())
+
+	_, err = NewChecker(net).WithClientTypes(client.Teku).Run(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,327 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+// Call is a JSON-RPC request issued to every execution client
+type Call struct {
+	// Name identifies the call in reports and defaults to the method
+	Name   string
+	Method string
+	Params []interface{}
+	// CompareResults compares the results of the clients, not only their
+	// shape. Leave it unset for results that move with the head.
+	CompareResults bool
+}
+
+// name returns the name of the call in reports
+func (c Call) name() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return c.Method
+}
+
+// DivergenceKind classifies how a client's response differs from the majority
+type DivergenceKind string
+
+const (
+	// DivergenceUnavailable is a request that got no JSON-RPC response
+	DivergenceUnavailable DivergenceKind = "unavailable"
+	// DivergenceError is an error where the majority returned a result, the
+	// other way round, or a different error code
+	DivergenceError DivergenceKind = "error"
+	// DivergenceShape is a result with different fields or types
+	DivergenceShape DivergenceKind = "shape"
+	// DivergenceResult is a result with a different value
+	DivergenceResult DivergenceKind = "result"
+)
+
+// Divergence is a client's response differing from the majority of clients
+type Divergence struct {
+	Kind     DivergenceKind
+	Expected string
+	Got      string
+}
+
+// Error describes the divergence
+func (d *Divergence) Error() string {
+	return fmt.Sprintf("%s divergence: expected %s, got %s", d.Kind, d.Expected, d.Got)
+}
+
+// StandardCalls returns the calls issued to every execution client by default
+func StandardCalls() []Call {
+	zeroAddress := "0x0000000000000000000000000000000000000000"
+	return []Call{
+		{Method: "eth_chainId", CompareResults: true},
+		{Method: "net_version", CompareResults: true},
+		{Method: "web3_clientVersion"},
+		{Method: "eth_blockNumber"},
+		{Method: "eth_syncing"},
+		{Method: "eth_gasPrice"},
+		{Method: "eth_feeHistory", Params: []interface{}{"0x1", "latest", []int{50}}},
+		{Name: "eth_getBlockByNumber_genesis", Method: "eth_getBlockByNumber", Params: []interface{}{"0x0", false}, CompareResults: true},
+		{Name: "eth_getBlockByNumber_latest", Method: "eth_getBlockByNumber", Params: []interface{}{"latest", true}},
+		{Name: "eth_getBlockByNumber_future", Method: "eth_getBlockByNumber", Params: []interface{}{"0xffffffffff", false}, CompareResults: true},
+		{Method: "eth_getBalance", Params: []interface{}{zeroAddress, "0x0"}, CompareResults: true},
+		{Method: "eth_getCode", Params: []interface{}{zeroAddress, "0x0"}, CompareResults: true},
+		{Method: "eth_getTransactionCount", Params: []interface{}{zeroAddress, "0x0"}, CompareResults: true},
+		{Method: "eth_estimateGas", Params: []interface{}{map[string]string{"from": zeroAddress, "to": zeroAddress, "value": "0x0"}}, CompareResults: true},
+		{Name: "eth_getBalance_invalid_address", Method: "eth_getBalance", Params: []interface{}{"0xzz", "latest"}},
+		{Name: "unknown_method", Method: "eth_doesNotExist"},
+	}
+}
+
+// ExecutionChecker issues JSON-RPC calls to the execution clients of a network
+// and reports where their responses diverge
+type ExecutionChecker struct {
+	net            network.Network
+	requestTimeout time.Duration
+	clientTypes    []client.Type
+	calls          []Call
+}
+
+// NewExecutionChecker creates a checker issuing the standard calls
+func NewExecutionChecker(net network.Network) *ExecutionChecker {
+	return &ExecutionChecker{
+		net:            net,
+		requestTimeout: DefaultRequestTimeout,
+		calls:          StandardCalls(),
+	}
+}
+
+// WithRequestTimeout sets the timeout of each JSON-RPC request. Non-positive values are ignored.
+func (c *ExecutionChecker) WithRequestTimeout(timeout time.Duration) *ExecutionChecker {
+	if timeout > 0 {
+		c.requestTimeout = timeout
+	}
+	return c
+}
+
+// WithClientTypes limits the run to execution clients of the given types
+func (c *ExecutionChecker) WithClientTypes(types ...client.Type) *ExecutionChecker {
+	c.clientTypes = append(c.clientTypes, types...)
+	return c
+}
+
+// WithCalls adds custom calls to the standard ones
+func (c *ExecutionChecker) WithCalls(calls ...Call) *ExecutionChecker {
+	c.calls = append(c.calls, calls...)
+	return c
+}
+
+// RunExecution checks every execution client with the default settings
+func RunExecution(ctx context.Context, net network.Network) (*Report, error) {
+	return NewExecutionChecker(net).Run(ctx)
+}
+
+// Run issues every call to every running execution client and compares each
+// response with the majority. A failed result carries a *Divergence.
+func (c *ExecutionChecker) Run(ctx context.Context) (*Report, error) {
+	clients := c.clients()
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("no execution clients to check")
+	}
+
+	outcomes := make([][]rpcOutcome, len(clients))
+	timings := make([][]Result, len(clients))
+	var wg sync.WaitGroup
+	for i, el := range clients {
+		wg.Add(1)
+		go func(i int, el client.ExecutionClient) {
+			defer wg.Done()
+			httpClient := el.Credentials().Wrap(client.NewHTTPClient(c.requestTimeout, el.TLSConfig()))
+			outcomes[i] = make([]rpcOutcome, len(c.calls))
+			timings[i] = make([]Result, len(c.calls))
+			for j, call := range c.calls {
+				start := time.Now()
+				outcomes[i][j] = callRPC(ctx, httpClient, el.RPCURL(), call)
+				timings[i][j] = Result{Check: call.name(), Start: start, Duration: time.Since(start)}
+			}
+		}(i, el)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	report := &Report{Clients: make([]ClientReport, len(clients))}
+	for i, el := range clients {
+		report.Clients[i] = ClientReport{Client: el.Name(), Type: el.Type(), Version: el.Version()}
+	}
+	for j, call := range c.calls {
+		column := make([]rpcOutcome, len(clients))
+		for i := range clients {
+			column[i] = outcomes[i][j]
+		}
+		for i, err := range compareOutcomes(column, call.CompareResults) {
+			result := timings[i][j]
+			result.Err = err
+			report.Clients[i].Results = append(report.Clients[i].Results, result)
+		}
+	}
+	return report, nil
+}
+
+// clients returns the execution clients to check
+func (c *ExecutionChecker) clients() []client.ExecutionClient {
+	if c.net.ExecutionClients() == nil {
+		return nil
+	}
+	all := c.net.ExecutionClients().Except(c.net.LateJoiners()...)
+	if len(c.clientTypes) == 0 {
+		return all
+	}
+	var clients []client.ExecutionClient
+	for _, el := range all {
+		for _, clientType := range c.clientTypes {
+			if el.Type() == clientType {
+				clients = append(clients, el)
+				break
+			}
+		}
+	}
+	return clients
+}
+
+// rpcOutcome is a client's response to one call, described for comparison
+type rpcOutcome struct {
+	// unavailable is why no JSON-RPC response was received, if none was
+	unavailable error
+	// status is "result" or "error <code>"
+	status string
+	shape  string
+	result string
+}
+
+// callRPC issues the call and describes the response
+func callRPC(ctx context.Context, httpClient *http.Client, url string, call Call) rpcOutcome {
+	if url == "" {
+		return rpcOutcome{unavailable: fmt.Errorf("RPC URL is empty")}
+	}
+	params := call.Params
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": call.Method, "params": params})
+	if err != nil {
+		return rpcOutcome{unavailable: fmt.Errorf("failed to encode request: %w", err)}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return rpcOutcome{unavailable: fmt.Errorf("failed to create request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return rpcOutcome{unavailable: fmt.Errorf("%s failed: %w", call.Method, err)}
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return rpcOutcome{unavailable: fmt.Errorf("%s: failed to read response: %w", call.Method, err)}
+	}
+
+	var rpcResp struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(data, &rpcResp); err != nil {
+		return rpcOutcome{unavailable: fmt.Errorf("%s returned status %d without a JSON-RPC response", call.Method, resp.StatusCode)}
+	}
+	if rpcResp.Error != nil {
+		return rpcOutcome{status: fmt.Sprintf("error %d", rpcResp.Error.Code)}
+	}
+
+	var result interface{}
+	if len(rpcResp.Result) > 0 {
+		if err := json.Unmarshal(rpcResp.Result, &result); err != nil {
+			return rpcOutcome{unavailable: fmt.Errorf("%s returned an invalid result: %w", call.Method, err)}
+		}
+	}
+	// Re-encoding sorts object keys; hex quantities compare case-insensitively
+	canonical, _ := json.Marshal(result)
+	return rpcOutcome{status: "result", shape: jsonShape(result), result: strings.ToLower(string(canonical))}
+}
+
+// compareOutcomes returns the divergence of each outcome from the majority:
+// first by error code, then by result shape and, if requested, by result
+func compareOutcomes(outcomes []rpcOutcome, compareResults bool) []error {
+	errs := make([]error, len(outcomes))
+	for i, outcome := range outcomes {
+		if outcome.unavailable != nil {
+			errs[i] = &Divergence{Kind: DivergenceUnavailable, Expected: "a JSON-RPC response", Got: outcome.unavailable.Error()}
+		}
+	}
+
+	compare := func(kind DivergenceKind, value func(rpcOutcome) string, applies func(rpcOutcome) bool) {
+		values := make([]string, len(outcomes))
+		for i, outcome := range outcomes {
+			if errs[i] == nil && applies(outcome) {
+				values[i] = value(outcome)
+			}
+		}
+		majority := majorityValue(values)
+		for i, v := range values {
+			if v != "" && v != majority {
+				errs[i] = &Divergence{Kind: kind, Expected: majority, Got: v}
+			}
+		}
+	}
+	isResult := func(o rpcOutcome) bool { return o.status == "result" }
+	always := func(rpcOutcome) bool { return true }
+
+	compare(DivergenceError, func(o rpcOutcome) string { return o.status }, always)
+	compare(DivergenceShape, func(o rpcOutcome) string { return o.shape }, isResult)
+	if compareResults {
+		compare(DivergenceResult, func(o rpcOutcome) string { return o.result }, isResult)
+	}
+	return errs
+}
+
+// jsonShape describes the structure of a decoded JSON value: object keys with
+// the shapes of their values, and the shape of an array's first element
+func jsonShape(v interface{}) string {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fields := make([]string, len(keys))
+		for i, key := range keys {
+			fields[i] = key + ":" + jsonShape(value[key])
+		}
+		return "{" + strings.Join(fields, ",") + "}"
+	case []interface{}:
+		if len(value) == 0 {
+			return "[]"
+		}
+		return "[" + jsonShape(value[0]) + "]"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "bool"
+	default:
+		return "null"
+	}
+}
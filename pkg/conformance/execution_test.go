@@ -0,0 +1,176 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rpcReply is a canned JSON-RPC result or error code
+type rpcReply struct {
+	result interface{}
+	code   int
+}
+
+// newRPCServer serves an execution client answering the standard calls, with
+// replies overridden by call name (method, or method and first param)
+func newRPCServer(t *testing.T, overrides map[string]rpcReply) *httptest.Server {
+	t.Helper()
+
+	block := map[string]interface{}{"number": "0x0", "hash": "0xAB", "transactions": []interface{}{}}
+	replies := map[string]rpcReply{
+		"eth_chainId":                       {result: "0x301824"},
+		"net_version":                       {result: "3151908"},
+		"web3_clientVersion":                {result: "Geth/v1.14.0"},
+		"eth_blockNumber":                   {result: "0x10"},
+		"eth_syncing":                       {result: false},
+		"eth_gasPrice":                      {result: "0x3b9aca00"},
+		"eth_feeHistory":                    {result: map[string]interface{}{"oldestBlock": "0x10", "baseFeePerGas": []string{"0x7"}}},
+		"eth_getBlockByNumber":              {result: block},
+		"eth_getBlockByNumber 0xffffffffff": {result: nil},
+		"eth_getBalance":                    {result: "0x0"},
+		"eth_getBalance 0xzz":               {code: -32602},
+		"eth_getCode":                       {result: "0x"},
+		"eth_getTransactionCount":           {result: "0x0"},
+		"eth_estimateGas":                   {result: "0x5208"},
+		"eth_doesNotExist":                  {code: -32601},
+	}
+	for name, reply := range overrides {
+		replies[name] = reply
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		reply, ok := rpcReply{}, false
+		if len(req.Params) > 0 {
+			if param, isString := req.Params[0].(string); isString {
+				reply, ok = replies[req.Method+" "+param]
+			}
+		}
+		if !ok {
+			reply, ok = replies[req.Method]
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": 1}
+		switch {
+		case !ok:
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		case reply.code != 0:
+			resp["error"] = map[string]interface{}{"code": reply.code, "message": "failed"}
+		default:
+			resp["result"] = reply.result
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newExecutionNetwork creates a network of execution clients served by the given servers
+func newExecutionNetwork(servers map[string]*httptest.Server) network.Network {
+	clients := client.NewExecutionClients()
+	for name, server := range servers {
+		clientType := client.Type(strings.Split(name, "-")[2])
+		clients.Add(client.NewExecutionClient(clientType, name, "", server.URL, "", "", "", "", name, "", 30303))
+	}
+	return network.New(network.Config{ExecutionClients: clients, OrphanOnExit: true})
+}
+
+// divergences returns the divergences of a client keyed by call name
+func divergences(t *testing.T, report *Report, name string) map[string]*Divergence {
+	t.Helper()
+	clientReport, ok := report.Client(name)
+	require.True(t, ok)
+	result := make(map[string]*Divergence)
+	for _, failure := range clientReport.Failures() {
+		var divergence *Divergence
+		require.True(t, errors.As(failure.Err, &divergence), "%s: %v", failure.Check, failure.Err)
+		result[failure.Check] = divergence
+	}
+	return result
+}
+
+func TestRunExecutionConformant(t *testing.T) {
+	net := newExecutionNetwork(map[string]*httptest.Server{
+		"el-1-geth-lighthouse": newRPCServer(t, nil),
+		"el-2-besu-teku": newRPCServer(t, map[string]rpcReply{
+			// Clients differ in versions, heads and hex case without diverging
+			"web3_clientVersion":   {result: "besu/v24.1.0"},
+			"eth_blockNumber":      {result: "0x11"},
+			"eth_getBlockByNumber": {result: map[string]interface{}{"number": "0x0", "hash": "0xab", "transactions": []interface{}{}}},
+		}),
+	})
+
+	report, err := RunExecution(context.Background(), net)
+	require.NoError(t, err)
+	assert.True(t, report.Passed(), report.String())
+	assert.Len(t, report.Clients[0].Results, len(StandardCalls()))
+}
+
+func TestRunExecutionReportsDivergences(t *testing.T) {
+	net := newExecutionNetwork(map[string]*httptest.Server{
+		"el-1-geth-lighthouse": newRPCServer(t, nil),
+		"el-2-geth-teku":       newRPCServer(t, nil),
+		"el-3-nethermind-prysm": newRPCServer(t, map[string]rpcReply{
+			"eth_getBalance 0xzz": {code: -32000},
+			"eth_feeHistory":      {result: map[string]interface{}{"oldestBlock": 16, "baseFeePerGas": []string{"0x7"}}},
+			"eth_chainId":         {result: "0x1"},
+			"eth_doesNotExist":    {result: nil},
+		}),
+	})
+
+	report, err := RunExecution(context.Background(), net)
+	require.NoError(t, err)
+	assert.False(t, report.Passed())
+	assert.Empty(t, divergences(t, report, "el-1-geth-lighthouse"))
+
+	found := divergences(t, report, "el-3-nethermind-prysm")
+	require.Len(t, found, 4, report.String())
+	assert.Equal(t, &Divergence{Kind: DivergenceError, Expected: "error -32602", Got: "error -32000"}, found["eth_getBalance_invalid_address"])
+	assert.Equal(t, &Divergence{Kind: DivergenceError, Expected: "error -32601", Got: "result"}, found["unknown_method"])
+	assert.Equal(t, DivergenceShape, found["eth_feeHistory"].Kind)
+	assert.Equal(t, &Divergence{Kind: DivergenceResult, Expected: `"0x301824"`, Got: `"0x1"`}, found["eth_chainId"])
+}
+
+func TestRunExecutionUnavailableClient(t *testing.T) {
+	down := newRPCServer(t, nil)
+	down.Close()
+	net := newExecutionNetwork(map[string]*httptest.Server{
+		"el-1-geth-lighthouse": newRPCServer(t, nil),
+		"el-2-reth-teku":       down,
+	})
+
+	report, err := NewExecutionChecker(net).
+		WithCalls(Call{Name: "eth_getBlockByNumber_one", Method: "eth_getBlockByNumber", Params: []interface{}{"0x1", false}}).
+		Run(context.Background())
+	require.NoError(t, err)
+
+	found := divergences(t, report, "el-2-reth-teku")
+	require.Len(t, found, len(StandardCalls())+1)
+	assert.Equal(t, DivergenceUnavailable, found["eth_chainId"].Kind)
+
+	_, err = NewExecutionChecker(net).WithClientTypes(client.Erigon).Run(context.Background())
+	assert.Error(t, err)
+}
+
+func TestJSONShape(t *testing.T) {
+	var v interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"b":[{"x":1}],"a":"0x1","c":null,"d":true,"e":[]}`), &v))
+	assert.Equal(t, "{a:string,b:[{x:number}],c:null,d:bool,e:[]}", jsonShape(v))
+}
@@ -11,13 +11,26 @@ import (
 )
 
 // EndpointExtractor extracts and formats endpoints from Kurtosis service information
-type EndpointExtractor struct{}
+type EndpointExtractor struct {
+	host string
+}
 
 // NewEndpointExtractor creates a new endpoint extractor
 func NewEndpointExtractor() *EndpointExtractor {
 	return &EndpointExtractor{}
 }
 
+// WithHost makes every extracted URL use host, for Docker daemons that publish
+// ports on another machine than the one Kurtosis reports. Empty keeps the
+// reported addresses.
+func (e *EndpointExtractor) WithHost(host string) *EndpointExtractor {
+	e.host = host
+	return e
+}
+
+// keymanagerPortName is the port of a validator client's keymanager API
+const keymanagerPortName = "http-validator"
+
 // ExtractExecutionEndpoints extracts all endpoints for an execution client
 func (e *EndpointExtractor) ExtractExecutionEndpoints(service *kurtosis.ServiceInfo) (*network.ExecutionEndpoints, error) {
 	endpoints := &network.ExecutionEndpoints{}
@@ -25,17 +38,18 @@ func (e *EndpointExtractor) ExtractExecutionEndpoints(service *kurtosis.ServiceI
 	for portName, portInfo := range service.Ports {
 		portNameLower := strings.ToLower(portName)
 
+		// Engine is matched first since ethereum-package names it "engine-rpc"
 		switch {
-		case strings.Contains(portNameLower, "rpc") && !strings.Contains(portNameLower, "ws"):
-			endpoints.RPCURL = e.buildURL(service, portInfo, "http")
-		case strings.Contains(portNameLower, "ws") || strings.Contains(portNameLower, "websocket"):
-			endpoints.WSURL = e.buildURL(service, portInfo, "ws")
 		case strings.Contains(portNameLower, "engine") || strings.Contains(portNameLower, "auth"):
 			endpoints.EngineURL = e.buildURL(service, portInfo, "http")
-		case strings.Contains(portNameLower, "p2p") || strings.Contains(portNameLower, "tcp"):
-			endpoints.P2PURL = e.buildURL(service, portInfo, "tcp")
+		case strings.Contains(portNameLower, "ws") || strings.Contains(portNameLower, "websocket"):
+			endpoints.WSURL = e.buildURL(service, portInfo, "ws")
+		case strings.Contains(portNameLower, "rpc"):
+			endpoints.RPCURL = e.buildURL(service, portInfo, "http")
 		case strings.Contains(portNameLower, "metrics"):
 			endpoints.MetricsURL = e.buildURL(service, portInfo, "http")
+		case isP2PPortName(portNameLower):
+			endpoints.P2PURL = e.buildURL(service, portInfo, "tcp")
 		}
 	}
 
@@ -58,12 +72,14 @@ func (e *EndpointExtractor) ExtractConsensusEndpoints(service *kurtosis.ServiceI
 		portNameLower := strings.ToLower(portName)
 
 		switch {
+		case strings.Contains(portNameLower, "metrics"):
+			endpoints.MetricsURL = e.buildURL(service, portInfo, "http")
+		case portNameLower == keymanagerPortName:
+			// The keymanager API of a validator client running in the beacon node
 		case strings.Contains(portNameLower, "beacon") || strings.Contains(portNameLower, "http"):
 			endpoints.BeaconURL = e.buildURL(service, portInfo, "http")
-		case strings.Contains(portNameLower, "p2p") || strings.Contains(portNameLower, "tcp"):
+		case isP2PPortName(portNameLower):
 			endpoints.P2PURL = e.buildURL(service, portInfo, "tcp")
-		case strings.Contains(portNameLower, "metrics"):
-			endpoints.MetricsURL = e.buildURL(service, portInfo, "http")
 		}
 	}
 
@@ -82,9 +98,14 @@ func (e *EndpointExtractor) ExtractValidatorEndpoints(service *kurtosis.ServiceI
 	for portName, portInfo := range service.Ports {
 		portNameLower := strings.ToLower(portName)
 
+		// ethereum-package names the keymanager API port "http-validator"
 		switch {
-		case strings.Contains(portNameLower, "api") || strings.Contains(portNameLower, "http"):
+		case portNameLower == keymanagerPortName:
 			endpoints.APIURL = e.buildURL(service, portInfo, "http")
+		case strings.Contains(portNameLower, "api") || strings.Contains(portNameLower, "http"):
+			if _, ok := service.Ports[keymanagerPortName]; !ok {
+				endpoints.APIURL = e.buildURL(service, portInfo, "http")
+			}
 		case strings.Contains(portNameLower, "metrics"):
 			endpoints.MetricsURL = e.buildURL(service, portInfo, "http")
 		}
@@ -97,24 +118,34 @@ func (e *EndpointExtractor) ExtractValidatorEndpoints(service *kurtosis.ServiceI
 	return endpoints, nil
 }
 
+// isP2PPortName reports whether a lowercased port name is the TCP peer-to-peer port.
+// ethereum-package names it "tcp-discovery"; UDP discovery ports are not dialable over TCP.
+func isP2PPortName(portNameLower string) bool {
+	if strings.Contains(portNameLower, "udp") {
+		return false
+	}
+	return strings.Contains(portNameLower, "p2p") || strings.Contains(portNameLower, "tcp")
+}
+
 // buildURL constructs a URL from service info and port information
 func (e *EndpointExtractor) buildURL(service *kurtosis.ServiceInfo, port kurtosis.PortInfo, scheme string) string {
+	if e.host != "" {
+		service = service.WithHost(e.host)
+		port = port.WithHost(e.host)
+	}
+
 	// Use MaybeURL if available
 	if port.MaybeURL != "" {
 		return port.MaybeURL
 	}
 
-	// Construct URL from parts
-	if service.IPAddress != "" {
-		return fmt.Sprintf("%s://%s:%d", scheme, service.IPAddress, port.Number)
-	}
-
-	// Fallback to service name or localhost
-	host := service.Name
+	// Construct URL from parts. The service name only resolves inside the
+	// enclave, so without an address the Docker daemon's host is used.
+	host := service.IPAddress
 	if host == "" {
-		host = "localhost"
+		host = kurtosis.DockerHost()
 	}
-	return fmt.Sprintf("%s://%s:%d", scheme, host, port.Number)
+	return fmt.Sprintf("%s://%s:%d", scheme, kurtosis.URLHost(host), port.Number)
 }
 
 // findFallbackEndpoint attempts to find an endpoint based on port name patterns
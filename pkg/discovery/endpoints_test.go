@@ -438,3 +438,90 @@ func TestEndpointExtractor_BuildURL(t *testing.T) {
 		})
 	}
 }
+
+func TestEndpointExtractor_BuildURLWithHost(t *testing.T) {
+	extractor := NewEndpointExtractor().WithHost("docker.example.com")
+	service := &kurtosis.ServiceInfo{IPAddress: "127.0.0.1"}
+
+	assert.Equal(t, "http://docker.example.com:8545",
+		extractor.buildURL(service, kurtosis.PortInfo{Number: 8545, MaybeURL: "http://127.0.0.1:8545"}, "http"))
+	assert.Equal(t, "ws://docker.example.com:8546",
+		extractor.buildURL(service, kurtosis.PortInfo{Number: 8546}, "ws"))
+}
+
+func TestEndpointExtractor_BuildURLRemoteDockerFallback(t *testing.T) {
+	t.Setenv("DOCKER_HOST", "tcp://10.1.2.3:2376")
+
+	url := NewEndpointExtractor().buildURL(&kurtosis.ServiceInfo{Name: "el-1-geth-lighthouse"}, kurtosis.PortInfo{Number: 8545}, "http")
+	assert.Equal(t, "http://10.1.2.3:8545", url)
+}
+
+func TestEndpointExtractor_EthereumPackagePortNames(t *testing.T) {
+	extractor := NewEndpointExtractor()
+
+	el := &kurtosis.ServiceInfo{
+		Name:      "el-1-geth-lighthouse",
+		IPAddress: "10.0.0.1",
+		Ports: map[string]kurtosis.PortInfo{
+			"rpc":           {Number: 8545},
+			"ws":            {Number: 8546},
+			"engine-rpc":    {Number: 8551},
+			"metrics":       {Number: 9001},
+			"tcp-discovery": {Number: 30303},
+			"udp-discovery": {Number: 30303},
+		},
+	}
+	elEndpoints, err := extractor.ExtractExecutionEndpoints(el)
+	require.NoError(t, err)
+	assert.Equal(t, "http://10.0.0.1:8545", elEndpoints.RPCURL)
+	assert.Equal(t, "ws://10.0.0.1:8546", elEndpoints.WSURL)
+	assert.Equal(t, "http://10.0.0.1:8551", elEndpoints.EngineURL)
+	assert.Equal(t, "http://10.0.0.1:9001", elEndpoints.MetricsURL)
+	assert.Equal(t, "tcp://10.0.0.1:30303", elEndpoints.P2PURL)
+
+	cl := &kurtosis.ServiceInfo{
+		Name:      "cl-1-lighthouse-geth",
+		IPAddress: "10.0.0.2",
+		Ports: map[string]kurtosis.PortInfo{
+			"http":          {Number: 4000},
+			"metrics":       {Number: 5054},
+			"tcp-discovery": {Number: 9000},
+			"udp-discovery": {Number: 9000},
+		},
+	}
+	clEndpoints, err := extractor.ExtractConsensusEndpoints(cl)
+	require.NoError(t, err)
+	assert.Equal(t, "http://10.0.0.2:4000", clEndpoints.BeaconURL)
+	assert.Equal(t, "http://10.0.0.2:5054", clEndpoints.MetricsURL)
+	assert.Equal(t, "tcp://10.0.0.2:9000", clEndpoints.P2PURL)
+}
+
+func TestEndpointExtractor_KeymanagerPort(t *testing.T) {
+	extractor := NewEndpointExtractor()
+
+	vc := &kurtosis.ServiceInfo{
+		Name:      "vc-1-geth-lighthouse",
+		IPAddress: "10.0.0.3",
+		Ports: map[string]kurtosis.PortInfo{
+			"http-validator": {Number: 7500},
+			"http-other":     {Number: 7600},
+			"metrics":        {Number: 8080},
+		},
+	}
+	vcEndpoints, err := extractor.ExtractValidatorEndpoints(vc)
+	require.NoError(t, err)
+	assert.Equal(t, "http://10.0.0.3:7500", vcEndpoints.APIURL)
+
+	// Teku runs its validator client in the beacon node
+	cl := &kurtosis.ServiceInfo{
+		Name:      "cl-1-teku-geth",
+		IPAddress: "10.0.0.2",
+		Ports: map[string]kurtosis.PortInfo{
+			"http":           {Number: 4000},
+			"http-validator": {Number: 7500},
+		},
+	}
+	clEndpoints, err := extractor.ExtractConsensusEndpoints(cl)
+	require.NoError(t, err)
+	assert.Equal(t, "http://10.0.0.2:4000", clEndpoints.BeaconURL)
+}
@@ -2,10 +2,15 @@ package discovery
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"io"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/ethpandaops/ethereum-package-go/pkg/artifacts"
 	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 	"github.com/ethpandaops/ethereum-package-go/pkg/config"
 	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
@@ -16,6 +21,24 @@ import (
 type ServiceMapper struct {
 	kurtosisClient kurtosis.Client
 	metadataParser *MetadataParser
+	fanoutLimit    int
+	rpcTimeout     time.Duration
+	cleanupTimeout time.Duration
+	configHash     string
+	maxRestarts    int
+	logPatterns    []network.LogPattern
+	seed           int64
+	hostOverride   string
+	tlsConfig      *tls.Config
+	credentials    *client.Credentials
+	perClientCreds map[string]*client.Credentials
+	artifactsDir   string
+	grafanaPanels  []network.GrafanaPanel
+	retention      artifacts.RetentionPolicy
+	plan           *network.Plan
+	validation     network.ValidationResults
+	readOnly       bool
+	policy         []network.Rule
 }
 
 // NewServiceMapper creates a new service mapper
@@ -23,9 +46,132 @@ func NewServiceMapper(kurtosisClient kurtosis.Client) *ServiceMapper {
 	return &ServiceMapper{
 		kurtosisClient: kurtosisClient,
 		metadataParser: NewMetadataParser(),
+		maxRestarts:    -1,
 	}
 }
 
+// WithFanoutLimit sets the concurrency limit for network-wide operations on mapped networks
+func (m *ServiceMapper) WithFanoutLimit(limit int) *ServiceMapper {
+	m.fanoutLimit = limit
+	return m
+}
+
+// WithRPCTimeout sets the per-request timeout of the mapped clients' RPC and beacon API calls
+func (m *ServiceMapper) WithRPCTimeout(timeout time.Duration) *ServiceMapper {
+	m.rpcTimeout = timeout
+	return m
+}
+
+// WithCleanupTimeout bounds how long the mapped network's Cleanup waits for the enclave to be destroyed
+func (m *ServiceMapper) WithCleanupTimeout(timeout time.Duration) *ServiceMapper {
+	m.cleanupTimeout = timeout
+	return m
+}
+
+// WithConfigHash sets the config hash reported by the mapped network
+func (m *ServiceMapper) WithConfigHash(hash string) *ServiceMapper {
+	m.configHash = hash
+	return m
+}
+
+// WithMaxRestarts sets how often a service may restart before the mapped network's
+// Health reports it. Negative values disable the check.
+func (m *ServiceMapper) WithMaxRestarts(n int) *ServiceMapper {
+	m.maxRestarts = n
+	return m
+}
+
+// WithLogHealthPatterns makes the mapped network's Health report clients whose
+// logs match the patterns as degraded. Nil disables log analysis.
+func (m *ServiceMapper) WithLogHealthPatterns(patterns []network.LogPattern) *ServiceMapper {
+	m.logPatterns = patterns
+	return m
+}
+
+// WithSeed sets the random seed of the mapped network; 0 picks one from the clock
+func (m *ServiceMapper) WithSeed(seed int64) *ServiceMapper {
+	m.seed = seed
+	return m
+}
+
+// WithHostOverride maps every published port to host instead of the address
+// Kurtosis reports, for Docker daemons on another machine. Empty keeps the
+// reported addresses.
+func (m *ServiceMapper) WithHostOverride(host string) *ServiceMapper {
+	m.hostOverride = host
+	return m
+}
+
+// WithTLSConfig sets the TLS settings the mapped clients and readiness probes use
+// for https and wss endpoints, e.g. a CA bundle for a private ingress certificate
+func (m *ServiceMapper) WithTLSConfig(tlsConfig *tls.Config) *ServiceMapper {
+	m.tlsConfig = tlsConfig
+	return m
+}
+
+// WithCredentials sets the credentials sent to every mapped client's endpoints,
+// for networks exposed through an authenticating proxy
+func (m *ServiceMapper) WithCredentials(credentials *client.Credentials) *ServiceMapper {
+	m.credentials = credentials
+	return m
+}
+
+// WithClientCredentials sets credentials per client service name; they take
+// precedence over WithCredentials
+func (m *ServiceMapper) WithClientCredentials(credentials map[string]*client.Credentials) *ServiceMapper {
+	m.perClientCreds = credentials
+	return m
+}
+
+// credentialsFor returns the credentials of the named client service
+func (m *ServiceMapper) credentialsFor(name string) *client.Credentials {
+	if credentials, ok := m.perClientCreds[name]; ok {
+		return credentials
+	}
+	return m.credentials
+}
+
+// WithArtifacts makes the mapped network render Grafana panels into dir when
+// it is cleaned up; no panels renders every dashboard
+func (m *ServiceMapper) WithArtifacts(dir string, panels []network.GrafanaPanel) *ServiceMapper {
+	m.artifactsDir = dir
+	m.grafanaPanels = panels
+	return m
+}
+
+// WithArtifactsRetention makes the mapped network prune its artifacts directory
+// after writing to it
+func (m *ServiceMapper) WithArtifactsRetention(policy artifacts.RetentionPolicy) *ServiceMapper {
+	m.retention = policy
+	return m
+}
+
+// WithPlan attaches the plan of a dry run to the mapped network
+func (m *ServiceMapper) WithPlan(plan *network.Plan) *ServiceMapper {
+	m.plan = plan
+	return m
+}
+
+// WithValidation attaches the package's validation feedback to the mapped network
+func (m *ServiceMapper) WithValidation(validation network.ValidationResults) *ServiceMapper {
+	m.validation = validation
+	return m
+}
+
+// WithReadOnly maps a network that cannot change the enclave: operations that
+// start, add or exec into services fail with network.ErrReadOnly and Cleanup
+// leaves the enclave running
+func (m *ServiceMapper) WithReadOnly() *ServiceMapper {
+	m.readOnly = true
+	return m
+}
+
+// WithPolicy makes mapped networks deny or allow classes of operations by the rules
+func (m *ServiceMapper) WithPolicy(rules ...network.Rule) *ServiceMapper {
+	m.policy = rules
+	return m
+}
+
 // MapToNetwork discovers services and creates a Network instance
 func (m *ServiceMapper) MapToNetwork(ctx context.Context, enclaveName string, cfg *config.EthereumPackageConfig, orphanOnExit bool) (network.Network, error) {
 	// Get all services from Kurtosis
@@ -34,41 +180,70 @@ func (m *ServiceMapper) MapToNetwork(ctx context.Context, enclaveName string, cf
 		return nil, fmt.Errorf("failed to get services: %w", err)
 	}
 
-	// Initialize client collections
+	// Map services concurrently into name-ordered slots so the result doesn't depend on
+	// scheduling or map iteration order
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mapped := make([]mappedService, len(names))
+	limiter := client.NewLimiter(m.fanoutLimit)
+	errs := client.FanOut(ctx, limiter, names, func(ctx context.Context, i int, name string) error {
+		mapped[i] = m.mapService(services[name], enclaveName)
+		return nil
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("failed to map services: %w", err)
+		}
+	}
+
+	// Assemble the network in name order
 	executionClients := client.NewExecutionClients()
 	consensusClients := client.NewConsensusClients()
+	validatorClients := client.NewValidatorClients()
 	var networkServices []network.Service
 	var apacheConfigServer network.ApacheConfigServer
+	var metricsExporters []*client.MetricsExporter
+	nodeTags := cfg.NodeTags()
+	validatorRanges := cfg.ValidatorRanges()
+	tags := make(map[string][]string)
+	var lateJoiners []string
+
+	for _, result := range mapped {
+		if result.execution != nil {
+			executionClients.Add(result.execution)
+		}
+		if result.consensus != nil {
+			consensusClients.Add(result.consensus)
+		}
+		if result.validator != nil {
+			name := result.service.Name
+			validatorClients.Add(client.NewValidatorClient(detectValidatorClientType(name), name, name, result.service.ContainerID,
+				result.validator.APIURL, result.validator.MetricsURL, validatorRanges[client.NodeIndex(name)].Count()))
+		}
+		if result.apache != nil {
+			apacheConfigServer = result.apache
+		}
+		if result.exporter != nil {
+			metricsExporters = append(metricsExporters, result.exporter)
+		}
 
-	// Process each service
-	for _, service := range services {
-		serviceType := m.detectServiceTypeWithPorts(service)
-
-		switch serviceType {
-		case network.ServiceTypeExecutionClient:
-			client := m.mapExecutionClient(service)
-			if client != nil {
-				executionClients.Add(client)
-			}
-
-		case network.ServiceTypeConsensusClient:
-			client := m.mapConsensusClient(service)
-			if client != nil {
-				consensusClients.Add(client)
+		// Carry participant tags over to the node's client services
+		name := result.service.Name
+		if index, _ := parseNodeInfo(name); index > 0 && len(nodeTags[index]) > 0 {
+			tags[name] = nodeTags[index]
+			for _, tag := range nodeTags[index] {
+				if tag == config.LateJoinerTag {
+					lateJoiners = append(lateJoiners, name)
+					break
+				}
 			}
-
-		case network.ServiceTypeApache:
-			apacheConfigServer = m.mapApacheConfigServer(service)
 		}
 
-		// Add to network services
-		networkServices = append(networkServices, network.Service{
-			Name:        service.Name,
-			Type:        serviceType,
-			ContainerID: service.UUID,
-			Ports:       m.convertPorts(service.Ports),
-			Status:      service.Status,
-		})
+		networkServices = append(networkServices, result.service)
 	}
 
 	// Determine chain ID from network ID
@@ -79,22 +254,120 @@ func (m *ServiceMapper) MapToNetwork(ctx context.Context, enclaveName string, cf
 		}
 	}
 
+	// Determine the consensus spec preset
+	specPreset := config.SpecPresetMainnet
+	if cfg.NetworkParams != nil && cfg.NetworkParams.Preset != "" {
+		specPreset = cfg.NetworkParams.Preset
+	}
+
 	// Create network configuration
 	networkConfig := network.Config{
-		Name:             fmt.Sprintf("ethereum-network-%s", enclaveName),
-		ChainID:          chainID,
-		EnclaveName:      enclaveName,
-		ExecutionClients: executionClients,
-		ConsensusClients: consensusClients,
-		Services:         networkServices,
-		ApacheConfig:     apacheConfigServer,
-		CleanupFunc:      m.createCleanupFunc(enclaveName),
-		OrphanOnExit:     orphanOnExit,
+		Name:                fmt.Sprintf("ethereum-network-%s", enclaveName),
+		ChainID:             chainID,
+		EnclaveName:         enclaveName,
+		SpecPreset:          specPreset,
+		ConfigHash:          m.configHash,
+		ExecutionClients:    executionClients,
+		ConsensusClients:    consensusClients,
+		ValidatorClients:    validatorClients,
+		Services:            networkServices,
+		ApacheConfig:        apacheConfigServer,
+		Tags:                tags,
+		LateJoiners:         sortLateJoiners(lateJoiners),
+		ValidatorRanges:     validatorRanges,
+		FeeRecipients:       cfg.NodeFeeRecipients(),
+		StartServiceFunc:    m.createStartServiceFunc(enclaveName),
+		StopServiceFunc:     m.createStopServiceFunc(enclaveName),
+		AddServiceFunc:      m.createAddServiceFunc(enclaveName),
+		CaptureFunc:         m.createCaptureFunc(enclaveName),
+		ReadFileFunc:        m.createReadFileFunc(enclaveName),
+		ContainerStatesFunc: m.createContainerStatesFunc(enclaveName),
+		MaxRestarts:         m.maxRestarts,
+		LogsFunc:            m.createLogsFunc(enclaveName),
+		SnapshotFunc:        m.createSnapshotFunc(enclaveName),
+		RestoreFunc:         m.createRestoreFunc(enclaveName),
+		LogHealthPatterns:   m.logPatterns,
+		FanoutLimit:         m.fanoutLimit,
+		Seed:                m.seed,
+		TLSConfig:           m.tlsConfig,
+		ProbeTimeout:        m.rpcTimeout,
+		CredentialsFunc:     m.credentialsFor,
+		ArtifactsDir:        m.artifactsDir,
+		GrafanaPanels:       m.grafanaPanels,
+		ArtifactsRetention:  m.retention,
+		Plan:                m.plan,
+		Validation:          m.validation,
+		MetricsExporters:    metricsExporters,
+		CleanupFunc:         m.createCleanupFunc(enclaveName),
+		OrphanOnExit:        orphanOnExit,
+		Policy:              network.NewPolicy(m.policy...),
+	}
+	if m.readOnly {
+		networkConfig.ReadOnly = true
+		networkConfig.StartServiceFunc = nil
+		networkConfig.StopServiceFunc = nil
+		networkConfig.AddServiceFunc = nil
+		networkConfig.CaptureFunc = nil
+		networkConfig.SnapshotFunc = nil
+		networkConfig.RestoreFunc = nil
+		networkConfig.CleanupFunc = nil
 	}
 
 	return network.New(networkConfig), nil
 }
 
+// mappedService is the result of mapping a single Kurtosis service
+type mappedService struct {
+	service   network.Service
+	execution client.ExecutionClient
+	consensus client.ConsensusClient
+	validator *network.ValidatorEndpoints
+	apache    network.ApacheConfigServer
+	exporter  *client.MetricsExporter
+}
+
+// mapService maps a single Kurtosis service. It is safe to call concurrently.
+func (m *ServiceMapper) mapService(service *kurtosis.ServiceInfo, enclaveName string) mappedService {
+	service = service.WithHost(m.hostOverride)
+	serviceType := m.detectServiceTypeWithPorts(service)
+	result := mappedService{
+		service: network.Service{
+			Name:        service.Name,
+			Type:        serviceType,
+			ContainerID: service.UUID,
+			Ports:       m.convertPorts(service.Ports),
+			Status:      service.Status,
+			URL:         kurtosis.ProbeURL(service),
+			Hostname:    service.Hostname,
+			IPAddress:   service.PrivateIPAddress,
+			// Kurtosis publishes ports on the service's public address
+			ExternalHost: service.IPAddress,
+		},
+	}
+
+	switch serviceType {
+	case network.ServiceTypeExecutionClient:
+		result.execution = m.mapExecutionClient(service, enclaveName)
+
+	case network.ServiceTypeConsensusClient:
+		result.consensus = m.mapConsensusClient(service, enclaveName)
+
+	case network.ServiceTypeValidator:
+		// Skip helper services such as validator key generation
+		if strings.HasPrefix(service.Name, "vc-") {
+			result.validator = m.mapValidator(service)
+		}
+
+	case network.ServiceTypeApache:
+		result.apache = m.mapApacheConfigServer(service)
+
+	case network.ServiceTypeMetricsExporter:
+		result.exporter = m.mapMetricsExporter(service)
+	}
+
+	return result
+}
+
 // detectServiceTypeWithPorts detects the service type based on name and ports
 func (m *ServiceMapper) detectServiceTypeWithPorts(service *kurtosis.ServiceInfo) network.ServiceType {
 	// Check by name patterns
@@ -116,57 +389,78 @@ func (m *ServiceMapper) detectServiceTypeWithPorts(service *kurtosis.ServiceInfo
 	return network.ServiceTypeOther
 }
 
-// mapExecutionClient maps a Kurtosis service to an ExecutionClient
-func (m *ServiceMapper) mapExecutionClient(service *kurtosis.ServiceInfo) client.ExecutionClient {
-	// Extract endpoints
-	extractor := NewEndpointExtractor()
-	endpoints, _ := extractor.ExtractExecutionEndpoints(service)
-
-	// Detect client type
+// mapExecutionClient maps a Kurtosis service to an ExecutionClient. Endpoints and
+// metadata are resolved on first use so large networks map quickly.
+func (m *ServiceMapper) mapExecutionClient(service *kurtosis.ServiceInfo, enclaveName string) client.ExecutionClient {
 	clientType := detectExecutionClientType(service.Name)
 
-	// Extract metadata
-	metadata, _ := m.metadataParser.ParseServiceMetadata(service)
-
-	return client.NewExecutionClient(
-		clientType,
-		service.Name,
-		metadata.Version,
-		endpoints.RPCURL,
-		endpoints.WSURL,
-		endpoints.EngineURL,
-		endpoints.MetricsURL,
-		metadata.Enode,
-		service.Name,
-		service.UUID,
-		metadata.P2PPort,
-	)
-}
-
-// mapConsensusClient maps a Kurtosis service to a ConsensusClient
-func (m *ServiceMapper) mapConsensusClient(service *kurtosis.ServiceInfo) client.ConsensusClient {
-	// Extract endpoints
-	extractor := NewEndpointExtractor()
-	endpoints, _ := extractor.ExtractConsensusEndpoints(service)
+	return client.NewLazyExecutionClient(clientType, service.Name, service.Name, service.UUID, func() client.ExecutionClient {
+		// Extract endpoints
+		extractor := NewEndpointExtractor()
+		endpoints, _ := extractor.ExtractExecutionEndpoints(service)
+
+		// Extract metadata
+		metadata, _ := m.metadataParser.ParseServiceMetadata(service)
+
+		return client.NewExecutionClient(
+			clientType,
+			service.Name,
+			metadata.Version,
+			endpoints.RPCURL,
+			endpoints.WSURL,
+			endpoints.EngineURL,
+			endpoints.MetricsURL,
+			metadata.Enode,
+			service.Name,
+			service.UUID,
+			metadata.P2PPort,
+		).WithP2PURL(endpoints.P2PURL).WithEnclave(enclaveName).WithRPCTimeout(m.rpcTimeout).WithTLSConfig(m.tlsConfig).
+			WithCredentials(m.credentialsFor(service.Name)).WithExec(m.createExecFunc(enclaveName, service.Name)).
+			WithJWTSecret(m.createJWTSecretFunc(enclaveName))
+	})
+}
 
-	// Detect client type
+// mapConsensusClient maps a Kurtosis service to a ConsensusClient. Endpoints and
+// metadata are resolved on first use so large networks map quickly.
+func (m *ServiceMapper) mapConsensusClient(service *kurtosis.ServiceInfo, enclaveName string) client.ConsensusClient {
 	clientType := detectConsensusClientType(service.Name)
 
-	// Extract metadata
-	metadata, _ := m.metadataParser.ParseServiceMetadata(service)
+	return client.NewLazyConsensusClient(clientType, service.Name, service.Name, service.UUID, func() client.ConsensusClient {
+		// Extract endpoints
+		extractor := NewEndpointExtractor()
+		endpoints, _ := extractor.ExtractConsensusEndpoints(service)
+
+		// Extract metadata
+		metadata, _ := m.metadataParser.ParseServiceMetadata(service)
+
+		return client.NewConsensusClient(
+			clientType,
+			service.Name,
+			metadata.Version,
+			endpoints.BeaconURL,
+			endpoints.MetricsURL,
+			metadata.ENR,
+			metadata.PeerID,
+			service.Name,
+			service.UUID,
+			metadata.P2PPort,
+		).WithP2PURL(endpoints.P2PURL).WithEnclave(enclaveName).WithRPCTimeout(m.rpcTimeout).WithTLSConfig(m.tlsConfig).
+			WithCredentials(m.credentialsFor(service.Name)).WithExec(m.createExecFunc(enclaveName, service.Name))
+	})
+}
+
+// mapValidator returns the endpoints of a validator client service
+func (m *ServiceMapper) mapValidator(service *kurtosis.ServiceInfo) *network.ValidatorEndpoints {
+	extractor := NewEndpointExtractor()
+	endpoints, err := extractor.ExtractValidatorEndpoints(service)
+	if err != nil {
+		// Validators only expose an API when the keymanager is enabled
+		endpoints = &network.ValidatorEndpoints{
+			MetricsURL: extractor.findFallbackEndpoint(service, []string{"metrics"}, "http"),
+		}
+	}
 
-	return client.NewConsensusClient(
-		clientType,
-		service.Name,
-		metadata.Version,
-		endpoints.BeaconURL,
-		endpoints.MetricsURL,
-		metadata.ENR,
-		metadata.PeerID,
-		service.Name,
-		service.UUID,
-		metadata.P2PPort,
-	)
+	return endpoints
 }
 
 // mapApacheConfigServer maps a Kurtosis service to an ApacheConfigServer
@@ -174,38 +468,175 @@ func (m *ServiceMapper) mapApacheConfigServer(service *kurtosis.ServiceInfo) net
 	// Find the HTTP port
 	for portName, port := range service.Ports {
 		if strings.Contains(portName, "http") || portName == "http" {
-			url := fmt.Sprintf("http://%s:%d", service.IPAddress, port.Number)
+			url := fmt.Sprintf("http://%s:%d", kurtosis.URLHost(service.IPAddress), port.Number)
 			return network.NewApacheConfigServer(url)
 		}
 	}
 
 	// Fallback to default port
-	url := fmt.Sprintf("http://%s:80", service.IPAddress)
+	url := fmt.Sprintf("http://%s:80", kurtosis.URLHost(service.IPAddress))
 	return network.NewApacheConfigServer(url)
 }
 
+// mapMetricsExporter maps a Kurtosis service to an ethereum-metrics-exporter client
+func (m *ServiceMapper) mapMetricsExporter(service *kurtosis.ServiceInfo) *client.MetricsExporter {
+	extractor := NewEndpointExtractor()
+	url := extractor.findFallbackEndpoint(service, []string{"metrics", "http"}, "http")
+	return client.NewMetricsExporter(service.Name, url).WithRPCTimeout(m.rpcTimeout).WithTLSConfig(m.tlsConfig).
+		WithCredentials(m.credentialsFor(service.Name))
+}
+
 // convertPorts converts Kurtosis ports to network Port types
 func (m *ServiceMapper) convertPorts(ports map[string]kurtosis.PortInfo) []network.Port {
 	var result []network.Port
 	for name, port := range ports {
+		internal := port.PrivateNumber
+		if internal == 0 {
+			internal = port.Number
+		}
 		result = append(result, network.Port{
 			Name:          name,
-			InternalPort:  int(port.Number),
+			InternalPort:  int(internal),
 			ExternalPort:  int(port.Number),
 			Protocol:      port.Protocol,
 			ExposedToHost: true, // Assume exposed for now
 		})
 	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
 	return result
 }
 
 // createCleanupFunc creates a cleanup function for the network
 func (m *ServiceMapper) createCleanupFunc(enclaveName string) func(context.Context) error {
 	return func(ctx context.Context) error {
+		if m.cleanupTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, m.cleanupTimeout)
+			defer cancel()
+		}
 		return m.kurtosisClient.DestroyEnclave(ctx, enclaveName)
 	}
 }
 
+// createStartServiceFunc creates a function that starts a stopped service in the enclave
+func (m *ServiceMapper) createStartServiceFunc(enclaveName string) func(context.Context, string) error {
+	return func(ctx context.Context, serviceName string) error {
+		return m.kurtosisClient.StartService(ctx, enclaveName, serviceName)
+	}
+}
+
+// createStopServiceFunc creates a function that stops a running service in the enclave
+func (m *ServiceMapper) createStopServiceFunc(enclaveName string) func(context.Context, string) error {
+	return func(ctx context.Context, serviceName string) error {
+		return m.kurtosisClient.StopService(ctx, enclaveName, serviceName)
+	}
+}
+
+// createAddServiceFunc creates a function that deploys an extra container into the enclave
+func (m *ServiceMapper) createAddServiceFunc(enclaveName string) func(context.Context, network.ServiceSpec) (network.Service, error) {
+	return func(ctx context.Context, spec network.ServiceSpec) (network.Service, error) {
+		info, err := m.kurtosisClient.AddService(ctx, enclaveName, spec)
+		if err != nil {
+			return network.Service{}, err
+		}
+		service := m.mapService(info, enclaveName).service
+		// Detect by name only, ports would mistake e.g. an explorer's http port for a beacon API
+		service.Type = detectServiceType(info.Name)
+		return service, nil
+	}
+}
+
+// createCaptureFunc creates a function that captures the traffic of a service in the enclave
+func (m *ServiceMapper) createCaptureFunc(enclaveName string) func(context.Context, string, time.Duration, io.Writer) error {
+	return func(ctx context.Context, serviceName string, d time.Duration, w io.Writer) error {
+		return m.kurtosisClient.CapturePackets(ctx, enclaveName, serviceName, d, w)
+	}
+}
+
+// createReadFileFunc creates a function that reads files from the enclave's service containers
+func (m *ServiceMapper) createReadFileFunc(enclaveName string) func(context.Context, string, string) ([]byte, error) {
+	return func(ctx context.Context, serviceName, path string) ([]byte, error) {
+		return m.kurtosisClient.ReadFile(ctx, enclaveName, serviceName, path)
+	}
+}
+
+// createExecFunc creates a function that runs commands in a service's container.
+// Read-only networks refuse them, as commands can change the service's files.
+func (m *ServiceMapper) createExecFunc(enclaveName, serviceName string) client.ExecFunc {
+	readOnly := m.readOnly
+	return func(ctx context.Context, cmd []string) (int, string, string, error) {
+		if readOnly {
+			return 0, "", "", fmt.Errorf("%w: Exec is disabled", network.ErrReadOnly)
+		}
+		return m.kurtosisClient.ExecCommand(ctx, enclaveName, serviceName, cmd)
+	}
+}
+
+// createJWTSecretFunc creates a function that reads the enclave's engine API JWT secret
+func (m *ServiceMapper) createJWTSecretFunc(enclaveName string) func(context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		secret, err := m.kurtosisClient.ReadArtifactFile(ctx, enclaveName, config.JWTSecretArtifact, config.JWTSecretFile)
+		if err != nil {
+			return "", err
+		}
+		return string(secret), nil
+	}
+}
+
+// createContainerStatesFunc creates a function that inspects the enclave's service containers
+func (m *ServiceMapper) createContainerStatesFunc(enclaveName string) func(context.Context) (map[string]network.ContainerState, error) {
+	return func(ctx context.Context) (map[string]network.ContainerState, error) {
+		return m.kurtosisClient.ContainerStates(ctx, enclaveName)
+	}
+}
+
+// createLogsFunc creates a function that tails the logs of the enclave's services
+func (m *ServiceMapper) createLogsFunc(enclaveName string) func(context.Context, string, int) ([]string, error) {
+	return func(ctx context.Context, serviceName string, lines int) ([]string, error) {
+		return m.kurtosisClient.ServiceLogs(ctx, enclaveName, serviceName, lines)
+	}
+}
+
+// createSnapshotFunc creates a function that saves service directories of the
+// enclave as files artifacts
+func (m *ServiceMapper) createSnapshotFunc(enclaveName string) func(context.Context, []network.ServiceDir) error {
+	return func(ctx context.Context, dirs []network.ServiceDir) error {
+		return m.kurtosisClient.SnapshotServices(ctx, enclaveName, dirs)
+	}
+}
+
+// createRestoreFunc creates a function that restores service directories of
+// the enclave from their files artifacts
+func (m *ServiceMapper) createRestoreFunc(enclaveName string) func(context.Context, []network.ServiceDir) error {
+	return func(ctx context.Context, dirs []network.ServiceDir) error {
+		return m.kurtosisClient.RestoreServices(ctx, enclaveName, dirs)
+	}
+}
+
+// sortLateJoiners orders late-joiner services so execution clients start before
+// consensus clients, and consensus clients before validators
+func sortLateJoiners(names []string) []string {
+	rank := func(name string) int {
+		switch {
+		case strings.HasPrefix(name, "el-"):
+			return 0
+		case strings.HasPrefix(name, "cl-"):
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if rank(names[i]) != rank(names[j]) {
+			return rank(names[i]) < rank(names[j])
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
 // detectExecutionClientType detects the execution client type from the service name
 func detectExecutionClientType(name string) client.Type {
 	nameLower := strings.ToLower(name)
@@ -248,71 +679,20 @@ func detectConsensusClientType(name string) client.Type {
 	}
 }
 
-// detectServiceType detects the service type from the service name
-func detectServiceType(name string) network.ServiceType {
-	nameLower := strings.ToLower(name)
-
-	// Check for validator services first (most specific)
-	if strings.Contains(nameLower, "validator-key-generation") ||
-		strings.HasPrefix(nameLower, "vc-") ||
-		(strings.Contains(nameLower, "validator") && !strings.HasPrefix(nameLower, "cl-") && !strings.HasPrefix(nameLower, "el-")) {
-		return network.ServiceTypeValidator
-	}
-
-	// Check for consensus clients (cl- prefix takes precedence)
-	if strings.HasPrefix(nameLower, "cl-") || strings.Contains(nameLower, "beacon") {
-		return network.ServiceTypeConsensusClient
-	}
-
-	// Check for execution clients
-	if strings.HasPrefix(nameLower, "el-") || strings.Contains(nameLower, "execution") {
-		return network.ServiceTypeExecutionClient
-	}
-
-	// Check by client name patterns (only if no prefix found)
-	if !strings.Contains(nameLower, "-") ||
-		(!strings.HasPrefix(nameLower, "cl-") && !strings.HasPrefix(nameLower, "el-")) {
-		// Execution clients
-		if strings.Contains(nameLower, "geth") ||
-			strings.Contains(nameLower, "besu") ||
-			strings.Contains(nameLower, "nethermind") ||
-			strings.Contains(nameLower, "erigon") ||
-			strings.Contains(nameLower, "reth") {
-			return network.ServiceTypeExecutionClient
+// detectValidatorClientType detects the validator client type from the service
+// name. ethereum-package appends the validator client to the name when it differs
+// from the consensus client, e.g. vc-1-geth-lighthouse-teku, so the last match wins.
+func detectValidatorClientType(name string) client.Type {
+	parts := strings.Split(name, "-")
+	for i := len(parts) - 1; i >= 0; i-- {
+		if clientType := detectConsensusClientType(parts[i]); clientType != client.Unknown {
+			return clientType
 		}
-
-		// Consensus clients
-		if strings.Contains(nameLower, "lighthouse") ||
-			strings.Contains(nameLower, "teku") ||
-			strings.Contains(nameLower, "prysm") ||
-			strings.Contains(nameLower, "nimbus") ||
-			strings.Contains(nameLower, "lodestar") ||
-			strings.Contains(nameLower, "grandine") {
-			return network.ServiceTypeConsensusClient
-		}
-	}
-
-	// Validator check already done above, skip duplicate
-
-	// Check for other services
-	if strings.Contains(nameLower, "prometheus") {
-		return network.ServiceTypePrometheus
-	}
-	if strings.Contains(nameLower, "grafana") {
-		return network.ServiceTypeGrafana
-	}
-	if strings.Contains(nameLower, "blockscout") {
-		return network.ServiceTypeBlockscout
-	}
-	if strings.Contains(nameLower, "dora") {
-		return network.ServiceTypeDora
-	}
-	if strings.Contains(nameLower, "apache") {
-		return network.ServiceTypeApache
-	}
-	if strings.Contains(nameLower, "spamoor") {
-		return network.ServiceTypeSpamoor
 	}
+	return client.Unknown
+}
 
-	return network.ServiceTypeOther
+// detectServiceType detects the service type from the service name
+func detectServiceType(name string) network.ServiceType {
+	return network.DetectServiceType(name)
 }
@@ -2,11 +2,14 @@ package discovery
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 	"github.com/ethpandaops/ethereum-package-go/pkg/config"
 	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
 	"github.com/ethpandaops/ethereum-package-go/test/helpers"
 	"github.com/ethpandaops/ethereum-package-go/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -43,6 +46,7 @@ func TestServiceMapper_MapToNetwork(t *testing.T) {
 	// Verify network properties
 	assert.Equal(t, uint64(12345), networkObj.ChainID())
 	assert.Equal(t, "test-enclave", networkObj.EnclaveName())
+	assert.Equal(t, config.SpecPresetMainnet, networkObj.SpecPreset())
 
 	// Verify clients were discovered
 	execClients := networkObj.ExecutionClients().All()
@@ -279,3 +283,492 @@ func TestServiceMapper_MultipleClientTypes(t *testing.T) {
 	assert.Contains(t, consNames, "cl-1-lighthouse-geth")
 	assert.Contains(t, consNames, "cl-2-teku-besu")
 }
+
+func TestServiceMapper_MapToNetworkSpecPreset(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{}, nil
+	}
+
+	ethConfig := &config.EthereumPackageConfig{
+		NetworkParams: &config.NetworkParams{Preset: config.SpecPresetMinimal},
+	}
+
+	networkObj, err := NewServiceMapper(mockClient).MapToNetwork(context.Background(), "test-enclave", ethConfig, true)
+	require.NoError(t, err)
+	assert.Equal(t, config.SpecPresetMinimal, networkObj.SpecPreset())
+	assert.Equal(t, uint64(8), networkObj.SpecPreset().SlotsPerEpoch())
+}
+
+func TestServiceMapper_MapToNetworkTags(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{
+			"el-1-geth-lighthouse": {
+				Name:  "el-1-geth-lighthouse",
+				Ports: map[string]kurtosis.PortInfo{"rpc": {Number: 8545, MaybeURL: "http://10.0.0.1:8545"}},
+			},
+			"cl-1-lighthouse-geth": {
+				Name:  "cl-1-lighthouse-geth",
+				Ports: map[string]kurtosis.PortInfo{"http": {Number: 4000, MaybeURL: "http://10.0.0.2:4000"}},
+			},
+			"el-2-besu-teku": {
+				Name:  "el-2-besu-teku",
+				Ports: map[string]kurtosis.PortInfo{"rpc": {Number: 8545, MaybeURL: "http://10.0.0.3:8545"}},
+			},
+		}, nil
+	}
+
+	ethConfig := &config.EthereumPackageConfig{
+		Participants: []config.ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse, Tags: []string{"bootnode", "supernode"}},
+			{ELType: client.Besu, CLType: client.Teku},
+		},
+	}
+
+	networkObj, err := NewServiceMapper(mockClient).MapToNetwork(context.Background(), "test-enclave", ethConfig, true)
+	require.NoError(t, err)
+
+	bootnodes := networkObj.ClientsByTag("bootnode")
+	require.Len(t, bootnodes.Execution, 1)
+	require.Len(t, bootnodes.Consensus, 1)
+	assert.Equal(t, "el-1-geth-lighthouse", bootnodes.Execution[0].Name())
+	assert.Equal(t, "cl-1-lighthouse-geth", bootnodes.Consensus[0].Name())
+
+	assert.Equal(t, 0, networkObj.ClientsByTag("late-joiner").Len())
+	assert.Equal(t, []string{"bootnode", "supernode"}, networkObj.Tags("cl-1-lighthouse-geth"))
+	assert.Empty(t, networkObj.Tags("el-2-besu-teku"))
+}
+
+func TestServiceMapper_MapToNetworkEndpoints(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{
+			"el-1-geth-lighthouse": {
+				Name:      "el-1-geth-lighthouse",
+				IPAddress: "10.0.0.1",
+				Ports: map[string]kurtosis.PortInfo{
+					"rpc":           {Number: 8545},
+					"engine-rpc":    {Number: 8551},
+					"metrics":       {Number: 9001},
+					"tcp-discovery": {Number: 30303},
+				},
+			},
+			"cl-1-lighthouse-geth": {
+				Name:      "cl-1-lighthouse-geth",
+				IPAddress: "10.0.0.2",
+				Ports: map[string]kurtosis.PortInfo{
+					"http":          {Number: 4000},
+					"metrics":       {Number: 5054},
+					"tcp-discovery": {Number: 9000},
+				},
+			},
+		}, nil
+	}
+
+	networkObj, err := NewServiceMapper(mockClient).MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+
+	el := networkObj.ExecutionClients().All()
+	require.Len(t, el, 1)
+	assert.Equal(t, "http://10.0.0.1:8545", el[0].RPCURL())
+	assert.Equal(t, "http://10.0.0.1:8551", el[0].EngineURL())
+	assert.Equal(t, "http://10.0.0.1:9001", el[0].MetricsURL())
+	assert.Equal(t, "tcp://10.0.0.1:30303", el[0].P2PURL())
+	assert.Equal(t, 30303, el[0].P2PPort())
+
+	cl := networkObj.ConsensusClients().All()
+	require.Len(t, cl, 1)
+	assert.Equal(t, "http://10.0.0.2:4000", cl[0].BeaconAPIURL())
+	assert.Equal(t, "http://10.0.0.2:5054", cl[0].MetricsURL())
+	assert.Equal(t, "tcp://10.0.0.2:9000", cl[0].P2PURL())
+	assert.Equal(t, 9000, cl[0].P2PPort())
+}
+
+func TestServiceMapper_MapToNetworkHostOverride(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{
+			"el-1-geth-lighthouse": {
+				Name:      "el-1-geth-lighthouse",
+				IPAddress: "127.0.0.1",
+				Ports: map[string]kurtosis.PortInfo{
+					"rpc":     {Number: 32770, MaybeURL: "http://127.0.0.1:32770"},
+					"metrics": {Number: 32771},
+				},
+			},
+		}, nil
+	}
+
+	networkObj, err := NewServiceMapper(mockClient).
+		WithHostOverride("docker.example.com").
+		MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+
+	el := networkObj.ExecutionClients().All()
+	require.Len(t, el, 1)
+	assert.Equal(t, "http://docker.example.com:32770", el[0].RPCURL())
+	assert.Equal(t, "http://docker.example.com:32771", el[0].MetricsURL())
+
+	services := networkObj.Services()
+	require.Len(t, services, 1)
+	assert.Equal(t, "docker.example.com", services[0].ExternalHost)
+}
+
+func TestServiceMapper_MapToNetworkCredentials(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{
+			"el-1-geth-lighthouse": {
+				Name:      "el-1-geth-lighthouse",
+				IPAddress: "127.0.0.1",
+				Ports:     map[string]kurtosis.PortInfo{"rpc": {Number: 32770}},
+			},
+			"cl-1-lighthouse-geth": {
+				Name:      "cl-1-lighthouse-geth",
+				IPAddress: "127.0.0.1",
+				Ports:     map[string]kurtosis.PortInfo{"http": {Number: 32771}},
+			},
+			"ethereum-metrics-exporter-1-lighthouse-geth": {
+				Name:      "ethereum-metrics-exporter-1-lighthouse-geth",
+				IPAddress: "127.0.0.1",
+				Ports:     map[string]kurtosis.PortInfo{"http": {Number: 32790}},
+			},
+		}, nil
+	}
+
+	shared := client.BearerToken("shared")
+	beacon := client.BasicAuth("beacon", "secret")
+	networkObj, err := NewServiceMapper(mockClient).
+		WithCredentials(shared).
+		WithClientCredentials(map[string]*client.Credentials{"cl-1-lighthouse-geth": beacon}).
+		MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+
+	el := networkObj.ExecutionClients().All()
+	require.Len(t, el, 1)
+	assert.Same(t, shared, el[0].Credentials())
+
+	cl := networkObj.ConsensusClients().All()
+	require.Len(t, cl, 1)
+	assert.Same(t, beacon, cl[0].Credentials())
+
+	exporters := networkObj.MetricsExporters()
+	require.Len(t, exporters, 1)
+	assert.Same(t, shared, exporters[0].Credentials())
+}
+
+func TestServiceMapper_MapToNetworkMetricsExporter(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{
+			"el-1-geth-lighthouse": {
+				Name:      "el-1-geth-lighthouse",
+				IPAddress: "127.0.0.1",
+				Ports:     map[string]kurtosis.PortInfo{"rpc": {Number: 32770}},
+			},
+			"ethereum-metrics-exporter-1-lighthouse-geth": {
+				Name:      "ethereum-metrics-exporter-1-lighthouse-geth",
+				IPAddress: "127.0.0.1",
+				Ports:     map[string]kurtosis.PortInfo{"http": {Number: 32790}},
+			},
+		}, nil
+	}
+
+	networkObj, err := NewServiceMapper(mockClient).
+		MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+
+	// The exporter carries its node's client names but is not a client
+	assert.Len(t, networkObj.ExecutionClients().All(), 1)
+	exporters := networkObj.MetricsExporters()
+	require.Len(t, exporters, 1)
+	assert.Equal(t, "ethereum-metrics-exporter-1-lighthouse-geth", exporters[0].Name())
+	assert.Equal(t, "http://127.0.0.1:32790/metrics", exporters[0].MetricsURL())
+}
+
+func TestServiceMapper_MapToNetworkInternalAddresses(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{
+			"el-1-geth-lighthouse": {
+				Name:             "el-1-geth-lighthouse",
+				IPAddress:        "127.0.0.1",
+				PrivateIPAddress: "172.16.0.5",
+				Hostname:         "el-1-geth-lighthouse",
+				Ports: map[string]kurtosis.PortInfo{
+					"rpc":           {Number: 32771, PrivateNumber: 8545},
+					"ws":            {Number: 32772, PrivateNumber: 8546},
+					"tcp-discovery": {Number: 32773, PrivateNumber: 30303, Protocol: "TCP"},
+				},
+			},
+		}, nil
+	}
+
+	networkObj, err := NewServiceMapper(mockClient).MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+
+	services := networkObj.Services()
+	require.Len(t, services, 1)
+	assert.Equal(t, "172.16.0.5", services[0].IPAddress)
+
+	rpcURL, err := networkObj.InternalURL("el-1-geth-lighthouse", "rpc")
+	require.NoError(t, err)
+	assert.Equal(t, "http://el-1-geth-lighthouse:8545", rpcURL)
+	wsURL, err := networkObj.InternalURL("el-1-geth-lighthouse", "ws")
+	require.NoError(t, err)
+	assert.Equal(t, "ws://el-1-geth-lighthouse:8546", wsURL)
+	p2pURL, err := networkObj.InternalURL("el-1-geth-lighthouse", "tcp-discovery")
+	require.NoError(t, err)
+	assert.Equal(t, "tcp://el-1-geth-lighthouse:30303", p2pURL)
+
+	_, err = networkObj.InternalURL("el-1-geth-lighthouse", "engine-rpc")
+	assert.Error(t, err)
+	_, err = networkObj.InternalURL("el-9-geth-lighthouse", "rpc")
+	assert.Error(t, err)
+}
+
+func TestServiceMapper_MapToNetworkAddService(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{}, nil
+	}
+	mockClient.AddServiceFunc = func(ctx context.Context, enclaveName string, spec network.ServiceSpec) (*kurtosis.ServiceInfo, error) {
+		assert.Equal(t, "test-enclave", enclaveName)
+		return &kurtosis.ServiceInfo{
+			Name:             spec.Name,
+			UUID:             "uuid-" + spec.Name,
+			Status:           "RUNNING",
+			IPAddress:        "127.0.0.1",
+			PrivateIPAddress: "172.16.0.20",
+			Hostname:         spec.Name,
+			Ports: map[string]kurtosis.PortInfo{
+				"http": {Number: 32800, PrivateNumber: 4000, Protocol: "TCP"},
+			},
+		}, nil
+	}
+
+	networkObj, err := NewServiceMapper(mockClient).MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+	require.Empty(t, networkObj.Services())
+
+	service, err := networkObj.AddService(context.Background(), network.ServiceSpec{
+		Name:  "blockscout",
+		Image: "blockscout/blockscout:latest",
+		Ports: []network.Port{{Name: "http", InternalPort: 4000}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockClient.CallCount["AddService"])
+	assert.Equal(t, network.ServiceTypeBlockscout, service.Type)
+	assert.Len(t, networkObj.Services(), 1)
+
+	internalURL, err := service.InternalURL("http")
+	require.NoError(t, err)
+	assert.Equal(t, "http://blockscout:4000", internalURL)
+	externalURL, err := service.ExternalURL("http")
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:32800", externalURL)
+}
+
+func TestServiceMapper_MapToNetworkExec(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	services := helpers.NewTestServiceBuilder().CreateDefaultServices()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return services, nil
+	}
+	var execs []string
+	mockClient.ExecCommandFunc = func(ctx context.Context, enclaveName, serviceName string, cmd []string) (int, string, string, error) {
+		assert.Equal(t, "test-enclave", enclaveName)
+		execs = append(execs, serviceName+": "+strings.Join(cmd, " "))
+		return 1, "", "du: /data/missing: No such file or directory", nil
+	}
+
+	networkObj, err := NewServiceMapper(mockClient).MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+	el := networkObj.ExecutionClients().All()[0]
+	cl := networkObj.ConsensusClients().All()[0]
+
+	exitCode, stdout, stderr, err := el.Exec(context.Background(), []string{"du", "-sh", "/data/missing"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, exitCode)
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "No such file or directory")
+	_, _, _, err = cl.Exec(context.Background(), []string{"ls", "/data"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{el.ServiceName() + ": du -sh /data/missing", cl.ServiceName() + ": ls /data"}, execs)
+
+	// Read-only networks refuse commands, as they can change files
+	networkObj, err = NewServiceMapper(mockClient).WithReadOnly().MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+	_, _, _, err = networkObj.ExecutionClients().All()[0].Exec(context.Background(), []string{"ls"})
+	assert.ErrorIs(t, err, network.ErrReadOnly)
+	assert.Len(t, execs, 2)
+}
+
+func TestServiceMapper_MapToNetworkJWTSecret(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	services := helpers.NewTestServiceBuilder().CreateDefaultServices()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return services, nil
+	}
+
+	networkObj, err := NewServiceMapper(mockClient).MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+
+	secret, err := networkObj.JWTSecret(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, mocks.MockJWTSecret, secret)
+	secret, err = networkObj.ExecutionClients().All()[0].JWTSecret(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, mocks.MockJWTSecret, secret)
+
+	mockClient.ReadArtifactFileFunc = func(ctx context.Context, enclaveName, artifactName, filePath string) ([]byte, error) {
+		return nil, fmt.Errorf("%w: %s", kurtosis.ErrArtifactFileNotFound, filePath)
+	}
+	_, err = networkObj.JWTSecret(context.Background())
+	assert.ErrorIs(t, err, kurtosis.ErrArtifactFileNotFound)
+}
+
+func TestServiceMapper_MapToNetworkValidators(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{
+			"vc-2-teku-besu": {
+				Name:      "vc-2-teku-besu",
+				UUID:      "uuid-vc-2",
+				IPAddress: "10.0.0.4",
+				Ports: map[string]kurtosis.PortInfo{
+					"metrics": {Number: 8080},
+				},
+			},
+			"vc-1-geth-lighthouse": {
+				Name:      "vc-1-geth-lighthouse",
+				UUID:      "uuid-vc-1",
+				IPAddress: "10.0.0.3",
+				Ports: map[string]kurtosis.PortInfo{
+					"http-validator": {Number: 5056},
+					"metrics":        {Number: 8080},
+				},
+			},
+			"validator-key-generation-cl-validator-keystore": {
+				Name: "validator-key-generation-cl-validator-keystore",
+			},
+		}, nil
+	}
+
+	cfg := &config.EthereumPackageConfig{
+		Participants: []config.ParticipantConfig{
+			{ELType: client.Geth, CLType: client.Lighthouse, Count: 1, ValidatorCount: 64},
+			{ELType: client.Besu, CLType: client.Teku, Count: 1, ValidatorCount: 32},
+		},
+	}
+	networkObj, err := NewServiceMapper(mockClient).MapToNetwork(context.Background(), "test-enclave", cfg, true)
+	require.NoError(t, err)
+
+	validatorClients := networkObj.ValidatorClients()
+	require.Equal(t, 2, validatorClients.Count())
+	assert.Equal(t, 96, validatorClients.KeyCount())
+	vc, ok := validatorClients.ByIndex(1)
+	require.True(t, ok)
+	assert.Equal(t, client.Lighthouse, vc.Type())
+	assert.Equal(t, "http://10.0.0.3:5056", vc.APIURL())
+	assert.Equal(t, 64, vc.KeyCount())
+	vc, ok = validatorClients.ByName("vc-2-teku-besu")
+	require.True(t, ok)
+	assert.Equal(t, client.Teku, vc.Type())
+	assert.Equal(t, 32, vc.KeyCount())
+
+	validators := networkObj.Validators()
+	require.Len(t, validators, 2)
+	assert.Equal(t, "vc-1-geth-lighthouse", validators[0].Name())
+	assert.Equal(t, "http://10.0.0.3:5056", validators[0].APIURL())
+	assert.Equal(t, "http://10.0.0.3:8080", validators[0].MetricsURL())
+	assert.Equal(t, "uuid-vc-1", validators[0].ContainerID())
+
+	// Without a keymanager API only metrics are exposed
+	assert.Equal(t, "vc-2-teku-besu", validators[1].Name())
+	assert.Empty(t, validators[1].APIURL())
+	assert.Equal(t, "http://10.0.0.4:8080", validators[1].MetricsURL())
+}
+
+// largeEnclave returns the services of an n-node enclave
+func largeEnclave(n int) map[string]*kurtosis.ServiceInfo {
+	services := make(map[string]*kurtosis.ServiceInfo)
+	for i := 1; i <= n; i++ {
+		for _, name := range []string{
+			fmt.Sprintf("el-%d-geth-lighthouse", i),
+			fmt.Sprintf("cl-%d-lighthouse-geth", i),
+			fmt.Sprintf("vc-%d-geth-lighthouse", i),
+		} {
+			services[name] = &kurtosis.ServiceInfo{
+				Name:      name,
+				UUID:      "uuid-" + name,
+				Status:    "running",
+				IPAddress: fmt.Sprintf("10.0.%d.%d", i/250, i%250),
+				Ports: map[string]kurtosis.PortInfo{
+					"rpc":     {Number: 8545},
+					"http":    {Number: 4000},
+					"metrics": {Number: 9001},
+				},
+			}
+		}
+	}
+	return services
+}
+
+func TestServiceMapper_MapToNetworkDeterministic(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return largeEnclave(12), nil
+	}
+	mapper := NewServiceMapper(mockClient).WithFanoutLimit(4)
+
+	first, err := mapper.MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+	require.NoError(t, err)
+	require.Len(t, first.Services(), 36)
+	assert.Len(t, first.ExecutionClients().All(), 12)
+	assert.Len(t, first.ConsensusClients().All(), 12)
+	assert.Len(t, first.Validators(), 12)
+
+	for i := 0; i < 5; i++ {
+		again, err := mapper.MapToNetwork(context.Background(), "test-enclave", &config.EthereumPackageConfig{}, true)
+		require.NoError(t, err)
+		assert.Equal(t, first.Services(), again.Services())
+	}
+}
+
+func TestServiceMapper_MapToNetworkCanceled(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return largeEnclave(2), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewServiceMapper(mockClient).MapToNetwork(ctx, "test-enclave", &config.EthereumPackageConfig{}, true)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func BenchmarkServiceMapper_MapToNetwork(b *testing.B) {
+	mockClient := mocks.NewMockKurtosisClient()
+	services := largeEnclave(100)
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return services, nil
+	}
+	mapper := NewServiceMapper(mockClient)
+	cfg := &config.EthereumPackageConfig{}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mapper.MapToNetwork(context.Background(), "bench-enclave", cfg, true); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestDetectValidatorClientType(t *testing.T) {
+	assert.Equal(t, client.Lighthouse, detectValidatorClientType("vc-1-geth-lighthouse"))
+	assert.Equal(t, client.Teku, detectValidatorClientType("vc-1-geth-lighthouse-teku"))
+	assert.Equal(t, client.Unknown, detectValidatorClientType("vc-1-geth"))
+}
@@ -12,6 +12,13 @@ import (
 	"github.com/ethpandaops/ethereum-package-go/pkg/network"
 )
 
+var (
+	// nodeInfoPattern matches node service names such as el-1-geth-lighthouse
+	nodeInfoPattern = regexp.MustCompile(`^(el|cl|vc)-(\d+)-(.+)$`)
+	// validatorInfoPattern matches validator service names such as validator-5-10
+	validatorInfoPattern = regexp.MustCompile(`validator-(\d+)-(\d+)`)
+)
+
 // MetadataParser parses service metadata and extracts useful information
 type MetadataParser struct {
 	endpointExtractor *EndpointExtractor
@@ -85,7 +92,7 @@ func extractClientSpecificMetadata(metadata *network.ServiceMetadata, service *k
 
 	// Extract P2P port
 	for portName, portInfo := range service.Ports {
-		if strings.Contains(strings.ToLower(portName), "p2p") {
+		if isP2PPortName(strings.ToLower(portName)) {
 			metadata.P2PPort = int(portInfo.Number)
 			break
 		}
@@ -102,9 +109,8 @@ func extractClientSpecificMetadata(metadata *network.ServiceMetadata, service *k
 
 // parseNodeInfo extracts node index and name from service name
 func parseNodeInfo(serviceName string) (int, string) {
-	// Pattern: el-1-geth-lighthouse, cl-2-teku-geth, etc.
-	re := regexp.MustCompile(`^(el|cl)-(\d+)-(.+)$`)
-	matches := re.FindStringSubmatch(serviceName)
+	// Pattern: el-1-geth-lighthouse, cl-2-teku-geth, vc-1-geth-lighthouse, etc.
+	matches := nodeInfoPattern.FindStringSubmatch(serviceName)
 
 	if len(matches) >= 4 {
 		index, _ := strconv.Atoi(matches[2])
@@ -121,8 +127,7 @@ func parseValidatorInfo(service *kurtosis.ServiceInfo) (int, int) {
 	// Will try to parse from service name instead
 
 	// Try to parse from service name (e.g., "validator-5-10" means 5 validators starting at index 10)
-	re := regexp.MustCompile(`validator-(\d+)-(\d+)`)
-	if matches := re.FindStringSubmatch(service.Name); len(matches) >= 3 {
+	if matches := validatorInfoPattern.FindStringSubmatch(service.Name); len(matches) >= 3 {
 		count, _ := strconv.Atoi(matches[1])
 		startIndex, _ := strconv.Atoi(matches[2])
 		return count, startIndex
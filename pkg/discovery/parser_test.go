@@ -187,6 +187,12 @@ func TestMetadataParser_ParseNodeInfo(t *testing.T) {
 			expectedIndex: 10,
 			expectedName:  "besu",
 		},
+		{
+			name:          "vc pattern",
+			serviceName:   "vc-3-geth-lighthouse",
+			expectedIndex: 3,
+			expectedName:  "geth-lighthouse",
+		},
 		{
 			name:          "no index pattern",
 			serviceName:   "prometheus",
@@ -255,6 +261,12 @@ func TestDetectServiceType(t *testing.T) {
 		{"blockscout", "blockscout", network.ServiceTypeBlockscout},
 		{"apache", "apache", network.ServiceTypeApache},
 		{"apache config", "apache-config-server", network.ServiceTypeApache},
+		{"metrics exporter", "ethereum-metrics-exporter-1-lighthouse-geth", network.ServiceTypeMetricsExporter},
+		{"tracoor", "tracoor", network.ServiceTypeTracoor},
+		{"forky", "forky", network.ServiceTypeForky},
+		{"checkpointz", "checkpointz", network.ServiceTypeCheckpointz},
+		{"mev relay", "mev-relay-api", network.ServiceTypeRelay},
+		{"mev relay website", "mev-relay-website", network.ServiceTypeOther},
 
 		// Unknown
 		{"unknown", "random-service", network.ServiceTypeOther},
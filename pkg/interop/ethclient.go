@@ -0,0 +1,94 @@
+// Package interop binds the package's clients to go-ethereum's, for code that
+// already speaks ethclient. It is the only package depending on go-ethereum.
+package interop
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// DefaultDialTimeout bounds connecting to and checking an execution client
+const DefaultDialTimeout = 10 * time.Second
+
+// dialConfig holds the settings of EthClient
+type dialConfig struct {
+	websocket bool
+	timeout   time.Duration
+}
+
+// DialOption configures EthClient
+type DialOption func(*dialConfig)
+
+// WithWebSocket dials the client's WebSocket endpoint instead of its HTTP RPC
+// endpoint, which subscriptions such as SubscribeNewHead need
+func WithWebSocket() DialOption {
+	return func(cfg *dialConfig) {
+		cfg.websocket = true
+	}
+}
+
+// WithDialTimeout bounds connecting to and checking the client; the default is
+// DefaultDialTimeout
+func WithDialTimeout(timeout time.Duration) DialOption {
+	return func(cfg *dialConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// EthClient returns a go-ethereum ethclient bound to the execution client's
+// RPC endpoint, or its WebSocket endpoint with WithWebSocket. The connection
+// uses the client's TLS settings, credentials and per-request timeout, and is
+// checked with eth_chainId before it is returned. The caller owns the returned
+// client and must Close it; closing it does not affect c.
+func EthClient(ctx context.Context, c client.ExecutionClient, opts ...DialOption) (*ethclient.Client, error) {
+	cfg := &dialConfig{timeout: DefaultDialTimeout}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	url := c.RPCURL()
+	if cfg.websocket {
+		url = c.WSURL()
+	}
+	if url == "" {
+		return nil, fmt.Errorf("%s has no endpoint to dial", c.Name())
+	}
+
+	// Headers carry the credentials on HTTP requests and the WebSocket handshake
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %s: %w", url, err)
+	}
+	c.Credentials().Apply(req)
+
+	dialCtx, cancel := context.WithTimeout(ctx, cfg.timeout)
+	defer cancel()
+
+	rpcClient, err := rpc.DialOptions(dialCtx, url,
+		rpc.WithHTTPClient(client.NewHTTPClient(c.RPCTimeout(), c.TLSConfig())),
+		rpc.WithWebsocketDialer(websocket.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			HandshakeTimeout: cfg.timeout,
+			TLSClientConfig:  c.TLSConfig(),
+		}),
+		rpc.WithHeaders(req.Header),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", c.Name(), err)
+	}
+
+	ethClient := ethclient.NewClient(rpcClient)
+	if _, err := ethClient.ChainID(dialCtx); err != nil {
+		ethClient.Close()
+		return nil, fmt.Errorf("failed to reach %s: %w", c.Name(), err)
+	}
+	return ethClient, nil
+}
@@ -0,0 +1,70 @@
+package interop
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+func newRPCServer(t *testing.T, authorization *string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*authorization = r.Header.Get("Authorization")
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "eth_chainId":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"0x3039"}`))
+		case "eth_blockNumber":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"0x2a"}`))
+		default:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"error":{"code":-32601,"message":"method not found"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEthClient(t *testing.T) {
+	var authorization string
+	server := newRPCServer(t, &authorization)
+	el := client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "", "el-1-geth-lighthouse", "", 30303).
+		WithCredentials(client.BearerToken("secret"))
+
+	ethClient, err := EthClient(context.Background(), el)
+	require.NoError(t, err)
+	defer ethClient.Close()
+
+	number, err := ethClient.BlockNumber(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), number)
+	assert.Equal(t, "Bearer secret", authorization)
+}
+
+func TestEthClientErrors(t *testing.T) {
+	var authorization string
+	server := newRPCServer(t, &authorization)
+	el := client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "", "el-1-geth-lighthouse", "", 30303)
+
+	// Without a WebSocket endpoint there is nothing to dial
+	_, err := EthClient(context.Background(), el, WithWebSocket())
+	assert.ErrorContains(t, err, "has no endpoint")
+
+	// Unreachable endpoints fail at dial time rather than on first use
+	server.Close()
+	_, err = EthClient(context.Background(), el)
+	assert.ErrorContains(t, err, "failed to reach el-1-geth-lighthouse")
+}
@@ -0,0 +1,60 @@
+package kurtosis
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// ErrArtifactFileNotFound is returned when a files artifact has no file at the path
+var ErrArtifactFileNotFound = errors.New("file not found in files artifact")
+
+// extractFile returns the file at filePath from a gzipped tar archive, as
+// Kurtosis serves files artifacts
+func extractFile(archive []byte, filePath string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	want := path.Clean(strings.TrimPrefix(filePath, "/"))
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%w: %s", ErrArtifactFileNotFound, filePath)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag == tar.TypeReg && path.Clean(header.Name) == want {
+			return io.ReadAll(reader)
+		}
+	}
+}
+
+// ReadArtifactFile returns a file of one of the enclave's files artifacts, such
+// as the genesis data or JWT secret ethereum-package generates. The path is
+// relative to the artifact's root.
+func (k *KurtosisClient) ReadArtifactFile(ctx context.Context, enclaveName, artifactName, filePath string) ([]byte, error) {
+	enclaveCtx, err := k.enclaveContext(ctx, enclaveName)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := enclaveCtx.DownloadFilesArtifact(ctx, artifactName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download files artifact %s: %w", artifactName, k.versionError(err))
+	}
+	content, err := extractFile(archive, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from files artifact %s: %w", filePath, artifactName, err)
+	}
+	return content, nil
+}
@@ -0,0 +1,49 @@
+package kurtosis
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// artifactArchive builds a files artifact archive of the named files
+func artifactArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0o755}))
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestExtractFile(t *testing.T) {
+	archive := artifactArchive(t, map[string]string{
+		"./jwtsecret":                   "0xabc\n",
+		"metadata/genesis.json":         `{"config":{}}`,
+		"metadata/deposit_contract.txt": "0x4242424242424242424242424242424242424242",
+	})
+
+	content, err := extractFile(archive, "jwtsecret")
+	require.NoError(t, err)
+	assert.Equal(t, "0xabc\n", string(content))
+
+	content, err = extractFile(archive, "/metadata/genesis.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"config":{}}`, string(content))
+
+	_, err = extractFile(archive, "metadata")
+	assert.ErrorIs(t, err, ErrArtifactFileNotFound)
+	_, err = extractFile([]byte("not gzip"), "jwtsecret")
+	assert.Error(t, err)
+}
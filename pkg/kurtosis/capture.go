@@ -0,0 +1,60 @@
+package kurtosis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+// CaptureImage is the image that runs tcpdump next to a service container
+var CaptureImage = "nicolaka/netshoot:latest"
+
+// captureArgs returns the docker arguments running tcpdump for the duration in
+// the network namespace of the container, writing the pcap to stdout. tcpdump
+// is stopped with SIGINT so it flushes the capture before exiting.
+func captureArgs(container string, duration time.Duration) []string {
+	seconds := int(duration.Round(time.Second) / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return []string{
+		"run", "--rm", "--network", "container:" + container,
+		CaptureImage,
+		"timeout", "-s", "INT", strconv.Itoa(seconds),
+		"tcpdump", "-i", "any", "-U", "-w", "-",
+	}
+}
+
+// dockerCapture runs tcpdump next to the container and copies the pcap to w
+func dockerCapture(ctx context.Context, container string, duration time.Duration, w io.Writer) error {
+	cmd := exec.CommandContext(ctx, "docker", captureArgs(container, duration)...)
+	cmd.Stdout = w
+	if err := cmd.Run(); err != nil {
+		// timeout exits with 124 when it had to stop tcpdump
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 124 {
+			return nil
+		}
+		return fmt.Errorf("packet capture failed: %w", err)
+	}
+	return nil
+}
+
+// CapturePackets captures the traffic of a service for the duration and writes
+// it to w in pcap format. tcpdump runs in a separate container sharing the
+// service's network namespace, so client images need no capture tools.
+func (k *KurtosisClient) CapturePackets(ctx context.Context, enclaveName, serviceName string, duration time.Duration, w io.Writer) error {
+	services, err := k.GetServices(ctx, enclaveName)
+	if err != nil {
+		return err
+	}
+	service, ok := services[serviceName]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrServiceNotFound, serviceName)
+	}
+	return k.capture(ctx, serviceName+"--"+service.UUID, duration, w)
+}
@@ -0,0 +1,21 @@
+package kurtosis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureArgs(t *testing.T) {
+	args := captureArgs("el-1-geth-lighthouse--abc", 90*time.Second)
+	assert.Equal(t, []string{
+		"run", "--rm", "--network", "container:el-1-geth-lighthouse--abc",
+		CaptureImage,
+		"timeout", "-s", "INT", "90",
+		"tcpdump", "-i", "any", "-U", "-w", "-",
+	}, args)
+
+	// Sub-second captures still run for a second
+	assert.Equal(t, "1", captureArgs("c", 100*time.Millisecond)[8])
+}
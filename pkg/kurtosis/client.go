@@ -3,10 +3,17 @@ package kurtosis
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
 	kurtosis_core_rpc_api_bindings "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
@@ -20,26 +27,112 @@ type Client interface {
 	StopEnclave(ctx context.Context, enclaveName string) error
 	DestroyEnclave(ctx context.Context, enclaveName string) error
 	WaitForServices(ctx context.Context, enclaveName string, serviceNames []string, timeout time.Duration) error
+	StopService(ctx context.Context, enclaveName, serviceName string) error
+	StartService(ctx context.Context, enclaveName, serviceName string) error
+	AddService(ctx context.Context, enclaveName string, spec network.ServiceSpec) (*ServiceInfo, error)
+	EnclaveLabels(ctx context.Context, enclaveName string) (map[string]string, error)
+	SetEnclaveLabel(ctx context.Context, enclaveName, key, value string) error
+	ContainerStates(ctx context.Context, enclaveName string) (map[string]network.ContainerState, error)
+	CapturePackets(ctx context.Context, enclaveName, serviceName string, duration time.Duration, w io.Writer) error
+	ReadFile(ctx context.Context, enclaveName, serviceName, path string) ([]byte, error)
+	ExecCommand(ctx context.Context, enclaveName, serviceName string, cmd []string) (int, string, string, error)
+	ReadArtifactFile(ctx context.Context, enclaveName, artifactName, filePath string) ([]byte, error)
+	ServiceLogs(ctx context.Context, enclaveName, serviceName string, lines int) ([]string, error)
+	SnapshotServices(ctx context.Context, enclaveName string, dirs []network.ServiceDir) error
+	RestoreServices(ctx context.Context, enclaveName string, dirs []network.ServiceDir) error
 }
 
 // KurtosisClient wraps the Kurtosis SDK for ethereum-package operations
 type KurtosisClient struct {
-	kurtosisCtx *kurtosis_context.KurtosisContext
-	enclaves    map[string]*enclaves.EnclaveContext
-	mu          sync.RWMutex
+	engine   engine
+	enclaves map[string]*enclaves.EnclaveContext
+	mu       sync.RWMutex
+
+	engineVersion string
+	hostOverride  string
+	probeTimeout  time.Duration
+	inspect       func(ctx context.Context, containers ...string) ([]byte, error)
+	capture       func(ctx context.Context, container string, duration time.Duration, w io.Writer) error
+	copyTo        func(ctx context.Context, container, dir string, archive io.Reader) error
+	execute       func(ctx context.Context, container string, cmd []string) (int, string, string, error)
 }
 
-// NewKurtosisClient creates a new Kurtosis client
+// NewKurtosisClient creates a new Kurtosis client. It fails with ErrEngineVersion
+// when the running engine is not of a release series the SDK can talk to.
 func NewKurtosisClient(ctx context.Context) (*KurtosisClient, error) {
+	engineVersion, err := queryEngineVersion(ctx, localEngineAddress())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := CheckEngineVersion(engineVersion); err != nil {
+		return nil, err
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Kurtosis context: %w", err)
 	}
 
+	return newKurtosisClient(sdkEngine{kurtosisCtx}, engineVersion), nil
+}
+
+// NewRemoteKurtosisClient creates a client for the engine listening on
+// host:port, such as a shared engine CI runners connect to. Published ports
+// are reached on host unless WithHostOverride picks another one. Like
+// NewKurtosisClient it fails with ErrEngineVersion on incompatible engines.
+func NewRemoteKurtosisClient(ctx context.Context, host string, port uint16) (*KurtosisClient, error) {
+	if port == 0 {
+		port = kurtosis_context.DefaultGrpcEngineServerPortNum
+	}
+	engineVersion, err := queryEngineVersion(ctx, net.JoinHostPort(host, strconv.Itoa(int(port))))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := CheckEngineVersion(engineVersion); err != nil {
+		return nil, err
+	}
+
+	remote, err := newRemoteEngine(host, port)
+	if err != nil {
+		return nil, err
+	}
+	return newKurtosisClient(remote, engineVersion).WithHostOverride(host), nil
+}
+
+// NewKurtosisClientFromContext creates a client on an existing SDK context,
+// e.g. one connected through the Kurtosis Cloud portal. The SDK checked the
+// engine's API version when it created the context.
+func NewKurtosisClientFromContext(kurtosisCtx *kurtosis_context.KurtosisContext) *KurtosisClient {
+	return newKurtosisClient(sdkEngine{kurtosisCtx}, "")
+}
+
+func newKurtosisClient(e engine, engineVersion string) *KurtosisClient {
 	return &KurtosisClient{
-		kurtosisCtx: kurtosisCtx,
-		enclaves:    make(map[string]*enclaves.EnclaveContext),
-	}, nil
+		engine:        e,
+		engineVersion: engineVersion,
+		enclaves:      make(map[string]*enclaves.EnclaveContext),
+		probeTimeout:  DefaultProbeTimeout,
+		inspect:       dockerInspect,
+		capture:       dockerCapture,
+		copyTo:        dockerCopyTo,
+		execute:       dockerExec,
+	}
+}
+
+// WithProbeTimeout sets the per-request timeout of readiness probes in WaitForServices.
+// Non-positive values are ignored.
+func (k *KurtosisClient) WithProbeTimeout(timeout time.Duration) *KurtosisClient {
+	if timeout > 0 {
+		k.probeTimeout = timeout
+	}
+	return k
+}
+
+// WithHostOverride reports every published port on host instead of the address
+// Kurtosis returns, for remote Docker daemons. Empty keeps the reported addresses.
+func (k *KurtosisClient) WithHostOverride(host string) *KurtosisClient {
+	k.hostOverride = host
+	return k
 }
 
 // RunPackageConfig contains configuration for running a package
@@ -52,6 +145,16 @@ type RunPackageConfig struct {
 	VerboseMode     bool
 	ImageDownload   bool
 	NonBlockingMode bool
+	// OnProgress receives the package's progress as it runs in blocking mode
+	OnProgress func(PackageProgress)
+}
+
+// PackageProgress is a progress update of a package run. Step and TotalSteps
+// are zero for instruction descriptions, warnings and info messages.
+type PackageProgress struct {
+	Step       int
+	TotalSteps int
+	Message    string
 }
 
 // RunPackageResult contains the result of running a package
@@ -59,8 +162,14 @@ type RunPackageResult struct {
 	EnclaveName         string
 	ResponseLines       []string
 	InterpretationError error
-	ValidationErrors    []string
 	ExecutionError      error
+	// Validation is the package's validation feedback: the errors that
+	// rejected the configuration and the warnings it gave while running
+	Validation network.ValidationResults
+	// Instructions are the executable Starlark instructions of the run
+	Instructions []string
+	// Plan lists the services a dry run would create; nil for real runs
+	Plan *network.Plan
 }
 
 // ServiceInfo contains information about a service
@@ -70,7 +179,10 @@ type ServiceInfo struct {
 	Status    string
 	Ports     map[string]PortInfo
 	IPAddress string
-	Hostname  string
+	// PrivateIPAddress is the service's address inside the enclave network
+	PrivateIPAddress string
+	// Hostname resolves to the service inside the enclave network
+	Hostname string
 }
 
 // PortInfo contains information about a service port
@@ -79,6 +191,8 @@ type PortInfo struct {
 	Protocol          string
 	MaybeURL          string
 	TransportProtocol string
+	// PrivateNumber is the port inside the enclave network
+	PrivateNumber uint16
 }
 
 // RunPackage runs the ethereum-package with the given configuration
@@ -140,7 +254,7 @@ func (k *KurtosisClient) RunPackage(ctx context.Context, config RunPackageConfig
 		}
 
 		if err != nil {
-			result.ExecutionError = err
+			result.ExecutionError = k.versionError(err)
 			return result, nil
 		}
 		defer cancelFunc()
@@ -164,30 +278,19 @@ func (k *KurtosisClient) RunPackage(ctx context.Context, config RunPackageConfig
 	done:
 	} else {
 		// Blocking mode - wait for completion
-		var runResult *enclaves.StarlarkRunResult
-		var err error
-
-		if isRemotePackage {
-			runResult, err = enclaveCtx.RunStarlarkRemotePackageBlocking(ctx, config.PackageID, runConfig)
-		} else {
-			runResult, err = enclaveCtx.RunStarlarkPackageBlocking(ctx, config.PackageID, runConfig)
-		}
-
+		// Streaming keeps the warnings the blocking SDK calls drop
+		runResult, warnings, err := runStreaming(ctx, enclaveCtx, isRemotePackage, config.PackageID, runConfig, config.OnProgress)
 		if err != nil {
-			result.ExecutionError = err
+			result.ExecutionError = k.versionError(err)
 			return result, nil
 		}
 
-		// Process validation errors
-		if len(runResult.ValidationErrors) > 0 {
-			for _, validationErr := range runResult.ValidationErrors {
-				result.ValidationErrors = append(result.ValidationErrors, validationErr.GetErrorMessage())
-			}
-		}
+		// Process validation feedback
+		result.Validation = validationResults(runResult.ValidationErrors, warnings)
 
 		// Process interpretation error
 		if runResult.InterpretationError != nil {
-			result.InterpretationError = fmt.Errorf("interpretation error: %s", runResult.InterpretationError.GetErrorMessage())
+			result.InterpretationError = k.versionError(fmt.Errorf("interpretation error: %s", runResult.InterpretationError.GetErrorMessage()))
 		}
 
 		// Process execution error
@@ -205,37 +308,38 @@ func (k *KurtosisClient) RunPackage(ctx context.Context, config RunPackageConfig
 			}
 		}
 
+		for _, instruction := range runResult.Instructions {
+			result.Instructions = append(result.Instructions, instruction.GetExecutableInstruction())
+		}
+		if config.DryRun {
+			plan, err := ParsePlan(result.Instructions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse dry run plan: %w", err)
+			}
+			result.Plan = plan
+		}
+
 		// Add final status
 		if len(runResult.ValidationErrors) == 0 && runResult.InterpretationError == nil && runResult.ExecutionError == nil {
 			responseLines = append(responseLines, "Package run completed successfully")
 		}
 	}
 
+	result.ResponseLines = responseLines
 	return result, nil
 }
 
 // GetServices returns all services in the enclave
 func (k *KurtosisClient) GetServices(ctx context.Context, enclaveName string) (map[string]*ServiceInfo, error) {
-	k.mu.RLock()
-	enclaveCtx, exists := k.enclaves[enclaveName]
-	k.mu.RUnlock()
-	if !exists {
-		// Try to get the enclave context if not cached
-		var err error
-		enclaveCtx, err = k.kurtosisCtx.GetEnclaveContext(ctx, enclaveName)
-		if err != nil {
-			return nil, fmt.Errorf("enclave not found: %s", enclaveName)
-		}
-
-		k.mu.Lock()
-		k.enclaves[enclaveName] = enclaveCtx
-		k.mu.Unlock()
+	enclaveCtx, err := k.enclaveContext(ctx, enclaveName)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get all services from the enclave
 	serviceIdentifiers, err := enclaveCtx.GetServices()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get services: %w", err)
+		return nil, fmt.Errorf("failed to get services: %w", k.versionError(err))
 	}
 
 	result := make(map[string]*ServiceInfo)
@@ -278,20 +382,25 @@ func (k *KurtosisClient) GetServices(ctx context.Context, enclaveName string) (m
 				}
 			}
 
+			if privateSpec, ok := serviceContext.GetPrivatePorts()[portName]; ok {
+				portInfo.PrivateNumber = privateSpec.GetNumber()
+			}
+
 			ports[portName] = portInfo
 		}
 
 		// Create ServiceInfo
 		serviceInfo := &ServiceInfo{
-			Name:      string(serviceName),
-			UUID:      string(serviceUUID),
-			Status:    serviceStatus,
-			IPAddress: serviceContext.GetMaybePublicIPAddress(),
-			Hostname:  string(serviceName), // Use service name as hostname
-			Ports:     ports,
+			Name:             string(serviceName),
+			UUID:             string(serviceUUID),
+			Status:           serviceStatus,
+			IPAddress:        serviceContext.GetMaybePublicIPAddress(),
+			PrivateIPAddress: serviceContext.GetPrivateIPAddress(),
+			Hostname:         string(serviceName), // Kurtosis resolves service names inside the enclave
+			Ports:            ports,
 		}
 
-		result[string(serviceName)] = serviceInfo
+		result[string(serviceName)] = serviceInfo.WithHost(k.hostOverride)
 	}
 
 	return result, nil
@@ -320,7 +429,7 @@ func (k *KurtosisClient) DestroyEnclave(ctx context.Context, enclaveName string)
 	k.mu.Unlock()
 
 	// Destroy the enclave using the Kurtosis context
-	err := k.kurtosisCtx.DestroyEnclave(ctx, enclaveName)
+	err := k.engine.DestroyEnclave(ctx, enclaveName)
 	if err != nil {
 		return fmt.Errorf("failed to destroy enclave: %w", err)
 	}
@@ -328,28 +437,56 @@ func (k *KurtosisClient) DestroyEnclave(ctx context.Context, enclaveName string)
 	return nil
 }
 
-// WaitForServices waits for services to be ready
+// WaitForServices waits until the named services are running and pass the readiness
+// probe registered for their service type. An empty list waits for every service in
+// the enclave. Services without a probe are ready once they are running.
 func (k *KurtosisClient) WaitForServices(ctx context.Context, enclaveName string, serviceNames []string, timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
+	httpClient := &http.Client{Timeout: k.probeTimeout}
+	ready := make(map[string]bool)
 
-	for time.Now().Before(deadline) {
+	var notReady []string
+	for {
 		services, err := k.GetServices(ctx, enclaveName)
 		if err != nil {
 			return err
 		}
 
-		allReady := true
-		for _, name := range serviceNames {
+		names := serviceNames
+		if len(names) == 0 {
+			names = make([]string, 0, len(services))
+			for name := range services {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+		}
+
+		notReady = notReady[:0]
+		for _, name := range names {
+			if ready[name] {
+				continue
+			}
+
 			service, exists := services[name]
 			if !exists || service.Status != "RUNNING" {
-				allReady = false
-				break
+				notReady = append(notReady, name)
+				continue
+			}
+
+			serviceType := network.DetectServiceType(name)
+			if err := network.CheckReadiness(ctx, httpClient, serviceType, ProbeURL(service)); err != nil {
+				notReady = append(notReady, name)
+				continue
 			}
+			ready[name] = true
 		}
 
-		if allReady {
+		if len(notReady) == 0 {
 			return nil
 		}
+		if !time.Now().Before(deadline) {
+			break
+		}
 
 		select {
 		case <-ctx.Done():
@@ -359,7 +496,7 @@ func (k *KurtosisClient) WaitForServices(ctx context.Context, enclaveName string
 		}
 	}
 
-	return fmt.Errorf("timeout waiting for services to be ready")
+	return fmt.Errorf("timeout waiting for services to be ready: %s", strings.Join(notReady, ", "))
 }
 
 // getOrCreateEnclave gets an existing enclave or creates a new one
@@ -373,13 +510,13 @@ func (k *KurtosisClient) getOrCreateEnclave(ctx context.Context, enclaveName str
 	k.mu.RUnlock()
 
 	// Try to get existing enclave
-	enclaveCtx, err := k.kurtosisCtx.GetEnclaveContext(ctx, enclaveName)
+	enclaveCtx, err := k.engine.GetEnclaveContext(ctx, enclaveName)
 	if err == nil {
 		return enclaveCtx, nil
 	}
 
 	// Create new enclave if it doesn't exist
-	enclaveCtx, err = k.kurtosisCtx.CreateEnclave(ctx, enclaveName)
+	enclaveCtx, err = k.engine.CreateEnclave(ctx, enclaveName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create enclave: %w", err)
 	}
@@ -409,6 +546,78 @@ func isWSPort(port uint16) bool {
 	return false
 }
 
+// runStreaming runs the package to completion like the blocking SDK calls,
+// reporting progress as the response lines arrive if onProgress is set and
+// collecting the package's warnings
+func runStreaming(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, remote bool, packageID string, runConfig *starlark_run_config.StarlarkRunConfig, onProgress func(PackageProgress)) (*enclaves.StarlarkRunResult, []string, error) {
+	var lines chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine
+	var cancel context.CancelFunc
+	var err error
+	if remote {
+		lines, cancel, err = enclaveCtx.RunStarlarkRemotePackage(ctx, packageID, runConfig)
+	} else {
+		lines, cancel, err = enclaveCtx.RunStarlarkPackage(ctx, packageID, runConfig)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cancel()
+
+	// The reader returns once forwarded is closed, after the last warning
+	var warnings []string
+	forwarded := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	go func() {
+		defer close(forwarded)
+		for line := range lines {
+			if warning := line.GetWarning(); warning != nil {
+				warnings = append(warnings, warning.GetWarningMessage())
+			}
+			if progress, ok := packageProgress(line); ok && onProgress != nil {
+				onProgress(progress)
+			}
+			// Don't block forever if the reader gave up early
+			select {
+			case forwarded <- line:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return enclaves.ReadStarlarkRunResponseLineBlocking(forwarded), warnings, nil
+}
+
+// validationResults types a run's validation errors and warnings
+func validationResults(validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, warnings []string) network.ValidationResults {
+	var results network.ValidationResults
+	for _, validationErr := range validationErrors {
+		results = append(results, network.NewValidationResult(config.SeverityError, validationErr.GetErrorMessage()))
+	}
+	for _, warning := range warnings {
+		results = append(results, network.NewValidationResult(config.SeverityWarning, warning))
+	}
+	return results
+}
+
+// packageProgress converts a response line into a progress update, if it carries one
+func packageProgress(line *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (PackageProgress, bool) {
+	switch {
+	case line.GetProgressInfo() != nil:
+		info := line.GetProgressInfo()
+		return PackageProgress{
+			Step:       int(info.GetCurrentStepNumber()),
+			TotalSteps: int(info.GetTotalSteps()),
+			Message:    strings.Join(info.GetCurrentStepInfo(), " "),
+		}, true
+	case line.GetInstruction() != nil && line.GetInstruction().GetDescription() != "":
+		return PackageProgress{Message: line.GetInstruction().GetDescription()}, true
+	case line.GetWarning() != nil:
+		return PackageProgress{Message: "WARNING: " + line.GetWarning().GetWarningMessage()}, true
+	case line.GetInfo() != nil:
+		return PackageProgress{Message: line.GetInfo().GetInfoMessage()}, true
+	}
+	return PackageProgress{}, false
+}
+
 // formatStarlarkResponse formats a Starlark response line for display
 func formatStarlarkResponse(response *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) string {
 	if response == nil {
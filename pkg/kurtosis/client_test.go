@@ -3,9 +3,12 @@ package kurtosis
 import (
 	"context"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	kurtosis_core_rpc_api_bindings "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -15,12 +18,14 @@ type MockKurtosisClient struct {
 	services       map[string]map[string]*ServiceInfo
 	runPackageFunc func(ctx context.Context, config RunPackageConfig) (*RunPackageResult, error)
 	enclaveStatus  map[string]bool
+	labels         map[string]map[string]string
 }
 
 func NewMockKurtosisClient() *MockKurtosisClient {
 	return &MockKurtosisClient{
 		services:      make(map[string]map[string]*ServiceInfo),
 		enclaveStatus: make(map[string]bool),
+		labels:        make(map[string]map[string]string),
 	}
 }
 
@@ -77,7 +82,71 @@ func (m *MockKurtosisClient) WaitForServices(ctx context.Context, enclaveName st
 	return nil
 }
 
-func (m *MockKurtosisClient) AddService(enclaveName, serviceName string, service *ServiceInfo) {
+func (m *MockKurtosisClient) StopService(ctx context.Context, enclaveName, serviceName string) error {
+	return m.setServiceStatus(enclaveName, serviceName, "STOPPED")
+}
+
+func (m *MockKurtosisClient) StartService(ctx context.Context, enclaveName, serviceName string) error {
+	return m.setServiceStatus(enclaveName, serviceName, "RUNNING")
+}
+
+func (m *MockKurtosisClient) setServiceStatus(enclaveName, serviceName, status string) error {
+	service, exists := m.services[enclaveName][serviceName]
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrServiceNotFound, serviceName)
+	}
+	service.Status = status
+	return nil
+}
+
+func (m *MockKurtosisClient) EnclaveLabels(ctx context.Context, enclaveName string) (map[string]string, error) {
+	if _, exists := m.enclaveStatus[enclaveName]; !exists {
+		return nil, fmt.Errorf("%w: %s", ErrEnclaveNotFound, enclaveName)
+	}
+	labels := make(map[string]string)
+	for key, value := range m.labels[enclaveName] {
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+func (m *MockKurtosisClient) SetEnclaveLabel(ctx context.Context, enclaveName, key, value string) error {
+	if _, exists := m.enclaveStatus[enclaveName]; !exists {
+		return fmt.Errorf("%w: %s", ErrEnclaveNotFound, enclaveName)
+	}
+	if m.labels[enclaveName] == nil {
+		m.labels[enclaveName] = make(map[string]string)
+	}
+	m.labels[enclaveName][key] = value
+	return nil
+}
+
+func (m *MockKurtosisClient) ContainerStates(ctx context.Context, enclaveName string) (map[string]network.ContainerState, error) {
+	services, exists := m.services[enclaveName]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrEnclaveNotFound, enclaveName)
+	}
+	states := make(map[string]network.ContainerState, len(services))
+	for name := range services {
+		states[name] = network.ContainerState{Status: "running"}
+	}
+	return states, nil
+}
+
+func (m *MockKurtosisClient) CapturePackets(ctx context.Context, enclaveName, serviceName string, duration time.Duration, w io.Writer) error {
+	if _, exists := m.services[enclaveName][serviceName]; !exists {
+		return fmt.Errorf("%w: %s", ErrServiceNotFound, serviceName)
+	}
+	return nil
+}
+
+func (m *MockKurtosisClient) AddService(ctx context.Context, enclaveName string, spec network.ServiceSpec) (*ServiceInfo, error) {
+	service := &ServiceInfo{Name: spec.Name, Status: "RUNNING"}
+	m.putService(enclaveName, spec.Name, service)
+	return service, nil
+}
+
+func (m *MockKurtosisClient) putService(enclaveName, serviceName string, service *ServiceInfo) {
 	if m.services[enclaveName] == nil {
 		m.services[enclaveName] = make(map[string]*ServiceInfo)
 	}
@@ -172,7 +241,7 @@ func TestMockGetServices(t *testing.T) {
 			"rpc": {Number: 8545, Protocol: "TCP"},
 		},
 	}
-	mock.AddService("test-enclave", "geth-1", service)
+	mock.putService("test-enclave", "geth-1", service)
 
 	// Get services
 	services, err := mock.GetServices(ctx, "test-enclave")
@@ -237,3 +306,34 @@ func TestWaitForServicesTimeout(t *testing.T) {
 	// or implement it in the mock if needed
 	t.Skip("WaitForServices not implemented in mock")
 }
+
+func TestPackageProgress(t *testing.T) {
+	type line = kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine
+
+	progress, ok := packageProgress(&line{RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_ProgressInfo{
+		ProgressInfo: &kurtosis_core_rpc_api_bindings.StarlarkRunProgress{
+			CurrentStepInfo:   []string{"Adding service", "el-1-geth-lighthouse"},
+			CurrentStepNumber: 12,
+			TotalSteps:        40,
+		},
+	}})
+	require.True(t, ok)
+	assert.Equal(t, PackageProgress{Step: 12, TotalSteps: 40, Message: "Adding service el-1-geth-lighthouse"}, progress)
+
+	progress, ok = packageProgress(&line{RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Instruction{
+		Instruction: &kurtosis_core_rpc_api_bindings.StarlarkInstruction{Description: "Generating genesis"},
+	}})
+	require.True(t, ok)
+	assert.Equal(t, PackageProgress{Message: "Generating genesis"}, progress)
+
+	progress, ok = packageProgress(&line{RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Warning{
+		Warning: &kurtosis_core_rpc_api_bindings.StarlarkWarning{WarningMessage: "deprecated field"},
+	}})
+	require.True(t, ok)
+	assert.Equal(t, "WARNING: deprecated field", progress.Message)
+
+	_, ok = packageProgress(&line{RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_InstructionResult{
+		InstructionResult: &kurtosis_core_rpc_api_bindings.StarlarkInstructionResult{SerializedInstructionResult: "ok"},
+	}})
+	assert.False(t, ok)
+}
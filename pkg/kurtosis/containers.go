@@ -0,0 +1,83 @@
+package kurtosis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+// dockerInspect runs docker inspect on the named containers and returns its JSON output
+func dockerInspect(ctx context.Context, containers ...string) ([]byte, error) {
+	args := append([]string{"inspect"}, containers...)
+	output, err := exec.CommandContext(ctx, "docker", args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("docker inspect failed: %w", err)
+	}
+	return output, nil
+}
+
+// ContainerStates inspects the Docker container of every service in the enclave.
+// Kurtosis names service containers "<service name>--<service UUID>".
+func (k *KurtosisClient) ContainerStates(ctx context.Context, enclaveName string) (map[string]network.ContainerState, error) {
+	services, err := k.GetServices(ctx, enclaveName)
+	if err != nil {
+		return nil, err
+	}
+	if len(services) == 0 {
+		return map[string]network.ContainerState{}, nil
+	}
+
+	containers := make(map[string]string, len(services))
+	names := make([]string, 0, len(services))
+	for name, service := range services {
+		container := name + "--" + service.UUID
+		containers[container] = name
+		names = append(names, container)
+	}
+
+	output, err := k.inspect(ctx, names...)
+	if err != nil {
+		return nil, err
+	}
+	return parseContainerStates(output, containers)
+}
+
+// parseContainerStates maps docker inspect output to container states keyed by
+// service name, given the service name of each container
+func parseContainerStates(output []byte, containers map[string]string) (map[string]network.ContainerState, error) {
+	var inspected []struct {
+		Name         string `json:"Name"`
+		RestartCount int    `json:"RestartCount"`
+		State        struct {
+			Status    string `json:"Status"`
+			ExitCode  int    `json:"ExitCode"`
+			StartedAt string `json:"StartedAt"`
+			OOMKilled bool   `json:"OOMKilled"`
+		} `json:"State"`
+	}
+	if err := json.Unmarshal(output, &inspected); err != nil {
+		return nil, fmt.Errorf("failed to parse docker inspect output: %w", err)
+	}
+
+	states := make(map[string]network.ContainerState, len(inspected))
+	for _, container := range inspected {
+		service, ok := containers[strings.TrimPrefix(container.Name, "/")]
+		if !ok {
+			continue
+		}
+		startedAt, _ := time.Parse(time.RFC3339Nano, container.State.StartedAt)
+		states[service] = network.ContainerState{
+			Status:       container.State.Status,
+			RestartCount: container.RestartCount,
+			ExitCode:     container.State.ExitCode,
+			StartedAt:    startedAt,
+			OOMKilled:    container.State.OOMKilled,
+		}
+	}
+	return states, nil
+}
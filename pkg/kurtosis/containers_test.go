@@ -0,0 +1,36 @@
+package kurtosis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseContainerStates(t *testing.T) {
+	output := []byte(`[
+		{"Name":"/el-1-geth-lighthouse--abc","RestartCount":3,"State":{"Status":"restarting","ExitCode":137,"StartedAt":"2024-05-01T10:00:00.5Z","OOMKilled":true}},
+		{"Name":"/cl-1-lighthouse-geth--def","RestartCount":0,"State":{"Status":"running","ExitCode":0,"StartedAt":"2024-05-01T09:00:00Z"}},
+		{"Name":"/unrelated","RestartCount":9,"State":{"Status":"running"}}
+	]`)
+
+	states, err := parseContainerStates(output, map[string]string{
+		"el-1-geth-lighthouse--abc": "el-1-geth-lighthouse",
+		"cl-1-lighthouse-geth--def": "cl-1-lighthouse-geth",
+	})
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+
+	el := states["el-1-geth-lighthouse"]
+	assert.Equal(t, 3, el.RestartCount)
+	assert.Equal(t, "restarting", el.Status)
+	assert.Equal(t, 137, el.ExitCode)
+	assert.True(t, el.OOMKilled)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 5e8, time.UTC), el.StartedAt)
+	assert.Equal(t, "running", states["cl-1-lighthouse-geth"].Status)
+	assert.False(t, states["cl-1-lighthouse-geth"].OOMKilled)
+
+	_, err = parseContainerStates([]byte("not json"), nil)
+	assert.Error(t, err)
+}
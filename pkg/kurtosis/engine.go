@@ -0,0 +1,169 @@
+package kurtosis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	kurtosis_core_rpc_api_bindings "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxMessageSize matches the SDK's limit for engine and API container responses
+const maxMessageSize = 100 * 1024 * 1024
+
+// engine is the part of the Kurtosis engine API the client uses
+type engine interface {
+	GetEnclaveContext(ctx context.Context, enclaveIdentifier string) (*enclaves.EnclaveContext, error)
+	CreateEnclave(ctx context.Context, enclaveName string) (*enclaves.EnclaveContext, error)
+	DestroyEnclave(ctx context.Context, enclaveIdentifier string) error
+	ServiceLogs(ctx context.Context, enclaveIdentifier, serviceName string, lines int) ([]string, error)
+}
+
+// sdkEngine is an engine reached through a Kurtosis SDK context
+type sdkEngine struct {
+	*kurtosis_context.KurtosisContext
+}
+
+// ServiceLogs returns the last lines of a service's logs
+func (e sdkEngine) ServiceLogs(ctx context.Context, enclaveIdentifier, serviceName string, lines int) ([]string, error) {
+	return client.NewLogsClient(e.KurtosisContext, enclaveIdentifier).Logs(ctx, serviceRef(serviceName), client.WithLines(lines))
+}
+
+// GetEnclaveContext returns the enclave with the given name or (shortened)
+// UUID. Unlike the SDK it fails with ErrEnclaveNotFound, and only when the
+// engine has no such enclave.
+func (e sdkEngine) GetEnclaveContext(ctx context.Context, enclaveIdentifier string) (*enclaves.EnclaveContext, error) {
+	all, err := e.GetEnclaves(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enclaves: %w", err)
+	}
+	_, byUUID := all.GetEnclavesByUuid()[enclaveIdentifier]
+	_, byName := all.GetEnclavesByName()[enclaveIdentifier]
+	_, byShortenedUUID := all.GetEnclavesByShortenedUuid()[enclaveIdentifier]
+	if !byUUID && !byName && !byShortenedUUID {
+		return nil, fmt.Errorf("%w: %s", ErrEnclaveNotFound, enclaveIdentifier)
+	}
+	return e.KurtosisContext.GetEnclaveContext(ctx, enclaveIdentifier)
+}
+
+// serviceRef identifies a service by name for the logs client
+type serviceRef string
+
+func (s serviceRef) ServiceName() string { return string(s) }
+func (s serviceRef) ContainerID() string { return "" }
+
+// remoteEngine talks gRPC to an engine on another machine. The SDK only
+// connects to engines on localhost and reaches API containers on the address
+// the engine reports, which is local to the engine's machine.
+type remoteEngine struct {
+	host   string
+	client kurtosis_engine_rpc_api_bindings.EngineServiceClient
+}
+
+// newRemoteEngine connects to the engine at host:port
+func newRemoteEngine(host string, port uint16) (*remoteEngine, error) {
+	conn, err := dial(net.JoinHostPort(host, strconv.Itoa(int(port))))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Kurtosis engine at %s:%d: %w", host, port, err)
+	}
+	return &remoteEngine{host: host, client: kurtosis_engine_rpc_api_bindings.NewEngineServiceClient(conn)}, nil
+}
+
+// GetEnclaveContext returns the enclave with the given name or (shortened) UUID
+func (e *remoteEngine) GetEnclaveContext(ctx context.Context, enclaveIdentifier string) (*enclaves.EnclaveContext, error) {
+	response, err := e.client.GetEnclaves(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enclaves: %w", err)
+	}
+	for uuid, info := range response.GetEnclaveInfo() {
+		if uuid == enclaveIdentifier || info.GetName() == enclaveIdentifier || info.GetShortenedUuid() == enclaveIdentifier {
+			return e.enclaveContext(info)
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrEnclaveNotFound, enclaveIdentifier)
+}
+
+// CreateEnclave creates a test mode enclave with the engine's default API container
+func (e *remoteEngine) CreateEnclave(ctx context.Context, enclaveName string) (*enclaves.EnclaveContext, error) {
+	versionTag, logLevel, mode, debug := "", "debug", kurtosis_engine_rpc_api_bindings.EnclaveMode_TEST, false
+	response, err := e.client.CreateEnclave(ctx, &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
+		EnclaveName:              &enclaveName,
+		ApiContainerVersionTag:   &versionTag,
+		ApiContainerLogLevel:     &logLevel,
+		Mode:                     &mode,
+		ShouldApicRunInDebugMode: &debug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create enclave %s: %w", enclaveName, err)
+	}
+	return e.enclaveContext(response.GetEnclaveInfo())
+}
+
+// DestroyEnclave stops and removes an enclave
+func (e *remoteEngine) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
+	_, err := e.client.DestroyEnclave(ctx, &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{EnclaveIdentifier: enclaveIdentifier})
+	return err
+}
+
+// ServiceLogs returns the last lines of a service's logs
+func (e *remoteEngine) ServiceLogs(ctx context.Context, enclaveIdentifier, serviceName string, lines int) ([]string, error) {
+	follow, all, numLines := false, false, uint32(lines)
+	stream, err := e.client.GetServiceLogs(ctx, &kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		ServiceUuidSet:    map[string]bool{serviceName: true},
+		FollowLogs:        &follow,
+		ReturnAllLogs:     &all,
+		NumLogLines:       &numLines,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs for service %s: %w", serviceName, err)
+	}
+
+	var logs []string
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return logs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get logs for service %s: %w", serviceName, err)
+		}
+		for _, line := range response.GetServiceLogsByServiceUuid() {
+			logs = append(logs, line.GetLine()...)
+		}
+	}
+}
+
+// enclaveContext connects to the enclave's API container through the engine's host
+func (e *remoteEngine) enclaveContext(info *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*enclaves.EnclaveContext, error) {
+	if info.GetApiContainerStatus() != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+		return nil, fmt.Errorf("API container of enclave %s is not running", info.GetName())
+	}
+	port := info.GetApiContainerHostMachineInfo().GetGrpcPortOnHostMachine()
+	conn, err := dial(net.JoinHostPort(e.host, strconv.Itoa(int(port))))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to API container of enclave %s: %w", info.GetName(), err)
+	}
+	return enclaves.NewEnclaveContext(
+		kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(conn),
+		enclaves.EnclaveUUID(info.GetEnclaveUuid()),
+		info.GetName(),
+	), nil
+}
+
+// dial opens an unencrypted gRPC connection, as the SDK does
+func dial(address string) (*grpc.ClientConn, error) {
+	return grpc.Dial(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize)))
+}
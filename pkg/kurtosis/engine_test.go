@@ -0,0 +1,108 @@
+package kurtosis
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakeRemoteEngine struct {
+	fakeEngine
+	destroyed []string
+}
+
+func (f *fakeRemoteEngine) GetEnclaves(context.Context, *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse, error) {
+	return &kurtosis_engine_rpc_api_bindings.GetEnclavesResponse{EnclaveInfo: map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		"0123456789ab": {
+			EnclaveUuid:                 "0123456789ab",
+			Name:                        "ci-network",
+			ShortenedUuid:               "012345",
+			ApiContainerStatus:          kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING,
+			ApiContainerHostMachineInfo: &kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerHostMachineInfo{IpOnHostMachine: "127.0.0.1", GrpcPortOnHostMachine: 50051},
+		},
+	}}, nil
+}
+
+func (f *fakeRemoteEngine) DestroyEnclave(_ context.Context, args *kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs) (*emptypb.Empty, error) {
+	f.destroyed = append(f.destroyed, args.GetEnclaveIdentifier())
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeRemoteEngine) GetServiceLogs(args *kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, stream kurtosis_engine_rpc_api_bindings.EngineService_GetServiceLogsServer) error {
+	for service := range args.GetServiceUuidSet() {
+		for _, line := range []string{"first", "second"} {
+			if err := stream.Send(&kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse{
+				ServiceLogsByServiceUuid: map[string]*kurtosis_engine_rpc_api_bindings.LogLine{service: {Line: []string{service + " " + line}}},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// startRemoteEngine serves fake on a local port and returns the port
+func startRemoteEngine(t *testing.T, fake *fakeRemoteEngine) uint16 {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	kurtosis_engine_rpc_api_bindings.RegisterEngineServiceServer(server, fake)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	n, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return uint16(n)
+}
+
+func TestNewRemoteKurtosisClient(t *testing.T) {
+	fake := &fakeRemoteEngine{fakeEngine: fakeEngine{version: "1.10.1"}}
+	port := startRemoteEngine(t, fake)
+	ctx := context.Background()
+
+	k, err := NewRemoteKurtosisClient(ctx, "127.0.0.1", port)
+	require.NoError(t, err)
+	assert.Equal(t, "1.10.1", k.EngineVersion())
+	assert.Equal(t, "127.0.0.1", k.hostOverride)
+
+	for _, identifier := range []string{"ci-network", "0123456789ab", "012345"} {
+		enclaveCtx, err := k.engine.GetEnclaveContext(ctx, identifier)
+		require.NoError(t, err, identifier)
+		assert.Equal(t, "ci-network", enclaveCtx.GetEnclaveName())
+	}
+	_, err = k.engine.GetEnclaveContext(ctx, "other")
+	assert.ErrorIs(t, err, ErrEnclaveNotFound)
+	_, err = k.GetServices(ctx, "other")
+	assert.ErrorIs(t, err, ErrEnclaveNotFound)
+
+	// An engine that cannot be asked is not a missing enclave
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = k.GetServices(cancelled, "ci-network")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrEnclaveNotFound)
+
+	logs, err := k.ServiceLogs(ctx, "ci-network", "el-1-geth-lighthouse", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"el-1-geth-lighthouse first", "el-1-geth-lighthouse second"}, logs)
+
+	require.NoError(t, k.DestroyEnclave(ctx, "ci-network"))
+	assert.Equal(t, []string{"ci-network"}, fake.destroyed)
+}
+
+func TestNewRemoteKurtosisClient_EngineVersion(t *testing.T) {
+	port := startRemoteEngine(t, &fakeRemoteEngine{fakeEngine: fakeEngine{version: "1.9.0"}})
+
+	_, err := NewRemoteKurtosisClient(context.Background(), "127.0.0.1", port)
+	assert.ErrorIs(t, err, ErrEngineVersion)
+}
@@ -17,4 +17,7 @@ var (
 
 	// ErrKurtosisNotRunning is returned when Kurtosis engine is not running
 	ErrKurtosisNotRunning = errors.New("kurtosis engine is not running")
+
+	// ErrEngineVersion is returned when the Kurtosis engine version does not match the SDK
+	ErrEngineVersion = errors.New("unsupported kurtosis engine version")
 )
@@ -0,0 +1,49 @@
+package kurtosis
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+)
+
+// execArgs returns the docker arguments running cmd in the container
+func execArgs(container string, cmd []string) []string {
+	return append([]string{"exec", container}, cmd...)
+}
+
+// dockerExec runs cmd in the container and returns its exit code and output.
+// A command that exits non-zero is not an error; failing to run docker is.
+func dockerExec(ctx context.Context, container string, cmd []string) (int, string, string, error) {
+	var stdout, stderr bytes.Buffer
+	command := exec.CommandContext(ctx, "docker", execArgs(container, cmd)...)
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+	if err := command.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && ctx.Err() == nil {
+			return exitErr.ExitCode(), stdout.String(), stderr.String(), nil
+		}
+		return 0, "", "", fmt.Errorf("docker exec failed: %w", err)
+	}
+	return 0, stdout.String(), stderr.String(), nil
+}
+
+// ExecCommand runs a command in a service's container and returns its exit code,
+// stdout and stderr. The SDK's exec merges the two streams, so the command runs
+// with the local docker CLI, which must reach the engine's Docker daemon.
+func (k *KurtosisClient) ExecCommand(ctx context.Context, enclaveName, serviceName string, cmd []string) (int, string, string, error) {
+	if len(cmd) == 0 {
+		return 0, "", "", fmt.Errorf("no command to run in %s", serviceName)
+	}
+	services, err := k.GetServices(ctx, enclaveName)
+	if err != nil {
+		return 0, "", "", err
+	}
+	service, ok := services[serviceName]
+	if !ok {
+		return 0, "", "", fmt.Errorf("%w: %s", ErrServiceNotFound, serviceName)
+	}
+	return k.execute(ctx, serviceName+"--"+service.UUID, cmd)
+}
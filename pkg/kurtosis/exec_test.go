@@ -0,0 +1,46 @@
+package kurtosis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecArgs(t *testing.T) {
+	assert.Equal(t, []string{"exec", "el-1-geth-lighthouse--abc", "geth", "version"},
+		execArgs("el-1-geth-lighthouse--abc", []string{"geth", "version"}))
+}
+
+func TestDockerExec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake docker is a shell script")
+	}
+	// A fake docker that echoes the command to stdout, complains on stderr and
+	// exits with the code passed as the command's last argument
+	dir := t.TempDir()
+	script := "#!/bin/sh\nshift 2\necho \"$@\"\necho oops >&2\neval exit \\${$#}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docker"), []byte(script), 0o755))
+	t.Setenv("PATH", dir)
+
+	exitCode, stdout, stderr, err := dockerExec(context.Background(), "c", []string{"ls", "/data", "0"})
+	require.NoError(t, err)
+	assert.Zero(t, exitCode)
+	assert.Equal(t, "ls /data 0\n", stdout)
+	assert.Equal(t, "oops\n", stderr)
+
+	// Failing commands return their exit code and output
+	exitCode, stdout, stderr, err = dockerExec(context.Background(), "c", []string{"ls", "3"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, exitCode)
+	assert.Equal(t, "ls 3\n", stdout)
+	assert.Equal(t, "oops\n", stderr)
+
+	t.Setenv("PATH", t.TempDir())
+	_, _, _, err = dockerExec(context.Background(), "c", []string{"ls"})
+	assert.Error(t, err)
+}
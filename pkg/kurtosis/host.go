@@ -0,0 +1,80 @@
+package kurtosis
+
+import (
+	"net"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// localDockerHost is the host published ports are reachable on when the Docker
+// daemon runs on this machine
+const localDockerHost = "localhost"
+
+// DockerHost returns the host that ports published by the Docker daemon are
+// reachable on. A DOCKER_HOST pointing at a remote daemon over tcp or ssh
+// publishes ports on that machine; unix sockets and Windows named pipes mean a
+// local daemon.
+func DockerHost() string {
+	return dockerHostFrom(os.Getenv("DOCKER_HOST"))
+}
+
+// dockerHostFrom extracts the published port host from a DOCKER_HOST value
+func dockerHostFrom(dockerHost string) string {
+	u, err := url.Parse(dockerHost)
+	if err != nil {
+		return localDockerHost
+	}
+	switch u.Scheme {
+	case "tcp", "ssh", "http", "https":
+		if host := u.Hostname(); host != "" {
+			return host
+		}
+	}
+	return localDockerHost
+}
+
+// WithHost returns a copy of the service whose published ports are reached
+// through host instead of the address Kurtosis reported. Empty returns s.
+func (s *ServiceInfo) WithHost(host string) *ServiceInfo {
+	if host == "" {
+		return s
+	}
+	info := *s
+	info.IPAddress = host
+	info.Ports = make(map[string]PortInfo, len(s.Ports))
+	for name, port := range s.Ports {
+		info.Ports[name] = port.WithHost(host)
+	}
+	return &info
+}
+
+// WithHost returns the port with its URL, if any, pointing at host
+func (p PortInfo) WithHost(host string) PortInfo {
+	if p.MaybeURL != "" && host != "" {
+		p.MaybeURL = replaceURLHost(p.MaybeURL, host)
+	}
+	return p
+}
+
+// URLHost brackets IPv6 literals so they can be used as a URL host
+func URLHost(host string) string {
+	if strings.Contains(host, ":") && !strings.HasPrefix(host, "[") {
+		return "[" + host + "]"
+	}
+	return host
+}
+
+// replaceURLHost returns rawURL with its host replaced, keeping scheme, port and path
+func replaceURLHost(rawURL, host string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	if port := u.Port(); port != "" {
+		u.Host = net.JoinHostPort(host, port)
+	} else {
+		u.Host = URLHost(host)
+	}
+	return u.String()
+}
@@ -0,0 +1,44 @@
+package kurtosis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDockerHostFrom(t *testing.T) {
+	tests := map[string]string{
+		"":                                 "localhost",
+		"unix:///var/run/docker.sock":      "localhost",
+		"npipe:////./pipe/docker_engine":   "localhost",
+		"tcp://192.168.1.20:2376":          "192.168.1.20",
+		"ssh://builder@docker.example.com": "docker.example.com",
+		"tcp://[fd00::20]:2375":            "fd00::20",
+		"%zz":                              "localhost",
+	}
+	for dockerHost, expected := range tests {
+		assert.Equal(t, expected, dockerHostFrom(dockerHost), dockerHost)
+	}
+}
+
+func TestServiceInfoWithHost(t *testing.T) {
+	service := &ServiceInfo{
+		Name:      "el-1-geth-lighthouse",
+		IPAddress: "127.0.0.1",
+		Ports: map[string]PortInfo{
+			"rpc":     {Number: 32770, MaybeURL: "http://127.0.0.1:32770"},
+			"metrics": {Number: 32771},
+		},
+	}
+
+	assert.Same(t, service, service.WithHost(""))
+
+	remote := service.WithHost("fd00::20")
+	assert.Equal(t, "fd00::20", remote.IPAddress)
+	assert.Equal(t, "http://[fd00::20]:32770", remote.Ports["rpc"].MaybeURL)
+	assert.Empty(t, remote.Ports["metrics"].MaybeURL)
+
+	// The original is left untouched
+	assert.Equal(t, "http://127.0.0.1:32770", service.Ports["rpc"].MaybeURL)
+	assert.Equal(t, "127.0.0.1", service.IPAddress)
+}
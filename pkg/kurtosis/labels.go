@@ -0,0 +1,92 @@
+package kurtosis
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LabelConfigHash is the enclave label holding the hash of the config a network was deployed with
+const LabelConfigHash = "config-hash"
+
+// labelArtifactPrefix marks the files artifacts that store enclave labels. Kurtosis
+// enclaves have no label API, so each label is an empty artifact whose name encodes
+// the key and value.
+const labelArtifactPrefix = "ethereum-package-go-label--"
+
+// labelArtifactName returns the name of the files artifact storing a label
+func labelArtifactName(key, value string) string {
+	return labelArtifactPrefix + key + "--" + value
+}
+
+// parseLabelArtifactName returns the label stored by a files artifact, if it stores one
+func parseLabelArtifactName(name string) (key, value string, ok bool) {
+	rest, ok := strings.CutPrefix(name, labelArtifactPrefix)
+	if !ok {
+		return "", "", false
+	}
+	return strings.Cut(rest, "--")
+}
+
+// EnclaveLabels returns the labels set on the enclave
+func (k *KurtosisClient) EnclaveLabels(ctx context.Context, enclaveName string) (map[string]string, error) {
+	enclaveCtx, err := k.enclaveContext(ctx, enclaveName)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts, err := enclaveCtx.GetAllFilesArtifactNamesAndUuids(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files artifacts: %w", err)
+	}
+
+	labels := make(map[string]string)
+	for _, artifact := range artifacts {
+		if key, value, ok := parseLabelArtifactName(artifact.GetFileName()); ok {
+			labels[key] = value
+		}
+	}
+	return labels, nil
+}
+
+// SetEnclaveLabel sets a label on the enclave. Labels can't be changed once set;
+// setting a label to its current value is a no-op.
+func (k *KurtosisClient) SetEnclaveLabel(ctx context.Context, enclaveName, key, value string) error {
+	if key == "" || strings.Contains(key, "--") {
+		return fmt.Errorf("%w: invalid label key %q", ErrInvalidConfiguration, key)
+	}
+
+	labels, err := k.EnclaveLabels(ctx, enclaveName)
+	if err != nil {
+		return err
+	}
+	if current, ok := labels[key]; ok {
+		if current == value {
+			return nil
+		}
+		return fmt.Errorf("label %s is already set to %q", key, current)
+	}
+
+	enclaveCtx, err := k.enclaveContext(ctx, enclaveName)
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "ethereum-package-go-label")
+	if err != nil {
+		return fmt.Errorf("failed to create label directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, key)
+	if err := os.WriteFile(path, []byte(value), 0o600); err != nil {
+		return fmt.Errorf("failed to write label file: %w", err)
+	}
+	if _, _, err := enclaveCtx.UploadFiles(path, labelArtifactName(key, value)); err != nil {
+		return fmt.Errorf("failed to set label %s: %w", key, err)
+	}
+
+	return nil
+}
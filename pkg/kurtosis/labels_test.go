@@ -0,0 +1,20 @@
+package kurtosis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelArtifactName(t *testing.T) {
+	name := labelArtifactName(LabelConfigHash, "abc123")
+	assert.Equal(t, "ethereum-package-go-label--config-hash--abc123", name)
+
+	key, value, ok := parseLabelArtifactName(name)
+	assert.True(t, ok)
+	assert.Equal(t, LabelConfigHash, key)
+	assert.Equal(t, "abc123", value)
+
+	_, _, ok = parseLabelArtifactName("el_cl_genesis_data")
+	assert.False(t, ok)
+}
@@ -0,0 +1,317 @@
+package kurtosis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+// ParsePlan extracts the services a dry run would create from its Starlark
+// instructions, such as
+//
+//	add_service(name="el-1-geth-lighthouse", config=ServiceConfig(image="ethereum/client-go:latest", ports={"rpc": PortSpec(number=8545, transport_protocol="TCP")}))
+//
+// Instructions other than add_service and add_services are ignored.
+func ParsePlan(instructions []string) (*network.Plan, error) {
+	plan := &network.Plan{}
+	for _, instruction := range instructions {
+		instruction = strings.TrimSpace(instruction)
+		if !strings.HasPrefix(instruction, "add_service(") && !strings.HasPrefix(instruction, "add_services(") {
+			continue
+		}
+
+		call, err := parseStarlark(instruction)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse instruction %q: %w", truncate(instruction, 80), err)
+		}
+
+		switch call.name {
+		case "add_service":
+			name := call.arg("name", 0)
+			config := call.arg("config", 1)
+			if name == nil || config == nil {
+				return nil, fmt.Errorf("add_service without name or config")
+			}
+			plan.Services = append(plan.Services, plannedService(name.str, config))
+		case "add_services":
+			configs := call.arg("configs", 0)
+			if configs == nil {
+				return nil, fmt.Errorf("add_services without configs")
+			}
+			for _, entry := range configs.entries {
+				plan.Services = append(plan.Services, plannedService(entry.key.str, entry.value))
+			}
+		}
+	}
+	return plan, nil
+}
+
+// plannedService converts a ServiceConfig value
+func plannedService(name string, config *starlarkValue) network.PlannedService {
+	service := network.PlannedService{Name: name, Type: network.DetectServiceType(name)}
+	if image := config.arg("image", -1); image != nil {
+		service.Image = image.str
+		// Images built or pulled from a spec name the image inside it
+		if image.name != "" {
+			if specImage := image.arg("image", -1); specImage != nil {
+				service.Image = specImage.str
+			} else if specName := image.arg("image_name", -1); specName != nil {
+				service.Image = specName.str
+			}
+		}
+	}
+	if ports := config.arg("ports", -1); ports != nil {
+		for _, entry := range ports.entries {
+			port := network.Port{Name: entry.key.str}
+			if number := entry.value.arg("number", 0); number != nil {
+				port.InternalPort, _ = strconv.Atoi(number.str)
+			}
+			if protocol := entry.value.arg("transport_protocol", 1); protocol != nil {
+				port.Protocol = protocol.str
+			}
+			service.Ports = append(service.Ports, port)
+		}
+	}
+	return service
+}
+
+// starlarkValue is a parsed Starlark literal: a scalar in str, a call with a
+// name and args, a dict in entries or a list in items
+type starlarkValue struct {
+	str     string
+	name    string
+	args    []starlarkArg
+	entries []starlarkEntry
+	items   []*starlarkValue
+}
+
+type starlarkArg struct {
+	name  string
+	value *starlarkValue
+}
+
+type starlarkEntry struct {
+	key   *starlarkValue
+	value *starlarkValue
+}
+
+// arg returns a call's keyword argument, or its positional argument at index
+// when no keyword argument has the name; nil if there is neither
+func (v *starlarkValue) arg(name string, index int) *starlarkValue {
+	if v == nil {
+		return nil
+	}
+	position := 0
+	for _, arg := range v.args {
+		if arg.name == name {
+			return arg.value
+		}
+		if arg.name == "" {
+			if position == index {
+				return arg.value
+			}
+			position++
+		}
+	}
+	return nil
+}
+
+// parseStarlark parses a single Starlark expression
+func parseStarlark(src string) (*starlarkValue, error) {
+	p := &starlarkParser{src: src}
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.src) {
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.src[p.pos], p.pos)
+	}
+	return value, nil
+}
+
+type starlarkParser struct {
+	src string
+	pos int
+}
+
+func (p *starlarkParser) skipSpace() {
+	for p.pos < len(p.src) && strings.ContainsRune(" \t\r\n", rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *starlarkParser) value() (*starlarkValue, error) {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return nil, fmt.Errorf("unexpected end of input")
+	}
+
+	switch c := p.src[p.pos]; {
+	case c == '"' || c == '\'':
+		s, err := p.string()
+		return &starlarkValue{str: s}, err
+	case c == '{':
+		return p.dict()
+	case c == '[' || c == '(':
+		return p.list()
+	default:
+		start := p.pos
+		for p.pos < len(p.src) && !strings.ContainsRune(" \t\r\n,:()[]{}=", rune(p.src[p.pos])) {
+			p.pos++
+		}
+		if start == p.pos {
+			return nil, fmt.Errorf("unexpected %q at offset %d", c, p.pos)
+		}
+		token := p.src[start:p.pos]
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == '(' {
+			return p.call(token)
+		}
+		return &starlarkValue{str: token}, nil
+	}
+}
+
+// string parses a quoted string, resolving escapes
+func (p *starlarkParser) string() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		p.pos++
+		switch {
+		case c == quote:
+			return b.String(), nil
+		case c == '\\' && p.pos < len(p.src):
+			escaped := p.src[p.pos]
+			p.pos++
+			switch escaped {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(escaped)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("unterminated string")
+}
+
+func (p *starlarkParser) call(name string) (*starlarkValue, error) {
+	p.pos++ // (
+	call := &starlarkValue{name: name}
+	for {
+		p.skipSpace()
+		if p.consume(')') {
+			return call, nil
+		}
+
+		arg := starlarkArg{}
+		start := p.pos
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		// A bare identifier followed by = names a keyword argument
+		if p.pos < len(p.src) && p.src[p.pos] == '=' && value.name == "" && p.src[start] != '"' && p.src[start] != '\'' {
+			p.pos++
+			arg.name = value.str
+			if value, err = p.value(); err != nil {
+				return nil, err
+			}
+		}
+		arg.value = value
+		call.args = append(call.args, arg)
+
+		if err := p.separator(')'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *starlarkParser) dict() (*starlarkValue, error) {
+	p.pos++ // {
+	dict := &starlarkValue{}
+	for {
+		p.skipSpace()
+		if p.consume('}') {
+			return dict, nil
+		}
+		key, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(':') {
+			return nil, fmt.Errorf("expected ':' at offset %d", p.pos)
+		}
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		dict.entries = append(dict.entries, starlarkEntry{key: key, value: value})
+
+		if err := p.separator('}'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *starlarkParser) list() (*starlarkValue, error) {
+	closing := byte(']')
+	if p.src[p.pos] == '(' {
+		closing = ')'
+	}
+	p.pos++
+	list := &starlarkValue{}
+	for {
+		p.skipSpace()
+		if p.consume(closing) {
+			return list, nil
+		}
+		item, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		list.items = append(list.items, item)
+
+		if err := p.separator(closing); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// separator expects a comma or, without consuming it, the closing bracket
+func (p *starlarkParser) separator(closing byte) error {
+	p.skipSpace()
+	if p.consume(',') {
+		return nil
+	}
+	if p.pos < len(p.src) && p.src[p.pos] == closing {
+		return nil
+	}
+	return fmt.Errorf("expected ',' or %q at offset %d", closing, p.pos)
+}
+
+func (p *starlarkParser) consume(c byte) bool {
+	if p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// truncate shortens s to at most n bytes for error messages
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
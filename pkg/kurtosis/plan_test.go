@@ -0,0 +1,55 @@
+package kurtosis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+func TestParsePlan(t *testing.T) {
+	instructions := []string{
+		`upload_files(src="static_files/jwt/jwtsecret", name="jwt_file")`,
+		`add_service(name="el-1-geth-lighthouse", config=ServiceConfig(image="ethereum/client-go:latest", ports={"engine-rpc": PortSpec(number=8551, transport_protocol="TCP", application_protocol=""), "rpc": PortSpec(number=8545, transport_protocol="TCP", application_protocol="http")}, cmd=["--http", "--http.api=eth,net"], env_vars={}, min_cpu=100))`,
+		`add_services(configs={"cl-1-lighthouse-geth": ServiceConfig(image="sigp/lighthouse:latest", ports={"http": PortSpec(number=4000, transport_protocol="TCP")}), "vc-1-geth-lighthouse": ServiceConfig(image=ImageSpec(image="sigp/lighthouse:latest"), ports={})})`,
+		`add_service("dora", ServiceConfig(image='ethpandaops/dora:latest', labels={"note": "it's \"quoted\""}))`,
+	}
+
+	plan, err := ParsePlan(instructions)
+	require.NoError(t, err)
+	require.Len(t, plan.Services, 4)
+
+	el := plan.Services[0]
+	assert.Equal(t, "el-1-geth-lighthouse", el.Name)
+	assert.Equal(t, network.ServiceTypeExecutionClient, el.Type)
+	assert.Equal(t, "ethereum/client-go:latest", el.Image)
+	assert.Equal(t, []network.Port{
+		{Name: "engine-rpc", InternalPort: 8551, Protocol: "TCP"},
+		{Name: "rpc", InternalPort: 8545, Protocol: "TCP"},
+	}, el.Ports)
+
+	vc, ok := plan.Service("vc-1-geth-lighthouse")
+	require.True(t, ok)
+	assert.Equal(t, "sigp/lighthouse:latest", vc.Image)
+	assert.Empty(t, vc.Ports)
+
+	dora, ok := plan.Service("dora")
+	require.True(t, ok)
+	assert.Equal(t, "ethpandaops/dora:latest", dora.Image)
+
+	assert.Equal(t, []string{"ethereum/client-go:latest", "ethpandaops/dora:latest", "sigp/lighthouse:latest"}, plan.Images())
+}
+
+func TestParsePlanErrors(t *testing.T) {
+	_, err := ParsePlan([]string{`add_service(name="el-1", config=ServiceConfig(image="geth"`})
+	assert.ErrorContains(t, err, "failed to parse instruction")
+
+	_, err = ParsePlan([]string{`add_service(name="el-1")`})
+	assert.ErrorContains(t, err, "without name or config")
+
+	plan, err := ParsePlan(nil)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Services)
+}
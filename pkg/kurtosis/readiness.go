@@ -0,0 +1,35 @@
+package kurtosis
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+// DefaultProbeTimeout is the per-request timeout of a single readiness probe
+const DefaultProbeTimeout = 5 * time.Second
+
+// ProbeURL returns the base URL the readiness probe for the service's type should
+// target, or an empty string if the service has no probe or none of its ports match
+func ProbeURL(service *ServiceInfo) string {
+	probe, ok := network.ReadinessProbeFor(network.DetectServiceType(service.Name))
+	if !ok {
+		return ""
+	}
+
+	for _, name := range probe.Ports {
+		port, exists := service.Ports[name]
+		if !exists {
+			continue
+		}
+		if port.MaybeURL != "" {
+			return port.MaybeURL
+		}
+		if service.IPAddress != "" {
+			return fmt.Sprintf("http://%s:%d", service.IPAddress, port.Number)
+		}
+	}
+
+	return ""
+}
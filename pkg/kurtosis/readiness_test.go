@@ -0,0 +1,146 @@
+package kurtosis
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		service  *ServiceInfo
+		expected string
+	}{
+		{
+			name: "execution client uses rpc port",
+			service: &ServiceInfo{
+				Name:      "el-1-geth-lighthouse",
+				IPAddress: "172.16.0.2",
+				Ports: map[string]PortInfo{
+					"engine-rpc": {Number: 8551},
+					"rpc":        {Number: 8545},
+				},
+			},
+			expected: "http://172.16.0.2:8545",
+		},
+		{
+			name: "consensus client prefers published URL",
+			service: &ServiceInfo{
+				Name:      "cl-1-lighthouse-geth",
+				IPAddress: "172.16.0.3",
+				Ports: map[string]PortInfo{
+					"http": {Number: 4000, MaybeURL: "http://127.0.0.1:32000"},
+				},
+			},
+			expected: "http://127.0.0.1:32000",
+		},
+		{
+			name: "service type without probe",
+			service: &ServiceInfo{
+				Name:      "apache",
+				IPAddress: "172.16.0.4",
+				Ports:     map[string]PortInfo{"http": {Number: 80}},
+			},
+			expected: "",
+		},
+		{
+			name: "no matching port",
+			service: &ServiceInfo{
+				Name:      "prometheus",
+				IPAddress: "172.16.0.5",
+				Ports:     map[string]PortInfo{"metrics": {Number: 9090}},
+			},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ProbeURL(tt.service))
+		})
+	}
+}
+
+func TestReadinessProbes(t *testing.T) {
+	status := http.StatusPartialContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/node/health":
+			w.WriteHeader(status)
+		case "/-/ready":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x0"}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	httpClient := server.Client()
+
+	// A syncing beacon node (206) counts as ready
+	assert.NoError(t, network.CheckReadiness(ctx, httpClient, network.ServiceTypeConsensusClient, server.URL))
+	status = http.StatusServiceUnavailable
+	assert.Error(t, network.CheckReadiness(ctx, httpClient, network.ServiceTypeConsensusClient, server.URL))
+
+	assert.NoError(t, network.CheckReadiness(ctx, httpClient, network.ServiceTypeExecutionClient, server.URL))
+	assert.Error(t, network.CheckReadiness(ctx, httpClient, network.ServiceTypePrometheus, server.URL))
+
+	// No probe or no URL means ready
+	assert.NoError(t, network.CheckReadiness(ctx, httpClient, network.ServiceTypeApache, server.URL))
+	assert.NoError(t, network.CheckReadiness(ctx, httpClient, network.ServiceTypePrometheus, ""))
+}
+
+func TestRegisterReadinessProbe(t *testing.T) {
+	_, ok := network.ReadinessProbeFor(network.ServiceTypeSpamoor)
+	require.False(t, ok)
+
+	called := false
+	network.RegisterReadinessProbe(network.ServiceTypeSpamoor, network.ReadinessProbe{
+		Ports: []string{"http"},
+		Check: func(ctx context.Context, httpClient *http.Client, baseURL string) error {
+			called = true
+			return nil
+		},
+	})
+
+	service := &ServiceInfo{Name: "spamoor", IPAddress: "172.16.0.6", Ports: map[string]PortInfo{"http": {Number: 8080}}}
+	url := ProbeURL(service)
+	assert.Equal(t, "http://172.16.0.6:8080", url)
+	assert.NoError(t, network.CheckReadiness(context.Background(), http.DefaultClient, network.ServiceTypeSpamoor, url))
+	assert.True(t, called)
+}
+
+func TestNetworkHealth(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer unhealthy.Close()
+
+	net := network.New(network.Config{
+		Name: "test",
+		Services: []network.Service{
+			{Name: "cl-1-lighthouse-geth", Type: network.ServiceTypeConsensusClient, URL: healthy.URL},
+			{Name: "grafana", Type: network.ServiceTypeGrafana, URL: unhealthy.URL},
+			{Name: "apache", Type: network.ServiceTypeApache},
+		},
+		OrphanOnExit: true,
+	})
+
+	health := net.Health(context.Background())
+	require.Len(t, health, 3)
+	assert.NoError(t, health["cl-1-lighthouse-geth"])
+	assert.Error(t, health["grafana"])
+	assert.NoError(t, health["apache"])
+}
@@ -0,0 +1,203 @@
+package kurtosis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+)
+
+// StopService stops a running service in the enclave, keeping its container and data
+func (k *KurtosisClient) StopService(ctx context.Context, enclaveName, serviceName string) error {
+	script := fmt.Sprintf("def run(plan):\n    plan.stop_service(name = %q)\n", serviceName)
+	if err := k.runScript(ctx, enclaveName, script); err != nil {
+		return fmt.Errorf("failed to stop service %s: %w", serviceName, err)
+	}
+	return nil
+}
+
+// StartService starts a previously stopped service in the enclave
+func (k *KurtosisClient) StartService(ctx context.Context, enclaveName, serviceName string) error {
+	script := fmt.Sprintf("def run(plan):\n    plan.start_service(name = %q)\n", serviceName)
+	if err := k.runScript(ctx, enclaveName, script); err != nil {
+		return fmt.Errorf("failed to start service %s: %w", serviceName, err)
+	}
+	return nil
+}
+
+// AddService deploys a container into the enclave. Spec files are uploaded as
+// files artifacts and mounted at their container directories.
+func (k *KurtosisClient) AddService(ctx context.Context, enclaveName string, spec network.ServiceSpec) (*ServiceInfo, error) {
+	enclaveCtx, err := k.enclaveContext(ctx, enclaveName)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := make(map[string]string, len(spec.Files))
+	for i, mountPath := range sortedKeys(spec.Files) {
+		artifactName := fmt.Sprintf("%s-files-%d", spec.Name, i)
+		if _, _, err := enclaveCtx.UploadFiles(spec.Files[mountPath], artifactName); err != nil {
+			return nil, fmt.Errorf("failed to upload %s for service %s: %w", spec.Files[mountPath], spec.Name, err)
+		}
+		artifacts[mountPath] = artifactName
+	}
+
+	if err := k.runScript(ctx, enclaveName, addServiceScript(spec, artifacts)); err != nil {
+		return nil, fmt.Errorf("failed to add service %s: %w", spec.Name, err)
+	}
+
+	services, err := k.GetServices(ctx, enclaveName)
+	if err != nil {
+		return nil, err
+	}
+	service, ok := services[spec.Name]
+	if !ok {
+		return nil, fmt.Errorf("service %s not found after adding it", spec.Name)
+	}
+	return service, nil
+}
+
+// addServiceScript builds the Starlark script adding the service, mounting the
+// given files artifacts keyed by container directory
+func addServiceScript(spec network.ServiceSpec, artifacts map[string]string) string {
+	var b strings.Builder
+	b.WriteString("def run(plan):\n")
+	fmt.Fprintf(&b, "    plan.add_service(name = %q, config = ServiceConfig(\n", spec.Name)
+	fmt.Fprintf(&b, "        image = %q,\n", spec.Image)
+
+	if len(spec.Ports) > 0 {
+		b.WriteString("        ports = {\n")
+		for _, port := range spec.Ports {
+			protocol := strings.ToUpper(port.Protocol)
+			if protocol == "" {
+				protocol = "TCP"
+			}
+			fmt.Fprintf(&b, "            %q: PortSpec(number = %d, transport_protocol = %q, wait = None),\n", port.Name, port.InternalPort, protocol)
+		}
+		b.WriteString("        },\n")
+	}
+	if len(spec.Env) > 0 {
+		b.WriteString("        env_vars = {\n")
+		for _, key := range sortedKeys(spec.Env) {
+			fmt.Fprintf(&b, "            %q: %q,\n", key, spec.Env[key])
+		}
+		b.WriteString("        },\n")
+	}
+	if len(artifacts) > 0 {
+		b.WriteString("        files = {\n")
+		for _, mountPath := range sortedKeys(artifacts) {
+			fmt.Fprintf(&b, "            %q: %q,\n", mountPath, artifacts[mountPath])
+		}
+		b.WriteString("        },\n")
+	}
+	if len(spec.Entrypoint) > 0 {
+		fmt.Fprintf(&b, "        entrypoint = %s,\n", starlarkList(spec.Entrypoint))
+	}
+	if len(spec.Cmd) > 0 {
+		fmt.Fprintf(&b, "        cmd = %s,\n", starlarkList(spec.Cmd))
+	}
+
+	b.WriteString("    ))\n")
+	return b.String()
+}
+
+// starlarkList formats strings as a Starlark list literal
+func starlarkList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// sortedKeys returns the keys of a string map in order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ReadFile returns the contents of a file inside a service's container
+func (k *KurtosisClient) ReadFile(ctx context.Context, enclaveName, serviceName, path string) ([]byte, error) {
+	enclaveCtx, err := k.enclaveContext(ctx, enclaveName)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceCtx, err := enclaveCtx.GetServiceContext(serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrServiceNotFound, serviceName)
+	}
+
+	exitCode, output, err := serviceCtx.ExecCommand([]string{"cat", path})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from %s: %w", path, serviceName, err)
+	}
+	if exitCode != 0 {
+		return nil, fmt.Errorf("failed to read %s from %s: %s", path, serviceName, strings.TrimSpace(output))
+	}
+	return []byte(output), nil
+}
+
+// ServiceLogs returns the last lines of a service's logs
+func (k *KurtosisClient) ServiceLogs(ctx context.Context, enclaveName, serviceName string, lines int) ([]string, error) {
+	logs, err := k.engine.ServiceLogs(ctx, enclaveName, serviceName, lines)
+	if err != nil {
+		return nil, k.versionError(err)
+	}
+	return logs, nil
+}
+
+// runScript runs a Starlark script in the enclave and blocks until it completes
+func (k *KurtosisClient) runScript(ctx context.Context, enclaveName, script string) error {
+	enclaveCtx, err := k.enclaveContext(ctx, enclaveName)
+	if err != nil {
+		return err
+	}
+
+	result, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, script, starlark_run_config.NewRunStarlarkConfig())
+	if err != nil {
+		return k.versionError(err)
+	}
+	if result.InterpretationError != nil {
+		return k.versionError(fmt.Errorf("interpretation error: %s", result.InterpretationError.GetErrorMessage()))
+	}
+	if result.ExecutionError != nil {
+		return fmt.Errorf("execution error: %s", result.ExecutionError.GetErrorMessage())
+	}
+
+	return nil
+}
+
+// enclaveContext returns the cached enclave context, fetching it from the engine if needed
+func (k *KurtosisClient) enclaveContext(ctx context.Context, enclaveName string) (*enclaves.EnclaveContext, error) {
+	k.mu.RLock()
+	enclaveCtx, exists := k.enclaves[enclaveName]
+	k.mu.RUnlock()
+	if exists {
+		return enclaveCtx, nil
+	}
+
+	enclaveCtx, err := k.engine.GetEnclaveContext(ctx, enclaveName)
+	if err != nil {
+		if errors.Is(err, ErrEnclaveNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get enclave %s: %w", enclaveName, err)
+	}
+
+	k.mu.Lock()
+	k.enclaves[enclaveName] = enclaveCtx
+	k.mu.Unlock()
+
+	return enclaveCtx, nil
+}
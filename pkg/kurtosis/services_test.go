@@ -0,0 +1,56 @@
+package kurtosis
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddServiceScript(t *testing.T) {
+	spec := network.ServiceSpec{
+		Name:  "blockscout",
+		Image: "blockscout/blockscout:latest",
+		Ports: []network.Port{
+			{Name: "http", InternalPort: 4000},
+			{Name: "discovery", InternalPort: 30303, Protocol: "udp"},
+		},
+		Env: map[string]string{
+			"NETWORK":                   "kurtosis",
+			"ETHEREUM_JSONRPC_HTTP_URL": "http://el-1-geth-lighthouse:8545",
+		},
+		Entrypoint: []string{"/bin/sh", "-c"},
+		Cmd:        []string{`echo "ready" && sleep infinity`},
+	}
+
+	script := addServiceScript(spec, map[string]string{"/config": "blockscout-files-0"})
+
+	assert.Equal(t, `def run(plan):
+    plan.add_service(name = "blockscout", config = ServiceConfig(
+        image = "blockscout/blockscout:latest",
+        ports = {
+            "http": PortSpec(number = 4000, transport_protocol = "TCP", wait = None),
+            "discovery": PortSpec(number = 30303, transport_protocol = "UDP", wait = None),
+        },
+        env_vars = {
+            "ETHEREUM_JSONRPC_HTTP_URL": "http://el-1-geth-lighthouse:8545",
+            "NETWORK": "kurtosis",
+        },
+        files = {
+            "/config": "blockscout-files-0",
+        },
+        entrypoint = ["/bin/sh", "-c"],
+        cmd = ["echo \"ready\" && sleep infinity"],
+    ))
+`, script)
+}
+
+func TestAddServiceScriptMinimal(t *testing.T) {
+	script := addServiceScript(network.ServiceSpec{Name: "nginx", Image: "nginx"}, nil)
+
+	assert.Equal(t, `def run(plan):
+    plan.add_service(name = "nginx", config = ServiceConfig(
+        image = "nginx",
+    ))
+`, script)
+}
@@ -0,0 +1,175 @@
+package kurtosis
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+// preRestoreSuffix names where a directory is moved while it is restored
+const preRestoreSuffix = ".pre-restore"
+
+// dockerCopyTo extracts a tar archive into a directory of the container. It
+// works on stopped containers.
+func dockerCopyTo(ctx context.Context, container, dir string, archive io.Reader) error {
+	cmd := exec.CommandContext(ctx, "docker", "cp", "-", container+":"+dir)
+	cmd.Stdin = archive
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("docker cp failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// SnapshotServices saves each directory as a files artifact of the enclave.
+// The services are stopped in reverse order while their data is copied and
+// started again in order.
+func (k *KurtosisClient) SnapshotServices(ctx context.Context, enclaveName string, dirs []network.ServiceDir) (err error) {
+	services := serviceOrder(dirs)
+	if err := k.stopServices(ctx, enclaveName, services); err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, k.startServices(ctx, enclaveName, services))
+	}()
+
+	var script strings.Builder
+	script.WriteString("def run(plan):\n")
+	for _, dir := range dirs {
+		fmt.Fprintf(&script, "    plan.store_service_files(service_name = %q, src = %q, name = %q)\n", dir.Service, dir.Path, dir.Artifact)
+	}
+	if err := k.runScript(ctx, enclaveName, script.String()); err != nil {
+		return fmt.Errorf("failed to store service files: %w", err)
+	}
+	return nil
+}
+
+// RestoreServices replaces each directory with the contents of its files
+// artifact. The directories are moved aside while the services still run,
+// the services are stopped in reverse order, the artifacts are copied in and
+// the services are started again in order.
+func (k *KurtosisClient) RestoreServices(ctx context.Context, enclaveName string, dirs []network.ServiceDir) error {
+	enclaveCtx, err := k.enclaveContext(ctx, enclaveName)
+	if err != nil {
+		return err
+	}
+	infos, err := k.GetServices(ctx, enclaveName)
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if _, ok := infos[dir.Service]; !ok {
+			return fmt.Errorf("%w: %s", ErrServiceNotFound, dir.Service)
+		}
+	}
+
+	// Fetch the artifacts first, so a missing one leaves the services untouched
+	archives := make([][]byte, len(dirs))
+	for i, dir := range dirs {
+		archive, err := enclaveCtx.DownloadFilesArtifact(ctx, dir.Artifact)
+		if err != nil {
+			return fmt.Errorf("failed to download files artifact %s: %w", dir.Artifact, k.versionError(err))
+		}
+		archives[i] = archive
+	}
+
+	// Moving the directories needs the containers running; clearing them
+	// afterwards would leave files the snapshot does not know of
+	for i, dir := range dirs {
+		if err := k.exec(ctx, enclaveName, dir.Service, "rm -rf %[1]q && mv %[2]q %[1]q", dir.Path+preRestoreSuffix, dir.Path); err != nil {
+			for _, moved := range dirs[:i] {
+				_ = k.exec(ctx, enclaveName, moved.Service, "mv %q %q", moved.Path+preRestoreSuffix, moved.Path)
+			}
+			return fmt.Errorf("failed to move %s of %s aside: %w", dir.Path, dir.Service, err)
+		}
+	}
+
+	services := serviceOrder(dirs)
+	if err := k.stopServices(ctx, enclaveName, services); err != nil {
+		return err
+	}
+	for i, dir := range dirs {
+		archive, err := gzip.NewReader(bytes.NewReader(archives[i]))
+		if err != nil {
+			return fmt.Errorf("failed to read files artifact %s: %w", dir.Artifact, err)
+		}
+		container := dir.Service + "--" + infos[dir.Service].UUID
+		if err := k.copyTo(ctx, container, path.Dir(dir.Path), archive); err != nil {
+			return fmt.Errorf("failed to restore %s of %s: %w", dir.Path, dir.Service, err)
+		}
+	}
+	if err := k.startServices(ctx, enclaveName, services); err != nil {
+		return err
+	}
+
+	// The old data only takes up space now
+	var errs []error
+	for _, dir := range dirs {
+		if err := k.exec(ctx, enclaveName, dir.Service, "rm -rf %q", dir.Path+preRestoreSuffix); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove the old data of %s: %w", dir.Service, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// exec runs a shell command in a running service's container
+func (k *KurtosisClient) exec(ctx context.Context, enclaveName, serviceName, format string, args ...any) error {
+	enclaveCtx, err := k.enclaveContext(ctx, enclaveName)
+	if err != nil {
+		return err
+	}
+	serviceCtx, err := enclaveCtx.GetServiceContext(serviceName)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrServiceNotFound, serviceName)
+	}
+
+	exitCode, output, err := serviceCtx.ExecCommand([]string{"sh", "-c", fmt.Sprintf(format, args...)})
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("exit code %d: %s", exitCode, strings.TrimSpace(output))
+	}
+	return nil
+}
+
+// serviceOrder returns the services of the directories in order, without duplicates
+func serviceOrder(dirs []network.ServiceDir) []string {
+	seen := make(map[string]bool)
+	var services []string
+	for _, dir := range dirs {
+		if !seen[dir.Service] {
+			seen[dir.Service] = true
+			services = append(services, dir.Service)
+		}
+	}
+	return services
+}
+
+// stopServices stops the services in reverse order
+func (k *KurtosisClient) stopServices(ctx context.Context, enclaveName string, services []string) error {
+	for i := len(services) - 1; i >= 0; i-- {
+		if err := k.StopService(ctx, enclaveName, services[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startServices starts the services in order, trying every service
+func (k *KurtosisClient) startServices(ctx context.Context, enclaveName string, services []string) error {
+	var errs []error
+	for _, service := range services {
+		if err := k.StartService(ctx, enclaveName, service); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
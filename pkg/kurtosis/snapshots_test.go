@@ -0,0 +1,17 @@
+package kurtosis
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceOrder(t *testing.T) {
+	dirs := []network.ServiceDir{
+		{Service: "el-1-geth-lighthouse", Path: "/data/geth/execution-data"},
+		{Service: "cl-1-lighthouse-geth", Path: "/data/lighthouse/beacon-data"},
+		{Service: "el-1-geth-lighthouse", Path: "/data/geth/ancient"},
+	}
+	assert.Equal(t, []string{"el-1-geth-lighthouse", "cl-1-lighthouse-geth"}, serviceOrder(dirs))
+}
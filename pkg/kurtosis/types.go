@@ -12,9 +12,10 @@ func ConvertServiceInfoToExecutionClient(service *ServiceInfo, clientType client
 	wsURL := ""
 	engineURL := ""
 	metricsURL := ""
+	p2pURL := ""
 	p2pPort := 0
 
-	// Extract URLs from ports
+	// Extract URLs from ports, accepting both short names and ethereum-package port ids
 	for portName, portInfo := range service.Ports {
 		switch portName {
 		case "rpc":
@@ -27,7 +28,7 @@ func ConvertServiceInfoToExecutionClient(service *ServiceInfo, clientType client
 			if wsURL == "" && service.IPAddress != "" {
 				wsURL = fmt.Sprintf("ws://%s:%d", service.IPAddress, portInfo.Number)
 			}
-		case "engine":
+		case "engine", "engine-rpc":
 			engineURL = portInfo.MaybeURL
 			if engineURL == "" && service.IPAddress != "" {
 				engineURL = fmt.Sprintf("http://%s:%d", service.IPAddress, portInfo.Number)
@@ -37,8 +38,9 @@ func ConvertServiceInfoToExecutionClient(service *ServiceInfo, clientType client
 			if metricsURL == "" && service.IPAddress != "" {
 				metricsURL = fmt.Sprintf("http://%s:%d", service.IPAddress, portInfo.Number)
 			}
-		case "p2p":
+		case "p2p", "tcp-discovery":
 			p2pPort = int(portInfo.Number)
+			p2pURL = p2pURLFromPort(service, portInfo)
 		}
 	}
 
@@ -46,16 +48,18 @@ func ConvertServiceInfoToExecutionClient(service *ServiceInfo, clientType client
 	version := extractVersionFromService(service)
 	enode := extractEnodeFromService(service)
 
-	return client.NewExecutionClient(clientType, service.Name, version, rpcURL, wsURL, engineURL, metricsURL, enode, service.Name, service.UUID, p2pPort)
+	return client.NewExecutionClient(clientType, service.Name, version, rpcURL, wsURL, engineURL, metricsURL, enode, service.Name, service.UUID, p2pPort).
+		WithP2PURL(p2pURL)
 }
 
 // ConvertServiceInfoToConsensusClient converts Kurtosis ServiceInfo to a ConsensusClient
 func ConvertServiceInfoToConsensusClient(service *ServiceInfo, clientType client.Type) client.ConsensusClient {
 	beaconAPIURL := ""
 	metricsURL := ""
+	p2pURL := ""
 	p2pPort := 0
 
-	// Extract URLs from ports
+	// Extract URLs from ports, accepting both short names and ethereum-package port ids
 	for portName, portInfo := range service.Ports {
 		switch portName {
 		case "beacon", "http":
@@ -68,8 +72,9 @@ func ConvertServiceInfoToConsensusClient(service *ServiceInfo, clientType client
 			if metricsURL == "" && service.IPAddress != "" {
 				metricsURL = fmt.Sprintf("http://%s:%d", service.IPAddress, portInfo.Number)
 			}
-		case "p2p":
+		case "p2p", "tcp-discovery":
 			p2pPort = int(portInfo.Number)
+			p2pURL = p2pURLFromPort(service, portInfo)
 		}
 	}
 
@@ -78,7 +83,19 @@ func ConvertServiceInfoToConsensusClient(service *ServiceInfo, clientType client
 	enr := extractENRFromService(service)
 	peerID := extractPeerIDFromService(service)
 
-	return client.NewConsensusClient(clientType, service.Name, version, beaconAPIURL, metricsURL, enr, peerID, service.Name, service.UUID, p2pPort)
+	return client.NewConsensusClient(clientType, service.Name, version, beaconAPIURL, metricsURL, enr, peerID, service.Name, service.UUID, p2pPort).
+		WithP2PURL(p2pURL)
+}
+
+// p2pURLFromPort builds a tcp:// URL for a peer-to-peer port
+func p2pURLFromPort(service *ServiceInfo, port PortInfo) string {
+	if port.MaybeURL != "" {
+		return port.MaybeURL
+	}
+	if service.IPAddress == "" {
+		return ""
+	}
+	return fmt.Sprintf("tcp://%s:%d", service.IPAddress, port.Number)
 }
 
 // DetectClientType attempts to detect the client type from the service name
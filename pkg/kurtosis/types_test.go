@@ -169,3 +169,33 @@ func TestToLower(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertServiceInfoEthereumPackagePortNames(t *testing.T) {
+	el := &ServiceInfo{
+		Name:      "el-1-geth-lighthouse",
+		IPAddress: "172.16.0.2",
+		Ports: map[string]PortInfo{
+			"rpc":           {Number: 8545},
+			"engine-rpc":    {Number: 8551},
+			"metrics":       {Number: 9001},
+			"tcp-discovery": {Number: 30303},
+		},
+	}
+	execClient := ConvertServiceInfoToExecutionClient(el, client.Geth)
+	assert.Equal(t, "http://172.16.0.2:8551", execClient.EngineURL())
+	assert.Equal(t, "http://172.16.0.2:9001", execClient.MetricsURL())
+	assert.Equal(t, "tcp://172.16.0.2:30303", execClient.P2PURL())
+	assert.Equal(t, 30303, execClient.P2PPort())
+
+	cl := &ServiceInfo{
+		Name:      "cl-1-lighthouse-geth",
+		IPAddress: "172.16.0.3",
+		Ports: map[string]PortInfo{
+			"http":          {Number: 4000},
+			"tcp-discovery": {Number: 9000},
+		},
+	}
+	consClient := ConvertServiceInfoToConsensusClient(cl, client.Lighthouse)
+	assert.Equal(t, "tcp://172.16.0.3:9000", consClient.P2PURL())
+	assert.Equal(t, 9000, consClient.P2PPort())
+}
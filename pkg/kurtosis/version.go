@@ -0,0 +1,146 @@
+package kurtosis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/kurtosis_version"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SDKVersion is the version of the Kurtosis SDK this package is built against
+const SDKVersion = kurtosis_version.KurtosisVersion
+
+// engineInfoTimeout bounds the engine version query made when the client is created
+const engineInfoTimeout = 10 * time.Second
+
+// compatibleEngineSeries lists the engine release series (major.minor) this
+// package is tested against. The SDK only talks to engines of its own series,
+// so the list must contain the SDK's series; patch releases within a series
+// are compatible.
+var compatibleEngineSeries = []string{"1.10"}
+
+var semverPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)$`)
+
+// EngineCompatibility describes how a Kurtosis engine version relates to the SDK
+type EngineCompatibility int
+
+const (
+	// EngineCompatible engines run a tested release series
+	EngineCompatible EngineCompatibility = iota
+	// EngineTooOld engines predate every tested release series
+	EngineTooOld
+	// EngineTooNew engines are newer than every tested release series
+	EngineTooNew
+	// EngineUnknown engines report a version that is not X.Y.Z, such as a dev build
+	EngineUnknown
+)
+
+// CheckEngineVersion compares an engine version against the compatibility table.
+// It returns an error wrapping ErrEngineVersion that explains how to fix the skew
+// when the engine cannot serve this SDK.
+func CheckEngineVersion(engineVersion string) (EngineCompatibility, error) {
+	major, minor, ok := parseSeries(engineVersion)
+	if !ok {
+		return EngineUnknown, nil
+	}
+
+	older, newer := true, true
+	for _, series := range compatibleEngineSeries {
+		seriesMajor, seriesMinor, _ := parseSeries(series + ".0")
+		switch {
+		case major == seriesMajor && minor == seriesMinor:
+			return EngineCompatible, nil
+		case major < seriesMajor || (major == seriesMajor && minor < seriesMinor):
+			newer = false
+		default:
+			older = false
+		}
+	}
+
+	tested := strings.Join(compatibleEngineSeries, ", ")
+	switch {
+	case older:
+		return EngineTooOld, fmt.Errorf("%w: engine %s is older than the tested series %s (SDK %s); "+
+			"upgrade the Kurtosis CLI and run 'kurtosis engine restart'", ErrEngineVersion, engineVersion, tested, SDKVersion)
+	case newer:
+		return EngineTooNew, fmt.Errorf("%w: engine %s is newer than the tested series %s (SDK %s); "+
+			"upgrade ethereum-package-go or install Kurtosis CLI %s.x and run 'kurtosis engine restart'",
+			ErrEngineVersion, engineVersion, tested, SDKVersion, compatibleEngineSeries[len(compatibleEngineSeries)-1])
+	default:
+		return EngineTooOld, fmt.Errorf("%w: engine %s is not in the tested series %s (SDK %s)",
+			ErrEngineVersion, engineVersion, tested, SDKVersion)
+	}
+}
+
+// parseSeries returns the major and minor version of an X.Y.Z version
+func parseSeries(version string) (major, minor int, ok bool) {
+	match := semverPattern.FindStringSubmatch(version)
+	if match == nil {
+		return 0, 0, false
+	}
+	major, _ = strconv.Atoi(match[1])
+	minor, _ = strconv.Atoi(match[2])
+	return major, minor, true
+}
+
+// localEngineAddress is the gRPC address of the engine the SDK connects to
+func localEngineAddress() string {
+	return fmt.Sprintf("localhost:%d", kurtosis_context.DefaultGrpcEngineServerPortNum)
+}
+
+// queryEngineVersion asks the engine at address for its version
+func queryEngineVersion(ctx context.Context, address string) (string, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to Kurtosis engine at %s: %w", address, err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, engineInfoTimeout)
+	defer cancel()
+	info, err := kurtosis_engine_rpc_api_bindings.NewEngineServiceClient(conn).GetEngineInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			return "", fmt.Errorf("%w at %s", ErrKurtosisNotRunning, address)
+		}
+		return "", fmt.Errorf("failed to get Kurtosis engine info: %w", err)
+	}
+	return info.GetEngineVersion(), nil
+}
+
+// EngineVersion returns the version of the engine the client is connected to
+func (k *KurtosisClient) EngineVersion() string {
+	return k.engineVersion
+}
+
+// versionError adds a version skew hint to an engine error caused by a call or
+// Starlark instruction the engine does not implement
+func (k *KurtosisClient) versionError(err error) error {
+	if err == nil || errors.Is(err, ErrEngineVersion) || !unsupportedByEngine(err) {
+		return err
+	}
+	return fmt.Errorf("%w: engine %s does not support this call (SDK %s): %w", ErrEngineVersion, k.engineVersion, SDKVersion, err)
+}
+
+// unsupportedByEngine reports whether an engine error means the call is missing
+// from the engine, either as a gRPC method or as a Starlark plan instruction
+func unsupportedByEngine(err error) bool {
+	if status.Code(err) == codes.Unimplemented {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "code = Unimplemented") ||
+		(strings.Contains(msg, "has no .") && strings.Contains(msg, "field or method"))
+}
@@ -0,0 +1,92 @@
+package kurtosis
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestCheckEngineVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected EngineCompatibility
+	}{
+		{SDKVersion, EngineCompatible},
+		{"1.10.0", EngineCompatible},
+		{"1.10.7", EngineCompatible},
+		{"1.9.3", EngineTooOld},
+		{"0.90.1", EngineTooOld},
+		{"1.11.0", EngineTooNew},
+		{"2.0.0", EngineTooNew},
+		{"1.10.1-dev", EngineUnknown},
+		{"", EngineUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			compatibility, err := CheckEngineVersion(tt.version)
+			assert.Equal(t, tt.expected, compatibility)
+			if tt.expected == EngineTooOld || tt.expected == EngineTooNew {
+				assert.ErrorIs(t, err, ErrEngineVersion)
+				assert.Contains(t, err.Error(), tt.version)
+				assert.Contains(t, err.Error(), "kurtosis engine restart")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type fakeEngine struct {
+	kurtosis_engine_rpc_api_bindings.UnimplementedEngineServiceServer
+	version string
+}
+
+func (f *fakeEngine) GetEngineInfo(context.Context, *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse, error) {
+	return &kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{EngineVersion: f.version}, nil
+}
+
+func TestQueryEngineVersion(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	kurtosis_engine_rpc_api_bindings.RegisterEngineServiceServer(server, &fakeEngine{version: "1.9.0"})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	version, err := queryEngineVersion(context.Background(), listener.Addr().String())
+	require.NoError(t, err)
+	assert.Equal(t, "1.9.0", version)
+
+	// Nothing listens on the closed listener's address any more
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := closed.Addr().String()
+	require.NoError(t, closed.Close())
+	_, err = queryEngineVersion(context.Background(), address)
+	assert.ErrorIs(t, err, ErrKurtosisNotRunning)
+}
+
+func TestVersionError(t *testing.T) {
+	k := &KurtosisClient{engineVersion: "1.10.0"}
+
+	err := k.versionError(status.Error(codes.Unimplemented, "unknown method"))
+	assert.ErrorIs(t, err, ErrEngineVersion)
+	assert.Contains(t, err.Error(), "engine 1.10.0")
+
+	err = k.versionError(errors.New(`interpretation error: Plan object has no .stop_service field or method`))
+	assert.ErrorIs(t, err, ErrEngineVersion)
+
+	other := errors.New("service not found")
+	assert.Equal(t, other, k.versionError(other))
+	assert.NoError(t, k.versionError(nil))
+}
@@ -0,0 +1,148 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/spam"
+	"github.com/ethpandaops/ethereum-package-go/pkg/wallet"
+)
+
+// Faucet returns the signer of the prefunded account test accounts are funded from
+func (n *network) Faucet() (*wallet.Signer, error) {
+	if err := n.checkAllowed(OperationTransactions, "Faucet"); err != nil {
+		return nil, err
+	}
+	n.accountsMu.Lock()
+	defer n.accountsMu.Unlock()
+	return n.faucetLocked()
+}
+
+func (n *network) faucetLocked() (*wallet.Signer, error) {
+	if n.faucet != nil {
+		return n.faucet, nil
+	}
+
+	keyHex := n.faucetKey
+	if keyHex == "" {
+		keyHex = wallet.DefaultFaucetKey
+	}
+	key, err := wallet.KeyFromHex(keyHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid faucet key: %w", err)
+	}
+	el, err := n.accountClient()
+	if err != nil {
+		return nil, err
+	}
+	n.faucet = wallet.NewSigner(key, el, n.chainID)
+	return n.faucet, nil
+}
+
+// NewFundedAccount creates an account with a fresh key, funds it with amount wei
+// from the faucet and waits until the funds arrived. Each test using its own
+// account avoids nonce contention with other tests.
+func (n *network) NewFundedAccount(ctx context.Context, amount *big.Int) (*wallet.Signer, error) {
+	if err := n.checkAllowed(OperationTransactions, "NewFundedAccount"); err != nil {
+		return nil, err
+	}
+	n.accountsMu.Lock()
+	key, err := wallet.GenerateKeyFrom(n.keyRand)
+	n.accountsMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	el, err := n.accountClient()
+	if err != nil {
+		return nil, err
+	}
+	faucet, err := n.Faucet()
+	if err != nil {
+		return nil, err
+	}
+
+	account := wallet.NewSigner(key, el, n.chainID)
+	if _, err := faucet.Transfer(ctx, account.Address(), amount); err != nil {
+		return nil, fmt.Errorf("failed to fund %s: %w", account.Address(), err)
+	}
+	account.AddFunding(amount)
+	if err := account.WaitForBalance(ctx, amount, DefaultTxPollInterval); err != nil {
+		return nil, err
+	}
+
+	n.accountsMu.Lock()
+	n.accounts = append(n.accounts, account)
+	n.accountsMu.Unlock()
+	return account, nil
+}
+
+// FundedAccounts returns the accounts created by NewFundedAccount
+func (n *network) FundedAccounts() []*wallet.Signer {
+	n.accountsMu.Lock()
+	defer n.accountsMu.Unlock()
+	return append([]*wallet.Signer(nil), n.accounts...)
+}
+
+// Accounts returns the accounts ethereum-package prefunds at genesis, sending
+// through the same execution client as the faucet. The faucet's account shares
+// the faucet's signer, so their nonces stay in step.
+func (n *network) Accounts() (*wallet.Manager, error) {
+	if err := n.checkAllowed(OperationTransactions, "Accounts"); err != nil {
+		return nil, err
+	}
+	n.accountsMu.Lock()
+	defer n.accountsMu.Unlock()
+
+	if n.prefunded != nil {
+		return n.prefunded, nil
+	}
+	faucet, err := n.faucetLocked()
+	if err != nil {
+		return nil, err
+	}
+	el, err := n.accountClient()
+	if err != nil {
+		return nil, err
+	}
+	prefunded, err := wallet.NewPrefundedManager(el, n.chainID)
+	if err != nil {
+		return nil, err
+	}
+	signers := prefunded.Signers()
+	for i, signer := range signers {
+		if signer.Address() == faucet.Address() {
+			signers[i] = faucet
+		}
+	}
+	n.prefunded = wallet.NewManager(signers...)
+	return n.prefunded, nil
+}
+
+// Spammer returns a transaction spammer sending from the faucet. It sends value
+// transfers at spam.DefaultTPS for spam.DefaultDuration unless configured otherwise.
+func (n *network) Spammer() (*spam.Spammer, error) {
+	if err := n.checkAllowed(OperationTransactions, "Spammer"); err != nil {
+		return nil, err
+	}
+	faucet, err := n.Faucet()
+	if err != nil {
+		return nil, err
+	}
+	el, err := n.accountClient()
+	if err != nil {
+		return nil, err
+	}
+	return spam.New(faucet, el), nil
+}
+
+// accountClient returns the execution client accounts send transactions through
+func (n *network) accountClient() (client.ExecutionClient, error) {
+	if n.executionClients != nil {
+		if clients := n.executionClients.Except(n.lateJoiners...); len(clients) > 0 {
+			return clients[0], nil
+		}
+	}
+	return nil, fmt.Errorf("no execution clients available")
+}
@@ -0,0 +1,179 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFaucetServer serves an execution client where every sent transaction
+// funds every account except the faucet with one ether
+func newFaucetServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		var result interface{}
+		switch req.Method {
+		case "eth_getTransactionCount":
+			result = "0x5"
+		case "eth_gasPrice":
+			result = "0x3b9aca00"
+		case "eth_sendRawTransaction":
+			var raw string
+			if err := json.Unmarshal(req.Params[0], &raw); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			sent = append(sent, raw)
+			result = "0xtx"
+		case "eth_getBalance":
+			var address string
+			if err := json.Unmarshal(req.Params[0], &address); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			result = "0x0"
+			if len(sent) > 0 && !strings.EqualFold(address, "0x8943545177806ED17B9F23F0a21ee5948eCaa776") {
+				result = "0xde0b6b3a7640000"
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), sent...)
+	}
+}
+
+func TestNewFundedAccount(t *testing.T) {
+	server, sent := newFaucetServer(t)
+	defer server.Close()
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth", "", server.URL, "", "", "", "", "el-1-geth", "", 0))
+	net := New(Config{
+		Name:             "test",
+		ChainID:          3151908,
+		SpecPreset:       config.SpecPresetMinimal,
+		ExecutionClients: executionClients,
+		ConsensusClients: client.NewConsensusClients(),
+		OrphanOnExit:     true,
+	})
+
+	faucet, err := net.Faucet()
+	require.NoError(t, err)
+	assert.Equal(t, "0x8943545177806ED17B9F23F0a21ee5948eCaa776", faucet.Address())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ether := big.NewInt(1e18)
+	first, err := net.NewFundedAccount(ctx, ether)
+	require.NoError(t, err)
+	second, err := net.NewFundedAccount(ctx, ether)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.Address(), second.Address())
+	assert.Len(t, net.FundedAccounts(), 2)
+	assert.Equal(t, ether, first.Funded())
+
+	// The faucet tracks its nonce locally, so the second funding uses the next one
+	require.Len(t, sent(), 2)
+	assert.NotEqual(t, sent()[0], sent()[1])
+
+	spent, err := first.Spent(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), spent.Int64())
+}
+
+func TestFaucet_InvalidKey(t *testing.T) {
+	net := New(Config{
+		Name:             "test",
+		ExecutionClients: client.NewExecutionClients(),
+		ConsensusClients: client.NewConsensusClients(),
+		FaucetKey:        "0xnotakey",
+		OrphanOnExit:     true,
+	})
+
+	_, err := net.Faucet()
+	assert.Error(t, err)
+}
+
+func TestSpammer(t *testing.T) {
+	server, sent := newFaucetServer(t)
+	defer server.Close()
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth", "", server.URL, "", "", "", "", "el-1-geth", "", 0))
+	net := New(Config{
+		Name:             "test",
+		ChainID:          3151908,
+		ExecutionClients: executionClients,
+		ConsensusClients: client.NewConsensusClients(),
+		OrphanOnExit:     true,
+	})
+
+	spammer, err := net.Spammer()
+	require.NoError(t, err)
+	report, err := spammer.WithTPS(50).WithDuration(100 * time.Millisecond).Run(context.Background())
+	require.NoError(t, err)
+	assert.Greater(t, report.Sent, 0)
+	assert.Len(t, sent(), report.Sent)
+}
+
+func TestAccounts(t *testing.T) {
+	server, _ := newFaucetServer(t)
+	defer server.Close()
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth", "", server.URL, "", "", "", "", "el-1-geth", "", 0))
+	net := New(Config{
+		Name:             "test",
+		ChainID:          3151908,
+		ExecutionClients: executionClients,
+		ConsensusClients: client.NewConsensusClients(),
+		OrphanOnExit:     true,
+	})
+
+	accounts, err := net.Accounts()
+	require.NoError(t, err)
+	assert.Equal(t, 21, accounts.Len())
+
+	// The first account is the faucet, sharing its nonce tracking
+	faucet, err := net.Faucet()
+	require.NoError(t, err)
+	first, err := accounts.Signer(0)
+	require.NoError(t, err)
+	assert.Same(t, faucet, first)
+
+	again, err := net.Accounts()
+	require.NoError(t, err)
+	assert.Same(t, accounts, again)
+}
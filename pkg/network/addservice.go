@@ -0,0 +1,91 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ServiceSpec describes an extra container to deploy into the network's enclave
+type ServiceSpec struct {
+	Name  string
+	Image string
+	// Ports are the ports the container listens on, by name and InternalPort.
+	// Protocol is "TCP" when empty.
+	Ports []Port
+	Env   map[string]string
+	// Files maps a directory in the container to a local file or directory
+	// that is uploaded and mounted there
+	Files      map[string]string
+	Entrypoint []string
+	Cmd        []string
+}
+
+// Validate checks that the spec can be deployed
+func (s ServiceSpec) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("service name is required")
+	}
+	if strings.TrimSpace(s.Image) == "" {
+		return fmt.Errorf("service %s: image is required", s.Name)
+	}
+
+	seen := make(map[string]bool, len(s.Ports))
+	for _, port := range s.Ports {
+		if port.Name == "" {
+			return fmt.Errorf("service %s: port name is required", s.Name)
+		}
+		if seen[port.Name] {
+			return fmt.Errorf("service %s: port %q is defined more than once", s.Name, port.Name)
+		}
+		seen[port.Name] = true
+		if port.InternalPort < 1 || port.InternalPort > 65535 {
+			return fmt.Errorf("service %s: port %q has invalid number %d", s.Name, port.Name, port.InternalPort)
+		}
+		switch strings.ToUpper(port.Protocol) {
+		case "", "TCP", "UDP", "SCTP":
+		default:
+			return fmt.Errorf("service %s: port %q has invalid protocol %q", s.Name, port.Name, port.Protocol)
+		}
+	}
+
+	// Containers are Linux, so mount directories are slash-separated even when
+	// the local files come from a Windows path
+	for dir := range s.Files {
+		if !path.IsAbs(dir) || strings.Contains(dir, `\`) {
+			return fmt.Errorf("service %s: container directory %q must be an absolute path such as /data", s.Name, dir)
+		}
+	}
+	return nil
+}
+
+// AddService deploys an extra container into the network's enclave, next to the
+// clients, and returns it once it is running. It is removed with the enclave
+// when the network is cleaned up.
+func (n *network) AddService(ctx context.Context, spec ServiceSpec) (Service, error) {
+	if err := n.checkAllowed(OperationServices, "AddService"); err != nil {
+		return Service{}, err
+	}
+	if err := spec.Validate(); err != nil {
+		return Service{}, err
+	}
+	if n.addServiceFunc == nil {
+		return Service{}, fmt.Errorf("network does not support adding services")
+	}
+	for _, existing := range n.Services() {
+		if existing.Name == spec.Name {
+			return Service{}, fmt.Errorf("service %s already exists", spec.Name)
+		}
+	}
+
+	service, err := n.addServiceFunc(ctx, spec)
+	if err != nil {
+		return Service{}, fmt.Errorf("failed to add service %s: %w", spec.Name, err)
+	}
+
+	n.servicesMu.Lock()
+	n.services = append(n.services, service)
+	n.servicesMu.Unlock()
+	return service, nil
+}
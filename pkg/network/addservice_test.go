@@ -0,0 +1,125 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceSpec_Validate(t *testing.T) {
+	valid := ServiceSpec{
+		Name:  "blockscout",
+		Image: "blockscout/blockscout:latest",
+		Ports: []Port{{Name: "http", InternalPort: 4000}},
+	}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(*ServiceSpec)
+	}{
+		{"missing name", func(s *ServiceSpec) { s.Name = "" }},
+		{"missing image", func(s *ServiceSpec) { s.Image = " " }},
+		{"unnamed port", func(s *ServiceSpec) { s.Ports = []Port{{InternalPort: 80}} }},
+		{"duplicate port", func(s *ServiceSpec) {
+			s.Ports = []Port{{Name: "http", InternalPort: 80}, {Name: "http", InternalPort: 81}}
+		}},
+		{"invalid port number", func(s *ServiceSpec) { s.Ports = []Port{{Name: "http", InternalPort: 70000}} }},
+		{"invalid protocol", func(s *ServiceSpec) { s.Ports = []Port{{Name: "http", InternalPort: 80, Protocol: "QUIC"}} }},
+		{"relative container directory", func(s *ServiceSpec) { s.Files = map[string]string{"data": "./data"} }},
+		{"windows container directory", func(s *ServiceSpec) { s.Files = map[string]string{`\data`: `C:\data`} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := valid
+			tt.modify(&spec)
+			assert.Error(t, spec.Validate())
+		})
+	}
+}
+
+func TestNetwork_AddService(t *testing.T) {
+	var deployed []ServiceSpec
+	net := New(Config{
+		Services:     []Service{{Name: "el-1-geth-lighthouse"}},
+		OrphanOnExit: true,
+		AddServiceFunc: func(ctx context.Context, spec ServiceSpec) (Service, error) {
+			deployed = append(deployed, spec)
+			return Service{
+				Name:     spec.Name,
+				Hostname: spec.Name,
+				Ports:    []Port{{Name: "http", InternalPort: 4000, ExternalPort: 32800}},
+				Status:   "RUNNING",
+			}, nil
+		},
+	})
+
+	service, err := net.AddService(context.Background(), ServiceSpec{
+		Name:  "blockscout",
+		Image: "blockscout/blockscout:latest",
+		Ports: []Port{{Name: "http", InternalPort: 4000}},
+		Env:   map[string]string{"ETHEREUM_JSONRPC_HTTP_URL": "http://el-1-geth-lighthouse:8545"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "blockscout", service.Name)
+	require.Len(t, deployed, 1)
+
+	assert.Len(t, net.Services(), 2)
+	internalURL, err := net.InternalURL("blockscout", "http")
+	require.NoError(t, err)
+	assert.Equal(t, "http://blockscout:4000", internalURL)
+
+	// Names must be unique within the enclave
+	_, err = net.AddService(context.Background(), ServiceSpec{Name: "el-1-geth-lighthouse", Image: "nginx"})
+	assert.Error(t, err)
+	// Invalid specs are not deployed
+	_, err = net.AddService(context.Background(), ServiceSpec{Name: "nginx"})
+	assert.Error(t, err)
+	assert.Len(t, deployed, 1)
+}
+
+func TestNetwork_AddServiceFailure(t *testing.T) {
+	deployErr := errors.New("image not found")
+	net := New(Config{
+		OrphanOnExit: true,
+		AddServiceFunc: func(ctx context.Context, spec ServiceSpec) (Service, error) {
+			return Service{}, deployErr
+		},
+	})
+
+	_, err := net.AddService(context.Background(), ServiceSpec{Name: "nginx", Image: "nginx:missing"})
+	assert.ErrorIs(t, err, deployErr)
+	assert.Empty(t, net.Services())
+
+	// Networks without an enclave cannot add services
+	_, err = New(Config{OrphanOnExit: true}).AddService(context.Background(), ServiceSpec{Name: "nginx", Image: "nginx"})
+	assert.Error(t, err)
+}
+
+func TestService_ExternalURL(t *testing.T) {
+	service := Service{
+		Name:         "blockscout",
+		ExternalHost: "127.0.0.1",
+		Ports: []Port{
+			{Name: "http", InternalPort: 4000, ExternalPort: 32800},
+			{Name: "admin", InternalPort: 4001},
+		},
+	}
+
+	url, err := service.ExternalURL("http")
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:32800", url)
+
+	service.ExternalHost = "fd00::1"
+	url, err = service.ExternalURL("http")
+	require.NoError(t, err)
+	assert.Equal(t, "http://[fd00::1]:32800", url)
+
+	_, err = service.ExternalURL("admin")
+	assert.Error(t, err)
+	_, err = service.ExternalURL("metrics")
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ package network
 type ApacheConfigServer interface {
 	URL() string
 	GenesisSSZURL() string
+	GenesisJSONURL() string
 	ConfigYAMLURL() string
 	BootnodesYAMLURL() string
 	DepositContractBlockURL() string
@@ -27,6 +28,10 @@ func (a *apacheConfigServer) GenesisSSZURL() string {
 	return a.url + "/network-configs/genesis.ssz"
 }
 
+func (a *apacheConfigServer) GenesisJSONURL() string {
+	return a.url + "/network-configs/genesis.json"
+}
+
 func (a *apacheConfigServer) ConfigYAMLURL() string {
 	return a.url + "/network-configs/config.yaml"
 }
@@ -0,0 +1,100 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// ErrNoArchiveClient is returned when no execution client serves historical state
+var ErrNoArchiveClient = errors.New("no archive execution client")
+
+// archiveProbeBlock is the block whose state an archive client must still serve
+const archiveProbeBlock = 1
+
+// archiveProbeAddress is the account read when probing for historical state
+const archiveProbeAddress = "0x0000000000000000000000000000000000000000"
+
+// ArchiveClients returns the execution clients that still serve the state of
+// block 1. Full nodes serve old state until they prune it, so on a young chain
+// they are reported too.
+func (n *network) ArchiveClients(ctx context.Context) []client.ExecutionClient {
+	if n.executionClients == nil {
+		return nil
+	}
+
+	var archives []client.ExecutionClient
+	for _, el := range n.executionClients.Except(n.lateJoiners...) {
+		block := uint64(archiveProbeBlock)
+		if _, err := client.RPC(el).Balance(ctx, archiveProbeAddress, &block); err == nil {
+			archives = append(archives, el)
+		}
+	}
+	return archives
+}
+
+// ArchiveClient returns an execution client that serves historical state. The
+// client is remembered until a query routed to it fails.
+func (n *network) ArchiveClient(ctx context.Context) (client.ExecutionClient, error) {
+	n.archiveMu.Lock()
+	defer n.archiveMu.Unlock()
+
+	if n.archive != nil {
+		return n.archive, nil
+	}
+	archives := n.ArchiveClients(ctx)
+	if len(archives) == 0 {
+		return nil, ErrNoArchiveClient
+	}
+	n.archive = archives[0]
+	return n.archive, nil
+}
+
+// HistoricalBalance returns the balance of an address at an old block from an archive client
+func (n *network) HistoricalBalance(ctx context.Context, address string, block uint64) (*big.Int, error) {
+	return withArchive(ctx, n, func(ctx context.Context, archive *client.BaseExecutionClient) (*big.Int, error) {
+		return archive.Balance(ctx, address, &block)
+	})
+}
+
+// TraceTransaction traces a transaction on an archive client, which can replay
+// transactions of any age
+func (n *network) TraceTransaction(ctx context.Context, hash string) (json.RawMessage, error) {
+	return withArchive(ctx, n, func(ctx context.Context, archive *client.BaseExecutionClient) (json.RawMessage, error) {
+		return archive.TraceTransaction(ctx, hash, nil)
+	})
+}
+
+// withArchive runs a query on the archive client. If it fails, archive clients
+// are detected again and the query is retried once on a different client.
+func withArchive[T any](ctx context.Context, n *network, query func(context.Context, *client.BaseExecutionClient) (T, error)) (T, error) {
+	var zero T
+	archive, err := n.ArchiveClient(ctx)
+	if err != nil {
+		return zero, err
+	}
+	result, err := query(ctx, client.RPC(archive))
+	if err == nil {
+		return result, nil
+	}
+
+	n.archiveMu.Lock()
+	if n.archive == archive {
+		n.archive = nil
+	}
+	n.archiveMu.Unlock()
+
+	retry, retryErr := n.ArchiveClient(ctx)
+	if retryErr != nil || retry.Name() == archive.Name() {
+		return zero, fmt.Errorf("%s: %w", archive.Name(), err)
+	}
+	result, err = query(ctx, client.RPC(retry))
+	if err != nil {
+		return zero, fmt.Errorf("%s: %w", retry.Name(), err)
+	}
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newStateServer serves an execution client that either keeps the state of
+// every block or only of the latest one
+func newStateServer(t *testing.T, archive bool) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": 1}
+		switch {
+		case !archive:
+			resp["error"] = map[string]interface{}{"code": -32000, "message": "missing trie node"}
+		case req.Method == "eth_getBalance":
+			resp["result"] = "0x2a"
+		case req.Method == "debug_traceTransaction":
+			resp["result"] = map[string]interface{}{"gas": 21000, "failed": false}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func newArchiveTestNetwork(servers map[string]*httptest.Server) Network {
+	executionClients := client.NewExecutionClients()
+	for name, server := range servers {
+		executionClients.Add(client.NewExecutionClient(client.Geth, name, "", server.URL, "", "", "", "", name, "", 0))
+	}
+	return New(Config{
+		Name:             "test",
+		SpecPreset:       config.SpecPresetMinimal,
+		ExecutionClients: executionClients,
+		ConsensusClients: client.NewConsensusClients(),
+		OrphanOnExit:     true,
+	})
+}
+
+func TestArchiveClient(t *testing.T) {
+	pruned := newStateServer(t, false)
+	defer pruned.Close()
+	archive := newStateServer(t, true)
+	defer archive.Close()
+
+	net := newArchiveTestNetwork(map[string]*httptest.Server{"el-1-geth": pruned, "el-2-erigon": archive})
+	ctx := context.Background()
+
+	archives := net.ArchiveClients(ctx)
+	require.Len(t, archives, 1)
+	assert.Equal(t, "el-2-erigon", archives[0].Name())
+
+	selected, err := net.ArchiveClient(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "el-2-erigon", selected.Name())
+
+	balance, err := net.HistoricalBalance(ctx, "0x8943545177806ED17B9F23F0a21ee5948eCaa776", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), balance.Int64())
+
+	trace, err := net.TraceTransaction(ctx, "0xtx")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"gas":21000,"failed":false}`, string(trace))
+}
+
+func TestArchiveClient_None(t *testing.T) {
+	pruned := newStateServer(t, false)
+	defer pruned.Close()
+
+	net := newArchiveTestNetwork(map[string]*httptest.Server{"el-1-geth": pruned})
+
+	_, err := net.ArchiveClient(context.Background())
+	assert.ErrorIs(t, err, ErrNoArchiveClient)
+	_, err = net.HistoricalBalance(context.Background(), "0x0", 1)
+	assert.ErrorIs(t, err, ErrNoArchiveClient)
+}
+
+func TestArchiveClient_Reselects(t *testing.T) {
+	first := newStateServer(t, true)
+	second := newStateServer(t, true)
+	defer second.Close()
+
+	net := newArchiveTestNetwork(map[string]*httptest.Server{"el-1-geth": first, "el-2-erigon": second})
+	ctx := context.Background()
+
+	selected, err := net.ArchiveClient(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "el-1-geth", selected.Name())
+
+	// Once the selected client stops answering, queries move to the other one
+	first.Close()
+	balance, err := net.HistoricalBalance(ctx, "0x0", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), balance.Int64())
+
+	selected, err = net.ArchiveClient(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "el-2-erigon", selected.Name())
+}
@@ -0,0 +1,175 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// AttestationInclusion records whether and when a validator's attestation for an epoch was included
+type AttestationInclusion struct {
+	ValidatorIndex uint64
+	Epoch          uint64
+	// Slot is the slot the validator was assigned to attest in
+	Slot uint64
+	// Included is false if no block in the inclusion window carried the attestation
+	Included bool
+	// InclusionSlot is the slot of the first block that included the attestation
+	InclusionSlot uint64
+}
+
+// Distance returns the number of slots between the duty and the attestation's inclusion
+func (a AttestationInclusion) Distance() uint64 {
+	if !a.Included {
+		return 0
+	}
+	return a.InclusionSlot - a.Slot
+}
+
+// AttestationReport summarizes attestation inclusion for a set of validators
+type AttestationReport struct {
+	Epoch      uint64
+	Inclusions []AttestationInclusion
+}
+
+// Missed returns the validators whose attestations were not included
+func (r *AttestationReport) Missed() []AttestationInclusion {
+	var missed []AttestationInclusion
+	for _, inclusion := range r.Inclusions {
+		if !inclusion.Included {
+			missed = append(missed, inclusion)
+		}
+	}
+	return missed
+}
+
+// InclusionRate returns the fraction of attestations that were included
+func (r *AttestationReport) InclusionRate() float64 {
+	if len(r.Inclusions) == 0 {
+		return 0
+	}
+	return float64(len(r.Inclusions)-len(r.Missed())) / float64(len(r.Inclusions))
+}
+
+// AverageDistance returns the mean inclusion distance of included attestations
+func (r *AttestationReport) AverageDistance() float64 {
+	var total, count uint64
+	for _, inclusion := range r.Inclusions {
+		if inclusion.Included {
+			total += inclusion.Distance()
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return float64(total) / float64(count)
+}
+
+// TrackNodeAttestations tracks the attestations of every validator run by the node
+// with the given 1-based index. See TrackAttestations.
+func (n *network) TrackNodeAttestations(ctx context.Context, node int, epoch uint64) (*AttestationReport, error) {
+	r, ok := n.validatorRanges[node]
+	if !ok || r.Count() == 0 {
+		return nil, fmt.Errorf("node %d has no validators", node)
+	}
+
+	validators := make([]uint64, 0, r.Count())
+	for index := r.Start; index < r.End; index++ {
+		validators = append(validators, index)
+	}
+	return n.TrackAttestations(ctx, validators, epoch)
+}
+
+// TrackAttestations waits until the inclusion window of the epoch's attestations has
+// passed (the end of the following epoch) and reports, for every given validator,
+// whether and how quickly its attestation was included. The epoch must not be older
+// than the previous epoch when called, as committees are read from the head state.
+// Validators without a committee assignment in the epoch are reported as missed.
+func (n *network) TrackAttestations(ctx context.Context, validators []uint64, epoch uint64) (*AttestationReport, error) {
+	beacon, clock, err := n.beaconClock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	committees, err := beacon.Committees(ctx, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get committees for epoch %d: %w", epoch, err)
+	}
+
+	// Locate each tracked validator's committee position
+	type position struct {
+		slot      uint64
+		committee uint64
+		member    int
+	}
+	tracked := make(map[uint64]int, len(validators))
+	for i, index := range validators {
+		tracked[index] = i
+	}
+	positions := make(map[uint64]position, len(validators))
+	committeeSizes := make(map[[2]uint64]int)
+	for _, committee := range committees {
+		committeeSizes[[2]uint64{committee.Slot, committee.Index}] = len(committee.Validators)
+		for member, index := range committee.Validators {
+			if _, ok := tracked[index]; ok {
+				positions[index] = position{slot: committee.Slot, committee: committee.Index, member: member}
+			}
+		}
+	}
+
+	report := &AttestationReport{Epoch: epoch, Inclusions: make([]AttestationInclusion, len(validators))}
+	for i, index := range validators {
+		report.Inclusions[i] = AttestationInclusion{ValidatorIndex: index, Epoch: epoch, Slot: positions[index].slot}
+	}
+
+	slotsPerEpoch := n.specPreset.SlotsPerEpoch()
+	lastSlot := (epoch+2)*slotsPerEpoch - 1
+	if err := sleepUntil(ctx, clock.slotTime(lastSlot+1)); err != nil {
+		return report, err
+	}
+
+	for slot := epoch*slotsPerEpoch + 1; slot <= lastSlot; slot++ {
+		attestations, err := beacon.BlockAttestations(ctx, slot)
+		if errors.Is(err, client.ErrBlockNotFound) {
+			continue
+		}
+		if err != nil {
+			return report, fmt.Errorf("failed to get attestations at slot %d: %w", slot, err)
+		}
+
+		for _, attestation := range attestations {
+			for index, pos := range positions {
+				inclusion := &report.Inclusions[tracked[index]]
+				if inclusion.Included || attestation.Slot != pos.slot {
+					continue
+				}
+				if offset, ok := memberOffset(attestation, pos.slot, pos.committee, committeeSizes); ok && attestation.Attested(offset+pos.member) {
+					inclusion.Included = true
+					inclusion.InclusionSlot = slot
+				}
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// memberOffset returns the bit offset of a committee within an attestation's aggregation
+// bits. From Electra onwards an attestation aggregates several committees of a slot.
+func memberOffset(attestation client.Attestation, slot, committee uint64, sizes map[[2]uint64]int) (int, bool) {
+	if len(attestation.CommitteeBits) == 0 {
+		return 0, attestation.CommitteeIndex == committee
+	}
+
+	offset := 0
+	for _, index := range attestation.CommitteeBits {
+		if index == committee {
+			return offset, true
+		}
+		offset += sizes[[2]uint64{slot, index}]
+	}
+	return 0, false
+}
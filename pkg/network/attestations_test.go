@@ -0,0 +1,82 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAttestationTestNetwork serves epoch 0 of a minimal preset chain whose inclusion
+// window has passed. Slot s has one committee of validators 2s and 2s+1. Validator 7
+// never attests, validator 6 is included late and slot 4 uses Electra-style committee
+// bits. Node 1 runs validators 6 to 9.
+func newAttestationTestNetwork(t *testing.T) Network {
+	t.Helper()
+
+	server := newBeaconStub(t, time.Now().Add(-20*time.Second), map[string]beaconRoute{
+		"/eth/v1/beacon/states/head/committees": func(w http.ResponseWriter, _ string) {
+			committees := make([]string, 0, 8)
+			for slot := 0; slot < 8; slot++ {
+				committees = append(committees, fmt.Sprintf(`{"index":"0","slot":"%d","validators":["%d","%d"]}`, slot, 2*slot, 2*slot+1))
+			}
+			fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(committees, ","))
+		},
+		"/eth/v2/beacon/blocks/": func(w http.ResponseWriter, suffix string) {
+			slot, _ := strconv.Atoi(strings.TrimSuffix(suffix, "/attestations"))
+			var attestations []string
+			switch slot {
+			case 10:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			case 5:
+				attestations = append(attestations,
+					`{"aggregation_bits":"0x05","data":{"slot":"3","index":"0"}}`,
+					`{"aggregation_bits":"0x07","committee_bits":"0x01","data":{"slot":"4","index":"0"}}`)
+			case 4:
+			default:
+				if slot >= 1 && slot <= 8 {
+					attestations = append(attestations, fmt.Sprintf(`{"aggregation_bits":"0x07","data":{"slot":"%d","index":"0"}}`, slot-1))
+				}
+			}
+			fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(attestations, ","))
+		},
+	})
+
+	return newBeaconTestNetwork(server.URL, map[int]config.ValidatorRange{1: {Start: 6, End: 10}})
+}
+
+func TestTrackAttestations(t *testing.T) {
+	net := newAttestationTestNetwork(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	report, err := net.TrackNodeAttestations(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, report.Inclusions, 4)
+
+	byValidator := make(map[uint64]AttestationInclusion)
+	for _, inclusion := range report.Inclusions {
+		byValidator[inclusion.ValidatorIndex] = inclusion
+	}
+
+	assert.True(t, byValidator[6].Included)
+	assert.Equal(t, uint64(5), byValidator[6].InclusionSlot)
+	assert.Equal(t, uint64(2), byValidator[6].Distance())
+	assert.False(t, byValidator[7].Included)
+	assert.Equal(t, uint64(1), byValidator[8].Distance())
+	assert.Equal(t, uint64(1), byValidator[9].Distance())
+
+	require.Len(t, report.Missed(), 1)
+	assert.Equal(t, uint64(7), report.Missed()[0].ValidatorIndex)
+	assert.InDelta(t, 0.75, report.InclusionRate(), 0.001)
+	assert.InDelta(t, 4.0/3.0, report.AverageDistance(), 0.001)
+}
@@ -0,0 +1,200 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// DefaultBlockSettleTime is how long StreamBlocks waits for every consensus
+// client to see a block before emitting it
+const DefaultBlockSettleTime = 12 * time.Second
+
+// blockStreamRetryDelay is how long a client's head subscription waits before reconnecting
+var blockStreamRetryDelay = time.Second
+
+// BlockSighting is when a consensus client reported a block as its head
+type BlockSighting struct {
+	Client string
+	SeenAt time.Time
+}
+
+// StreamedBlock is a block seen by one or more consensus clients
+type StreamedBlock struct {
+	Slot      uint64
+	Root      string
+	FirstSeen time.Time
+	// Sightings are ordered by the time each client saw the block
+	Sightings []BlockSighting
+	// Missing lists the clients that did not see the block within the settle time
+	Missing []string
+}
+
+// Delay returns how long after the first sighting the client saw the block
+func (b StreamedBlock) Delay(clientName string) (time.Duration, bool) {
+	for _, sighting := range b.Sightings {
+		if sighting.Client == clientName {
+			return sighting.SeenAt.Sub(b.FirstSeen), true
+		}
+	}
+	return 0, false
+}
+
+// StreamBlocks merges the head events of all consensus clients into one stream
+// with one entry per block. A block is emitted once every client saw it or
+// DefaultBlockSettleTime after it was first seen. Client subscriptions that
+// fail are reported on the error channel and reconnected; both channels are
+// closed when the context is done.
+func (n *network) StreamBlocks(ctx context.Context) (<-chan StreamedBlock, <-chan error) {
+	return n.streamBlocks(ctx, DefaultBlockSettleTime)
+}
+
+// headSighting is a head event received from one client
+type headSighting struct {
+	client string
+	event  client.HeadEvent
+	seenAt time.Time
+}
+
+func (n *network) streamBlocks(ctx context.Context, settle time.Duration) (<-chan StreamedBlock, <-chan error) {
+	blocks := make(chan StreamedBlock)
+	errs := make(chan error, 16)
+
+	var beacons []client.ConsensusClient
+	if n.consensusClients != nil {
+		beacons = n.consensusClients.Except(n.lateJoiners...)
+	}
+	if len(beacons) == 0 {
+		errs <- fmt.Errorf("no consensus clients available")
+		close(blocks)
+		close(errs)
+		return blocks, errs
+	}
+
+	sightings := make(chan headSighting)
+	var wg sync.WaitGroup
+	for _, beacon := range beacons {
+		wg.Add(1)
+		go func(beacon client.ConsensusClient) {
+			defer wg.Done()
+			subscribeHeads(ctx, beacon, sightings, errs)
+		}(beacon)
+	}
+
+	go func() {
+		defer close(blocks)
+		defer close(errs)
+		defer wg.Wait()
+
+		names := make([]string, len(beacons))
+		for i, beacon := range beacons {
+			names[i] = beacon.Name()
+		}
+
+		tick := settle / 4
+		if tick < 10*time.Millisecond {
+			tick = 10 * time.Millisecond
+		}
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+
+		pending := make(map[string]*StreamedBlock)
+		emitted := make(map[string]bool)
+		emit := func(block *StreamedBlock) bool {
+			delete(pending, block.Root)
+			emitted[block.Root] = true
+			block.Missing = missingClients(names, block.Sightings)
+			select {
+			case blocks <- *block:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case s := <-sightings:
+				if emitted[s.event.Block] {
+					continue
+				}
+				block, ok := pending[s.event.Block]
+				if !ok {
+					block = &StreamedBlock{Slot: s.event.Slot, Root: s.event.Block, FirstSeen: s.seenAt}
+					pending[s.event.Block] = block
+				}
+				if _, seen := block.Delay(s.client); seen {
+					continue
+				}
+				block.Sightings = append(block.Sightings, BlockSighting{Client: s.client, SeenAt: s.seenAt})
+				if len(block.Sightings) == len(names) && !emit(block) {
+					return
+				}
+			case now := <-ticker.C:
+				var settled []*StreamedBlock
+				for _, block := range pending {
+					if now.Sub(block.FirstSeen) >= settle {
+						settled = append(settled, block)
+					}
+				}
+				sort.Slice(settled, func(i, j int) bool { return settled[i].FirstSeen.Before(settled[j].FirstSeen) })
+				for _, block := range settled {
+					if !emit(block) {
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return blocks, errs
+}
+
+// subscribeHeads forwards the head events of a client until the context is
+// done, reconnecting whenever the subscription ends
+func subscribeHeads(ctx context.Context, beacon client.ConsensusClient, sightings chan<- headSighting, errs chan<- error) {
+	for ctx.Err() == nil {
+		events, subErrs := beacon.SubscribeHeads(ctx)
+		for event := range events {
+			select {
+			case sightings <- headSighting{client: beacon.Name(), event: event, seenAt: time.Now()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err, ok := <-subErrs; ok && err != nil {
+			// Drop the error rather than block if nobody is reading them
+			select {
+			case errs <- fmt.Errorf("%s: %w", beacon.Name(), err):
+			default:
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(blockStreamRetryDelay):
+		}
+	}
+}
+
+// missingClients returns the clients without a sighting
+func missingClients(names []string, sightings []BlockSighting) []string {
+	seen := make(map[string]bool, len(sightings))
+	for _, sighting := range sightings {
+		seen[sighting.Client] = true
+	}
+	var missing []string
+	for _, name := range names {
+		if !seen[name] {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,86 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHeadServer streams a head event for each block, waiting delay before the first
+func newHeadServer(delay time.Duration, blocks ...string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		time.Sleep(delay)
+		for i, block := range blocks {
+			fmt.Fprintf(w, "data: {\"slot\":\"%d\",\"block\":\"%s\",\"state\":\"0x0\",\"epoch_transition\":false}\n\n", i+1, block)
+		}
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+}
+
+func TestStreamBlocks(t *testing.T) {
+	fast := newHeadServer(0, "0xa", "0xb")
+	defer fast.Close()
+	slow := newHeadServer(50*time.Millisecond, "0xa")
+	defer slow.Close()
+
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", fast.URL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+	consensusClients.Add(client.NewConsensusClient(client.Teku, "cl-2-teku-besu", "", slow.URL, "", "", "", "cl-2-teku-besu", "", 0))
+	net := New(Config{
+		Name:             "test",
+		SpecPreset:       config.SpecPresetMinimal,
+		ConsensusClients: consensusClients,
+		ExecutionClients: client.NewExecutionClients(),
+		OrphanOnExit:     true,
+	}).(*network)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	blocks, _ := net.streamBlocks(ctx, 300*time.Millisecond)
+
+	// 0xa is emitted as soon as both clients saw it
+	first := <-blocks
+	assert.Equal(t, "0xa", first.Root)
+	assert.Equal(t, uint64(1), first.Slot)
+	require.Len(t, first.Sightings, 2)
+	assert.Equal(t, "cl-1-lighthouse-geth", first.Sightings[0].Client)
+	delay, ok := first.Delay("cl-2-teku-besu")
+	assert.True(t, ok)
+	assert.GreaterOrEqual(t, delay, 40*time.Millisecond)
+	assert.Empty(t, first.Missing)
+
+	// 0xb is emitted once it settles, without the client that never saw it
+	second := <-blocks
+	assert.Equal(t, "0xb", second.Root)
+	require.Len(t, second.Sightings, 1)
+	assert.Equal(t, []string{"cl-2-teku-besu"}, second.Missing)
+
+	cancel()
+	for range blocks {
+	}
+}
+
+func TestStreamBlocks_NoClients(t *testing.T) {
+	net := New(Config{
+		Name:             "test",
+		ConsensusClients: client.NewConsensusClients(),
+		ExecutionClients: client.NewExecutionClients(),
+		OrphanOnExit:     true,
+	})
+
+	blocks, errs := net.StreamBlocks(context.Background())
+	_, open := <-blocks
+	assert.False(t, open)
+	assert.Error(t, <-errs)
+}
@@ -0,0 +1,106 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+const (
+	// StaticNodesFile lists the execution clients' enodes in geth's static-nodes.json format
+	StaticNodesFile = "static-nodes.json"
+	// BootnodesFile lists the consensus clients' ENRs in the boot_enr.yaml
+	// format of a lighthouse testnet directory
+	BootnodesFile = "bootnodes.yaml"
+)
+
+// WriteBootstrapFiles fetches the enodes and ENRs of the running clients and
+// writes them to dir as StaticNodesFile and BootnodesFile, so external geth
+// and lighthouse instances can join the network. The records carry the
+// addresses the clients advertise, which are reachable from the enclave's
+// Docker network. It returns the paths written.
+func (n *network) WriteBootstrapFiles(ctx context.Context, dir string) ([]string, error) {
+	stopped := n.stoppedServices()
+
+	var enodes []string
+	if n.executionClients != nil {
+		var clients []client.ExecutionClient
+		for _, el := range n.executionClients.All() {
+			if !stopped[el.Name()] {
+				clients = append(clients, el)
+			}
+		}
+		enodes = make([]string, len(clients))
+		errs := client.FanOut(ctx, n.limiter, clients, func(ctx context.Context, i int, el client.ExecutionClient) error {
+			enode, err := el.FetchEnode(ctx)
+			enodes[i] = enode
+			return err
+		})
+		for i, el := range clients {
+			if errs[i] != nil {
+				return nil, fmt.Errorf("failed to fetch enode of %s: %w", el.Name(), errs[i])
+			}
+		}
+	}
+
+	var enrs []string
+	if n.consensusClients != nil {
+		var clients []client.ConsensusClient
+		for _, cl := range n.consensusClients.All() {
+			if !stopped[cl.Name()] {
+				clients = append(clients, cl)
+			}
+		}
+		enrs = make([]string, len(clients))
+		errs := client.FanOut(ctx, n.limiter, clients, func(ctx context.Context, i int, cl client.ConsensusClient) error {
+			identity, err := cl.FetchIdentity(ctx)
+			if err == nil {
+				enrs[i] = identity.ENR
+			}
+			return err
+		})
+		for i, cl := range clients {
+			if errs[i] != nil {
+				return nil, fmt.Errorf("failed to fetch ENR of %s: %w", cl.Name(), errs[i])
+			}
+		}
+	}
+
+	if len(enodes) == 0 && len(enrs) == 0 {
+		return nil, fmt.Errorf("no running clients to bootstrap from")
+	}
+
+	staticNodes, err := json.MarshalIndent(nonNil(enodes), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode static nodes: %w", err)
+	}
+	bootnodes, err := yaml.Marshal(nonNil(enrs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode bootnodes: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create bootstrap directory: %w", err)
+	}
+	paths := []string{filepath.Join(dir, StaticNodesFile), filepath.Join(dir, BootnodesFile)}
+	for i, data := range [][]byte{staticNodes, bootnodes} {
+		if err := os.WriteFile(paths[i], data, 0o644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", paths[i], err)
+		}
+	}
+	return paths, nil
+}
+
+// nonNil turns a nil list into an empty one, so it encodes as [] rather than null
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
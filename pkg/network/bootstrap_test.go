@@ -0,0 +1,72 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteBootstrapFiles(t *testing.T) {
+	const (
+		enode = "enode://abcd@172.16.0.10:30303"
+		enr   = "enr:-Iq4QMCTfIMXnow27baRUb35Q8iiFHSIDBJh6hQM5Axohhf4b6Kr_cOCu0htQ5WvVqKvFgY28893DHAg8gnBAXsAVqmGAX53x8JggmlkgnY0gmlwhLKAlv6Jc2VjcDI1NmsxoQK6S-Cii_KmfFdUJL2TANL3ksaKUnNXvTCv1tLwXs0QgIN1ZHCCIyk"
+	)
+	el := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"enode":%q}}`, enode)
+	}))
+	defer el.Close()
+	cl := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":{"peer_id":"16Uiu2","enr":%q,"metadata":{"seq_number":"1","attnets":"0x00","syncnets":"0x00"}}}`, enr)
+	}))
+	defer cl.Close()
+	held := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("held late joiner was queried")
+	}))
+	defer held.Close()
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", el.URL, "", "", "", "", "el-1-geth-lighthouse", "", 30303))
+	executionClients.Add(client.NewExecutionClient(client.Besu, "el-2-besu-teku", "", held.URL, "", "", "", "", "el-2-besu-teku", "", 30303))
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", cl.URL, "", "", "", "cl-1-lighthouse-geth", "", 9000))
+	net := New(Config{
+		Name:             "test",
+		ExecutionClients: executionClients,
+		ConsensusClients: consensusClients,
+		LateJoiners:      []string{"el-2-besu-teku"},
+		OrphanOnExit:     true,
+	})
+
+	dir := filepath.Join(t.TempDir(), "bootstrap")
+	paths, err := net.WriteBootstrapFiles(context.Background(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, StaticNodesFile), filepath.Join(dir, BootnodesFile)}, paths)
+
+	data, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	var staticNodes []string
+	require.NoError(t, json.Unmarshal(data, &staticNodes))
+	assert.Equal(t, []string{enode}, staticNodes)
+
+	data, err = os.ReadFile(paths[1])
+	require.NoError(t, err)
+	assert.Equal(t, "- "+enr+"\n", string(data))
+}
+
+func TestWriteBootstrapFiles_NoClients(t *testing.T) {
+	net := New(Config{Name: "test", OrphanOnExit: true})
+
+	_, err := net.WriteBootstrapFiles(context.Background(), t.TempDir())
+	assert.ErrorContains(t, err, "no running clients")
+}
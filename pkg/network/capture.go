@@ -0,0 +1,70 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+// PacketCapture is a pcap file with the traffic of a service
+type PacketCapture struct {
+	Service  string
+	Path     string
+	Started  time.Time
+	Duration time.Duration
+	// Size is the size of the pcap file in bytes
+	Size int64
+}
+
+// String describes the capture on one line
+func (c *PacketCapture) String() string {
+	return fmt.Sprintf("%s: %s of traffic in %s (%d bytes)", c.Service, c.Duration, c.Path, c.Size)
+}
+
+// Capture records the network traffic of a service for the given duration into
+// a pcap file in the temporary directory, for inspection with e.g. Wireshark.
+// The caller owns the file.
+func (n *network) Capture(ctx context.Context, service string, d time.Duration) (*PacketCapture, error) {
+	if err := n.checkAllowed(OperationServices, "Capture"); err != nil {
+		return nil, err
+	}
+	if d <= 0 {
+		return nil, fmt.Errorf("capture duration must be positive, got %s", d)
+	}
+	if n.captureFunc == nil {
+		return nil, fmt.Errorf("network does not support packet capture")
+	}
+	found := false
+	for _, s := range n.Services() {
+		if s.Name == service {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("service not found: %s", service)
+	}
+
+	file, err := os.CreateTemp("", fmt.Sprintf("%s-%s-*.pcap", n.enclaveName, service))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create capture file: %w", err)
+	}
+	capture := &PacketCapture{Service: service, Path: file.Name(), Started: time.Now()}
+	err = n.captureFunc(ctx, service, d, file)
+	capture.Duration = time.Since(capture.Started)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(capture.Path)
+		return nil, fmt.Errorf("failed to capture packets of %s: %w", service, err)
+	}
+
+	info, err := os.Stat(capture.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat capture file: %w", err)
+	}
+	capture.Size = info.Size()
+	return capture, nil
+}
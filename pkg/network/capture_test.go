@@ -0,0 +1,66 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetwork_Capture(t *testing.T) {
+	pcap := []byte{0xd4, 0xc3, 0xb2, 0xa1, 0x02, 0x00, 0x04, 0x00}
+	var captured time.Duration
+	net := New(Config{
+		EnclaveName:  "test-enclave",
+		Services:     []Service{{Name: "cl-1-lighthouse-geth"}},
+		OrphanOnExit: true,
+		CaptureFunc: func(ctx context.Context, service string, d time.Duration, w io.Writer) error {
+			assert.Equal(t, "cl-1-lighthouse-geth", service)
+			captured = d
+			_, err := w.Write(pcap)
+			return err
+		},
+	})
+
+	capture, err := net.Capture(context.Background(), "cl-1-lighthouse-geth", 30*time.Second)
+	require.NoError(t, err)
+	t.Cleanup(func() { os.Remove(capture.Path) })
+
+	assert.Equal(t, 30*time.Second, captured)
+	assert.Equal(t, "cl-1-lighthouse-geth", capture.Service)
+	assert.Contains(t, capture.Path, "test-enclave-cl-1-lighthouse-geth-")
+	assert.Equal(t, int64(len(pcap)), capture.Size)
+	data, err := os.ReadFile(capture.Path)
+	require.NoError(t, err)
+	assert.Equal(t, pcap, data)
+}
+
+func TestNetwork_CaptureErrors(t *testing.T) {
+	captureErr := errors.New("docker not found")
+	var path string
+	net := New(Config{
+		Services:     []Service{{Name: "el-1-geth-lighthouse"}},
+		OrphanOnExit: true,
+		CaptureFunc: func(ctx context.Context, service string, d time.Duration, w io.Writer) error {
+			path = w.(*os.File).Name()
+			return captureErr
+		},
+	})
+
+	_, err := net.Capture(context.Background(), "el-1-geth-lighthouse", time.Second)
+	assert.ErrorIs(t, err, captureErr)
+	// Failed captures leave no file behind
+	assert.NoFileExists(t, path)
+
+	_, err = net.Capture(context.Background(), "el-9-geth-lighthouse", time.Second)
+	assert.ErrorContains(t, err, "service not found")
+	_, err = net.Capture(context.Background(), "el-1-geth-lighthouse", 0)
+	assert.Error(t, err)
+	_, err = New(Config{OrphanOnExit: true}).Capture(context.Background(), "el-1-geth-lighthouse", time.Second)
+	assert.Error(t, err)
+}
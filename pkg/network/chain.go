@@ -0,0 +1,79 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// Genesis returns the chain's genesis parameters, fetched once from the first
+// consensus client that answers
+func (n *network) Genesis(ctx context.Context) (*client.Genesis, error) {
+	n.genesisMu.Lock()
+	defer n.genesisMu.Unlock()
+
+	if n.genesis != nil {
+		return n.genesis, nil
+	}
+
+	genesis, err := queryBeacon(ctx, n, func(ctx context.Context, beacon client.ConsensusClient) (*client.Genesis, error) {
+		return beacon.Genesis(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	n.genesis = genesis
+
+	return genesis, nil
+}
+
+// GenesisValidatorsRoot returns the genesis validators root used in signing domains
+func (n *network) GenesisValidatorsRoot(ctx context.Context) (string, error) {
+	genesis, err := n.Genesis(ctx)
+	if err != nil {
+		return "", err
+	}
+	return genesis.ValidatorsRoot, nil
+}
+
+// GenesisForkVersion returns the fork version at genesis
+func (n *network) GenesisForkVersion(ctx context.Context) (string, error) {
+	genesis, err := n.Genesis(ctx)
+	if err != nil {
+		return "", err
+	}
+	return genesis.ForkVersion, nil
+}
+
+// CurrentForkVersion returns the fork version of the head state
+func (n *network) CurrentForkVersion(ctx context.Context) (string, error) {
+	fork, err := queryBeacon(ctx, n, func(ctx context.Context, beacon client.ConsensusClient) (*client.Fork, error) {
+		return beacon.Fork(ctx)
+	})
+	if err != nil {
+		return "", err
+	}
+	return fork.CurrentVersion, nil
+}
+
+// queryBeacon returns the result of the first running consensus client that answers
+func queryBeacon[T any](ctx context.Context, n *network, query func(context.Context, client.ConsensusClient) (T, error)) (T, error) {
+	var zero T
+	if n.consensusClients == nil {
+		return zero, fmt.Errorf("no consensus clients available")
+	}
+
+	var errs []error
+	for _, beacon := range n.consensusClients.Except(n.lateJoiners...) {
+		result, err := query(ctx, beacon)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", beacon.Name(), err))
+			continue
+		}
+		return result, nil
+	}
+
+	return zero, fmt.Errorf("no consensus client answered: %w", errors.Join(errs...))
+}
@@ -0,0 +1,61 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainParameters(t *testing.T) {
+	var genesisCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			genesisCalls.Add(1)
+			_, _ = w.Write([]byte(`{"data":{"genesis_time":"1700000000","genesis_validators_root":"0xabcd","genesis_fork_version":"0x10000038"}}`))
+		case "/eth/v1/beacon/states/head/fork":
+			_, _ = w.Write([]byte(`{"data":{"previous_version":"0x40000038","current_version":"0x50000038","epoch":"5"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	// The first node is down, so answers come from the second
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Teku, "cl-1-teku-geth", "", down.URL, "", "", "", "cl-1-teku-geth", "", 0))
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-2-lighthouse-geth", "", server.URL, "", "", "", "cl-2-lighthouse-geth", "", 0))
+	net := New(Config{Name: "test", ConsensusClients: consensusClients, OrphanOnExit: true})
+
+	ctx := context.Background()
+	root, err := net.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "0xabcd", root)
+
+	version, err := net.GenesisForkVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "0x10000038", version)
+	assert.Equal(t, int32(1), genesisCalls.Load(), "genesis is fetched once")
+
+	current, err := net.CurrentForkVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "0x50000038", current)
+}
+
+func TestChainParametersNoAnswer(t *testing.T) {
+	net := New(Config{Name: "test", ConsensusClients: client.NewConsensusClients(), OrphanOnExit: true})
+
+	_, err := net.GenesisValidatorsRoot(context.Background())
+	assert.ErrorContains(t, err, "no consensus client answered")
+}
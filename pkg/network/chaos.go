@@ -0,0 +1,108 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Chaos stops and starts the network's services, e.g. to kill a beacon node
+// mid-epoch and check that the network recovers. Stopped services keep their
+// containers and data, so starting them again resumes where they left off.
+// Its operations belong to OperationChaos.
+type Chaos struct {
+	n *network
+
+	mu      sync.Mutex
+	stopped map[string]bool
+}
+
+// Chaos returns the network's chaos controls
+func (n *network) Chaos() *Chaos { return n.chaos }
+
+// StopService stops a running service. Until it is started again, the network's
+// checks such as CrashLoops and AnalyzeLogs skip it like an unstarted late joiner.
+func (c *Chaos) StopService(ctx context.Context, name string) error {
+	if err := c.n.checkAllowed(OperationChaos, "StopService"); err != nil {
+		return err
+	}
+	if err := c.n.checkService(name); err != nil {
+		return err
+	}
+	if c.n.stopServiceFunc == nil {
+		return fmt.Errorf("network does not support stopping services")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.n.stopServiceFunc(ctx, name); err != nil {
+		return fmt.Errorf("failed to stop %s: %w", name, err)
+	}
+	c.stopped[name] = true
+	return nil
+}
+
+// StartService starts a stopped service
+func (c *Chaos) StartService(ctx context.Context, name string) error {
+	if err := c.n.checkAllowed(OperationChaos, "StartService"); err != nil {
+		return err
+	}
+	if err := c.n.checkService(name); err != nil {
+		return err
+	}
+	if c.n.startServiceFunc == nil {
+		return fmt.Errorf("network does not support starting services")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.n.startServiceFunc(ctx, name); err != nil {
+		return fmt.Errorf("failed to start %s: %w", name, err)
+	}
+	delete(c.stopped, name)
+	return nil
+}
+
+// RestartService stops a service and starts it again
+func (c *Chaos) RestartService(ctx context.Context, name string) error {
+	if err := c.StopService(ctx, name); err != nil {
+		return err
+	}
+	return c.StartService(ctx, name)
+}
+
+// Stopped returns the services stopped through StopService and not started
+// again, in name order
+func (c *Chaos) Stopped() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	names := make([]string, 0, len(c.stopped))
+	for name := range c.stopped {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// stoppedServices returns the services deliberately not running: late joiners
+// that have not been started and services stopped through Chaos
+func (n *network) stoppedServices() map[string]bool {
+	stopped := n.heldLateJoiners()
+	n.chaos.mu.Lock()
+	defer n.chaos.mu.Unlock()
+	for name := range n.chaos.stopped {
+		stopped[name] = true
+	}
+	return stopped
+}
+
+// checkService fails unless the network has a service with the name
+func (n *network) checkService(name string) error {
+	for _, service := range n.Services() {
+		if service.Name == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("service %s not found", name)
+}
@@ -0,0 +1,75 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChaos(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	states := map[string]ContainerState{
+		"cl-1-lighthouse-geth": {Status: "exited", ExitCode: 137},
+	}
+	net := New(Config{
+		Name:     "test",
+		Services: []Service{{Name: "cl-1-lighthouse-geth"}, {Name: "el-1-geth-lighthouse"}},
+		StopServiceFunc: func(ctx context.Context, name string) error {
+			calls = append(calls, "stop "+name)
+			return nil
+		},
+		StartServiceFunc: func(ctx context.Context, name string) error {
+			calls = append(calls, "start "+name)
+			return nil
+		},
+		ContainerStatesFunc: func(ctx context.Context) (map[string]ContainerState, error) { return states, nil },
+		MaxRestarts:         0,
+		OrphanOnExit:        true,
+	})
+	chaos := net.Chaos()
+
+	require.NoError(t, chaos.StopService(ctx, "cl-1-lighthouse-geth"))
+	assert.Equal(t, []string{"cl-1-lighthouse-geth"}, chaos.Stopped())
+
+	// A service stopped on purpose is not a crash loop
+	loops, err := net.CrashLoops(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, loops)
+
+	require.NoError(t, chaos.StartService(ctx, "cl-1-lighthouse-geth"))
+	assert.Empty(t, chaos.Stopped())
+	require.NoError(t, chaos.RestartService(ctx, "el-1-geth-lighthouse"))
+	assert.Equal(t, []string{
+		"stop cl-1-lighthouse-geth",
+		"start cl-1-lighthouse-geth",
+		"stop el-1-geth-lighthouse",
+		"start el-1-geth-lighthouse",
+	}, calls)
+
+	assert.EqualError(t, chaos.StopService(ctx, "cl-9-prysm-geth"), "service cl-9-prysm-geth not found")
+}
+
+func TestChaosErrors(t *testing.T) {
+	ctx := context.Background()
+	net := New(Config{
+		Name:     "test",
+		Services: []Service{{Name: "cl-1-lighthouse-geth"}},
+		StopServiceFunc: func(ctx context.Context, name string) error {
+			return errors.New("engine unreachable")
+		},
+		OrphanOnExit: true,
+		Policy:       NewPolicy(),
+	})
+
+	err := net.Chaos().RestartService(ctx, "cl-1-lighthouse-geth")
+	assert.EqualError(t, err, "failed to stop cl-1-lighthouse-geth: engine unreachable")
+	assert.Empty(t, net.Chaos().Stopped())
+	assert.ErrorContains(t, net.Chaos().StartService(ctx, "cl-1-lighthouse-geth"), "does not support starting")
+
+	net.Policy().Deny(OperationChaos)
+	assert.ErrorIs(t, net.Chaos().StopService(ctx, "cl-1-lighthouse-geth"), ErrDenied)
+}
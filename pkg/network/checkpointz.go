@@ -0,0 +1,18 @@
+package network
+
+import (
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/services"
+)
+
+// Checkpointz returns a client for the checkpointz service, which late joiners
+// checkpoint sync from. It fails unless checkpointz is deployed.
+func (n *network) Checkpointz() (*services.CheckpointzClient, error) {
+	url, err := n.serviceURL(ServiceTypeCheckpointz)
+	if err != nil {
+		return nil, err
+	}
+	return services.NewCheckpointzClient(url).WithHTTPClient(client.NewHTTPClient(30*time.Second, n.tlsConfig)), nil
+}
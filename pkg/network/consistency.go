@@ -0,0 +1,108 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// BlockDivergence is a block field on which execution clients disagree
+type BlockDivergence struct {
+	Number uint64
+	Field  string
+	// Values maps client name to the value it reported
+	Values map[string]string
+}
+
+// ConsistencyReport is the result of cross-validating blocks between execution clients
+type ConsistencyReport struct {
+	FromBlock   uint64
+	ToBlock     uint64
+	Divergences []BlockDivergence
+	// Errors maps client name to the first error seen fetching its blocks
+	Errors map[string]error
+}
+
+// Consistent reports whether every client agreed on every block and none failed
+func (r *ConsistencyReport) Consistent() bool {
+	return len(r.Divergences) == 0 && len(r.Errors) == 0
+}
+
+// String returns a summary of the divergences
+func (r *ConsistencyReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "blocks %d-%d: %d divergences\n", r.FromBlock, r.ToBlock, len(r.Divergences))
+	for _, d := range r.Divergences {
+		fmt.Fprintf(&b, "  block %d %s: %v\n", d.Number, d.Field, d.Values)
+	}
+	names := make([]string, 0, len(r.Errors))
+	for name := range r.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %s: %v\n", name, r.Errors[name])
+	}
+	return b.String()
+}
+
+// CrossValidateBlocks fetches blocks from..to (inclusive) from every running execution
+// client and flags blocks whose hash, state root, receipts root or logs bloom differ
+func (n *network) CrossValidateBlocks(ctx context.Context, from, to uint64) (*ConsistencyReport, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid block range %d-%d", from, to)
+	}
+	if n.executionClients == nil {
+		return nil, fmt.Errorf("no execution clients available")
+	}
+
+	clients := n.executionClients.Except(n.lateJoiners...)
+	headers := make([][]*client.BlockHeader, len(clients))
+	errs := client.FanOut(ctx, n.limiter, clients, func(ctx context.Context, i int, c client.ExecutionClient) error {
+		headers[i] = make([]*client.BlockHeader, 0, to-from+1)
+		for number := from; number <= to; number++ {
+			header, err := c.BlockHeader(ctx, number)
+			if err != nil {
+				return err
+			}
+			headers[i] = append(headers[i], header)
+		}
+		return nil
+	})
+
+	report := &ConsistencyReport{FromBlock: from, ToBlock: to, Errors: make(map[string]error)}
+	for i, c := range clients {
+		if errs[i] != nil {
+			report.Errors[c.Name()] = errs[i]
+		}
+	}
+
+	fields := []struct {
+		name  string
+		value func(*client.BlockHeader) string
+	}{
+		{"hash", func(h *client.BlockHeader) string { return h.Hash }},
+		{"stateRoot", func(h *client.BlockHeader) string { return h.StateRoot }},
+		{"receiptsRoot", func(h *client.BlockHeader) string { return h.ReceiptsRoot }},
+		{"logsBloom", func(h *client.BlockHeader) string { return h.LogsBloom }},
+	}
+
+	for offset := uint64(0); offset <= to-from; offset++ {
+		for _, field := range fields {
+			values := make(map[string]string)
+			for i, c := range clients {
+				if offset < uint64(len(headers[i])) {
+					values[c.Name()] = field.value(headers[i][offset])
+				}
+			}
+			if len(uniqueValues(values, func(v string) string { return v })) > 1 {
+				report.Divergences = append(report.Divergences, BlockDivergence{Number: from + offset, Field: field.name, Values: values})
+			}
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,86 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBlockServer serves eth_getBlockByNumber, reporting a different state root for divergentBlock
+func newBlockServer(t *testing.T, divergentBlock uint64) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Params []interface{} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var number uint64
+		if _, err := fmt.Sscanf(fmt.Sprint(req.Params[0]), "0x%x", &number); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		stateRoot := fmt.Sprintf("0xstate%d", number)
+		if number == divergentBlock {
+			stateRoot = "0xdiverged"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"result": map[string]string{
+				"number":       req.Params[0].(string),
+				"hash":         fmt.Sprintf("0xhash%d", number),
+				"stateRoot":    stateRoot,
+				"receiptsRoot": fmt.Sprintf("0xreceipts%d", number),
+				"logsBloom":    "0x00",
+			},
+		})
+	}))
+}
+
+func TestCrossValidateBlocks(t *testing.T) {
+	geth := newBlockServer(t, 0)
+	defer geth.Close()
+	besu := newBlockServer(t, 2)
+	defer besu.Close()
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", geth.URL, "", "", "", "", "el-1-geth-lighthouse", "", 0))
+	executionClients.Add(client.NewExecutionClient(client.Besu, "el-2-besu-teku", "", besu.URL, "", "", "", "", "el-2-besu-teku", "", 0))
+	executionClients.Add(client.NewExecutionClient(client.Reth, "el-3-reth-prysm", "", "", "", "", "", "", "el-3-reth-prysm", "", 0))
+
+	net := New(Config{
+		Name:             "test",
+		ExecutionClients: executionClients,
+		ConsensusClients: client.NewConsensusClients(),
+		OrphanOnExit:     true,
+	})
+
+	report, err := net.CrossValidateBlocks(context.Background(), 1, 3)
+	require.NoError(t, err)
+	assert.False(t, report.Consistent())
+
+	require.Len(t, report.Divergences, 1)
+	assert.Equal(t, uint64(2), report.Divergences[0].Number)
+	assert.Equal(t, "stateRoot", report.Divergences[0].Field)
+	assert.Equal(t, map[string]string{"el-1-geth-lighthouse": "0xstate2", "el-2-besu-teku": "0xdiverged"}, report.Divergences[0].Values)
+
+	require.Contains(t, report.Errors, "el-3-reth-prysm")
+	assert.Contains(t, report.String(), "block 2 stateRoot")
+
+	_, err = net.CrossValidateBlocks(context.Background(), 3, 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ErrCrashLoop is returned when a service restarted more often than allowed or exited with a failure
+var ErrCrashLoop = errors.New("service crash loop")
+
+// ContainerState is the runtime state of a service's container
+type ContainerState struct {
+	// Status is the container status, e.g. "running", "restarting" or "exited"
+	Status       string
+	RestartCount int
+	// ExitCode is the exit code of the last run, meaningful once the container exited
+	ExitCode  int
+	StartedAt time.Time
+	// OOMKilled is set when the kernel killed the container for running out of memory
+	OOMKilled bool
+}
+
+// CrashLoop is a service that restarted too often or exited with a failure
+type CrashLoop struct {
+	Service string
+	State   ContainerState
+}
+
+// String describes the crash loop
+func (c CrashLoop) String() string {
+	if c.State.Status == "exited" && c.State.ExitCode != 0 {
+		return fmt.Sprintf("%s exited with code %d after %d restarts", c.Service, c.State.ExitCode, c.State.RestartCount)
+	}
+	return fmt.Sprintf("%s restarted %d times (%s)", c.Service, c.State.RestartCount, c.State.Status)
+}
+
+// ContainerStates returns the container state of every service
+func (n *network) ContainerStates(ctx context.Context) (map[string]ContainerState, error) {
+	if n.containerStatesFunc == nil {
+		return nil, fmt.Errorf("network does not support container inspection")
+	}
+	return n.containerStatesFunc(ctx)
+}
+
+// CrashLoops returns the services that restarted more often than the network's
+// restart limit (any restart if no limit is set) or exited with a failure.
+// Late joiners that were never started and services stopped through Chaos are skipped.
+func (n *network) CrashLoops(ctx context.Context) ([]CrashLoop, error) {
+	states, err := n.ContainerStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	maxRestarts := n.maxRestarts
+	if maxRestarts < 0 {
+		maxRestarts = 0
+	}
+
+	stopped := n.stoppedServices()
+	var loops []CrashLoop
+	for service, state := range states {
+		if stopped[service] {
+			continue
+		}
+		failed := state.Status == "exited" && state.ExitCode != 0
+		if state.RestartCount > maxRestarts || failed {
+			loops = append(loops, CrashLoop{Service: service, State: state})
+		}
+	}
+	sort.Slice(loops, func(i, j int) bool { return loops[i].Service < loops[j].Service })
+
+	return loops, nil
+}
+
+// crashLoopErrors returns a health error for every crash-looping service when a
+// restart limit is set
+func (n *network) crashLoopErrors(ctx context.Context) map[string]error {
+	if n.maxRestarts < 0 || n.containerStatesFunc == nil {
+		return nil
+	}
+
+	loops, err := n.CrashLoops(ctx)
+	if err != nil {
+		return nil
+	}
+	errs := make(map[string]error, len(loops))
+	for _, loop := range loops {
+		errs[loop.Service] = fmt.Errorf("%w: %s", ErrCrashLoop, loop)
+	}
+	return errs
+}
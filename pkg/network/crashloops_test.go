@@ -0,0 +1,62 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrashLoops(t *testing.T) {
+	states := map[string]ContainerState{
+		"el-1-geth-lighthouse": {Status: "running", RestartCount: 4},
+		"cl-1-lighthouse-geth": {Status: "running", RestartCount: 1},
+		"vc-1-geth-lighthouse": {Status: "exited", ExitCode: 2},
+		"el-2-besu-teku":       {Status: "exited", ExitCode: 137}, // stopped late joiner
+		"cl-2-teku-besu":       {Status: "exited", ExitCode: 0},
+	}
+	net := New(Config{
+		Name:                "test",
+		LateJoiners:         []string{"el-2-besu-teku"},
+		ContainerStatesFunc: func(ctx context.Context) (map[string]ContainerState, error) { return states, nil },
+		MaxRestarts:         2,
+		StartServiceFunc:    func(ctx context.Context, name string) error { return nil },
+		OrphanOnExit:        true,
+	})
+
+	loops, err := net.CrashLoops(context.Background())
+	require.NoError(t, err)
+	require.Len(t, loops, 2)
+	assert.Equal(t, "el-1-geth-lighthouse", loops[0].Service)
+	assert.Equal(t, "el-1-geth-lighthouse restarted 4 times (running)", loops[0].String())
+	assert.Equal(t, "vc-1-geth-lighthouse exited with code 2 after 0 restarts", loops[1].String())
+
+	// Once started, a late joiner that exited counts
+	require.NoError(t, net.StartLateJoiners(context.Background()))
+	loops, err = net.CrashLoops(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, loops, 3)
+
+	// Health reports crash loops alongside readiness
+	health := net.Health(context.Background())
+	assert.ErrorIs(t, health["el-1-geth-lighthouse"], ErrCrashLoop)
+	assert.NotContains(t, health, "cl-1-lighthouse-geth")
+}
+
+func TestCrashLoopsDisabled(t *testing.T) {
+	net := New(Config{
+		Name:                "test",
+		ContainerStatesFunc: func(ctx context.Context) (map[string]ContainerState, error) { return nil, errors.New("no docker") },
+		MaxRestarts:         -1,
+		OrphanOnExit:        true,
+	})
+	assert.Empty(t, net.Health(context.Background()))
+
+	_, err := net.CrashLoops(context.Background())
+	assert.ErrorContains(t, err, "no docker")
+
+	_, err = New(Config{Name: "test", OrphanOnExit: true}).ContainerStates(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// GrafanaPanel selects a dashboard, or one of its panels, to render
+type GrafanaPanel struct {
+	Dashboard string // dashboard UID
+	Panel     int    // panel ID; 0 renders the whole dashboard
+	Name      string // file name without extension; derived from the UID and panel when empty
+	Width     int    // pixels, 1000 when zero
+	Height    int    // pixels, 500 for a panel and 2000 for a dashboard when zero
+}
+
+// fileName returns the PNG file name of the rendered panel
+func (p GrafanaPanel) fileName() string {
+	name := p.Name
+	if name == "" {
+		name = "grafana-" + p.Dashboard
+		if p.Panel != 0 {
+			name += "-panel-" + strconv.Itoa(p.Panel)
+		}
+	}
+	return unsafeFileChars.ReplaceAllString(name, "_") + ".png"
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// renderPath returns the render API path and query of the panel for the time range
+func (p GrafanaPanel) renderPath(from, to time.Time) string {
+	width, height := p.Width, p.Height
+	if width == 0 {
+		width = 1000
+	}
+	if height == 0 {
+		height = 2000
+		if p.Panel != 0 {
+			height = 500
+		}
+	}
+
+	query := url.Values{}
+	query.Set("from", strconv.FormatInt(from.UnixMilli(), 10))
+	query.Set("to", strconv.FormatInt(to.UnixMilli(), 10))
+	query.Set("width", strconv.Itoa(width))
+	query.Set("height", strconv.Itoa(height))
+
+	kind := "d"
+	if p.Panel != 0 {
+		kind = "d-solo"
+		query.Set("panelId", strconv.Itoa(p.Panel))
+	}
+	return fmt.Sprintf("/render/%s/%s/_?%s", kind, url.PathEscape(p.Dashboard), query.Encode())
+}
+
+// ExportDashboards renders Grafana panels over the network's lifetime to PNG
+// files in dir and returns their paths. Without panels every dashboard is
+// rendered whole. Rendering needs the Grafana image renderer.
+func (n *network) ExportDashboards(ctx context.Context, dir string, panels ...GrafanaPanel) ([]string, error) {
+	var grafana *Service
+	for _, s := range n.Services() {
+		if s.Type == ServiceTypeGrafana && s.URL != "" {
+			grafana = &s
+			break
+		}
+	}
+	if grafana == nil {
+		return nil, fmt.Errorf("grafana is not deployed")
+	}
+
+	// Rendering a dashboard takes a while, well beyond the usual request timeout
+	httpClient := client.NewHTTPClient(2*time.Minute, n.tlsConfig)
+	if len(panels) == 0 {
+		uids, err := grafanaDashboards(ctx, httpClient, grafana.URL)
+		if err != nil {
+			return nil, err
+		}
+		for _, uid := range uids {
+			panels = append(panels, GrafanaPanel{Dashboard: uid})
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create artifacts directory: %w", err)
+	}
+
+	to := time.Now()
+	var paths []string
+	var errs []error
+	for _, panel := range panels {
+		path := filepath.Join(dir, panel.fileName())
+		if err := renderPanel(ctx, httpClient, grafana.URL+panel.renderPath(n.created, to), path); err != nil {
+			errs = append(errs, fmt.Errorf("dashboard %s: %w", panel.Dashboard, err))
+			continue
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, errors.Join(errs...)
+}
+
+// grafanaDashboards returns the UIDs of all dashboards
+func grafanaDashboards(ctx context.Context, httpClient *http.Client, baseURL string) ([]string, error) {
+	var results []struct {
+		UID string `json:"uid"`
+	}
+	if err := getJSON(ctx, httpClient, baseURL+"/api/search?type=dash-db", &results); err != nil {
+		return nil, fmt.Errorf("failed to list dashboards: %w", err)
+	}
+
+	uids := make([]string, 0, len(results))
+	for _, result := range results {
+		uids = append(uids, result.UID)
+	}
+	return uids, nil
+}
+
+// renderPanel fetches a rendered PNG and writes it to path
+func renderPanel(ctx context.Context, httpClient *http.Client, renderURL, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, renderURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("render request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("render returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if contentType := resp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "image/png") {
+		return fmt.Errorf("render returned %s instead of a PNG", contentType)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return file.Close()
+}
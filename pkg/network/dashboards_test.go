@@ -0,0 +1,102 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+// newGrafanaServer serves two dashboards and renders every request except
+// dashboard "broken" as a PNG
+func newGrafanaServer(t *testing.T, rendered *[]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/search":
+			_, _ = w.Write([]byte(`[{"uid":"beacon"},{"uid":"execution"}]`))
+		case strings.HasPrefix(r.URL.Path, "/render/"):
+			*rendered = append(*rendered, r.URL.Path+"?panelId="+r.URL.Query().Get("panelId"))
+			if strings.Contains(r.URL.Path, "broken") {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte("no image renderer available"))
+				return
+			}
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(pngHeader)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExportDashboards(t *testing.T) {
+	var rendered []string
+	server := newGrafanaServer(t, &rendered)
+	net := New(Config{
+		Name:         "test",
+		Services:     []Service{{Name: "grafana", Type: ServiceTypeGrafana, URL: server.URL}},
+		OrphanOnExit: true,
+	})
+	dir := filepath.Join(t.TempDir(), "artifacts")
+
+	paths, err := net.ExportDashboards(context.Background(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "grafana-beacon.png"), filepath.Join(dir, "grafana-execution.png")}, paths)
+	assert.Equal(t, []string{"/render/d/beacon/_?panelId=", "/render/d/execution/_?panelId="}, rendered)
+
+	data, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	assert.Equal(t, pngHeader, data)
+
+	rendered = nil
+	paths, err = net.ExportDashboards(context.Background(), dir,
+		GrafanaPanel{Dashboard: "beacon", Panel: 4, Name: "head slot"},
+		GrafanaPanel{Dashboard: "broken", Panel: 1},
+	)
+	assert.ErrorContains(t, err, "dashboard broken: render returned status 500: no image renderer available")
+	assert.Equal(t, []string{filepath.Join(dir, "head_slot.png")}, paths)
+	assert.Equal(t, "/render/d-solo/beacon/_?panelId=4", rendered[0])
+}
+
+func TestCleanupExportsDashboards(t *testing.T) {
+	var rendered []string
+	server := newGrafanaServer(t, &rendered)
+	dir := t.TempDir()
+
+	destroyed := false
+	net := New(Config{
+		Name:          "test",
+		Services:      []Service{{Name: "grafana", Type: ServiceTypeGrafana, URL: server.URL}},
+		ArtifactsDir:  dir,
+		GrafanaPanels: []GrafanaPanel{{Dashboard: "beacon", Panel: 2}},
+		CleanupFunc: func(context.Context) error {
+			// Grafana must still be running when panels are rendered
+			assert.Len(t, rendered, 1)
+			destroyed = true
+			return nil
+		},
+		OrphanOnExit: true,
+	})
+
+	require.NoError(t, net.Cleanup(context.Background()))
+	assert.True(t, destroyed)
+	assert.FileExists(t, filepath.Join(dir, "grafana-beacon-panel-2.png"))
+}
+
+func TestExportDashboardsWithoutGrafana(t *testing.T) {
+	net := New(Config{Name: "test", OrphanOnExit: true})
+	_, err := net.ExportDashboards(context.Background(), t.TempDir())
+	assert.ErrorContains(t, err, "grafana is not deployed")
+}
@@ -0,0 +1,154 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// ErrSnapshotMismatch is returned when a snapshot is restored into a network it
+// was not taken from
+var ErrSnapshotMismatch = errors.New("snapshot does not match network")
+
+// ServiceDir is a directory of a service's container, saved as a files
+// artifact of the enclave
+type ServiceDir struct {
+	Service  string `json:"service"`
+	Path     string `json:"path"`
+	Artifact string `json:"artifact"`
+}
+
+// EnclaveSnapshot records the client data directories of a network, saved as
+// files artifacts in its enclave, and the chain they belong to
+type EnclaveSnapshot struct {
+	Name        string    `json:"name"`
+	EnclaveName string    `json:"enclave_name"`
+	ConfigHash  string    `json:"config_hash"`
+	Created     time.Time `json:"created"`
+	// GenesisTime and GenesisValidatorsRoot identify the chain, whose genesis
+	// config stays in the enclave
+	GenesisTime           time.Time `json:"genesis_time"`
+	GenesisValidatorsRoot string    `json:"genesis_validators_root"`
+	// Finalized is the finalized checkpoint when the snapshot was taken
+	Finalized client.Checkpoint `json:"finalized"`
+	// Dirs lists the data directories, execution clients first
+	Dirs []ServiceDir `json:"dirs"`
+}
+
+// executionDataDirs and consensusDataDirs are where ethereum-package keeps each
+// client's data
+var (
+	executionDataDirs = map[client.Type]string{
+		client.Geth:       "/data/geth/execution-data",
+		client.Besu:       "/data/besu/execution-data",
+		client.Nethermind: "/data/nethermind/execution-data",
+		client.Erigon:     "/data/erigon/execution-data",
+		client.Reth:       "/data/reth/execution-data",
+	}
+	consensusDataDirs = map[client.Type]string{
+		client.Lighthouse: "/data/lighthouse/beacon-data",
+		client.Teku:       "/data/teku/teku-beacon-data",
+		client.Prysm:      "/data/prysm/beacon-data",
+		client.Nimbus:     "/data/nimbus/beacon-data",
+		client.Lodestar:   "/data/lodestar/beacon-data",
+		client.Grandine:   "/data/grandine/beacon-data",
+	}
+)
+
+// SnapshotEnclave saves the data directories of the running execution and
+// consensus clients as files artifacts named after the snapshot. The clients
+// are stopped while their data is copied, so the snapshot is consistent.
+func (n *network) SnapshotEnclave(ctx context.Context, name string) (*EnclaveSnapshot, error) {
+	if err := n.checkAllowed(OperationSnapshots, "SnapshotEnclave"); err != nil {
+		return nil, err
+	}
+	if n.snapshotFunc == nil {
+		return nil, fmt.Errorf("network does not support snapshots")
+	}
+
+	genesis, err := n.Genesis(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genesis: %w", err)
+	}
+	checkpoints, err := queryBeacon(ctx, n, func(ctx context.Context, beacon client.ConsensusClient) (*client.FinalityCheckpoints, error) {
+		return beacon.FinalityCheckpoints(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finality checkpoints: %w", err)
+	}
+
+	snapshot := &EnclaveSnapshot{
+		Name:                  name,
+		EnclaveName:           n.enclaveName,
+		ConfigHash:            n.configHash,
+		Created:               time.Now(),
+		GenesisTime:           genesis.Time,
+		GenesisValidatorsRoot: genesis.ValidatorsRoot,
+		Finalized:             checkpoints.Finalized,
+	}
+	if n.executionClients != nil {
+		for _, el := range n.executionClients.Except(n.lateJoiners...) {
+			dir, ok := executionDataDirs[el.Type()]
+			if !ok {
+				return nil, fmt.Errorf("no known data directory for %s", el.Name())
+			}
+			snapshot.Dirs = append(snapshot.Dirs, snapshotDir(name, el.ServiceName(), dir))
+		}
+	}
+	if n.consensusClients != nil {
+		for _, cl := range n.consensusClients.Except(n.lateJoiners...) {
+			dir, ok := consensusDataDirs[cl.Type()]
+			if !ok {
+				return nil, fmt.Errorf("no known data directory for %s", cl.Name())
+			}
+			snapshot.Dirs = append(snapshot.Dirs, snapshotDir(name, cl.ServiceName(), dir))
+		}
+	}
+	if len(snapshot.Dirs) == 0 {
+		return nil, fmt.Errorf("no clients to snapshot")
+	}
+
+	if err := n.snapshotFunc(ctx, snapshot.Dirs); err != nil {
+		return nil, fmt.Errorf("failed to snapshot %s: %w", name, err)
+	}
+	return snapshot, nil
+}
+
+// RestoreEnclave rolls the clients back to the data of a snapshot taken from
+// this network. The clients restart on the restored data and catch up with
+// the slots that passed since.
+func (n *network) RestoreEnclave(ctx context.Context, snapshot *EnclaveSnapshot) error {
+	if err := n.checkAllowed(OperationSnapshots, "RestoreEnclave"); err != nil {
+		return err
+	}
+	if n.restoreFunc == nil {
+		return fmt.Errorf("network does not support snapshots")
+	}
+	if snapshot.EnclaveName != n.enclaveName {
+		return fmt.Errorf("%w: snapshot %s was taken in enclave %s, not %s", ErrSnapshotMismatch, snapshot.Name, snapshot.EnclaveName, n.enclaveName)
+	}
+	if snapshot.ConfigHash != "" && n.configHash != "" && snapshot.ConfigHash != n.configHash {
+		return fmt.Errorf("%w: snapshot %s has config hash %s, network has %s", ErrSnapshotMismatch, snapshot.Name, snapshot.ConfigHash, n.configHash)
+	}
+
+	genesis, err := n.Genesis(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get genesis: %w", err)
+	}
+	if genesis.ValidatorsRoot != snapshot.GenesisValidatorsRoot || !genesis.Time.Equal(snapshot.GenesisTime) {
+		return fmt.Errorf("%w: snapshot %s belongs to a chain with another genesis", ErrSnapshotMismatch, snapshot.Name)
+	}
+
+	if err := n.restoreFunc(ctx, snapshot.Dirs); err != nil {
+		return fmt.Errorf("failed to restore %s: %w", snapshot.Name, err)
+	}
+	return nil
+}
+
+// snapshotDir names the files artifact holding a service's directory
+func snapshotDir(name, service, path string) ServiceDir {
+	return ServiceDir{Service: service, Path: path, Artifact: fmt.Sprintf("snapshot-%s-%s", name, service)}
+}
@@ -0,0 +1,97 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSnapshotNetwork serves a chain finalized at epoch 3 and records the
+// directories passed to the snapshot and restore functions
+func newSnapshotNetwork(t *testing.T, genesis time.Time, snapshotted, restored *[]ServiceDir) Network {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			fmt.Fprintf(w, `{"data":{"genesis_time":"%d","genesis_validators_root":"0x01","genesis_fork_version":"0x10000038"}}`, genesis.Unix())
+		case "/eth/v1/beacon/states/head/finality_checkpoints":
+			fmt.Fprint(w, `{"data":{"previous_justified":{"epoch":"3","root":"0x03"},"current_justified":{"epoch":"4","root":"0x04"},"finalized":{"epoch":"3","root":"0x03"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "", "el-1-geth-lighthouse", "", 0))
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+	return New(Config{
+		Name:             "test",
+		EnclaveName:      "test-enclave",
+		ConfigHash:       "abc",
+		ExecutionClients: executionClients,
+		ConsensusClients: consensusClients,
+		SnapshotFunc: func(ctx context.Context, dirs []ServiceDir) error {
+			*snapshotted = dirs
+			return nil
+		},
+		RestoreFunc: func(ctx context.Context, dirs []ServiceDir) error {
+			*restored = dirs
+			return nil
+		},
+		OrphanOnExit: true,
+	})
+}
+
+func TestEnclaveSnapshot(t *testing.T) {
+	genesis := time.Now().Add(-time.Hour).Truncate(time.Second)
+	var snapshotted, restored []ServiceDir
+	net := newSnapshotNetwork(t, genesis, &snapshotted, &restored)
+
+	snapshot, err := net.SnapshotEnclave(context.Background(), "electra")
+	require.NoError(t, err)
+	assert.Equal(t, "test-enclave", snapshot.EnclaveName)
+	assert.Equal(t, "abc", snapshot.ConfigHash)
+	assert.Equal(t, genesis, snapshot.GenesisTime)
+	assert.Equal(t, "0x01", snapshot.GenesisValidatorsRoot)
+	assert.Equal(t, client.Checkpoint{Epoch: 3, Root: "0x03"}, snapshot.Finalized)
+	assert.Equal(t, []ServiceDir{
+		{Service: "el-1-geth-lighthouse", Path: "/data/geth/execution-data", Artifact: "snapshot-electra-el-1-geth-lighthouse"},
+		{Service: "cl-1-lighthouse-geth", Path: "/data/lighthouse/beacon-data", Artifact: "snapshot-electra-cl-1-lighthouse-geth"},
+	}, snapshotted)
+
+	require.NoError(t, net.RestoreEnclave(context.Background(), snapshot))
+	assert.Equal(t, snapshotted, restored)
+}
+
+func TestEnclaveSnapshot_RestoreMismatch(t *testing.T) {
+	genesis := time.Now().Add(-time.Hour).Truncate(time.Second)
+	var snapshotted, restored []ServiceDir
+	net := newSnapshotNetwork(t, genesis, &snapshotted, &restored)
+
+	snapshot, err := net.SnapshotEnclave(context.Background(), "electra")
+	require.NoError(t, err)
+
+	for name, modify := range map[string]func(*EnclaveSnapshot){
+		"other enclave":    func(s *EnclaveSnapshot) { s.EnclaveName = "other" },
+		"other config":     func(s *EnclaveSnapshot) { s.ConfigHash = "def" },
+		"other genesis":    func(s *EnclaveSnapshot) { s.GenesisTime = s.GenesisTime.Add(time.Second) },
+		"other validators": func(s *EnclaveSnapshot) { s.GenesisValidatorsRoot = "0x02" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			other := *snapshot
+			modify(&other)
+			assert.ErrorIs(t, net.RestoreEnclave(context.Background(), &other), ErrSnapshotMismatch)
+			assert.Nil(t, restored)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// FeeRecipientMismatch is a block whose execution payload pays someone other
+// than the fee recipient configured for its proposer's node
+type FeeRecipientMismatch struct {
+	Slot          uint64
+	BlockNumber   uint64
+	ProposerIndex uint64
+	// Node is the 1-based index of the node running the proposer
+	Node     int
+	Expected string
+	Actual   string
+}
+
+// FeeRecipientReport is the result of checking the fee recipients of the blocks in a slot range
+type FeeRecipientReport struct {
+	FromSlot uint64
+	ToSlot   uint64
+	// Checked is the number of blocks compared against their node's fee recipient
+	Checked int
+	// Unchecked lists the slots of blocks whose proposer's node has no configured
+	// fee recipient or is unknown. Missed slots are not listed.
+	Unchecked  []uint64
+	Mismatches []FeeRecipientMismatch
+}
+
+// Correct reports whether every checked block paid its configured fee recipient
+func (r *FeeRecipientReport) Correct() bool {
+	return len(r.Mismatches) == 0
+}
+
+// VerifyFeeRecipients checks that the canonical blocks of slots from..to
+// (inclusive) pay the fee recipient configured for the node that proposed them
+func (n *network) VerifyFeeRecipients(ctx context.Context, from, to uint64) (*FeeRecipientReport, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid slot range %d-%d", from, to)
+	}
+	beacon, _, err := n.beaconClock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &FeeRecipientReport{FromSlot: from, ToSlot: to}
+	for slot := from; slot <= to; slot++ {
+		payment, err := beacon.BlockPayment(ctx, slot)
+		if errors.Is(err, client.ErrBlockNotFound) {
+			continue
+		}
+		if err != nil {
+			return report, fmt.Errorf("failed to get block at slot %d: %w", slot, err)
+		}
+
+		node := n.nodeForValidator(payment.ProposerIndex)
+		expected, ok := n.feeRecipients[node]
+		if node == 0 || !ok {
+			report.Unchecked = append(report.Unchecked, slot)
+			continue
+		}
+
+		report.Checked++
+		if !strings.EqualFold(payment.FeeRecipient, expected) {
+			report.Mismatches = append(report.Mismatches, FeeRecipientMismatch{
+				Slot:          slot,
+				BlockNumber:   payment.BlockNumber,
+				ProposerIndex: payment.ProposerIndex,
+				Node:          node,
+				Expected:      expected,
+				Actual:        payment.FeeRecipient,
+			})
+		}
+	}
+
+	return report, nil
+}
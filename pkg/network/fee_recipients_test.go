@@ -0,0 +1,81 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyFeeRecipients(t *testing.T) {
+	// Validator s proposes slot s and pays 0x..0s, except validator 3 which
+	// pays 0x..ff. Slot 2 is missed.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/eth/v1/beacon/genesis":
+			fmt.Fprintf(w, `{"data":{"genesis_time":"%d"}}`, time.Now().Unix())
+		case r.URL.Path == "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(`{"data":{"SECONDS_PER_SLOT":"1"}}`))
+		case strings.HasPrefix(r.URL.Path, "/eth/v2/beacon/blocks/"):
+			slot, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/eth/v2/beacon/blocks/"))
+			if slot == 2 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			recipient := fmt.Sprintf("0x%040x", slot)
+			if slot == 3 {
+				recipient = fmt.Sprintf("0x%040x", 0xff)
+			}
+			fmt.Fprintf(w, `{"data":{"message":{"proposer_index":"%d","body":{"execution_payload":{"block_number":"%d","fee_recipient":"%s"}}}}}`, slot, slot, recipient)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+	net := New(Config{
+		Name:             "test",
+		ConsensusClients: consensusClients,
+		ExecutionClients: client.NewExecutionClients(),
+		ValidatorRanges: map[int]config.ValidatorRange{
+			1: {Start: 0, End: 2},
+			2: {Start: 2, End: 4},
+			3: {Start: 4, End: 6},
+		},
+		FeeRecipients: map[int]string{
+			1: fmt.Sprintf("0x%040x", 1),
+			2: fmt.Sprintf("0x%040x", 3),
+		},
+		OrphanOnExit: true,
+	})
+
+	report, err := net.VerifyFeeRecipients(context.Background(), 1, 4)
+	require.NoError(t, err)
+	assert.False(t, report.Correct())
+	assert.Equal(t, 2, report.Checked)
+	assert.Equal(t, []uint64{4}, report.Unchecked)
+	require.Len(t, report.Mismatches, 1)
+	assert.Equal(t, FeeRecipientMismatch{
+		Slot:          3,
+		BlockNumber:   3,
+		ProposerIndex: 3,
+		Node:          2,
+		Expected:      fmt.Sprintf("0x%040x", 3),
+		Actual:        fmt.Sprintf("0x%040x", 0xff),
+	}, report.Mismatches[0])
+
+	_, err = net.VerifyFeeRecipients(context.Background(), 4, 1)
+	assert.EqualError(t, err, "invalid slot range 4-1")
+}
@@ -0,0 +1,94 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// DefaultFinalityPollInterval is how often WaitForFinality checks the chain
+const DefaultFinalityPollInterval = 2 * time.Second
+
+// FinalityWaitOption configures WaitForFinality
+type FinalityWaitOption func(*finalityWaitConfig)
+
+type finalityWaitConfig struct {
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+// WithFinalityPollInterval sets how often the finality checkpoints are polled
+func WithFinalityPollInterval(interval time.Duration) FinalityWaitOption {
+	return func(cfg *finalityWaitConfig) {
+		cfg.pollInterval = interval
+	}
+}
+
+// WithFinalityTimeout bounds the wait; without it only ctx does
+func WithFinalityTimeout(timeout time.Duration) FinalityWaitOption {
+	return func(cfg *finalityWaitConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WaitForFinality polls the consensus clients' finality checkpoints until the
+// chain finalizes minEpoch or a later epoch, and returns the finalized
+// checkpoint. A minEpoch of 0 waits for the first finalization past genesis.
+// Stopped late joiners are skipped.
+func (n *network) WaitForFinality(ctx context.Context, minEpoch uint64, opts ...FinalityWaitOption) (*client.Checkpoint, error) {
+	cfg := &finalityWaitConfig{pollInterval: DefaultFinalityPollInterval}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.pollInterval <= 0 {
+		cfg.pollInterval = DefaultFinalityPollInterval
+	}
+	if minEpoch == 0 {
+		minEpoch = 1
+	}
+
+	waitCtx := ctx
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(cfg.pollInterval)
+	defer ticker.Stop()
+
+	var finalized client.Checkpoint
+	var lastErr error
+	for {
+		checkpoints, err := queryBeacon(waitCtx, n, func(ctx context.Context, beacon client.ConsensusClient) (*client.FinalityCheckpoints, error) {
+			return beacon.FinalityCheckpoints(ctx)
+		})
+		if err == nil {
+			finalized = checkpoints.Finalized
+			if finalized.Epoch > 0 {
+				n.milestones.Record(MilestoneFirstFinalized, time.Now())
+			}
+			if finalized.Epoch >= minEpoch {
+				return &finalized, nil
+			}
+		}
+		// A poll cut short by the deadline says nothing about the clients
+		if waitCtx.Err() == nil {
+			lastErr = err
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if lastErr != nil {
+				return nil, fmt.Errorf("chain did not finalize epoch %d within %s: %w", minEpoch, cfg.timeout, lastErr)
+			}
+			return nil, fmt.Errorf("chain did not finalize epoch %d within %s, finalized epoch is %d", minEpoch, cfg.timeout, finalized.Epoch)
+		case <-ticker.C:
+		}
+	}
+}
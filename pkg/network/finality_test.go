@@ -0,0 +1,34 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForFinality(t *testing.T) {
+	net := newMilestoneNetwork(t, time.Now(), 3)
+
+	checkpoint, err := net.WaitForFinality(context.Background(), 0, WithFinalityPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), checkpoint.Epoch)
+	assert.Equal(t, "0x00", checkpoint.Root)
+	_, ok := net.Milestones().Time(MilestoneFirstFinalized)
+	assert.True(t, ok)
+
+	// The served chain never finalizes epoch 2
+	_, err = net.WaitForFinality(context.Background(), 2,
+		WithFinalityPollInterval(10*time.Millisecond),
+		WithFinalityTimeout(50*time.Millisecond),
+	)
+	assert.ErrorContains(t, err, "chain did not finalize epoch 2 within 50ms, finalized epoch is 1")
+
+	// Cancelling the caller's context is reported as such
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = net.WaitForFinality(ctx, 2)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,183 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// ForkChoiceWeightDiff is a block whose fork choice weight differs between clients
+type ForkChoiceWeightDiff struct {
+	BlockRoot string
+	Slot      uint64
+	// Weights maps client name to the block's weight in that client's store
+	Weights map[string]uint64
+}
+
+// ForkChoiceComparison diffs the fork choice stores of several consensus clients
+type ForkChoiceComparison struct {
+	// Heads maps client name to the head it would choose
+	Heads map[string]client.ForkChoiceNode
+	// Justified and Finalized map client name to its checkpoints
+	Justified map[string]client.Checkpoint
+	Finalized map[string]client.Checkpoint
+	// WeightDiffs lists blocks known to several clients with differing weights, by slot
+	WeightDiffs []ForkChoiceWeightDiff
+	// Missing maps block roots to the clients that don't know them. Only blocks newer
+	// than every client's oldest stored block are compared, as pruning differs.
+	Missing map[string][]string
+	// Errors holds the clients whose fork choice could not be fetched
+	Errors map[string]error
+}
+
+// HeadsAgree reports whether every client chose the same head
+func (c *ForkChoiceComparison) HeadsAgree() bool {
+	return len(uniqueValues(c.Heads, func(n client.ForkChoiceNode) string { return n.BlockRoot })) <= 1
+}
+
+// FinalityAgrees reports whether every client has the same justified and finalized checkpoints
+func (c *ForkChoiceComparison) FinalityAgrees() bool {
+	root := func(cp client.Checkpoint) string { return fmt.Sprintf("%d/%s", cp.Epoch, cp.Root) }
+	return len(uniqueValues(c.Justified, root)) <= 1 && len(uniqueValues(c.Finalized, root)) <= 1
+}
+
+// String returns a summary of the differences
+func (c *ForkChoiceComparison) String() string {
+	var b strings.Builder
+	names := make([]string, 0, len(c.Heads))
+	for name := range c.Heads {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(&b, "heads agree: %t, finality agrees: %t\n", c.HeadsAgree(), c.FinalityAgrees())
+	for _, name := range names {
+		head := c.Heads[name]
+		fmt.Fprintf(&b, "  %s: head %s at slot %d (weight %d), justified %d, finalized %d\n",
+			name, head.BlockRoot, head.Slot, head.Weight, c.Justified[name].Epoch, c.Finalized[name].Epoch)
+	}
+	for _, diff := range c.WeightDiffs {
+		fmt.Fprintf(&b, "  weight differs for %s at slot %d: %v\n", diff.BlockRoot, diff.Slot, diff.Weights)
+	}
+	for root, missing := range c.Missing {
+		fmt.Fprintf(&b, "  %s unknown to %s\n", root, strings.Join(missing, ", "))
+	}
+	for name, err := range c.Errors {
+		fmt.Fprintf(&b, "  %s: %v\n", name, err)
+	}
+	return b.String()
+}
+
+// CompareForkChoice fetches the fork choice store of every running consensus client and diffs them
+func (n *network) CompareForkChoice(ctx context.Context) (*ForkChoiceComparison, error) {
+	if n.consensusClients == nil {
+		return nil, fmt.Errorf("no consensus clients available")
+	}
+
+	clients := n.consensusClients.Except(n.lateJoiners...)
+	forkChoices := make([]*client.ForkChoice, len(clients))
+	errs := client.FanOut(ctx, n.limiter, clients, func(ctx context.Context, i int, c client.ConsensusClient) error {
+		var err error
+		forkChoices[i], err = c.ForkChoice(ctx)
+		return err
+	})
+
+	stores := make(map[string]*client.ForkChoice, len(clients))
+	failures := make(map[string]error)
+	for i, c := range clients {
+		if errs[i] != nil {
+			failures[c.Name()] = errs[i]
+			continue
+		}
+		stores[c.Name()] = forkChoices[i]
+	}
+	if len(stores) == 0 {
+		return nil, fmt.Errorf("failed to get fork choice from any consensus client")
+	}
+
+	comparison := CompareForkChoices(stores)
+	comparison.Errors = failures
+	return comparison, nil
+}
+
+// CompareForkChoices diffs fork choice stores keyed by client name
+func CompareForkChoices(stores map[string]*client.ForkChoice) *ForkChoiceComparison {
+	comparison := &ForkChoiceComparison{
+		Heads:     make(map[string]client.ForkChoiceNode, len(stores)),
+		Justified: make(map[string]client.Checkpoint, len(stores)),
+		Finalized: make(map[string]client.Checkpoint, len(stores)),
+		Missing:   make(map[string][]string),
+		Errors:    make(map[string]error),
+	}
+
+	// Index every store's nodes by root and find the oldest slot all stores cover
+	type entry struct {
+		slot    uint64
+		weights map[string]uint64
+	}
+	blocks := make(map[string]*entry)
+	var floor uint64
+	for name, store := range stores {
+		if head, ok := store.Head(); ok {
+			comparison.Heads[name] = head
+		}
+		comparison.Justified[name] = store.JustifiedCheckpoint
+		comparison.Finalized[name] = store.FinalizedCheckpoint
+
+		if len(store.Nodes) == 0 {
+			continue
+		}
+		oldest := store.Nodes[0].Slot
+		for _, node := range store.Nodes {
+			if node.Slot < oldest {
+				oldest = node.Slot
+			}
+			e, ok := blocks[node.BlockRoot]
+			if !ok {
+				e = &entry{slot: node.Slot, weights: make(map[string]uint64)}
+				blocks[node.BlockRoot] = e
+			}
+			e.weights[name] = node.Weight
+		}
+		if oldest > floor {
+			floor = oldest
+		}
+	}
+
+	for root, e := range blocks {
+		if e.slot < floor {
+			continue
+		}
+		if len(e.weights) < len(stores) {
+			for name := range stores {
+				if _, ok := e.weights[name]; !ok {
+					comparison.Missing[root] = append(comparison.Missing[root], name)
+				}
+			}
+			sort.Strings(comparison.Missing[root])
+		}
+		if len(uniqueValues(e.weights, func(w uint64) string { return fmt.Sprint(w) })) > 1 {
+			comparison.WeightDiffs = append(comparison.WeightDiffs, ForkChoiceWeightDiff{BlockRoot: root, Slot: e.slot, Weights: e.weights})
+		}
+	}
+	sort.Slice(comparison.WeightDiffs, func(i, j int) bool {
+		if comparison.WeightDiffs[i].Slot != comparison.WeightDiffs[j].Slot {
+			return comparison.WeightDiffs[i].Slot < comparison.WeightDiffs[j].Slot
+		}
+		return comparison.WeightDiffs[i].BlockRoot < comparison.WeightDiffs[j].BlockRoot
+	})
+
+	return comparison
+}
+
+// uniqueValues returns the distinct keys produced by key over a map's values
+func uniqueValues[T any](values map[string]T, key func(T) string) map[string]bool {
+	unique := make(map[string]bool)
+	for _, v := range values {
+		unique[key(v)] = true
+	}
+	return unique
+}
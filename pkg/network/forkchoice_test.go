@@ -0,0 +1,50 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareForkChoices(t *testing.T) {
+	finalized := client.Checkpoint{Epoch: 1, Root: "0xf"}
+	stores := map[string]*client.ForkChoice{
+		"cl-1-lighthouse-geth": {
+			FinalizedCheckpoint: finalized,
+			Nodes: []client.ForkChoiceNode{
+				{Slot: 5, BlockRoot: "0xold", ParentRoot: "0x0", Weight: 10},
+				{Slot: 8, BlockRoot: "0xa", ParentRoot: "0xold", Weight: 100},
+				{Slot: 9, BlockRoot: "0xb", ParentRoot: "0xa", Weight: 60},
+				{Slot: 9, BlockRoot: "0xc", ParentRoot: "0xa", Weight: 40},
+			},
+		},
+		"cl-2-teku-besu": {
+			FinalizedCheckpoint: finalized,
+			Nodes: []client.ForkChoiceNode{
+				{Slot: 8, BlockRoot: "0xa", ParentRoot: "0xold", Weight: 100},
+				{Slot: 9, BlockRoot: "0xb", ParentRoot: "0xa", Weight: 30},
+				{Slot: 10, BlockRoot: "0xd", ParentRoot: "0xb", Weight: 30},
+			},
+		},
+	}
+
+	comparison := CompareForkChoices(stores)
+
+	assert.False(t, comparison.HeadsAgree())
+	assert.Equal(t, "0xb", comparison.Heads["cl-1-lighthouse-geth"].BlockRoot)
+	assert.Equal(t, "0xd", comparison.Heads["cl-2-teku-besu"].BlockRoot)
+	assert.True(t, comparison.FinalityAgrees())
+
+	// 0xold predates teku's oldest block, so it is not reported as missing
+	assert.Equal(t, map[string][]string{
+		"0xc": {"cl-2-teku-besu"},
+		"0xd": {"cl-1-lighthouse-geth"},
+	}, comparison.Missing)
+
+	require.Len(t, comparison.WeightDiffs, 1)
+	assert.Equal(t, "0xb", comparison.WeightDiffs[0].BlockRoot)
+	assert.Equal(t, map[string]uint64{"cl-1-lighthouse-geth": 60, "cl-2-teku-besu": 30}, comparison.WeightDiffs[0].Weights)
+	assert.Contains(t, comparison.String(), "heads agree: false")
+}
@@ -0,0 +1,65 @@
+package network
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+)
+
+// beaconRoute answers a beacon API request; suffix is the path after the route's prefix
+type beaconRoute func(w http.ResponseWriter, suffix string)
+
+// newBeaconStub serves a beacon API with 1 second slots starting at genesis.
+// Routes are keyed by path prefix, the longest matching prefix answers, and
+// unrouted paths return 404. The server is closed when the test ends.
+func newBeaconStub(t *testing.T, genesis time.Time, routes map[string]beaconRoute) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			fmt.Fprintf(w, `{"data":{"genesis_time":"%d"}}`, genesis.Unix())
+			return
+		case "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(`{"data":{"SECONDS_PER_SLOT":"1"}}`))
+			return
+		}
+
+		var match string
+		for prefix := range routes {
+			if strings.HasPrefix(r.URL.Path, prefix) && len(prefix) > len(match) {
+				match = prefix
+			}
+		}
+		if match == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		routes[match](w, strings.TrimPrefix(r.URL.Path, match))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newBeaconTestNetwork returns a minimal preset network whose only consensus
+// client is served at beaconURL
+func newBeaconTestNetwork(beaconURL string, validatorRanges map[int]config.ValidatorRange) Network {
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", beaconURL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+
+	return New(Config{
+		Name:             "test",
+		SpecPreset:       config.SpecPresetMinimal,
+		ConsensusClients: consensusClients,
+		ExecutionClients: client.NewExecutionClients(),
+		ValidatorRanges:  validatorRanges,
+		OrphanOnExit:     true,
+	})
+}
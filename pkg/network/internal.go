@@ -0,0 +1,69 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// InternalURL returns the URL of a service port inside the enclave network,
+// for tooling deployed into the same enclave. The URL uses the service's
+// hostname, which Kurtosis resolves inside the enclave.
+func (s Service) InternalURL(port string) (string, error) {
+	for _, p := range s.Ports {
+		if p.Name != port {
+			continue
+		}
+		host := s.Hostname
+		if host == "" {
+			host = s.IPAddress
+		}
+		if host == "" {
+			return "", fmt.Errorf("service %s has no internal address", s.Name)
+		}
+		return fmt.Sprintf("%s://%s:%d", portScheme(p), host, p.InternalPort), nil
+	}
+	return "", fmt.Errorf("service %s has no port %q", s.Name, port)
+}
+
+// ExternalURL returns the URL of a service port published to the host
+func (s Service) ExternalURL(port string) (string, error) {
+	for _, p := range s.Ports {
+		if p.Name != port {
+			continue
+		}
+		if s.ExternalHost == "" || p.ExternalPort == 0 {
+			return "", fmt.Errorf("service %s does not publish port %q", s.Name, port)
+		}
+		return fmt.Sprintf("%s://%s", portScheme(p), net.JoinHostPort(s.ExternalHost, strconv.Itoa(p.ExternalPort))), nil
+	}
+	return "", fmt.Errorf("service %s has no port %q", s.Name, port)
+}
+
+// InternalURL returns the enclave-internal URL of a service's port
+func (n *network) InternalURL(service, port string) (string, error) {
+	for _, s := range n.Services() {
+		if s.Name == service {
+			return s.InternalURL(port)
+		}
+	}
+	return "", fmt.Errorf("service not found: %s", service)
+}
+
+// portScheme picks the URL scheme of a port from its name, like the public
+// URLs Kurtosis reports, falling back to the transport protocol
+func portScheme(p Port) string {
+	name := strings.ToLower(p.Name)
+	switch {
+	case strings.HasPrefix(name, "ws"):
+		return "ws"
+	case strings.Contains(name, "http") || strings.Contains(name, "rpc") || strings.Contains(name, "beacon") ||
+		strings.Contains(name, "engine") || strings.Contains(name, "metrics") || strings.Contains(name, "api"):
+		return "http"
+	case p.Protocol != "":
+		return strings.ToLower(p.Protocol)
+	default:
+		return "tcp"
+	}
+}
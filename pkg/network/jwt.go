@@ -0,0 +1,18 @@
+package network
+
+import (
+	"context"
+	"fmt"
+)
+
+// JWTSecret returns the hex-encoded secret engine API calls authenticate with.
+// ethereum-package shares one secret between every execution and consensus
+// client, so it is read through the first execution client. Sign calls with
+// client.EngineToken.
+func (n *network) JWTSecret(ctx context.Context) (string, error) {
+	clients := n.executionClients.All()
+	if len(clients) == 0 {
+		return "", fmt.Errorf("network has no execution clients")
+	}
+	return clients[0].JWTSecret(ctx)
+}
@@ -0,0 +1,35 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+)
+
+// Keymanager returns a keymanager API client for the named validator client,
+// authenticated with the token ethereum-package mounts into its container.
+// The participant needs KeymanagerEnabled.
+func (n *network) Keymanager(ctx context.Context, validator string) (*client.KeymanagerClient, error) {
+	if err := n.checkAllowed(OperationValidators, "Keymanager"); err != nil {
+		return nil, err
+	}
+	found, ok := n.validatorClients.ByName(validator)
+	if !ok {
+		return nil, fmt.Errorf("validator %s not found", validator)
+	}
+	if found.APIURL() == "" {
+		return nil, fmt.Errorf("validator %s has no keymanager API, enable it with KeymanagerEnabled", validator)
+	}
+	if n.readFileFunc == nil {
+		return nil, fmt.Errorf("network does not support reading the keymanager token")
+	}
+
+	token, err := n.readFileFunc(ctx, found.ServiceName(), config.KeymanagerTokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keymanager token of %s: %w", validator, err)
+	}
+	return client.NewKeymanagerClient(found.APIURL(), strings.TrimSpace(string(token))).WithTLSConfig(n.tlsConfig), nil
+}
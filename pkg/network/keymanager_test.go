@@ -0,0 +1,52 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeymanager(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer api-token-0x1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"validating_pubkey":"0xa99a"}]}`))
+	}))
+	defer server.Close()
+
+	net := New(Config{
+		Name: "test",
+		Validators: []Validator{
+			NewValidator("vc-1-geth-lighthouse", server.URL, "", "vc-1-geth-lighthouse", "uuid-1"),
+			NewValidator("vc-2-besu-teku", "", "", "vc-2-besu-teku", "uuid-2"),
+		},
+		ReadFileFunc: func(ctx context.Context, service, path string) ([]byte, error) {
+			if service != "vc-1-geth-lighthouse" || path != config.KeymanagerTokenPath {
+				return nil, fmt.Errorf("no such file")
+			}
+			return []byte("api-token-0x1\n"), nil
+		},
+		OrphanOnExit: true,
+	})
+
+	keymanager, err := net.Keymanager(context.Background(), "vc-1-geth-lighthouse")
+	require.NoError(t, err)
+	keystores, err := keymanager.ListKeystores(context.Background())
+	require.NoError(t, err)
+	require.Len(t, keystores, 1)
+	assert.Equal(t, "0xa99a", keystores[0].Pubkey)
+
+	_, err = net.Keymanager(context.Background(), "vc-2-besu-teku")
+	assert.ErrorContains(t, err, "validator vc-2-besu-teku has no keymanager API")
+
+	_, err = net.Keymanager(context.Background(), "vc-3")
+	assert.ErrorContains(t, err, "validator vc-3 not found")
+}
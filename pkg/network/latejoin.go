@@ -0,0 +1,57 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// LateJoiners returns the service names of late-joining nodes in start order
+func (n *network) LateJoiners() []string {
+	return n.lateJoiners
+}
+
+// StartLateJoiners starts the services of late-joining nodes. Execution clients
+// are started before consensus clients and validators so each layer finds its
+// dependency running. Calling it again after a successful start is a no-op.
+func (n *network) StartLateJoiners(ctx context.Context) error {
+	if err := n.checkAllowed(OperationServices, "StartLateJoiners"); err != nil {
+		return err
+	}
+	n.lateJoinMu.Lock()
+	defer n.lateJoinMu.Unlock()
+
+	if n.lateJoinersStarted || len(n.lateJoiners) == 0 {
+		return nil
+	}
+	if n.startServiceFunc == nil {
+		return fmt.Errorf("network does not support starting services")
+	}
+
+	var errs []error
+	for _, name := range n.lateJoiners {
+		if err := n.startServiceFunc(ctx, name); err != nil {
+			errs = append(errs, fmt.Errorf("failed to start late joiner %s: %w", name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	n.lateJoinersStarted = true
+	return nil
+}
+
+// heldLateJoiners returns the late joiners that have not been started yet
+func (n *network) heldLateJoiners() map[string]bool {
+	n.lateJoinMu.Lock()
+	defer n.lateJoinMu.Unlock()
+
+	held := make(map[string]bool)
+	if !n.lateJoinersStarted {
+		for _, name := range n.lateJoiners {
+			held[name] = true
+		}
+	}
+	return held
+}
@@ -0,0 +1,146 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// ErrDegraded is returned when a client's logs show trouble its health endpoint does not
+var ErrDegraded = errors.New("service degraded")
+
+const (
+	// logHealthLines is how many recent log lines each client is analyzed over
+	logHealthLines = 1000
+	// maxLogEvidence is how many matching lines a finding keeps as evidence
+	maxLogEvidence = 3
+	// maxEvidenceLength truncates evidence lines
+	maxEvidenceLength = 240
+)
+
+// LogPattern flags a client as degraded when at least Threshold of its recent
+// log lines match Pattern
+type LogPattern struct {
+	Name    string
+	Pattern *regexp.Regexp
+	// Threshold is the number of matching lines that flags the client; values
+	// below 1 flag it on the first match
+	Threshold int
+}
+
+// DefaultLogPatterns catch failures clients tend to log while still answering
+// health checks: peers being rejected en masse, a corrupted database and panics
+var DefaultLogPatterns = []LogPattern{
+	{Name: "rejected peers", Pattern: regexp.MustCompile(`(?i)reject(ed|ing)? (inbound |outbound )?peer|peer (was )?rejected`), Threshold: 10},
+	{Name: "database corruption", Pattern: regexp.MustCompile(`(?i)(database|db)\b.*corrupt|corrupt(ed|ion)?\b.*(database|db)\b`), Threshold: 1},
+	{Name: "panic", Pattern: regexp.MustCompile(`(^|\s)panic:|panicked at|goroutine \d+ \[running\]`), Threshold: 1},
+}
+
+// LogFinding is a log pattern that flagged a client as degraded
+type LogFinding struct {
+	Service string
+	Pattern string
+	Count   int
+	// Evidence holds the first matching lines
+	Evidence []string
+}
+
+// String describes the finding with its first piece of evidence
+func (f LogFinding) String() string {
+	if len(f.Evidence) == 0 {
+		return fmt.Sprintf("%s: %d log lines match %s", f.Service, f.Count, f.Pattern)
+	}
+	return fmt.Sprintf("%s: %d log lines match %s, e.g. %q", f.Service, f.Count, f.Pattern, f.Evidence[0])
+}
+
+// AnalyzeLogs matches the recent logs of every execution, consensus and
+// validator client against the network's log health patterns, or
+// DefaultLogPatterns when it has none, and returns the patterns that flag a
+// client as degraded. Late joiners that were never started and services stopped
+// through Chaos are skipped. Clients
+// whose logs cannot be read are reported in the error alongside the findings
+// of the others.
+func (n *network) AnalyzeLogs(ctx context.Context) ([]LogFinding, error) {
+	if n.logsFunc == nil {
+		return nil, fmt.Errorf("network does not support log inspection")
+	}
+	patterns := n.logHealthPatterns
+	if len(patterns) == 0 {
+		patterns = DefaultLogPatterns
+	}
+
+	stopped := n.stoppedServices()
+	var clients []string
+	for _, service := range n.Services() {
+		switch service.Type {
+		case ServiceTypeExecutionClient, ServiceTypeConsensusClient, ServiceTypeValidator:
+			if !stopped[service.Name] {
+				clients = append(clients, service.Name)
+			}
+		}
+	}
+
+	findings := make([][]LogFinding, len(clients))
+	errs := client.FanOut(ctx, n.limiter, clients, func(ctx context.Context, i int, service string) error {
+		lines, err := n.logsFunc(ctx, service, logHealthLines)
+		if err != nil {
+			return fmt.Errorf("failed to read logs of %s: %w", service, err)
+		}
+		findings[i] = matchLogPatterns(service, lines, patterns)
+		return nil
+	})
+
+	var all []LogFinding
+	for _, serviceFindings := range findings {
+		all = append(all, serviceFindings...)
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].Service < all[j].Service })
+
+	return all, errors.Join(errs...)
+}
+
+// matchLogPatterns returns the patterns matched by at least their threshold of lines
+func matchLogPatterns(service string, lines []string, patterns []LogPattern) []LogFinding {
+	var findings []LogFinding
+	for _, pattern := range patterns {
+		if pattern.Pattern == nil {
+			continue
+		}
+		finding := LogFinding{Service: service, Pattern: pattern.Name}
+		for _, line := range lines {
+			if !pattern.Pattern.MatchString(line) {
+				continue
+			}
+			finding.Count++
+			if len(finding.Evidence) < maxLogEvidence {
+				if len(line) > maxEvidenceLength {
+					line = line[:maxEvidenceLength] + "..."
+				}
+				finding.Evidence = append(finding.Evidence, line)
+			}
+		}
+		if finding.Count > 0 && finding.Count >= pattern.Threshold {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// logHealthErrors returns a health error for every client flagged by the
+// network's log health patterns
+func (n *network) logHealthErrors(ctx context.Context) map[string]error {
+	if n.logHealthPatterns == nil || n.logsFunc == nil {
+		return nil
+	}
+
+	findings, _ := n.AnalyzeLogs(ctx)
+	errs := make(map[string]error)
+	for _, finding := range findings {
+		errs[finding.Service] = errors.Join(errs[finding.Service], fmt.Errorf("%w: %s", ErrDegraded, finding))
+	}
+	return errs
+}
@@ -0,0 +1,97 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func logHealthNetwork(logs map[string][]string, patterns []LogPattern) Network {
+	return New(Config{
+		Name: "test",
+		Services: []Service{
+			{Name: "el-1-geth-lighthouse", Type: ServiceTypeExecutionClient},
+			{Name: "cl-1-lighthouse-geth", Type: ServiceTypeConsensusClient},
+			{Name: "vc-1-geth-lighthouse", Type: ServiceTypeValidator},
+			{Name: "el-2-besu-teku", Type: ServiceTypeExecutionClient},
+			{Name: "dora", Type: ServiceTypeDora},
+		},
+		LateJoiners: []string{"el-2-besu-teku"},
+		LogsFunc: func(ctx context.Context, service string, lines int) ([]string, error) {
+			serviceLogs, ok := logs[service]
+			if !ok {
+				return nil, errors.New("no such service")
+			}
+			return serviceLogs, nil
+		},
+		LogHealthPatterns: patterns,
+		OrphanOnExit:      true,
+	})
+}
+
+func TestAnalyzeLogs(t *testing.T) {
+	rejected := make([]string, 12)
+	for i := range rejected {
+		rejected[i] = "WARN Rejected peer peer_id=16Uiu2 reason=too many peers"
+	}
+	logs := map[string][]string{
+		"el-1-geth-lighthouse": {"INFO Imported new chain segment", "ERROR Database corrupted, head block missing"},
+		"cl-1-lighthouse-geth": rejected,
+		"vc-1-geth-lighthouse": {"panic: runtime error: index out of range", "goroutine 1 [running]:", strings.Repeat("x", 300)},
+		"el-2-besu-teku":       {"panic: never started"},
+	}
+
+	findings, err := logHealthNetwork(logs, nil).AnalyzeLogs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, findings, 3)
+	assert.Equal(t, "cl-1-lighthouse-geth", findings[0].Service)
+	assert.Equal(t, "rejected peers", findings[0].Pattern)
+	assert.Equal(t, 12, findings[0].Count)
+	assert.Len(t, findings[0].Evidence, 3)
+	assert.Equal(t, "el-1-geth-lighthouse", findings[1].Service)
+	assert.Equal(t, "database corruption", findings[1].Pattern)
+	assert.Equal(t, []string{"ERROR Database corrupted, head block missing"}, findings[1].Evidence)
+	assert.Equal(t, "vc-1-geth-lighthouse", findings[2].Service)
+	assert.Equal(t, 2, findings[2].Count)
+	assert.Contains(t, findings[2].String(), `"panic: runtime error: index out of range"`)
+
+	// Below the threshold a pattern does not flag the client
+	logs["cl-1-lighthouse-geth"] = rejected[:3]
+	findings, err = logHealthNetwork(logs, nil).AnalyzeLogs(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, findings, 2)
+
+	// Unreadable logs are reported alongside the other findings
+	delete(logs, "el-1-geth-lighthouse")
+	findings, err = logHealthNetwork(logs, nil).AnalyzeLogs(context.Background())
+	assert.ErrorContains(t, err, "failed to read logs of el-1-geth-lighthouse")
+	assert.Len(t, findings, 1)
+
+	_, err = New(Config{Name: "test", OrphanOnExit: true}).AnalyzeLogs(context.Background())
+	assert.Error(t, err)
+}
+
+func TestHealthLogPatterns(t *testing.T) {
+	logs := map[string][]string{
+		"el-1-geth-lighthouse": {"ERROR disk full"},
+		"cl-1-lighthouse-geth": {},
+		"vc-1-geth-lighthouse": {"panic: nil pointer dereference"},
+	}
+
+	// Log analysis is opt-in
+	for name, err := range logHealthNetwork(logs, nil).Health(context.Background()) {
+		assert.NoError(t, err, name)
+	}
+
+	patterns := []LogPattern{{Name: "disk full", Pattern: regexp.MustCompile(`disk full`)}}
+	health := logHealthNetwork(logs, patterns).Health(context.Background())
+	require.ErrorIs(t, health["el-1-geth-lighthouse"], ErrDegraded)
+	assert.Contains(t, health["el-1-geth-lighthouse"].Error(), `"ERROR disk full"`)
+	assert.NoError(t, health["vc-1-geth-lighthouse"])
+	assert.NoError(t, health["cl-1-lighthouse-geth"])
+}
@@ -0,0 +1,119 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// DefaultTxPollInterval is how often CheckTxPropagation polls the execution clients
+const DefaultTxPollInterval = 250 * time.Millisecond
+
+// TxPropagation records how a transaction spread from one execution client to the others
+type TxPropagation struct {
+	Hash   string
+	SentTo string
+	SentAt time.Time
+	// SeenAfter is how long after sending each other client knew the transaction
+	SeenAfter map[string]time.Duration
+	// Missing lists the clients that never saw the transaction
+	Missing []string
+}
+
+// Propagated reports whether every execution client saw the transaction
+func (p *TxPropagation) Propagated() bool {
+	return len(p.Missing) == 0
+}
+
+// String lists when each client saw the transaction
+func (p *TxPropagation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "tx %s sent to %s\n", p.Hash, p.SentTo)
+
+	names := make([]string, 0, len(p.SeenAfter))
+	for name := range p.SeenAfter {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s: seen after %s\n", name, p.SeenAfter[name])
+	}
+	for _, name := range p.Missing {
+		fmt.Fprintf(&b, "%s: never seen\n", name)
+	}
+	return b.String()
+}
+
+// CheckTxPropagation sends a signed transaction to one execution client and polls
+// the others until each knows it or the timeout passes. Clients that never see
+// the transaction are listed as missing; an error is only returned when the
+// transaction cannot be sent.
+func (n *network) CheckTxPropagation(ctx context.Context, from client.ExecutionClient, rawTx string, timeout time.Duration) (*TxPropagation, error) {
+	if err := n.checkAllowed(OperationTransactions, "CheckTxPropagation"); err != nil {
+		return nil, err
+	}
+	return n.checkTxPropagation(ctx, from, rawTx, timeout, DefaultTxPollInterval)
+}
+
+func (n *network) checkTxPropagation(ctx context.Context, from client.ExecutionClient, rawTx string, timeout, interval time.Duration) (*TxPropagation, error) {
+	sentAt := time.Now()
+	hash, err := client.RPC(from).SendRawTransaction(ctx, rawTx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", from.Name(), err)
+	}
+
+	result := &TxPropagation{
+		Hash:      hash,
+		SentTo:    from.Name(),
+		SentAt:    sentAt,
+		SeenAfter: make(map[string]time.Duration),
+	}
+
+	var peers []client.ExecutionClient
+	if n.executionClients != nil {
+		for _, el := range n.executionClients.Except(n.lateJoiners...) {
+			if el.Name() != from.Name() {
+				peers = append(peers, el)
+			}
+		}
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, el := range peers {
+			if _, seen := result.SeenAfter[el.Name()]; seen {
+				continue
+			}
+			// Poll errors are treated as not seen yet
+			if known, err := client.RPC(el).HasTransaction(pollCtx, hash); err == nil && known {
+				result.SeenAfter[el.Name()] = time.Since(sentAt)
+			}
+		}
+		if len(result.SeenAfter) == len(peers) {
+			return result, nil
+		}
+
+		select {
+		case <-pollCtx.Done():
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			for _, el := range peers {
+				if _, seen := result.SeenAfter[el.Name()]; !seen {
+					result.Missing = append(result.Missing, el.Name())
+				}
+			}
+			sort.Strings(result.Missing)
+			return result, nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTxPoolServer serves an execution client that learns every transaction after
+// the given number of lookups; a negative number never learns it
+func newTxPoolServer(t *testing.T, lookupsUntilSeen int64) *httptest.Server {
+	t.Helper()
+
+	var lookups atomic.Int64
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var result interface{}
+		switch req.Method {
+		case "eth_sendRawTransaction":
+			result = "0xtx"
+		case "eth_getTransactionByHash":
+			if n := lookups.Add(1); lookupsUntilSeen >= 0 && n > lookupsUntilSeen {
+				result = map[string]interface{}{"hash": "0xtx", "blockNumber": nil}
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+}
+
+func TestCheckTxPropagation(t *testing.T) {
+	sender := newTxPoolServer(t, 0)
+	defer sender.Close()
+	peer := newTxPoolServer(t, 1)
+	defer peer.Close()
+	isolated := newTxPoolServer(t, -1)
+	defer isolated.Close()
+
+	executionClients := client.NewExecutionClients()
+	for name, server := range map[string]*httptest.Server{"el-1-geth": sender, "el-2-besu": peer, "el-3-nethermind": isolated} {
+		executionClients.Add(client.NewExecutionClient(client.Geth, name, "", server.URL, "", "", "", "", name, "", 0))
+	}
+	net := New(Config{
+		Name:             "test",
+		SpecPreset:       config.SpecPresetMinimal,
+		ExecutionClients: executionClients,
+		ConsensusClients: client.NewConsensusClients(),
+		OrphanOnExit:     true,
+	}).(*network)
+
+	from, ok := executionClients.ByName("el-1-geth")
+	require.True(t, ok)
+	result, err := net.checkTxPropagation(context.Background(), from, "0xraw", 200*time.Millisecond, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	assert.Equal(t, "0xtx", result.Hash)
+	assert.Equal(t, "el-1-geth", result.SentTo)
+	assert.Contains(t, result.SeenAfter, "el-2-besu")
+	assert.NotContains(t, result.SeenAfter, "el-1-geth")
+	assert.Equal(t, []string{"el-3-nethermind"}, result.Missing)
+	assert.False(t, result.Propagated())
+	assert.Contains(t, result.String(), "el-3-nethermind: never seen")
+}
@@ -0,0 +1,194 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// DefaultMilestonePollInterval is how often TrackMilestones checks the chain
+const DefaultMilestonePollInterval = 2 * time.Second
+
+// Milestone is a point in a network's startup
+type Milestone string
+
+const (
+	// MilestoneRunStarted is when Run was called
+	MilestoneRunStarted Milestone = "run_started"
+	// MilestoneEnclaveCreated is when the enclave was created and ethereum-package ran in it
+	MilestoneEnclaveCreated Milestone = "enclave_created"
+	// MilestoneServicesReady is when every service passed its readiness probe
+	MilestoneServicesReady Milestone = "services_ready"
+	// MilestoneGenesis is the chain's genesis time
+	MilestoneGenesis Milestone = "genesis"
+	// MilestoneFirstBlock is the timestamp of execution block 1
+	MilestoneFirstBlock Milestone = "first_block"
+	// MilestoneFirstJustified is when a checkpoint past genesis was first seen justified
+	MilestoneFirstJustified Milestone = "first_justified"
+	// MilestoneFirstFinalized is when a checkpoint past genesis was first seen finalized
+	MilestoneFirstFinalized Milestone = "first_finalized"
+)
+
+// milestoneOrder lists the milestones in the order they are reached
+var milestoneOrder = []Milestone{
+	MilestoneRunStarted,
+	MilestoneEnclaveCreated,
+	MilestoneServicesReady,
+	MilestoneGenesis,
+	MilestoneFirstBlock,
+	MilestoneFirstJustified,
+	MilestoneFirstFinalized,
+}
+
+// Milestones records when a network reached each startup milestone
+type Milestones struct {
+	mu    sync.RWMutex
+	times map[Milestone]time.Time
+}
+
+// NewMilestones creates an empty milestone record
+func NewMilestones() *Milestones {
+	return &Milestones{times: make(map[Milestone]time.Time)}
+}
+
+// Record sets the time of a milestone. Only the first record of a milestone is kept.
+func (m *Milestones) Record(milestone Milestone, at time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.times[milestone]; !ok {
+		m.times[milestone] = at
+	}
+}
+
+// Time returns when the milestone was reached
+func (m *Milestones) Time(milestone Milestone) (time.Time, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	at, ok := m.times[milestone]
+	return at, ok
+}
+
+// Between returns the time from one milestone to another, if both were reached
+func (m *Milestones) Between(from, to Milestone) (time.Duration, bool) {
+	start, ok := m.Time(from)
+	if !ok {
+		return 0, false
+	}
+	end, ok := m.Time(to)
+	if !ok {
+		return 0, false
+	}
+	return end.Sub(start), true
+}
+
+// String lists the reached milestones with their offset from the first one
+func (m *Milestones) String() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var b strings.Builder
+	var first time.Time
+	for _, milestone := range milestoneOrder {
+		at, ok := m.times[milestone]
+		if !ok {
+			continue
+		}
+		if first.IsZero() {
+			first = at
+		}
+		fmt.Fprintf(&b, "%s: %s (+%s)\n", milestone, at.Format(time.RFC3339), at.Sub(first).Round(time.Second))
+	}
+	return b.String()
+}
+
+// Milestones returns the startup milestones the network reached so far
+func (n *network) Milestones() *Milestones {
+	return n.milestones
+}
+
+// TrackMilestones polls the chain until the first finalized checkpoint is seen,
+// recording genesis, first block, first justified and first finalized along
+// the way. A non-positive interval uses DefaultMilestonePollInterval.
+func (n *network) TrackMilestones(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultMilestonePollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n.pollMilestones(ctx)
+		if _, ok := n.milestones.Time(MilestoneFirstFinalized); ok {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RequireFinalityWithin tracks milestones and fails unless the chain finalizes
+// within d of genesis
+func (n *network) RequireFinalityWithin(ctx context.Context, d time.Duration) error {
+	genesis, err := n.Genesis(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get genesis: %w", err)
+	}
+	n.milestones.Record(MilestoneGenesis, genesis.Time)
+
+	deadline := genesis.Time.Add(d)
+	trackCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	if err := n.TrackMilestones(trackCtx, 0); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("chain did not finalize within %s of genesis", d)
+	}
+
+	finalized, _ := n.milestones.Time(MilestoneFirstFinalized)
+	if finalized.After(deadline) {
+		return fmt.Errorf("chain finalized %s after genesis, expected within %s",
+			finalized.Sub(genesis.Time).Round(time.Second), d)
+	}
+	return nil
+}
+
+// pollMilestones records every milestone that is observable now. Errors are
+// ignored; the milestone is retried on the next poll.
+func (n *network) pollMilestones(ctx context.Context) {
+	if genesis, err := n.Genesis(ctx); err == nil {
+		n.milestones.Record(MilestoneGenesis, genesis.Time)
+	}
+
+	if _, ok := n.milestones.Time(MilestoneFirstBlock); !ok && n.executionClients != nil {
+		for _, el := range n.executionClients.Except(n.lateJoiners...) {
+			if header, err := el.BlockHeader(ctx, 1); err == nil && header.Timestamp > 0 {
+				n.milestones.Record(MilestoneFirstBlock, time.Unix(int64(header.Timestamp), 0))
+				break
+			}
+		}
+	}
+
+	checkpoints, err := queryBeacon(ctx, n, func(ctx context.Context, beacon client.ConsensusClient) (*client.FinalityCheckpoints, error) {
+		return beacon.FinalityCheckpoints(ctx)
+	})
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	if checkpoints.CurrentJustified.Epoch > 0 {
+		n.milestones.Record(MilestoneFirstJustified, now)
+	}
+	if checkpoints.Finalized.Epoch > 0 {
+		n.milestones.Record(MilestoneFirstFinalized, now)
+	}
+}
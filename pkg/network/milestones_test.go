@@ -0,0 +1,102 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMilestoneNetwork serves a chain that justifies on the second finality poll
+// and finalizes from the finalizeAt-th poll on
+func newMilestoneNetwork(t *testing.T, genesis time.Time, finalizeAt int32) Network {
+	t.Helper()
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			fmt.Fprintf(w, `{"data":{"genesis_time":"%d","genesis_validators_root":"0x01","genesis_fork_version":"0x10000038"}}`, genesis.Unix())
+		case "/eth/v1/beacon/states/head/finality_checkpoints":
+			poll := polls.Add(1)
+			justified, finalized := 0, 0
+			if poll >= 2 {
+				justified = 1
+			}
+			if poll >= finalizeAt {
+				justified, finalized = 2, 1
+			}
+			fmt.Fprintf(w, `{"data":{"previous_justified":{"epoch":"0","root":"0x00"},"current_justified":{"epoch":"%d","root":"0x00"},"finalized":{"epoch":"%d","root":"0x00"}}}`, justified, finalized)
+		case "/":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x1","hash":"0x01","timestamp":"0x%x"}}`, genesis.Add(12*time.Second).Unix())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "", "el-1-geth-lighthouse", "", 0))
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+	return New(Config{Name: "test", ExecutionClients: executionClients, ConsensusClients: consensusClients, OrphanOnExit: true})
+}
+
+func TestTrackMilestones(t *testing.T) {
+	genesis := time.Now().Add(-time.Minute).Truncate(time.Second)
+	net := newMilestoneNetwork(t, genesis, 3)
+
+	require.NoError(t, net.TrackMilestones(context.Background(), 10*time.Millisecond))
+
+	milestones := net.Milestones()
+	at, ok := milestones.Time(MilestoneGenesis)
+	require.True(t, ok)
+	assert.Equal(t, genesis, at)
+
+	firstBlock, ok := milestones.Between(MilestoneGenesis, MilestoneFirstBlock)
+	require.True(t, ok)
+	assert.Equal(t, 12*time.Second, firstBlock)
+
+	justified, ok := milestones.Time(MilestoneFirstJustified)
+	require.True(t, ok)
+	finalized, ok := milestones.Time(MilestoneFirstFinalized)
+	require.True(t, ok)
+	assert.True(t, finalized.After(justified))
+
+	_, ok = milestones.Time(MilestoneServicesReady)
+	assert.False(t, ok, "deploy milestones are recorded by Run")
+	assert.Contains(t, milestones.String(), "first_finalized")
+}
+
+func TestRequireFinalityWithin(t *testing.T) {
+	genesis := time.Now().Truncate(time.Second)
+
+	net := newMilestoneNetwork(t, genesis, 1)
+	assert.NoError(t, net.RequireFinalityWithin(context.Background(), time.Minute))
+
+	// The deadline is measured from genesis, which is long past here
+	net = newMilestoneNetwork(t, time.Now().Add(-time.Hour), 1000)
+	err := net.RequireFinalityWithin(context.Background(), time.Minute)
+	assert.ErrorContains(t, err, "did not finalize within 1m0s of genesis")
+}
+
+func TestMilestonesRecordKeepsFirst(t *testing.T) {
+	milestones := NewMilestones()
+	first := time.Unix(100, 0)
+	milestones.Record(MilestoneServicesReady, first)
+	milestones.Record(MilestoneServicesReady, time.Unix(200, 0))
+
+	at, ok := milestones.Time(MilestoneServicesReady)
+	require.True(t, ok)
+	assert.Equal(t, first, at)
+
+	_, ok = milestones.Between(MilestoneRunStarted, MilestoneServicesReady)
+	assert.False(t, ok)
+}
@@ -0,0 +1,198 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+var (
+	// ErrMonitoring is returned when Prometheus does not scrape every client
+	ErrMonitoring = errors.New("monitoring incomplete")
+	// ErrNoPrometheus is returned when the network runs no Prometheus
+	ErrNoPrometheus = errors.New("prometheus is not deployed")
+)
+
+// metricsExporterPrefix is the service name prefix of ethereum-metrics-exporter instances
+const metricsExporterPrefix = "ethereum-metrics-exporter"
+
+// MonitoringError lists the services whose metrics are not collected
+type MonitoringError struct {
+	Missing      []string // services Prometheus has no target for
+	Down         []string // services whose target is not up, with the scrape error
+	NotExporting []string // metrics exporters that serve no Ethereum metrics
+}
+
+func (e *MonitoringError) Error() string {
+	var parts []string
+	if len(e.Missing) > 0 {
+		parts = append(parts, "missing targets: "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Down) > 0 {
+		parts = append(parts, "targets down: "+strings.Join(e.Down, ", "))
+	}
+	if len(e.NotExporting) > 0 {
+		parts = append(parts, "exporters not exporting: "+strings.Join(e.NotExporting, ", "))
+	}
+	return fmt.Sprintf("%s: %s", ErrMonitoring, strings.Join(parts, "; "))
+}
+
+func (e *MonitoringError) Unwrap() error { return ErrMonitoring }
+
+// VerifyMonitoring checks that Prometheus has an up target for every client and
+// validator exposing metrics and for every ethereum-metrics-exporter, and that
+// the exporters serve Ethereum metrics. Late joiners that were never started
+// and services stopped through Chaos are skipped. A failure is a *MonitoringError listing the affected services.
+func (n *network) VerifyMonitoring(ctx context.Context) error {
+	services := n.Services()
+
+	var prometheus *Service
+	for i := range services {
+		if services[i].Type == ServiceTypePrometheus {
+			prometheus = &services[i]
+			break
+		}
+	}
+	if prometheus == nil || prometheus.URL == "" {
+		return ErrNoPrometheus
+	}
+
+	httpClient := client.NewHTTPClient(10*time.Second, n.tlsConfig)
+	targets, err := prometheusTargets(ctx, httpClient, prometheus.URL)
+	if err != nil {
+		return fmt.Errorf("failed to get prometheus targets: %w", err)
+	}
+
+	stopped := n.stoppedServices()
+	result := &MonitoringError{}
+	for _, service := range services {
+		if stopped[service.Name] || !expectsScrape(service) {
+			continue
+		}
+
+		target, ok := targetFor(service, targets)
+		switch {
+		case !ok:
+			result.Missing = append(result.Missing, service.Name)
+		case target.Health != "up":
+			result.Down = append(result.Down, fmt.Sprintf("%s (%s: %s)", service.Name, target.Health, target.LastError))
+		}
+
+		if isMetricsExporter(service) {
+			if err := checkExporting(ctx, httpClient, service); err != nil {
+				result.NotExporting = append(result.NotExporting, fmt.Sprintf("%s (%v)", service.Name, err))
+			}
+		}
+	}
+
+	if len(result.Missing) == 0 && len(result.Down) == 0 && len(result.NotExporting) == 0 {
+		return nil
+	}
+	sort.Strings(result.Missing)
+	sort.Strings(result.Down)
+	sort.Strings(result.NotExporting)
+	return result
+}
+
+// expectsScrape reports whether Prometheus should scrape the service
+func expectsScrape(service Service) bool {
+	if isMetricsExporter(service) {
+		return true
+	}
+	switch service.Type {
+	case ServiceTypeExecutionClient, ServiceTypeConsensusClient, ServiceTypeValidator:
+		_, ok := metricsPort(service)
+		return ok
+	}
+	return false
+}
+
+// isMetricsExporter reports whether the service is an ethereum-metrics-exporter
+func isMetricsExporter(service Service) bool {
+	return service.Type == ServiceTypeMetricsExporter
+}
+
+// metricsPort returns the service's metrics port
+func metricsPort(service Service) (Port, bool) {
+	for _, p := range service.Ports {
+		if strings.Contains(strings.ToLower(p.Name), "metrics") {
+			return p, true
+		}
+	}
+	return Port{}, false
+}
+
+// targetFor finds the Prometheus target scraping the service, by a label naming
+// the service or by the scrape address. An up target wins over others.
+func targetFor(service Service, targets []PrometheusTarget) (PrometheusTarget, bool) {
+	var found PrometheusTarget
+	ok := false
+	for _, target := range targets {
+		if !scrapes(target, service) {
+			continue
+		}
+		if !ok || target.Health == "up" {
+			found, ok = target, true
+		}
+	}
+	return found, ok
+}
+
+// scrapes reports whether the target belongs to the service
+func scrapes(target PrometheusTarget, service Service) bool {
+	for _, label := range []string{"job", "service", "instance"} {
+		if target.Labels[label] == service.Name {
+			return true
+		}
+	}
+
+	u, err := url.Parse(target.ScrapeURL)
+	if err != nil {
+		return false
+	}
+	host := u.Hostname()
+	return host != "" && (host == service.Hostname || host == service.IPAddress)
+}
+
+// checkExporting checks that an exporter's metrics endpoint serves Ethereum metrics
+func checkExporting(ctx context.Context, httpClient *http.Client, service Service) error {
+	base := service.URL
+	if p, ok := metricsPort(service); ok {
+		if u, err := service.ExternalURL(p.Name); err == nil {
+			base = u
+		}
+	}
+	if base == "" {
+		return fmt.Errorf("no metrics endpoint")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+"/metrics", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("metrics returned status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read metrics: %w", err)
+	}
+	if !strings.Contains(string(body), "\neth_") && !strings.HasPrefix(string(body), "eth_") {
+		return fmt.Errorf("no eth_ metrics")
+	}
+	return nil
+}
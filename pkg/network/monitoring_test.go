@@ -0,0 +1,81 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPrometheusServer serves the given targets JSON and exporter metrics
+func newPrometheusServer(t *testing.T, targets, metrics string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/targets":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"activeTargets":` + targets + `}}`))
+		case "/metrics":
+			_, _ = w.Write([]byte(metrics))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func monitoredNetwork(url string) Network {
+	metrics := []Port{{Name: "metrics", InternalPort: 9001}}
+	return New(Config{
+		Name: "test",
+		Services: []Service{
+			{Name: "prometheus", Type: ServiceTypePrometheus, URL: url},
+			{Name: "el-1-geth-lighthouse", Type: ServiceTypeExecutionClient, Ports: metrics, IPAddress: "172.16.0.5"},
+			{Name: "cl-1-lighthouse-geth", Type: ServiceTypeConsensusClient, Ports: metrics},
+			{Name: "vc-1-geth-lighthouse", Type: ServiceTypeValidator},
+			{Name: "el-2-besu-teku", Type: ServiceTypeExecutionClient, Ports: metrics},
+			{Name: "ethereum-metrics-exporter-1-lighthouse-geth", Type: ServiceTypeMetricsExporter, URL: url},
+		},
+		LateJoiners:  []string{"el-2-besu-teku"},
+		OrphanOnExit: true,
+	})
+}
+
+func TestVerifyMonitoring(t *testing.T) {
+	server := newPrometheusServer(t, `[
+		{"labels":{"job":"scrape"},"scrapeUrl":"http://172.16.0.5:9001/metrics","health":"up"},
+		{"labels":{"job":"cl-1-lighthouse-geth"},"scrapeUrl":"http://172.16.0.6:5054/metrics","health":"up"},
+		{"labels":{"service":"ethereum-metrics-exporter-1-lighthouse-geth"},"scrapeUrl":"http://172.16.0.7:9090/metrics","health":"up"}
+	]`, "# HELP eth_exe_sync_is_syncing\neth_exe_sync_is_syncing 0\n")
+
+	// The validator exposes no metrics and the late joiner was never started
+	require.NoError(t, monitoredNetwork(server.URL).VerifyMonitoring(context.Background()))
+}
+
+func TestVerifyMonitoringFailures(t *testing.T) {
+	server := newPrometheusServer(t, `[
+		{"labels":{"job":"el-1-geth-lighthouse"},"scrapeUrl":"http://172.16.0.5:9001/metrics","health":"down","lastError":"connection refused"},
+		{"labels":{"job":"ethereum-metrics-exporter-1-lighthouse-geth"},"scrapeUrl":"http://172.16.0.7:9090/metrics","health":"up"}
+	]`, "go_goroutines 12\n")
+
+	err := monitoredNetwork(server.URL).VerifyMonitoring(context.Background())
+	require.ErrorIs(t, err, ErrMonitoring)
+
+	var monitoringErr *MonitoringError
+	require.True(t, errors.As(err, &monitoringErr))
+	assert.Equal(t, []string{"cl-1-lighthouse-geth"}, monitoringErr.Missing)
+	assert.Equal(t, []string{"el-1-geth-lighthouse (down: connection refused)"}, monitoringErr.Down)
+	require.Len(t, monitoringErr.NotExporting, 1)
+	assert.Contains(t, monitoringErr.NotExporting[0], "no eth_ metrics")
+	assert.Contains(t, err.Error(), "missing targets: cl-1-lighthouse-geth")
+}
+
+func TestVerifyMonitoringWithoutPrometheus(t *testing.T) {
+	net := New(Config{Name: "test", OrphanOnExit: true})
+	assert.ErrorIs(t, net.VerifyMonitoring(context.Background()), ErrNoPrometheus)
+}
@@ -0,0 +1,97 @@
+package network
+
+import (
+	"sort"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+)
+
+// Participant is a discovered node: the clients sharing a 1-based node index
+type Participant struct {
+	Node      int
+	Execution client.ExecutionClient
+	Consensus client.ConsensusClient
+	// Validator is nil when the node has no standalone validator client
+	Validator client.ValidatorClient
+	// Validators are the genesis validator indices the node runs
+	Validators config.ValidatorRange
+	Tags       []string
+	// Sidecars are the services deployed next to the node, such as snoopers
+	Sidecars []Service
+}
+
+// Participants returns every discovered node ordered by node index
+func (n *network) Participants() []Participant {
+	nodes := make(map[int]*Participant)
+	participant := func(node int) *Participant {
+		if nodes[node] == nil {
+			nodes[node] = &Participant{Node: node, Validators: n.validatorRanges[node]}
+		}
+		return nodes[node]
+	}
+
+	if n.executionClients != nil {
+		for _, c := range n.executionClients.All() {
+			if node := client.NodeIndex(c.Name()); node > 0 {
+				p := participant(node)
+				p.Execution = c
+				p.Tags = n.tags[c.Name()]
+			}
+		}
+	}
+	if n.consensusClients != nil {
+		for _, c := range n.consensusClients.All() {
+			if node := client.NodeIndex(c.Name()); node > 0 {
+				p := participant(node)
+				p.Consensus = c
+				if p.Tags == nil {
+					p.Tags = n.tags[c.Name()]
+				}
+			}
+		}
+	}
+	for _, v := range n.validatorClients.All() {
+		if node := client.NodeIndex(v.Name()); node > 0 {
+			participant(node).Validator = v
+		}
+	}
+
+	for _, s := range n.Services() {
+		if s.Type != ServiceTypeSidecar {
+			continue
+		}
+		if _, node, ok := SidecarName(s.Name); ok && nodes[node] != nil {
+			nodes[node].Sidecars = append(nodes[node].Sidecars, s)
+		}
+	}
+
+	result := make([]Participant, 0, len(nodes))
+	for _, p := range nodes {
+		sort.Slice(p.Sidecars, func(i, j int) bool { return p.Sidecars[i].Name < p.Sidecars[j].Name })
+		result = append(result, *p)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Node < result[j].Node
+	})
+	return result
+}
+
+// Participant returns the node with the given 1-based index
+func (n *network) Participant(node int) (Participant, bool) {
+	for _, p := range n.Participants() {
+		if p.Node == node {
+			return p, true
+		}
+	}
+	return Participant{}, false
+}
+
+// ValidatorNode returns the node running the genesis validator with the given index
+func (n *network) ValidatorNode(index uint64) (Participant, bool) {
+	node := n.nodeForValidator(index)
+	if node == 0 {
+		return Participant{}, false
+	}
+	return n.Participant(node)
+}
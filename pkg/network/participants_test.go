@@ -0,0 +1,73 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newParticipantTestNetwork() Network {
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Besu, "el-2-besu-teku", "", "", "", "", "", "", "el-2-besu-teku", "", 0))
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", "", "", "", "", "", "el-1-geth-lighthouse", "", 0))
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", "", "", "", "", "cl-1-lighthouse-geth", "", 0))
+	consensusClients.Add(client.NewConsensusClient(client.Teku, "cl-2-teku-besu", "", "", "", "", "", "cl-2-teku-besu", "", 0))
+
+	return New(Config{
+		Name:             "test",
+		ExecutionClients: executionClients,
+		ConsensusClients: consensusClients,
+		Validators: []Validator{
+			NewValidator("vc-1-geth-lighthouse", "", "", "vc-1-geth-lighthouse", ""),
+		},
+		Tags: map[string][]string{
+			"el-2-besu-teku": {TagBootnode},
+			"cl-2-teku-besu": {TagBootnode},
+		},
+		ValidatorRanges: map[int]config.ValidatorRange{
+			1: {Start: 0, End: 32},
+			2: {Start: 32, End: 64},
+		},
+		OrphanOnExit: true,
+	})
+}
+
+func TestParticipants(t *testing.T) {
+	net := newParticipantTestNetwork()
+
+	participants := net.Participants()
+	require.Len(t, participants, 2)
+
+	first := participants[0]
+	assert.Equal(t, 1, first.Node)
+	assert.Equal(t, "el-1-geth-lighthouse", first.Execution.Name())
+	assert.Equal(t, "cl-1-lighthouse-geth", first.Consensus.Name())
+	require.NotNil(t, first.Validator)
+	assert.Equal(t, "vc-1-geth-lighthouse", first.Validator.Name())
+	assert.Equal(t, config.ValidatorRange{Start: 0, End: 32}, first.Validators)
+	assert.Empty(t, first.Tags)
+
+	second := participants[1]
+	assert.Equal(t, 2, second.Node)
+	assert.Nil(t, second.Validator)
+	assert.Equal(t, []string{TagBootnode}, second.Tags)
+
+	_, ok := net.Participant(3)
+	assert.False(t, ok)
+}
+
+func TestValidatorNode(t *testing.T) {
+	net := newParticipantTestNetwork()
+
+	p, ok := net.ValidatorNode(40)
+	require.True(t, ok)
+	assert.Equal(t, 2, p.Node)
+	assert.Equal(t, "cl-2-teku-besu", p.Consensus.Name())
+
+	_, ok = net.ValidatorNode(64)
+	assert.False(t, ok)
+}
@@ -0,0 +1,162 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// PayloadSource is who built a block's execution payload
+type PayloadSource string
+
+const (
+	// PayloadSourceLocal is a payload built by the proposer's own execution client
+	PayloadSourceLocal PayloadSource = "local"
+	// PayloadSourceBuilder is a payload delivered by a block builder through MEV-boost
+	PayloadSourceBuilder PayloadSource = "builder"
+)
+
+// PayloadAttribution records who built the payload of a block
+type PayloadAttribution struct {
+	Slot          uint64
+	BlockNumber   uint64
+	BlockHash     string
+	ProposerIndex uint64
+	// Node is the 1-based index of the node running the proposer, or 0 if unknown
+	Node   int
+	Source PayloadSource
+	// Builder is the builder's pubkey when the relay delivered the payload, or the
+	// matching extra data marker otherwise. Empty for local payloads.
+	Builder string
+	// Value is the relay-reported payment to the proposer in wei, if known
+	Value     string
+	ExtraData string
+}
+
+// NodePayloads counts the payload sources of a node's blocks
+type NodePayloads struct {
+	Node    int
+	Local   int
+	Builder int
+}
+
+// BuilderRate returns the fraction of the node's blocks built by builders
+func (p NodePayloads) BuilderRate() float64 {
+	if p.Local+p.Builder == 0 {
+		return 0
+	}
+	return float64(p.Builder) / float64(p.Local+p.Builder)
+}
+
+// PayloadReport attributes the payloads of the blocks in a slot range
+type PayloadReport struct {
+	FromSlot uint64
+	ToSlot   uint64
+	Blocks   []PayloadAttribution
+}
+
+// BuilderRate returns the fraction of blocks built by builders
+func (r *PayloadReport) BuilderRate() float64 {
+	total := NodePayloads{}
+	for _, block := range r.Blocks {
+		total.count(block.Source)
+	}
+	return total.BuilderRate()
+}
+
+// ByNode aggregates the payload sources per node, in node order. Blocks of
+// unknown proposers are counted under node 0.
+func (r *PayloadReport) ByNode() []NodePayloads {
+	byNode := make(map[int]*NodePayloads)
+	for _, block := range r.Blocks {
+		stats, ok := byNode[block.Node]
+		if !ok {
+			stats = &NodePayloads{Node: block.Node}
+			byNode[block.Node] = stats
+		}
+		stats.count(block.Source)
+	}
+
+	nodes := make([]NodePayloads, 0, len(byNode))
+	for _, stats := range byNode {
+		nodes = append(nodes, *stats)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Node < nodes[j].Node })
+	return nodes
+}
+
+func (p *NodePayloads) count(source PayloadSource) {
+	if source == PayloadSourceBuilder {
+		p.Builder++
+	} else {
+		p.Local++
+	}
+}
+
+// AttributePayloads classifies the canonical blocks of slots from..to (inclusive)
+// as locally built or builder-delivered. A block is builder-delivered if the MEV
+// relay, when deployed, delivered its payload, or if its extra data contains one
+// of the builderExtraData markers (case-insensitive), which covers builders that
+// bypass the relay such as the mock builder.
+func (n *network) AttributePayloads(ctx context.Context, from, to uint64, builderExtraData ...string) (*PayloadReport, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid slot range %d-%d", from, to)
+	}
+	beacon, _, err := n.beaconClock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	relay, _ := n.Relay() // nil unless the network runs with full MEV
+
+	report := &PayloadReport{FromSlot: from, ToSlot: to}
+	for slot := from; slot <= to; slot++ {
+		payment, err := beacon.BlockPayment(ctx, slot)
+		if errors.Is(err, client.ErrBlockNotFound) {
+			continue
+		}
+		if err != nil {
+			return report, fmt.Errorf("failed to get block at slot %d: %w", slot, err)
+		}
+
+		block := PayloadAttribution{
+			Slot:          slot,
+			BlockNumber:   payment.BlockNumber,
+			BlockHash:     payment.BlockHash,
+			ProposerIndex: payment.ProposerIndex,
+			Node:          n.nodeForValidator(payment.ProposerIndex),
+			Source:        PayloadSourceLocal,
+			ExtraData:     payment.ExtraData,
+		}
+
+		if relay != nil {
+			traces, err := relay.DeliveredPayloads(ctx, slot)
+			if err != nil {
+				return report, fmt.Errorf("failed to get relay payloads at slot %d: %w", slot, err)
+			}
+			for _, trace := range traces {
+				if strings.EqualFold(trace.BlockHash, payment.BlockHash) {
+					block.Source = PayloadSourceBuilder
+					block.Builder = trace.BuilderPubkey
+					block.Value = trace.Value
+				}
+			}
+		}
+		if block.Source == PayloadSourceLocal {
+			for _, marker := range builderExtraData {
+				if marker != "" && strings.Contains(strings.ToLower(payment.ExtraData), strings.ToLower(marker)) {
+					block.Source = PayloadSourceBuilder
+					block.Builder = marker
+					break
+				}
+			}
+		}
+
+		report.Blocks = append(report.Blocks, block)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,95 @@
+package network
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributePayloads(t *testing.T) {
+	// Validator s proposes slot s. The relay delivered slot 1, slot 3 carries the
+	// mock builder's extra data and slot 2 is missed.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/eth/v1/beacon/genesis":
+			fmt.Fprintf(w, `{"data":{"genesis_time":"%d"}}`, time.Now().Unix())
+		case r.URL.Path == "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(`{"data":{"SECONDS_PER_SLOT":"1"}}`))
+		case strings.HasPrefix(r.URL.Path, "/eth/v2/beacon/blocks/"):
+			slot, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/eth/v2/beacon/blocks/"))
+			if slot == 2 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			extraData := "geth/v1.14"
+			if slot == 3 {
+				extraData = "Mock Builder"
+			}
+			fmt.Fprintf(w, `{"data":{"message":{"proposer_index":"%d","body":{"execution_payload":{"block_number":"%d","block_hash":"0xB%d","fee_recipient":"0x00","extra_data":"0x%s"}}}}}`,
+				slot, slot, slot, hex.EncodeToString([]byte(extraData)))
+		case r.URL.Path == "/relay/v1/data/bidtraces/proposer_payload_delivered":
+			if r.URL.Query().Get("slot") == "1" {
+				_, _ = w.Write([]byte(`[{"slot":"1","block_hash":"0xb1","builder_pubkey":"0xbuilder","value":"1000"}]`))
+				return
+			}
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+	net := New(Config{
+		Name:             "test",
+		ConsensusClients: consensusClients,
+		ExecutionClients: client.NewExecutionClients(),
+		Services:         []Service{{Name: "mev-relay-api", Type: ServiceTypeRelay, URL: server.URL}},
+		ValidatorRanges: map[int]config.ValidatorRange{
+			1: {Start: 0, End: 2},
+			2: {Start: 2, End: 4},
+		},
+		OrphanOnExit: true,
+	})
+
+	report, err := net.AttributePayloads(context.Background(), 0, 4, "mock builder")
+	require.NoError(t, err)
+	require.Len(t, report.Blocks, 4)
+
+	assert.Equal(t, PayloadSourceLocal, report.Blocks[0].Source)
+	assert.Equal(t, PayloadAttribution{
+		Slot:          1,
+		BlockNumber:   1,
+		BlockHash:     "0xB1",
+		ProposerIndex: 1,
+		Node:          1,
+		Source:        PayloadSourceBuilder,
+		Builder:       "0xbuilder",
+		Value:         "1000",
+		ExtraData:     "geth/v1.14",
+	}, report.Blocks[1])
+	assert.Equal(t, PayloadSourceBuilder, report.Blocks[2].Source)
+	assert.Equal(t, "mock builder", report.Blocks[2].Builder)
+	assert.Equal(t, 0, report.Blocks[3].Node)
+
+	assert.InDelta(t, 0.5, report.BuilderRate(), 0.001)
+	assert.Equal(t, []NodePayloads{
+		{Node: 0, Local: 1},
+		{Node: 1, Local: 1, Builder: 1},
+		{Node: 2, Builder: 1},
+	}, report.ByNode())
+	assert.InDelta(t, 0.5, report.ByNode()[1].BuilderRate(), 0.001)
+}
@@ -0,0 +1,47 @@
+package network
+
+import "sort"
+
+// Plan is what a dry run would deploy
+type Plan struct {
+	Services []PlannedService
+}
+
+// PlannedService is a service a dry run would create
+type PlannedService struct {
+	Name  string
+	Type  ServiceType
+	Image string
+	// Ports are the ports the service would expose, with InternalPort set
+	Ports []Port
+}
+
+// Images returns the distinct images the plan would pull, sorted
+func (p *Plan) Images() []string {
+	seen := make(map[string]bool)
+	var images []string
+	for _, service := range p.Services {
+		if service.Image != "" && !seen[service.Image] {
+			seen[service.Image] = true
+			images = append(images, service.Image)
+		}
+	}
+	sort.Strings(images)
+	return images
+}
+
+// Service returns the planned service with the given name
+func (p *Plan) Service(name string) (PlannedService, bool) {
+	for _, service := range p.Services {
+		if service.Name == name {
+			return service, true
+		}
+	}
+	return PlannedService{}, false
+}
+
+// Plan returns what the network would deploy when it was started as a dry
+// run, or nil otherwise
+func (n *network) Plan() *Plan {
+	return n.plan
+}
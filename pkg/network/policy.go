@@ -0,0 +1,127 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrDenied is returned by operations the network's policy denies
+var ErrDenied = errors.New("operation denied by policy")
+
+// Operation is a class of operations that change the network or its enclave
+type Operation string
+
+const (
+	// OperationCleanup stops or destroys the enclave: Stop and Cleanup
+	OperationCleanup Operation = "cleanup"
+	// OperationChaos injects faults: Proxy and the service controls of Chaos
+	OperationChaos Operation = "chaos"
+	// OperationServices starts containers in the enclave: AddService, AddSidecar,
+	// Capture and StartLateJoiners
+	OperationServices Operation = "services"
+	// OperationSnapshots stops the clients to save or roll back their data:
+	// SnapshotEnclave and RestoreEnclave
+	OperationSnapshots Operation = "snapshots"
+	// OperationTransactions sends transactions from the prefunded accounts:
+	// Faucet, NewFundedAccount, Accounts, Spammer and CheckTxPropagation
+	OperationTransactions Operation = "transactions"
+	// OperationValidators manages validator keys through the keymanager API: Keymanager
+	OperationValidators Operation = "validators"
+)
+
+// Rule allows or denies one class of operations
+type Rule struct {
+	Operation Operation
+	Allow     bool
+}
+
+// Rules for each class of operations
+var (
+	AllowCleanup      = Rule{Operation: OperationCleanup, Allow: true}
+	DenyCleanup       = Rule{Operation: OperationCleanup}
+	AllowChaos        = Rule{Operation: OperationChaos, Allow: true}
+	DenyChaos         = Rule{Operation: OperationChaos}
+	AllowServices     = Rule{Operation: OperationServices, Allow: true}
+	DenyServices      = Rule{Operation: OperationServices}
+	AllowSnapshots    = Rule{Operation: OperationSnapshots, Allow: true}
+	DenySnapshots     = Rule{Operation: OperationSnapshots}
+	AllowTransactions = Rule{Operation: OperationTransactions, Allow: true}
+	DenyTransactions  = Rule{Operation: OperationTransactions}
+	AllowValidators   = Rule{Operation: OperationValidators, Allow: true}
+	DenyValidators    = Rule{Operation: OperationValidators}
+)
+
+// Policy decides which classes of operations a network allows; classes without
+// a rule are allowed. It guards against accidents, such as a plugin destroying
+// an enclave it was only meant to observe, rather than being a security
+// boundary: code holding the network can change the policy.
+type Policy struct {
+	mu    sync.RWMutex
+	rules map[Operation]bool
+}
+
+// NewPolicy creates a policy from rules; later rules override earlier ones
+func NewPolicy(rules ...Rule) *Policy {
+	p := &Policy{rules: make(map[Operation]bool)}
+	for _, rule := range rules {
+		p.rules[rule.Operation] = rule.Allow
+	}
+	return p
+}
+
+// Allows reports whether the class of operations is allowed
+func (p *Policy) Allows(operation Operation) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	allow, ok := p.rules[operation]
+	return !ok || allow
+}
+
+// Allow allows the classes of operations
+func (p *Policy) Allow(operations ...Operation) {
+	p.set(true, operations)
+}
+
+// Deny denies the classes of operations
+func (p *Policy) Deny(operations ...Operation) {
+	p.set(false, operations)
+}
+
+// Denied returns the denied classes of operations in name order
+func (p *Policy) Denied() []Operation {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var denied []Operation
+	for operation, allow := range p.rules {
+		if !allow {
+			denied = append(denied, operation)
+		}
+	}
+	sort.Slice(denied, func(i, j int) bool { return denied[i] < denied[j] })
+	return denied
+}
+
+func (p *Policy) set(allow bool, operations []Operation) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, operation := range operations {
+		p.rules[operation] = allow
+	}
+}
+
+// Policy returns the policy the network enforces
+func (n *network) Policy() *Policy { return n.policy }
+
+// checkAllowed fails with ErrReadOnly when the network is read-only and with
+// ErrDenied when the policy denies the operation's class
+func (n *network) checkAllowed(operation Operation, method string) error {
+	if n.readOnly {
+		return fmt.Errorf("%w: %s is disabled", ErrReadOnly, method)
+	}
+	if !n.policy.Allows(operation) {
+		return fmt.Errorf("%w: %s (%s) is denied", ErrDenied, method, operation)
+	}
+	return nil
+}
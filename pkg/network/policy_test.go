@@ -0,0 +1,85 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy(t *testing.T) {
+	policy := NewPolicy(DenyChaos, DenyCleanup, AllowCleanup, DenyServices)
+	assert.True(t, policy.Allows(OperationCleanup))
+	assert.True(t, policy.Allows(OperationTransactions))
+	assert.False(t, policy.Allows(OperationChaos))
+	assert.Equal(t, []Operation{OperationChaos, OperationServices}, policy.Denied())
+
+	policy.Allow(OperationChaos)
+	policy.Deny(OperationSnapshots, OperationValidators)
+	assert.True(t, policy.Allows(OperationChaos))
+	assert.Equal(t, []Operation{OperationServices, OperationSnapshots, OperationValidators}, policy.Denied())
+}
+
+func TestPolicyEnforced(t *testing.T) {
+	ctx := context.Background()
+	cleanups := 0
+	executionClients := client.NewExecutionClients()
+	el := client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", "http://127.0.0.1:1", "", "", "", "", "el-1-geth-lighthouse", "", 30303)
+	executionClients.Add(el)
+	net := New(Config{
+		Name:             "test",
+		ExecutionClients: executionClients,
+		ConsensusClients: client.NewConsensusClients(),
+		CleanupFunc: func(context.Context) error {
+			cleanups++
+			return nil
+		},
+		OrphanOnExit: true,
+		Policy:       NewPolicy(DenyCleanup, DenyChaos),
+	})
+
+	_, err := net.Proxy(el)
+	assert.ErrorIs(t, err, ErrDenied)
+	assert.EqualError(t, err, "operation denied by policy: Proxy (chaos) is denied")
+	assert.ErrorIs(t, net.Stop(ctx), ErrDenied)
+	assert.ErrorIs(t, net.Cleanup(ctx), ErrDenied)
+	assert.Zero(t, cleanups)
+
+	// Operations without a rule still work
+	_, err = net.Faucet()
+	assert.NoError(t, err)
+
+	// Whoever holds the network can lift the guard for its own teardown
+	net.Policy().Allow(OperationCleanup)
+	require.NoError(t, net.Cleanup(ctx))
+	assert.Equal(t, 1, cleanups)
+}
+
+func TestPolicyAllowsOwnTeardown(t *testing.T) {
+	cleanups := 0
+	net := New(Config{
+		Name:             "test",
+		ExecutionClients: client.NewExecutionClients(),
+		ConsensusClients: client.NewConsensusClients(),
+		CleanupFunc: func(context.Context) error {
+			cleanups++
+			return nil
+		},
+		OrphanOnExit: true,
+		Policy:       NewPolicy(DenyCleanup),
+	}).(*network)
+	net.orphanOnExit = false
+
+	// The finalizer and signal handler tear down regardless of the policy
+	net.finalize()
+	assert.Equal(t, 1, cleanups)
+}
+
+func TestPolicyDefaultAllowsAll(t *testing.T) {
+	net := New(Config{Name: "test", OrphanOnExit: true})
+	require.NotNil(t, net.Policy())
+	assert.Empty(t, net.Policy().Denied())
+	assert.NoError(t, net.Stop(context.Background()))
+}
@@ -36,6 +36,6 @@ type ConsensusEndpoints struct {
 
 // ValidatorEndpoints holds all endpoint URLs for validator clients
 type ValidatorEndpoints struct {
-	APIURL     string
+	APIURL     string // keymanager API, only exposed with KeymanagerEnabled
 	MetricsURL string
 }
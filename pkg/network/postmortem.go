@@ -0,0 +1,28 @@
+package network
+
+import (
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/services"
+)
+
+// Tracoor returns a client for the tracoor service, which captures the beacon
+// states of every node. It fails unless tracoor is deployed.
+func (n *network) Tracoor() (*services.TracoorClient, error) {
+	url, err := n.serviceURL(ServiceTypeTracoor)
+	if err != nil {
+		return nil, err
+	}
+	return services.NewTracoorClient(url).WithHTTPClient(client.NewHTTPClient(30*time.Second, n.tlsConfig)), nil
+}
+
+// Forky returns a client for the forky service, which captures the fork choice
+// of every beacon node. It fails unless forky is deployed.
+func (n *network) Forky() (*services.ForkyClient, error) {
+	url, err := n.serviceURL(ServiceTypeForky)
+	if err != nil {
+		return nil, err
+	}
+	return services.NewForkyClient(url).WithHTTPClient(client.NewHTTPClient(30*time.Second, n.tlsConfig)), nil
+}
@@ -0,0 +1,145 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Spread returns the time between the first and the last client seeing the block
+func (b StreamedBlock) Spread() time.Duration {
+	if len(b.Sightings) == 0 {
+		return 0
+	}
+	return b.Sightings[len(b.Sightings)-1].SeenAt.Sub(b.FirstSeen)
+}
+
+// LatencySummary summarizes a set of latencies
+type LatencySummary struct {
+	Samples int
+	P50     time.Duration
+	P90     time.Duration
+	P99     time.Duration
+	Max     time.Duration
+}
+
+// String formats the summary on one line
+func (s LatencySummary) String() string {
+	return fmt.Sprintf("n=%d p50=%s p90=%s p99=%s max=%s", s.Samples, s.P50, s.P90, s.P99, s.Max)
+}
+
+// SummarizeLatencies computes nearest-rank percentiles of the latencies
+func SummarizeLatencies(latencies []time.Duration) LatencySummary {
+	if len(latencies) == 0 {
+		return LatencySummary{}
+	}
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	percentile := func(p float64) time.Duration {
+		rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+	return LatencySummary{
+		Samples: len(sorted),
+		P50:     percentile(50),
+		P90:     percentile(90),
+		P99:     percentile(99),
+		Max:     sorted[len(sorted)-1],
+	}
+}
+
+// PropagationReport summarizes how quickly blocks reached every consensus client
+type PropagationReport struct {
+	Blocks []StreamedBlock
+	// Spread summarizes the first-seen to last-seen delta of each block
+	Spread LatencySummary
+	// ByClient summarizes how far each client saw blocks behind the first client
+	ByClient map[string]LatencySummary
+	// Incomplete counts the blocks that some client did not see
+	Incomplete int
+}
+
+// NewPropagationReport summarizes the propagation of streamed blocks
+func NewPropagationReport(blocks []StreamedBlock) *PropagationReport {
+	report := &PropagationReport{
+		Blocks:   blocks,
+		ByClient: make(map[string]LatencySummary),
+	}
+
+	var spreads []time.Duration
+	delays := make(map[string][]time.Duration)
+	for _, block := range blocks {
+		if len(block.Missing) > 0 {
+			report.Incomplete++
+		}
+		if len(block.Sightings) > 1 {
+			spreads = append(spreads, block.Spread())
+		}
+		for _, sighting := range block.Sightings {
+			delays[sighting.Client] = append(delays[sighting.Client], sighting.SeenAt.Sub(block.FirstSeen))
+		}
+	}
+
+	report.Spread = SummarizeLatencies(spreads)
+	for name, clientDelays := range delays {
+		report.ByClient[name] = SummarizeLatencies(clientDelays)
+	}
+	return report
+}
+
+// String formats the report with one line per client
+func (r *PropagationReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "blocks: %d (%d incomplete)\n", len(r.Blocks), r.Incomplete)
+	fmt.Fprintf(&b, "spread: %s\n", r.Spread)
+
+	names := make([]string, 0, len(r.ByClient))
+	for name := range r.ByClient {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s: %s\n", name, r.ByClient[name])
+	}
+	return b.String()
+}
+
+// MeasurePropagation streams blocks for the given duration and summarizes
+// how quickly they propagated between the consensus clients
+func (n *network) MeasurePropagation(ctx context.Context, d time.Duration) (*PropagationReport, error) {
+	return n.measurePropagation(ctx, d, DefaultBlockSettleTime)
+}
+
+func (n *network) measurePropagation(ctx context.Context, d, settle time.Duration) (*PropagationReport, error) {
+	streamCtx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+
+	blocks, errs := n.streamBlocks(streamCtx, settle)
+	var collected []StreamedBlock
+	for block := range blocks {
+		collected = append(collected, block)
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if len(collected) == 0 {
+		var streamErrs []error
+		for err := range errs {
+			streamErrs = append(streamErrs, err)
+		}
+		if len(streamErrs) > 0 {
+			return nil, fmt.Errorf("no blocks seen: %w", errors.Join(streamErrs...))
+		}
+		return nil, fmt.Errorf("no blocks seen within %s", d)
+	}
+	return NewPropagationReport(collected), nil
+}
@@ -0,0 +1,90 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeLatencies(t *testing.T) {
+	var latencies []time.Duration
+	for i := 100; i >= 1; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	summary := SummarizeLatencies(latencies)
+	assert.Equal(t, 100, summary.Samples)
+	assert.Equal(t, 50*time.Millisecond, summary.P50)
+	assert.Equal(t, 90*time.Millisecond, summary.P90)
+	assert.Equal(t, 99*time.Millisecond, summary.P99)
+	assert.Equal(t, 100*time.Millisecond, summary.Max)
+
+	assert.Equal(t, LatencySummary{}, SummarizeLatencies(nil))
+	assert.Equal(t, 7*time.Millisecond, SummarizeLatencies([]time.Duration{7 * time.Millisecond}).P99)
+}
+
+func TestNewPropagationReport(t *testing.T) {
+	start := time.Unix(1000, 0)
+	blocks := []StreamedBlock{
+		{
+			Slot: 1, Root: "0xa", FirstSeen: start,
+			Sightings: []BlockSighting{
+				{Client: "cl-1", SeenAt: start},
+				{Client: "cl-2", SeenAt: start.Add(200 * time.Millisecond)},
+			},
+		},
+		{
+			Slot: 2, Root: "0xb", FirstSeen: start.Add(12 * time.Second),
+			Sightings: []BlockSighting{
+				{Client: "cl-2", SeenAt: start.Add(12 * time.Second)},
+				{Client: "cl-1", SeenAt: start.Add(12*time.Second + 400*time.Millisecond)},
+			},
+		},
+		{
+			Slot: 3, Root: "0xc", FirstSeen: start.Add(24 * time.Second),
+			Sightings: []BlockSighting{{Client: "cl-1", SeenAt: start.Add(24 * time.Second)}},
+			Missing:   []string{"cl-2"},
+		},
+	}
+
+	report := NewPropagationReport(blocks)
+	assert.Equal(t, 1, report.Incomplete)
+	assert.Equal(t, 2, report.Spread.Samples)
+	assert.Equal(t, 200*time.Millisecond, report.Spread.P50)
+	assert.Equal(t, 400*time.Millisecond, report.Spread.Max)
+
+	assert.Equal(t, 3, report.ByClient["cl-1"].Samples)
+	assert.Equal(t, 400*time.Millisecond, report.ByClient["cl-1"].Max)
+	assert.Equal(t, 2, report.ByClient["cl-2"].Samples)
+	assert.Equal(t, 200*time.Millisecond, report.ByClient["cl-2"].P99)
+	assert.Contains(t, report.String(), "blocks: 3 (1 incomplete)")
+}
+
+func TestMeasurePropagation(t *testing.T) {
+	fast := newHeadServer(0, "0xa")
+	defer fast.Close()
+	slow := newHeadServer(50*time.Millisecond, "0xa")
+	defer slow.Close()
+
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", fast.URL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+	consensusClients.Add(client.NewConsensusClient(client.Teku, "cl-2-teku-besu", "", slow.URL, "", "", "", "cl-2-teku-besu", "", 0))
+	net := New(Config{
+		Name:             "test",
+		SpecPreset:       config.SpecPresetMinimal,
+		ConsensusClients: consensusClients,
+		ExecutionClients: client.NewExecutionClients(),
+		OrphanOnExit:     true,
+	}).(*network)
+
+	report, err := net.measurePropagation(context.Background(), 500*time.Millisecond, 100*time.Millisecond)
+	require.NoError(t, err)
+	require.Len(t, report.Blocks, 1)
+	assert.GreaterOrEqual(t, report.Spread.P50, 40*time.Millisecond)
+	assert.Equal(t, time.Duration(0), report.ByClient["cl-1-lighthouse-geth"].Max)
+}
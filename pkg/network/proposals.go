@@ -0,0 +1,193 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+)
+
+// Proposal is an upcoming block proposal
+type Proposal struct {
+	Slot           uint64
+	ValidatorIndex uint64
+	// Node is the 1-based index of the node running the validator, or 0 if unknown
+	Node int
+	// Time is the start of the slot
+	Time time.Time
+}
+
+// chainClock converts between slots and wall-clock time
+type chainClock struct {
+	genesis      time.Time
+	slotDuration time.Duration
+}
+
+func (c chainClock) slotAt(t time.Time) uint64 {
+	if t.Before(c.genesis) {
+		return 0
+	}
+	return uint64(t.Sub(c.genesis) / c.slotDuration)
+}
+
+func (c chainClock) slotTime(slot uint64) time.Time {
+	return c.genesis.Add(time.Duration(slot) * c.slotDuration)
+}
+
+// NodeValidators returns the genesis validator indices run by the node with the given 1-based index
+func (n *network) NodeValidators(node int) (config.ValidatorRange, bool) {
+	r, ok := n.validatorRanges[node]
+	return r, ok
+}
+
+// NextProposalBy returns the next slot in which a validator of the node with the
+// given 1-based index proposes. It waits for later epochs' duties to become known
+// until the context is done.
+func (n *network) NextProposalBy(ctx context.Context, node int) (*Proposal, error) {
+	r, ok := n.validatorRanges[node]
+	if !ok || r.Count() == 0 {
+		return nil, fmt.Errorf("node %d has no validators", node)
+	}
+	return n.nextProposal(ctx, r.Contains)
+}
+
+// NextProposalByValidator returns the next slot in which the validator proposes
+func (n *network) NextProposalByValidator(ctx context.Context, index uint64) (*Proposal, error) {
+	return n.nextProposal(ctx, func(i uint64) bool { return i == index })
+}
+
+// WaitForProposalFrom waits for the node's next proposal slot and checks that its
+// block made it into the canonical chain
+func (n *network) WaitForProposalFrom(ctx context.Context, node int) (*Proposal, error) {
+	proposal, err := n.NextProposalBy(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	beacon, clock, err := n.beaconClock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Give the block until the end of the following slot to be imported
+	deadline := clock.slotTime(proposal.Slot + 2)
+	if err := sleepUntil(ctx, clock.slotTime(proposal.Slot+1)); err != nil {
+		return proposal, err
+	}
+
+	for {
+		proposer, err := beacon.BlockProposer(ctx, proposal.Slot)
+		switch {
+		case err == nil && proposer == proposal.ValidatorIndex:
+			return proposal, nil
+		case err == nil:
+			return proposal, fmt.Errorf("block at slot %d was proposed by validator %d, expected %d", proposal.Slot, proposer, proposal.ValidatorIndex)
+		case !errors.Is(err, client.ErrBlockNotFound):
+			return proposal, err
+		case !time.Now().Before(deadline):
+			return proposal, fmt.Errorf("node %d missed its proposal at slot %d", node, proposal.Slot)
+		}
+
+		if err := sleepUntil(ctx, time.Now().Add(clock.slotDuration/4)); err != nil {
+			return proposal, err
+		}
+	}
+}
+
+// nextProposal scans proposer duties of the current and next epoch for a matching
+// validator, moving on an epoch at a time until one is found
+func (n *network) nextProposal(ctx context.Context, match func(uint64) bool) (*Proposal, error) {
+	beacon, clock, err := n.beaconClock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slotsPerEpoch := n.specPreset.SlotsPerEpoch()
+	for {
+		current := clock.slotAt(time.Now())
+		epoch := current / slotsPerEpoch
+
+		for e := epoch; e <= epoch+1; e++ {
+			duties, err := beacon.ProposerDuties(ctx, e)
+			if err != nil {
+				if e == epoch {
+					return nil, fmt.Errorf("failed to get proposer duties for epoch %d: %w", e, err)
+				}
+				// Not every client serves next-epoch duties
+				break
+			}
+
+			sort.Slice(duties, func(i, j int) bool { return duties[i].Slot < duties[j].Slot })
+			for _, duty := range duties {
+				if duty.Slot > current && match(duty.ValidatorIndex) {
+					return &Proposal{
+						Slot:           duty.Slot,
+						ValidatorIndex: duty.ValidatorIndex,
+						Node:           n.nodeForValidator(duty.ValidatorIndex),
+						Time:           clock.slotTime(duty.Slot),
+					}, nil
+				}
+			}
+		}
+
+		// Wait for the next epoch, when another epoch of duties becomes known
+		if err := sleepUntil(ctx, clock.slotTime((epoch+1)*slotsPerEpoch)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// beaconClock returns a responsive consensus client and the chain's slot timing
+func (n *network) beaconClock(ctx context.Context) (client.ConsensusClient, chainClock, error) {
+	if n.consensusClients == nil {
+		return nil, chainClock{}, fmt.Errorf("no consensus clients available")
+	}
+
+	var errs []error
+	for _, beacon := range n.consensusClients.Except(n.lateJoiners...) {
+		genesis, err := beacon.GenesisTime(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", beacon.Name(), err))
+			continue
+		}
+		slotDuration, err := beacon.SecondsPerSlot(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", beacon.Name(), err))
+			continue
+		}
+		return beacon, chainClock{genesis: genesis, slotDuration: slotDuration}, nil
+	}
+
+	return nil, chainClock{}, fmt.Errorf("no consensus client answered: %w", errors.Join(errs...))
+}
+
+// nodeForValidator returns the 1-based index of the node running the validator, or 0
+func (n *network) nodeForValidator(index uint64) int {
+	for node, r := range n.validatorRanges {
+		if r.Contains(index) {
+			return node
+		}
+	}
+	return 0
+}
+
+// sleepUntil blocks until t or until the context is done
+func sleepUntil(ctx context.Context, t time.Time) error {
+	wait := time.Until(t)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,78 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProposalTestNetwork serves a beacon API with 1 second slots where slot s
+// is proposed by validator s%4
+func newProposalTestNetwork(t *testing.T, genesis time.Time) Network {
+	t.Helper()
+
+	server := newBeaconStub(t, genesis, map[string]beaconRoute{
+		"/eth/v1/validator/duties/proposer/": func(w http.ResponseWriter, suffix string) {
+			epoch, _ := strconv.ParseUint(suffix, 10, 64)
+			duties := make([]string, 0, 8)
+			for slot := epoch * 8; slot < (epoch+1)*8; slot++ {
+				duties = append(duties, fmt.Sprintf(`{"pubkey":"0x00","validator_index":"%d","slot":"%d"}`, slot%4, slot))
+			}
+			fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(duties, ","))
+		},
+		"/eth/v1/beacon/headers/": func(w http.ResponseWriter, suffix string) {
+			slot, _ := strconv.ParseUint(suffix, 10, 64)
+			fmt.Fprintf(w, `{"data":{"header":{"message":{"slot":"%d","proposer_index":"%d"}}}}`, slot, slot%4)
+		},
+	})
+
+	return newBeaconTestNetwork(server.URL, map[int]config.ValidatorRange{
+		1: {Start: 0, End: 2},
+		2: {Start: 2, End: 4},
+		3: {Start: 4, End: 4},
+	})
+}
+
+func TestNextProposalBy(t *testing.T) {
+	genesis := time.Now().Add(-2 * time.Second)
+	net := newProposalTestNetwork(t, genesis)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	proposal, err := net.NextProposalBy(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, proposal.Node)
+	assert.Contains(t, []uint64{2, 3}, proposal.ValidatorIndex)
+	assert.Equal(t, proposal.ValidatorIndex, proposal.Slot%4)
+	assert.Equal(t, genesis.Unix()+int64(proposal.Slot), proposal.Time.Unix())
+
+	proposal, err = net.NextProposalByValidator(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), proposal.Slot%4)
+	assert.Equal(t, 1, proposal.Node)
+
+	_, err = net.NextProposalBy(ctx, 3)
+	assert.ErrorContains(t, err, "node 3 has no validators")
+	_, err = net.NextProposalBy(ctx, 9)
+	assert.Error(t, err)
+}
+
+func TestWaitForProposalFrom(t *testing.T) {
+	net := newProposalTestNetwork(t, time.Now().Add(-1*time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	proposal, err := net.WaitForProposalFrom(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, proposal.Node)
+}
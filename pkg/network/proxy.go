@@ -0,0 +1,61 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/proxy"
+)
+
+// Proxy returns a logging reverse proxy in front of a client: the JSON-RPC
+// endpoint of an execution client or the beacon API of a consensus client.
+// The proxy is started on first use and shared by later calls for the same
+// client; point tools at its URL to record their traffic or inject faults.
+// Proxies are closed when the network is cleaned up.
+func (n *network) Proxy(c interface{ Name() string }) (*proxy.Proxy, error) {
+	if err := n.checkAllowed(OperationChaos, "Proxy"); err != nil {
+		return nil, err
+	}
+	var target string
+	switch c := c.(type) {
+	case client.ExecutionClient:
+		target = c.RPCURL()
+	case client.ConsensusClient:
+		target = c.BeaconAPIURL()
+	default:
+		return nil, fmt.Errorf("cannot proxy %T, expected an execution or consensus client", c)
+	}
+
+	n.proxyMu.Lock()
+	defer n.proxyMu.Unlock()
+
+	if p, ok := n.proxies[c.Name()]; ok {
+		return p, nil
+	}
+
+	p, err := proxy.New(c.Name(), target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create proxy for %s: %w", c.Name(), err)
+	}
+	p.WithSeed(DeriveSeed(n.seed, "proxy/"+c.Name()))
+	if err := p.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start proxy for %s: %w", c.Name(), err)
+	}
+
+	if n.proxies == nil {
+		n.proxies = make(map[string]*proxy.Proxy)
+	}
+	n.proxies[c.Name()] = p
+	return p, nil
+}
+
+// closeProxies stops every proxy started by Proxy
+func (n *network) closeProxies() {
+	n.proxyMu.Lock()
+	defer n.proxyMu.Unlock()
+
+	for name, p := range n.proxies {
+		_ = p.Close()
+		delete(n.proxies, name)
+	}
+}
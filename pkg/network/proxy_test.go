@@ -0,0 +1,50 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkProxy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	el := client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "", "el-1-geth-lighthouse", "", 0)
+	cl := client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 0)
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(el)
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(cl)
+	net := New(Config{Name: "test", ExecutionClients: executionClients, ConsensusClients: consensusClients, OrphanOnExit: true})
+
+	elProxy, err := net.Proxy(el)
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, elProxy.Target())
+
+	again, err := net.Proxy(el)
+	require.NoError(t, err)
+	assert.Same(t, elProxy, again, "proxies are shared per client")
+
+	clProxy, err := net.Proxy(cl)
+	require.NoError(t, err)
+	resp, err := http.Get(clProxy.URL() + "/eth/v1/node/health")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = net.Proxy(NewValidator("vc-1", "", "", "vc-1", ""))
+	assert.Error(t, err)
+
+	// Cleanup closes the proxies
+	require.NoError(t, net.Cleanup(context.Background()))
+	_, err = http.Get(clProxy.URL())
+	assert.Error(t, err)
+}
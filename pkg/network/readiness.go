@@ -0,0 +1,161 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// ReadinessProbe checks whether a service of a given type is ready to serve requests
+type ReadinessProbe struct {
+	// Ports lists candidate port names in order of preference
+	Ports []string
+	// Check probes the service at the base URL of the selected port
+	Check func(ctx context.Context, httpClient *http.Client, baseURL string) error
+}
+
+// defaultProbeTimeout bounds each readiness probe request when no RPC timeout is configured
+const defaultProbeTimeout = 5 * time.Second
+
+var (
+	readinessMu     sync.RWMutex
+	readinessProbes = map[ServiceType]ReadinessProbe{
+		ServiceTypeExecutionClient: {Ports: []string{"rpc", "http-rpc", "json-rpc"}, Check: probeJSONRPC},
+		ServiceTypeConsensusClient: {Ports: []string{"http", "beacon"}, Check: probeHTTP("/eth/v1/node/health", http.StatusOK, http.StatusPartialContent)},
+		ServiceTypePrometheus:      {Ports: []string{"http"}, Check: probeHTTP("/-/ready", http.StatusOK)},
+		ServiceTypeGrafana:         {Ports: []string{"dashboards", "http"}, Check: probeHTTP("/api/health", http.StatusOK)},
+		ServiceTypeDora:            {Ports: []string{"http"}, Check: probeHTTP("/", http.StatusOK)},
+		ServiceTypeBlockscout:      {Ports: []string{"http"}, Check: probeHTTP("/api/health", http.StatusOK)},
+		ServiceTypeTracoor:         {Ports: []string{"http"}, Check: probeHTTP("/", http.StatusOK)},
+		ServiceTypeForky:           {Ports: []string{"http"}, Check: probeHTTP("/", http.StatusOK)},
+		ServiceTypeCheckpointz:     {Ports: []string{"http"}, Check: probeHTTP("/api/v1/status", http.StatusOK)},
+		ServiceTypeRelay:           {Ports: []string{"http", "api"}, Check: probeHTTP("/eth/v1/builder/status", http.StatusOK)},
+	}
+)
+
+// RegisterReadinessProbe sets the readiness probe for a service type, replacing any existing one
+func RegisterReadinessProbe(serviceType ServiceType, probe ReadinessProbe) {
+	readinessMu.Lock()
+	defer readinessMu.Unlock()
+	readinessProbes[serviceType] = probe
+}
+
+// ReadinessProbeFor returns the readiness probe registered for a service type
+func ReadinessProbeFor(serviceType ServiceType) (ReadinessProbe, bool) {
+	readinessMu.RLock()
+	defer readinessMu.RUnlock()
+	probe, ok := readinessProbes[serviceType]
+	return probe, ok
+}
+
+// CheckReadiness probes a single service. Services without a registered probe or
+// without a probe URL are considered ready once they exist.
+func CheckReadiness(ctx context.Context, httpClient *http.Client, serviceType ServiceType, baseURL string) error {
+	probe, ok := ReadinessProbeFor(serviceType)
+	if !ok || baseURL == "" {
+		return nil
+	}
+	return probe.Check(ctx, httpClient, baseURL)
+}
+
+// probeCredentials returns the credentials readiness probes send to the named service
+func (n *network) probeCredentials(serviceName string) *client.Credentials {
+	if n.credentialsFunc == nil {
+		return nil
+	}
+	return n.credentialsFunc(serviceName)
+}
+
+// Health probes every service in the network and returns the result keyed by service
+// name. A nil value means the service is ready. When the network has a restart
+// limit, crash-looping services are reported too, and when it has log health
+// patterns, so are clients whose logs match them.
+func (n *network) Health(ctx context.Context) map[string]error {
+	timeout := n.probeTimeout
+	if timeout <= 0 {
+		timeout = defaultProbeTimeout
+	}
+	httpClient := client.NewHTTPClient(timeout, n.tlsConfig)
+
+	services := n.Services()
+	errs := client.FanOut(ctx, n.limiter, services, func(ctx context.Context, _ int, service Service) error {
+		return CheckReadiness(ctx, n.probeCredentials(service.Name).Wrap(httpClient), service.Type, service.URL)
+	})
+
+	results := make(map[string]error, len(services))
+	for i, service := range services {
+		results[service.Name] = errs[i]
+	}
+	for name, err := range n.crashLoopErrors(ctx) {
+		results[name] = errors.Join(results[name], err)
+	}
+	for name, err := range n.logHealthErrors(ctx) {
+		results[name] = errors.Join(results[name], err)
+	}
+
+	return results
+}
+
+// probeHTTP returns a check that GETs path and accepts any of the given status codes
+func probeHTTP(path string, okCodes ...int) func(context.Context, *http.Client, string) error {
+	return func(ctx context.Context, httpClient *http.Client, baseURL string) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+path, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("probe failed: %w", err)
+		}
+		defer resp.Body.Close()
+
+		for _, code := range okCodes {
+			if resp.StatusCode == code {
+				return nil
+			}
+		}
+		return fmt.Errorf("probe %s returned status %d", path, resp.StatusCode)
+	}
+}
+
+// probeJSONRPC checks that an execution client answers eth_blockNumber
+func probeJSONRPC(ctx context.Context, httpClient *http.Client, baseURL string) error {
+	body := []byte(`{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("probe failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var rpcResp struct {
+		Result *string `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return fmt.Errorf("failed to decode eth_blockNumber response: %w", err)
+	}
+	if rpcResp.Error != nil {
+		return fmt.Errorf("eth_blockNumber failed: %s", rpcResp.Error.Message)
+	}
+	if rpcResp.Result == nil {
+		return fmt.Errorf("eth_blockNumber returned no result")
+	}
+
+	return nil
+}
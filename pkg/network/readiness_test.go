@@ -0,0 +1,49 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthProbeSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow/-/ready" {
+			time.Sleep(500 * time.Millisecond)
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	net := New(Config{
+		Name: "test",
+		Services: []Service{
+			{Name: "prometheus", Type: ServiceTypePrometheus, URL: server.URL},
+			{Name: "grafana", Type: ServiceTypePrometheus, URL: server.URL},
+			{Name: "slow", Type: ServiceTypePrometheus, URL: server.URL + "/slow"},
+		},
+		MaxRestarts:  -1,
+		ProbeTimeout: 100 * time.Millisecond,
+		CredentialsFunc: func(serviceName string) *client.Credentials {
+			if serviceName == "grafana" {
+				return nil
+			}
+			return client.BearerToken("token")
+		},
+		OrphanOnExit: true,
+	})
+
+	health := net.Health(context.Background())
+	assert.NoError(t, health["prometheus"])
+	assert.ErrorContains(t, health["grafana"], "401")
+	assert.Error(t, health["slow"])
+}
@@ -0,0 +1,9 @@
+package network
+
+import "errors"
+
+// ErrReadOnly is returned by operations that change a read-only network
+var ErrReadOnly = errors.New("network is read-only")
+
+// ReadOnly reports whether the network was attached read-only
+func (n *network) ReadOnly() bool { return n.readOnly }
@@ -0,0 +1,58 @@
+package network
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnly(t *testing.T) {
+	ctx := context.Background()
+	cleanups := 0
+	executionClients := client.NewExecutionClients()
+	el := client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", "http://127.0.0.1:1", "", "", "", "", "el-1-geth-lighthouse", "", 30303)
+	executionClients.Add(el)
+	net := New(Config{
+		Name:             "test",
+		ExecutionClients: executionClients,
+		LateJoiners:      []string{"el-1-geth-lighthouse"},
+		StartServiceFunc: func(context.Context, string) error {
+			t.Error("read-only network started a service")
+			return nil
+		},
+		CleanupFunc: func(context.Context) error {
+			cleanups++
+			return nil
+		},
+		ReadOnly: true,
+	})
+	require.True(t, net.ReadOnly())
+
+	_, err := net.AddService(ctx, ServiceSpec{Name: "tool", Image: "busybox"})
+	assert.ErrorIs(t, err, ErrReadOnly)
+	_, err = net.Faucet()
+	assert.ErrorIs(t, err, ErrReadOnly)
+	_, err = net.NewFundedAccount(ctx, big.NewInt(1))
+	assert.ErrorIs(t, err, ErrReadOnly)
+	_, err = net.Spammer()
+	assert.ErrorIs(t, err, ErrReadOnly)
+	_, err = net.Accounts()
+	assert.ErrorIs(t, err, ErrReadOnly)
+	assert.ErrorIs(t, net.Chaos().StopService(ctx, "el-1-geth-lighthouse"), ErrReadOnly)
+	_, err = net.Proxy(el)
+	assert.ErrorIs(t, err, ErrReadOnly)
+	_, err = net.SnapshotEnclave(ctx, "snap")
+	assert.ErrorIs(t, err, ErrReadOnly)
+	assert.ErrorIs(t, net.StartLateJoiners(ctx), ErrReadOnly)
+	assert.ErrorIs(t, net.Stop(ctx), ErrReadOnly)
+	assert.EqualError(t, net.StartLateJoiners(ctx), "network is read-only: StartLateJoiners is disabled")
+
+	// Reads still work and Cleanup leaves the enclave alone
+	assert.Len(t, net.ExecutionClients().All(), 1)
+	assert.NoError(t, net.Cleanup(ctx))
+	assert.Zero(t, cleanups)
+}
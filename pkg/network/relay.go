@@ -0,0 +1,18 @@
+package network
+
+import (
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/services"
+)
+
+// Relay returns a client for the data API of the MEV relay. It fails unless
+// the network runs with full MEV.
+func (n *network) Relay() (*services.RelayClient, error) {
+	url, err := n.serviceURL(ServiceTypeRelay)
+	if err != nil {
+		return nil, err
+	}
+	return services.NewRelayClient(url).WithHTTPClient(client.NewHTTPClient(30*time.Second, n.tlsConfig)), nil
+}
@@ -0,0 +1,158 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrOutOfMemory is wrapped by a ResourceError when containers ran out of memory
+	ErrOutOfMemory = errors.New("out of memory")
+	// ErrDiskFull is wrapped by a ResourceError when Docker ran out of disk space
+	ErrDiskFull = errors.New("out of disk space")
+	// ErrPortsExhausted is wrapped by a ResourceError when no host ports were left to publish or bind
+	ErrPortsExhausted = errors.New("out of ports")
+)
+
+// resourceSignatures are the log and error messages that show each resource running out
+var resourceSignatures = []struct {
+	resource error
+	pattern  *regexp.Regexp
+}{
+	{ErrOutOfMemory, regexp.MustCompile(`(?i)out of memory|OutOfMemoryError|cannot allocate memory|oom[- ]?kill`)},
+	{ErrDiskFull, regexp.MustCompile(`(?i)no space left on device|ENOSPC|disk (is )?full|database or disk is full`)},
+	{ErrPortsExhausted, regexp.MustCompile(`(?i)address already in use|EADDRINUSE|port is already allocated|all ports are allocated|cannot assign requested address`)},
+}
+
+// resourceHints tell how to make more of each resource available
+var resourceHints = map[error]string{
+	ErrOutOfMemory:    "give Docker more memory (Docker Desktop: Settings > Resources), run fewer participants or cap clients with WithELResources and WithCLResources",
+	ErrDiskFull:       "free disk space with `docker system prune` and `kurtosis clean -a`, or give Docker a larger disk",
+	ErrPortsExhausted: "remove stale enclaves with `kurtosis clean -a`, stop other processes holding the ports or publish fewer ports",
+}
+
+// ResourceFinding is evidence that Docker ran out of a resource
+type ResourceFinding struct {
+	// Resource is ErrOutOfMemory, ErrDiskFull or ErrPortsExhausted
+	Resource error
+	// Service is where the evidence was found; empty for the failure's own message
+	Service  string
+	Evidence string
+}
+
+// String describes the finding
+func (f ResourceFinding) String() string {
+	if f.Service == "" {
+		return fmt.Sprintf("%v: %q", f.Resource, f.Evidence)
+	}
+	return fmt.Sprintf("%s %v: %q", f.Service, f.Resource, f.Evidence)
+}
+
+// ResourceError is a failure traced to Docker running out of memory, disk space
+// or ports. It matches the ErrOutOfMemory, ErrDiskFull or ErrPortsExhausted of
+// its findings with errors.Is, as well as the failure it explains.
+type ResourceError struct {
+	Findings []ResourceFinding
+	Err      error
+}
+
+// Error lists the findings and how to remedy them after the original failure
+func (e *ResourceError) Error() string {
+	findings := make([]string, len(e.Findings))
+	for i, finding := range e.Findings {
+		findings[i] = finding.String()
+	}
+	return fmt.Sprintf("%v; docker ran out of resources: %s; %s",
+		e.Err, strings.Join(findings, "; "), strings.Join(e.Hints(), "; "))
+}
+
+// Unwrap returns the exhausted resources and the original failure
+func (e *ResourceError) Unwrap() []error {
+	return append(e.Resources(), e.Err)
+}
+
+// Resources returns the exhausted resources, each once
+func (e *ResourceError) Resources() []error {
+	var resources []error
+	seen := make(map[error]bool)
+	for _, finding := range e.Findings {
+		if !seen[finding.Resource] {
+			seen[finding.Resource] = true
+			resources = append(resources, finding.Resource)
+		}
+	}
+	return resources
+}
+
+// Hints returns how to make more of each exhausted resource available
+func (e *ResourceError) Hints() []string {
+	resources := e.Resources()
+	hints := make([]string, len(resources))
+	for i, resource := range resources {
+		hints[i] = resourceHints[resource]
+	}
+	return hints
+}
+
+// DiagnoseResources looks for signs of Docker running out of resources in a
+// failure's message, the services' container states and their recent logs,
+// keyed by service name. It returns at most one finding per resource and
+// service, in service order.
+func DiagnoseResources(failure error, states map[string]ContainerState, logs map[string][]string) []ResourceFinding {
+	var findings []ResourceFinding
+	if failure != nil {
+		findings = append(findings, matchResources("", []string{failure.Error()})...)
+	}
+
+	services := make(map[string]bool)
+	for service := range states {
+		services[service] = true
+	}
+	for service := range logs {
+		services[service] = true
+	}
+	names := make([]string, 0, len(services))
+	for service := range services {
+		names = append(names, service)
+	}
+	sort.Strings(names)
+
+	for _, service := range names {
+		serviceFindings := matchResources(service, logs[service])
+		if state, ok := states[service]; ok && state.OOMKilled && !hasResource(serviceFindings, ErrOutOfMemory) {
+			serviceFindings = append([]ResourceFinding{{Resource: ErrOutOfMemory, Service: service, Evidence: "container was OOM killed"}}, serviceFindings...)
+		}
+		findings = append(findings, serviceFindings...)
+	}
+	return findings
+}
+
+// matchResources returns the first line matching each resource signature
+func matchResources(service string, lines []string) []ResourceFinding {
+	var findings []ResourceFinding
+	for _, signature := range resourceSignatures {
+		for _, line := range lines {
+			if signature.pattern.MatchString(line) {
+				evidence := strings.TrimSpace(line)
+				if len(evidence) > maxEvidenceLength {
+					evidence = evidence[:maxEvidenceLength] + "..."
+				}
+				findings = append(findings, ResourceFinding{Resource: signature.resource, Service: service, Evidence: evidence})
+				break
+			}
+		}
+	}
+	return findings
+}
+
+func hasResource(findings []ResourceFinding, resource error) bool {
+	for _, finding := range findings {
+		if finding.Resource == resource {
+			return true
+		}
+	}
+	return false
+}
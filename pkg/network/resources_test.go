@@ -0,0 +1,85 @@
+package network
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnoseResources(t *testing.T) {
+	failure := errors.New("failed to start: Bind for 0.0.0.0:8545 failed: port is already allocated")
+	states := map[string]ContainerState{
+		"el-1-geth-lighthouse": {Status: "exited", ExitCode: 137, OOMKilled: true},
+		"cl-1-lighthouse-geth": {Status: "running", RestartCount: 2},
+		"vc-1-geth-lighthouse": {Status: "running"},
+	}
+	logs := map[string][]string{
+		"cl-1-lighthouse-geth": {
+			"INFO Syncing",
+			"CRIT Database write failed: No space left on device",
+			"CRIT Database write failed: No space left on device",
+		},
+		"vc-1-geth-lighthouse": {"INFO All validators active"},
+	}
+
+	findings := DiagnoseResources(failure, states, logs)
+	require.Len(t, findings, 3)
+	assert.Equal(t, ErrPortsExhausted, findings[0].Resource)
+	assert.Empty(t, findings[0].Service)
+	assert.Equal(t, ErrDiskFull, findings[1].Resource)
+	assert.Equal(t, "cl-1-lighthouse-geth", findings[1].Service)
+	assert.Equal(t, `cl-1-lighthouse-geth out of disk space: "CRIT Database write failed: No space left on device"`, findings[1].String())
+	assert.Equal(t, ErrOutOfMemory, findings[2].Resource)
+	assert.Equal(t, "container was OOM killed", findings[2].Evidence)
+}
+
+func TestDiagnoseResourcesOOMLogged(t *testing.T) {
+	states := map[string]ContainerState{"el-1-besu-teku": {Status: "exited", OOMKilled: true}}
+	logs := map[string][]string{"el-1-besu-teku": {"java.lang.OutOfMemoryError: Java heap space"}}
+
+	findings := DiagnoseResources(nil, states, logs)
+	require.Len(t, findings, 1)
+	assert.Equal(t, "java.lang.OutOfMemoryError: Java heap space", findings[0].Evidence)
+}
+
+func TestDiagnoseResourcesNothingFound(t *testing.T) {
+	states := map[string]ContainerState{"el-1-geth-lighthouse": {Status: "running"}}
+	logs := map[string][]string{"el-1-geth-lighthouse": {"INFO Imported new chain segment"}}
+
+	assert.Empty(t, DiagnoseResources(errors.New("timeout waiting for services"), states, logs))
+}
+
+func TestDiagnoseResourcesTruncatesEvidence(t *testing.T) {
+	line := "ENOSPC " + strings.Repeat("x", 2*maxEvidenceLength)
+
+	findings := DiagnoseResources(nil, nil, map[string][]string{"cl-1-teku-besu": {line}})
+	require.Len(t, findings, 1)
+	assert.Len(t, findings[0].Evidence, maxEvidenceLength+len("..."))
+}
+
+func TestResourceError(t *testing.T) {
+	failure := errors.New("services failed to start")
+	err := error(&ResourceError{
+		Findings: []ResourceFinding{
+			{Resource: ErrOutOfMemory, Service: "el-1-geth-lighthouse", Evidence: "container was OOM killed"},
+			{Resource: ErrOutOfMemory, Service: "el-2-geth-lighthouse", Evidence: "container was OOM killed"},
+			{Resource: ErrDiskFull, Evidence: "no space left on device"},
+		},
+		Err: failure,
+	})
+
+	assert.ErrorIs(t, err, ErrOutOfMemory)
+	assert.ErrorIs(t, err, ErrDiskFull)
+	assert.ErrorIs(t, err, failure)
+	assert.NotErrorIs(t, err, ErrPortsExhausted)
+
+	var resourceErr *ResourceError
+	require.ErrorAs(t, err, &resourceErr)
+	assert.Equal(t, []error{ErrOutOfMemory, ErrDiskFull}, resourceErr.Resources())
+	require.Len(t, resourceErr.Hints(), 2)
+	assert.Contains(t, resourceErr.Hints()[1], "docker system prune")
+	assert.True(t, strings.HasPrefix(err.Error(), "services failed to start; docker ran out of resources: "))
+}
@@ -0,0 +1,23 @@
+package network
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+)
+
+// DeriveSeed returns the seed of one randomized component from the network's
+// random seed, so each component draws an independent stream and the values one
+// produces do not depend on how often another was used
+func DeriveSeed(seed int64, component string) int64 {
+	h := fnv.New64a()
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(seed))
+	_, _ = h.Write(b[:])
+	_, _ = h.Write([]byte(component))
+	return int64(h.Sum64())
+}
+
+// Seed returns the random seed the network's randomized behavior is derived from
+func (n *network) Seed() int64 {
+	return n.seed
+}
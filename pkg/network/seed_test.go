@@ -0,0 +1,42 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/wallet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveSeed(t *testing.T) {
+	assert.Equal(t, DeriveSeed(1, "accounts"), DeriveSeed(1, "accounts"))
+	assert.NotEqual(t, DeriveSeed(1, "accounts"), DeriveSeed(2, "accounts"))
+	assert.NotEqual(t, DeriveSeed(1, "accounts"), DeriveSeed(1, "proxy/el-1"))
+}
+
+func TestNetworkSeed(t *testing.T) {
+	newSeeded := func(seed int64) *network {
+		executionClients := client.NewExecutionClients()
+		for _, name := range []string{"el-1-geth", "el-2-besu", "el-3-reth"} {
+			executionClients.Add(client.NewExecutionClient(client.Geth, name, "", "", "", "", "", "", name, "", 0))
+		}
+		return New(Config{Name: "test", ExecutionClients: executionClients, Seed: seed, OrphanOnExit: true}).(*network)
+	}
+
+	first, second := newSeeded(99), newSeeded(99)
+	assert.Equal(t, int64(99), first.Seed())
+	for i := 0; i < 5; i++ {
+		a, _ := first.ExecutionClients().Random()
+		b, _ := second.ExecutionClients().Random()
+		assert.Equal(t, a.Name(), b.Name())
+	}
+
+	keyA, err := wallet.GenerateKeyFrom(first.keyRand)
+	require.NoError(t, err)
+	keyB, err := wallet.GenerateKeyFrom(second.keyRand)
+	require.NoError(t, err)
+	assert.Equal(t, keyA.Address(), keyB.Address())
+
+	assert.NotZero(t, New(Config{Name: "test", OrphanOnExit: true}).Seed())
+}
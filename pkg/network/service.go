@@ -1,6 +1,11 @@
 package network
 
-import "github.com/ethpandaops/ethereum-package-go/pkg/client"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
 
 // Service represents a generic service in the network
 type Service struct {
@@ -9,6 +14,12 @@ type Service struct {
 	ContainerID string
 	Ports       []Port
 	Status      string
+	URL         string // primary HTTP endpoint used for readiness probes, if any
+	// Hostname and IPAddress address the service from inside the enclave
+	Hostname  string
+	IPAddress string
+	// ExternalHost addresses the service's published ports from the host
+	ExternalHost string
 }
 
 // ServiceMetadata contains detailed information about a service
@@ -31,3 +42,103 @@ type ServiceMetadata struct {
 	ENR                 string
 	PeerID              string
 }
+
+// DetectServiceType detects the service type from an ethereum-package service name
+func DetectServiceType(name string) ServiceType {
+	nameLower := strings.ToLower(name)
+
+	// Sidecars and metrics exporters carry their node's client names, so check
+	// them before the clients
+	if sidecarPattern.MatchString(nameLower) {
+		return ServiceTypeSidecar
+	}
+	if strings.HasPrefix(nameLower, metricsExporterPrefix) {
+		return ServiceTypeMetricsExporter
+	}
+
+	// Check for validator services first (most specific)
+	if strings.Contains(nameLower, "validator-key-generation") ||
+		strings.HasPrefix(nameLower, "vc-") ||
+		(strings.Contains(nameLower, "validator") && !strings.HasPrefix(nameLower, "cl-") && !strings.HasPrefix(nameLower, "el-")) {
+		return ServiceTypeValidator
+	}
+
+	// Check for consensus clients (cl- prefix takes precedence)
+	if strings.HasPrefix(nameLower, "cl-") || strings.Contains(nameLower, "beacon") {
+		return ServiceTypeConsensusClient
+	}
+
+	// Check for execution clients
+	if strings.HasPrefix(nameLower, "el-") || strings.Contains(nameLower, "execution") {
+		return ServiceTypeExecutionClient
+	}
+
+	// Check by client name patterns (only if no prefix found)
+	if !strings.Contains(nameLower, "-") ||
+		(!strings.HasPrefix(nameLower, "cl-") && !strings.HasPrefix(nameLower, "el-")) {
+		// Execution clients
+		if strings.Contains(nameLower, "geth") ||
+			strings.Contains(nameLower, "besu") ||
+			strings.Contains(nameLower, "nethermind") ||
+			strings.Contains(nameLower, "erigon") ||
+			strings.Contains(nameLower, "reth") {
+			return ServiceTypeExecutionClient
+		}
+
+		// Consensus clients
+		if strings.Contains(nameLower, "lighthouse") ||
+			strings.Contains(nameLower, "teku") ||
+			strings.Contains(nameLower, "prysm") ||
+			strings.Contains(nameLower, "nimbus") ||
+			strings.Contains(nameLower, "lodestar") ||
+			strings.Contains(nameLower, "grandine") {
+			return ServiceTypeConsensusClient
+		}
+	}
+
+	// Validator check already done above, skip duplicate
+
+	// Check for other services
+	if strings.Contains(nameLower, "prometheus") {
+		return ServiceTypePrometheus
+	}
+	if strings.Contains(nameLower, "grafana") {
+		return ServiceTypeGrafana
+	}
+	if strings.Contains(nameLower, "blockscout") {
+		return ServiceTypeBlockscout
+	}
+	if strings.Contains(nameLower, "dora") {
+		return ServiceTypeDora
+	}
+	if strings.Contains(nameLower, "apache") {
+		return ServiceTypeApache
+	}
+	if strings.Contains(nameLower, "spamoor") {
+		return ServiceTypeSpamoor
+	}
+	if strings.Contains(nameLower, "tracoor") {
+		return ServiceTypeTracoor
+	}
+	if strings.Contains(nameLower, "forky") {
+		return ServiceTypeForky
+	}
+	if strings.Contains(nameLower, "checkpointz") {
+		return ServiceTypeCheckpointz
+	}
+	if strings.HasPrefix(nameLower, "mev-relay-api") {
+		return ServiceTypeRelay
+	}
+
+	return ServiceTypeOther
+}
+
+// serviceURL returns the URL of the first service of the given type
+func (n *network) serviceURL(serviceType ServiceType) (string, error) {
+	for _, s := range n.Services() {
+		if s.Type == serviceType && s.URL != "" {
+			return s.URL, nil
+		}
+	}
+	return "", fmt.Errorf("%s is not deployed", serviceType)
+}
@@ -0,0 +1,47 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceClients(t *testing.T) {
+	net := New(Config{
+		Name: "test",
+		Services: []Service{
+			{Name: "tracoor", Type: ServiceTypeTracoor, URL: "http://127.0.0.1:7007"},
+			{Name: "forky", Type: ServiceTypeForky, URL: "http://127.0.0.1:8080/"},
+			{Name: "checkpointz", Type: ServiceTypeCheckpointz, URL: "http://127.0.0.1:5555"},
+			{Name: "mev-relay-api", Type: ServiceTypeRelay, URL: "http://127.0.0.1:9062"},
+		},
+		OrphanOnExit: true,
+	})
+
+	tracoor, err := net.Tracoor()
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:7007", tracoor.URL())
+
+	forky, err := net.Forky()
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8080", forky.URL())
+
+	checkpointz, err := net.Checkpointz()
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:5555", checkpointz.URL())
+
+	relay, err := net.Relay()
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:9062", relay.URL())
+
+	net = New(Config{Name: "test", OrphanOnExit: true})
+	_, err = net.Tracoor()
+	assert.ErrorContains(t, err, "tracoor is not deployed")
+	_, err = net.Forky()
+	assert.ErrorContains(t, err, "forky is not deployed")
+	_, err = net.Checkpointz()
+	assert.ErrorContains(t, err, "checkpointz is not deployed")
+	_, err = net.Relay()
+	assert.ErrorContains(t, err, "mev-relay is not deployed")
+}
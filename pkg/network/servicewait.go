@@ -0,0 +1,256 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// ServiceCheck is a condition a service must meet before it is usable, checked
+// against the service's primary HTTP endpoint
+type ServiceCheck func(ctx context.Context, httpClient *http.Client, baseURL string) error
+
+// ServiceWaitStrategy polls checks until all of them pass. As a client.WaitStrategy
+// it takes the service's base URL as its target.
+type ServiceWaitStrategy struct {
+	Checks   []ServiceCheck
+	Timeout  time.Duration
+	Interval time.Duration
+
+	httpClient *http.Client
+}
+
+// NewServiceWaitStrategy creates a service wait strategy with defaults
+func NewServiceWaitStrategy(checks ...ServiceCheck) *ServiceWaitStrategy {
+	return &ServiceWaitStrategy{
+		Checks:   checks,
+		Timeout:  5 * time.Minute,
+		Interval: 2 * time.Second,
+	}
+}
+
+// WithTimeout sets the overall timeout
+func (s *ServiceWaitStrategy) WithTimeout(timeout time.Duration) *ServiceWaitStrategy {
+	s.Timeout = timeout
+	return s
+}
+
+// WithInterval sets the check interval
+func (s *ServiceWaitStrategy) WithInterval(interval time.Duration) *ServiceWaitStrategy {
+	s.Interval = interval
+	return s
+}
+
+// WaitUntilReady polls the checks against the base URL in target until all pass
+func (s *ServiceWaitStrategy) WaitUntilReady(ctx context.Context, target interface{}) error {
+	baseURL, ok := target.(string)
+	if !ok || baseURL == "" {
+		return fmt.Errorf("service wait strategy needs a base URL")
+	}
+	httpClient := s.httpClient
+	if httpClient == nil {
+		httpClient = client.NewHTTPClient(10*time.Second, nil)
+	}
+
+	timeout := time.After(s.Timeout)
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		err := s.check(ctx, httpClient, baseURL)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			return fmt.Errorf("timed out waiting for %s: %w", baseURL, err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// check runs the checks in order and returns the first failure
+func (s *ServiceWaitStrategy) check(ctx context.Context, httpClient *http.Client, baseURL string) error {
+	for _, check := range s.Checks {
+		if err := check(ctx, httpClient, baseURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitForService waits until the named service meets strategy, e.g. "dora" or
+// "grafana". A nil strategy uses the service type's bundled expectations: the
+// readiness probe plus loaded datasources for Grafana, healthy scrape targets
+// for Prometheus and an index at the chain head for Blockscout.
+func (n *network) WaitForService(ctx context.Context, name string, strategy client.WaitStrategy) error {
+	var service *Service
+	for _, s := range n.Services() {
+		if s.Name == name {
+			service = &s
+			break
+		}
+	}
+	if service == nil {
+		return fmt.Errorf("service not found: %s", name)
+	}
+	if service.URL == "" {
+		return fmt.Errorf("service %s has no HTTP endpoint to wait on", name)
+	}
+
+	if strategy == nil {
+		strategy = n.ServiceExpectations(service.Type)
+	}
+	if err := strategy.WaitUntilReady(ctx, service.URL); err != nil {
+		return fmt.Errorf("service %s not ready: %w", name, err)
+	}
+	return nil
+}
+
+// ServiceExpectations returns the bundled wait strategy for a service type
+func (n *network) ServiceExpectations(serviceType ServiceType) *ServiceWaitStrategy {
+	var checks []ServiceCheck
+	if probe, ok := ReadinessProbeFor(serviceType); ok {
+		checks = append(checks, probe.Check)
+	}
+
+	switch serviceType {
+	case ServiceTypeGrafana:
+		checks = append(checks, GrafanaDatasourcesLoaded)
+	case ServiceTypePrometheus:
+		checks = append(checks, PrometheusTargetsUp)
+	case ServiceTypeBlockscout:
+		checks = append(checks, BlockscoutIndexedTo(n.headBlock))
+	}
+
+	strategy := NewServiceWaitStrategy(checks...)
+	strategy.httpClient = client.NewHTTPClient(10*time.Second, n.tlsConfig)
+	return strategy
+}
+
+// headBlock returns the head block number of the first running execution client that answers
+func (n *network) headBlock(ctx context.Context) (uint64, error) {
+	if n.executionClients == nil {
+		return 0, fmt.Errorf("no execution clients available")
+	}
+
+	var errs []error
+	for _, el := range n.executionClients.Except(n.lateJoiners...) {
+		number, err := client.RPC(el).GetBlockNumber(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", el.Name(), err))
+			continue
+		}
+		return number, nil
+	}
+
+	return 0, fmt.Errorf("no execution client answered: %w", errors.Join(errs...))
+}
+
+// GrafanaDatasourcesLoaded checks that Grafana has provisioned at least one datasource
+func GrafanaDatasourcesLoaded(ctx context.Context, httpClient *http.Client, baseURL string) error {
+	var datasources []struct {
+		Name string `json:"name"`
+	}
+	if err := getJSON(ctx, httpClient, baseURL+"/api/datasources", &datasources); err != nil {
+		return err
+	}
+	if len(datasources) == 0 {
+		return fmt.Errorf("grafana has no datasources loaded")
+	}
+	return nil
+}
+
+// PrometheusTarget is a scrape target as reported by the Prometheus targets API
+type PrometheusTarget struct {
+	Labels    map[string]string `json:"labels"`
+	ScrapeURL string            `json:"scrapeUrl"`
+	Health    string            `json:"health"`
+	LastError string            `json:"lastError"`
+}
+
+// PrometheusTargetsUp checks that Prometheus has scrape targets and all of them are up
+func PrometheusTargetsUp(ctx context.Context, httpClient *http.Client, baseURL string) error {
+	targets, err := prometheusTargets(ctx, httpClient, baseURL)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("prometheus has no active targets")
+	}
+	for _, target := range targets {
+		if target.Health != "up" {
+			return fmt.Errorf("prometheus target %s is %s: %s", target.ScrapeURL, target.Health, target.LastError)
+		}
+	}
+	return nil
+}
+
+// prometheusTargets returns the active scrape targets of the Prometheus at baseURL
+func prometheusTargets(ctx context.Context, httpClient *http.Client, baseURL string) ([]PrometheusTarget, error) {
+	var resp struct {
+		Data struct {
+			ActiveTargets []PrometheusTarget `json:"activeTargets"`
+		} `json:"data"`
+	}
+	if err := getJSON(ctx, httpClient, baseURL+"/api/v1/targets?state=active", &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data.ActiveTargets, nil
+}
+
+// BlockscoutIndexedTo returns a check that Blockscout has indexed up to the block
+// head returns, so explorer lookups of recent transactions succeed
+func BlockscoutIndexedTo(head func(context.Context) (uint64, error)) ServiceCheck {
+	return func(ctx context.Context, httpClient *http.Client, baseURL string) error {
+		target, err := head(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get chain head: %w", err)
+		}
+
+		var blocks []struct {
+			Height uint64 `json:"height"`
+		}
+		if err := getJSON(ctx, httpClient, baseURL+"/api/v2/main-page/blocks", &blocks); err != nil {
+			return err
+		}
+		if len(blocks) == 0 {
+			return fmt.Errorf("blockscout has not indexed any blocks")
+		}
+		if blocks[0].Height < target {
+			return fmt.Errorf("blockscout indexed to block %d, chain head is %d", blocks[0].Height, target)
+		}
+		return nil
+	}
+}
+
+// getJSON GETs url and decodes a 200 response into v
+func getJSON(ctx context.Context, httpClient *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+	return nil
+}
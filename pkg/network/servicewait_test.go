@@ -0,0 +1,97 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForServiceGrafana(t *testing.T) {
+	// Datasources appear a few polls after the health endpoint is up
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/health":
+			_, _ = w.Write([]byte(`{"database":"ok"}`))
+		case "/api/datasources":
+			if polls.Add(1) < 3 {
+				_, _ = w.Write([]byte(`[]`))
+				return
+			}
+			_, _ = w.Write([]byte(`[{"name":"prometheus"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	net := New(Config{
+		Name:         "test",
+		Services:     []Service{{Name: "grafana", Type: ServiceTypeGrafana, URL: server.URL}},
+		OrphanOnExit: true,
+	})
+
+	strategy := net.ServiceExpectations(ServiceTypeGrafana).WithInterval(10 * time.Millisecond)
+	require.NoError(t, net.WaitForService(context.Background(), "grafana", strategy))
+	assert.GreaterOrEqual(t, polls.Load(), int32(3))
+}
+
+func TestWaitForServiceTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/-/ready" {
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"activeTargets":[{"scrapeUrl":"http://el-1:9001/metrics","health":"down","lastError":"connection refused"}]}}`))
+	}))
+	defer server.Close()
+
+	net := New(Config{
+		Name:         "test",
+		Services:     []Service{{Name: "prometheus", Type: ServiceTypePrometheus, URL: server.URL}},
+		OrphanOnExit: true,
+	})
+
+	strategy := net.ServiceExpectations(ServiceTypePrometheus).WithTimeout(50 * time.Millisecond).WithInterval(10 * time.Millisecond)
+	err := net.WaitForService(context.Background(), "prometheus", strategy)
+	assert.ErrorContains(t, err, "http://el-1:9001/metrics is down: connection refused")
+
+	assert.ErrorContains(t, net.WaitForService(context.Background(), "dora", nil), "service not found: dora")
+}
+
+func TestWaitForServiceCustomStrategy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	net := New(Config{
+		Name:         "test",
+		Services:     []Service{{Name: "dora", Type: ServiceTypeDora, URL: server.URL}},
+		OrphanOnExit: true,
+	})
+
+	strategy := client.NewHTTPWaitStrategy(0).WithInterval(10 * time.Millisecond)
+	require.NoError(t, net.WaitForService(context.Background(), "dora", strategy))
+}
+
+func TestBlockscoutIndexedTo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"height":41},{"height":40}]`))
+	}))
+	defer server.Close()
+
+	head := uint64(42)
+	check := BlockscoutIndexedTo(func(context.Context) (uint64, error) { return head, nil })
+	httpClient := client.NewHTTPClient(time.Second, nil)
+
+	err := check(context.Background(), httpClient, server.URL)
+	assert.ErrorContains(t, err, "blockscout indexed to block 41, chain head is 42")
+
+	head = 41
+	assert.NoError(t, check(context.Background(), httpClient, server.URL))
+}
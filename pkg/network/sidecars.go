@@ -0,0 +1,148 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+)
+
+// sidecarPattern matches the services deployed next to a node: ethereum-package
+// snoopers (e.g. "snooper-engine-1-geth-lighthouse") and configured sidecars
+// (e.g. "sidecar-tcpdump-1-geth-lighthouse")
+var sidecarPattern = regexp.MustCompile(`^(snooper(?:-[a-z]+)?|sidecar-([a-z][a-z0-9]*))-(\d+)-`)
+
+// SidecarName returns the sidecar name and node index of a sidecar service,
+// e.g. "tcpdump" and 1 for "sidecar-tcpdump-1-geth-lighthouse" or
+// "snooper-engine" and 1 for "snooper-engine-1-geth-lighthouse"
+func SidecarName(service string) (string, int, bool) {
+	matches := sidecarPattern.FindStringSubmatch(service)
+	if matches == nil {
+		return "", 0, false
+	}
+	node, _ := strconv.Atoi(matches[3])
+	if matches[2] != "" {
+		return matches[2], node, true
+	}
+	return matches[1], node, true
+}
+
+// Sidecar returns the node's sidecar with the given name
+func (p Participant) Sidecar(name string) (Service, bool) {
+	for _, s := range p.Sidecars {
+		if sidecar, _, _ := SidecarName(s.Name); sidecar == name {
+			return s, true
+		}
+	}
+	return Service{}, false
+}
+
+// AddSidecar deploys a sidecar next to the node with the given 1-based index.
+// Placeholders in its environment and command are filled in with the node's
+// internal addresses.
+func (n *network) AddSidecar(ctx context.Context, node int, sidecar config.Sidecar) (Service, error) {
+	if err := n.checkAllowed(OperationServices, "AddSidecar"); err != nil {
+		return Service{}, err
+	}
+	if err := sidecar.Validate(); err != nil {
+		return Service{}, err
+	}
+	p, ok := n.Participant(node)
+	if !ok {
+		return Service{}, fmt.Errorf("node %d not found", node)
+	}
+
+	var suffix string
+	switch {
+	case p.Execution != nil:
+		suffix = strings.TrimPrefix(p.Execution.Name(), "el-")
+	case p.Consensus != nil:
+		suffix = strings.TrimPrefix(p.Consensus.Name(), "cl-")
+	default:
+		return Service{}, fmt.Errorf("node %d has no clients", node)
+	}
+
+	expand := n.sidecarExpander(p)
+	spec := ServiceSpec{
+		Name:  fmt.Sprintf("sidecar-%s-%s", sidecar.Name, suffix),
+		Image: sidecar.Image,
+		Env:   make(map[string]string, len(sidecar.Env)),
+	}
+	for key, value := range sidecar.Env {
+		expanded, err := expand(value)
+		if err != nil {
+			return Service{}, fmt.Errorf("sidecar %s: env %s: %w", sidecar.Name, key, err)
+		}
+		spec.Env[key] = expanded
+	}
+	for _, args := range []struct {
+		in  []string
+		out *[]string
+	}{
+		{sidecar.Entrypoint, &spec.Entrypoint},
+		{sidecar.Cmd, &spec.Cmd},
+	} {
+		for _, arg := range args.in {
+			expanded, err := expand(arg)
+			if err != nil {
+				return Service{}, fmt.Errorf("sidecar %s: %w", sidecar.Name, err)
+			}
+			*args.out = append(*args.out, expanded)
+		}
+	}
+	for name, number := range sidecar.Ports {
+		spec.Ports = append(spec.Ports, Port{Name: name, InternalPort: number})
+	}
+	sort.Slice(spec.Ports, func(i, j int) bool { return spec.Ports[i].Name < spec.Ports[j].Name })
+
+	return n.AddService(ctx, spec)
+}
+
+// sidecarExpander returns a function filling in the node placeholders of a
+// sidecar setting. Placeholders the node has no value for are an error.
+func (n *network) sidecarExpander(p Participant) func(string) (string, error) {
+	values := map[string]string{
+		"{node}":      strconv.Itoa(p.Node),
+		"{el_host}":   "",
+		"{cl_host}":   "",
+		"{el_rpc}":    "",
+		"{cl_beacon}": "",
+	}
+	services := make(map[string]Service)
+	for _, s := range n.Services() {
+		services[s.Name] = s
+	}
+	host := func(name string) string {
+		if s, ok := services[name]; ok && s.Hostname != "" {
+			return s.Hostname
+		}
+		return name
+	}
+	if p.Execution != nil {
+		values["{el_host}"] = host(p.Execution.Name())
+		values["{el_rpc}"], _ = services[p.Execution.Name()].InternalURL("rpc")
+	}
+	if p.Consensus != nil {
+		values["{cl_host}"] = host(p.Consensus.Name())
+		values["{cl_beacon}"], _ = services[p.Consensus.Name()].InternalURL("http")
+	}
+
+	pairs := make([]string, 0, 2*len(values))
+	for placeholder, value := range values {
+		pairs = append(pairs, placeholder, value)
+	}
+	replacer := strings.NewReplacer(pairs...)
+
+	return func(s string) (string, error) {
+		for placeholder, value := range values {
+			if value == "" && strings.Contains(s, placeholder) {
+				return "", fmt.Errorf("node %d has no value for %s", p.Node, placeholder)
+			}
+		}
+		return replacer.Replace(s), nil
+	}
+}
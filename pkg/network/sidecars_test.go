@@ -0,0 +1,85 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSidecarName(t *testing.T) {
+	tests := []struct {
+		service string
+		name    string
+		node    int
+		ok      bool
+	}{
+		{"sidecar-tcpdump-1-geth-lighthouse", "tcpdump", 1, true},
+		{"snooper-engine-2-besu-teku", "snooper-engine", 2, true},
+		{"snooper-12-geth-lighthouse", "snooper", 12, true},
+		{"el-1-geth-lighthouse", "", 0, false},
+		{"blockscout", "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.service, func(t *testing.T) {
+			name, node, ok := SidecarName(tt.service)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.node, node)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.ok, DetectServiceType(tt.service) == ServiceTypeSidecar)
+		})
+	}
+}
+
+func TestNetwork_AddSidecar(t *testing.T) {
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", "", "", "", "", "", "el-1-geth-lighthouse", "", 30303))
+
+	var deployed []ServiceSpec
+	net := New(Config{
+		ExecutionClients: executionClients,
+		Services: []Service{{
+			Name:     "el-1-geth-lighthouse",
+			Type:     ServiceTypeExecutionClient,
+			Hostname: "el-1-geth-lighthouse",
+			Ports:    []Port{{Name: "rpc", InternalPort: 8545}},
+		}},
+		OrphanOnExit: true,
+		AddServiceFunc: func(ctx context.Context, spec ServiceSpec) (Service, error) {
+			deployed = append(deployed, spec)
+			return Service{Name: spec.Name, Type: ServiceTypeSidecar, Hostname: spec.Name}, nil
+		},
+	})
+
+	_, err := net.AddSidecar(context.Background(), 1, config.Sidecar{
+		Name:  "proxy",
+		Image: "ethpandaops/rpc-snooper",
+		Ports: map[string]int{"http": 8080, "admin": 8081},
+		Cmd:   []string{"--target", "{el_rpc}", "--node", "{node}"},
+	})
+	require.NoError(t, err)
+	require.Len(t, deployed, 1)
+	assert.Equal(t, "sidecar-proxy-1-geth-lighthouse", deployed[0].Name)
+	assert.Equal(t, []string{"--target", "http://el-1-geth-lighthouse:8545", "--node", "1"}, deployed[0].Cmd)
+	assert.Equal(t, []Port{{Name: "admin", InternalPort: 8081}, {Name: "http", InternalPort: 8080}}, deployed[0].Ports)
+
+	node, ok := net.Participant(1)
+	require.True(t, ok)
+	_, ok = node.Sidecar("proxy")
+	assert.True(t, ok)
+
+	// The node has no consensus client to fill in {cl_beacon}
+	_, err = net.AddSidecar(context.Background(), 1, config.Sidecar{
+		Name:  "watcher",
+		Image: "busybox",
+		Env:   map[string]string{"BEACON": "{cl_beacon}"},
+	})
+	assert.ErrorContains(t, err, "node 1 has no value for {cl_beacon}")
+
+	_, err = net.AddSidecar(context.Background(), 3, config.Sidecar{Name: "watcher", Image: "busybox"})
+	assert.ErrorContains(t, err, "node 3 not found")
+	assert.Len(t, deployed, 1)
+}
@@ -0,0 +1,139 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+)
+
+// ErrNoStateDumpClient is returned when no execution client can dump its state
+var ErrNoStateDumpClient = errors.New("no geth execution client to dump state from")
+
+// stateDumpPageSize is the number of accounts requested per debug_accountRange
+// call, the most geth returns at once
+const stateDumpPageSize = 256
+
+// StateSnapshot is the execution layer state of a network at one block. It can
+// seed the genesis of a new network through Alloc, so benchmarks start from a
+// large state without generating it again.
+type StateSnapshot struct {
+	Block     uint64                 `json:"block"`
+	StateRoot string                 `json:"stateRoot"`
+	Client    string                 `json:"client"`
+	Taken     time.Time              `json:"taken"`
+	Accounts  []client.DumpedAccount `json:"accounts"`
+	// Skipped counts accounts left out because the client had no address for them
+	Skipped int `json:"skipped,omitempty"`
+}
+
+// SnapshotState dumps the state at the current head of a geth execution client.
+// Geth must run with --cache.preimages, otherwise it cannot report the
+// addresses and storage slots of accounts created after genesis.
+func (n *network) SnapshotState(ctx context.Context) (*StateSnapshot, error) {
+	el := n.stateDumpClient()
+	if el == nil {
+		return nil, ErrNoStateDumpClient
+	}
+	rpc := client.RPC(el)
+
+	block, err := rpc.GetBlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get head block: %w", el.Name(), err)
+	}
+	snapshot := &StateSnapshot{Block: block, Client: el.Name(), Taken: time.Now()}
+
+	start := ""
+	for {
+		page, err := rpc.AccountRange(ctx, block, start, stateDumpPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", el.Name(), err)
+		}
+		snapshot.StateRoot = page.Root
+		snapshot.Accounts = append(snapshot.Accounts, page.Accounts...)
+		snapshot.Skipped += page.Skipped
+		if page.Next == "" {
+			break
+		}
+		start = page.Next
+	}
+
+	if len(snapshot.Accounts) == 0 && snapshot.Skipped > 0 {
+		return nil, fmt.Errorf("%s: no account addresses in state dump, run geth with --cache.preimages", el.Name())
+	}
+	return snapshot, nil
+}
+
+// stateDumpClient returns the first geth execution client that is running
+func (n *network) stateDumpClient() client.ExecutionClient {
+	if n.executionClients == nil {
+		return nil
+	}
+	for _, el := range n.executionClients.Except(n.lateJoiners...) {
+		if el.Type() == client.Geth {
+			return el
+		}
+	}
+	return nil
+}
+
+// Alloc returns the snapshot as genesis accounts for WithPreloadedAccounts. The
+// deposit contract and the system contracts are left out, because every genesis
+// deploys them with state that must match its own consensus layer.
+func (s *StateSnapshot) Alloc() map[string]config.PreloadedAccount {
+	excluded := map[string]bool{}
+	for _, address := range []string{
+		config.DefaultDepositContractAddress,
+		client.BeaconRootsAddress,
+		client.HistoryStorageAddress,
+		client.WithdrawalRequestAddress,
+		client.ConsolidationRequestAddress,
+	} {
+		excluded[strings.ToLower(address)] = true
+	}
+
+	alloc := make(map[string]config.PreloadedAccount, len(s.Accounts))
+	for _, account := range s.Accounts {
+		if excluded[strings.ToLower(account.Address)] {
+			continue
+		}
+		alloc[account.Address] = config.PreloadedAccount{
+			Balance: account.Balance,
+			Nonce:   account.Nonce,
+			Code:    account.Code,
+			Storage: account.Storage,
+		}
+	}
+	return alloc
+}
+
+// WriteFile saves the snapshot as JSON
+func (s *StateSnapshot) WriteFile(path string) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to encode state snapshot: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write state snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadStateSnapshot reads a snapshot saved with WriteFile
+func LoadStateSnapshot(path string) (*StateSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state snapshot: %w", err)
+	}
+	var snapshot StateSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse state snapshot %s: %w", path, err)
+	}
+	return &snapshot, nil
+}
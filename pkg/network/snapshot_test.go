@@ -0,0 +1,98 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newStateDumpServer serves a state of two accounts and the deposit contract,
+// split over two debug_accountRange pages
+func newStateDumpServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var result interface{}
+		switch req.Method {
+		case "eth_blockNumber":
+			result = "0x64"
+		case "debug_accountRange":
+			assert.Equal(t, "0x64", req.Params[0])
+			page := map[string]interface{}{"root": "0xroot"}
+			if req.Params[1] == "0x" {
+				page["accounts"] = map[string]interface{}{
+					"a": map[string]interface{}{"balance": "100", "nonce": 1, "address": "0x00000000000000000000000000000000000000aa"},
+					"b": map[string]interface{}{"balance": "0", "address": config.DefaultDepositContractAddress, "code": "0x60"},
+				}
+				page["next"] = []byte{0x01}
+			} else {
+				assert.Equal(t, "0x01", req.Params[1])
+				page["accounts"] = map[string]interface{}{
+					"c": map[string]interface{}{"balance": "0", "code": "0x6001", "address": "0x00000000000000000000000000000000000000cc"},
+					"d": map[string]interface{}{"balance": "9"},
+				}
+			}
+			result = page
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+}
+
+func TestSnapshotState(t *testing.T) {
+	server := newStateDumpServer(t)
+	defer server.Close()
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Nethermind, "el-1-nethermind", "", "http://127.0.0.1:1", "", "", "", "", "el-1-nethermind", "", 0))
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-2-geth", "", server.URL, "", "", "", "", "el-2-geth", "", 0))
+	net := New(Config{Name: "test", ExecutionClients: executionClients, OrphanOnExit: true})
+
+	snapshot, err := net.SnapshotState(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), snapshot.Block)
+	assert.Equal(t, "0xroot", snapshot.StateRoot)
+	assert.Equal(t, "el-2-geth", snapshot.Client)
+	assert.Len(t, snapshot.Accounts, 3)
+	assert.Equal(t, 1, snapshot.Skipped)
+
+	alloc := snapshot.Alloc()
+	assert.Equal(t, map[string]config.PreloadedAccount{
+		"0x00000000000000000000000000000000000000aa": {Balance: "100", Nonce: 1},
+		"0x00000000000000000000000000000000000000cc": {Balance: "0", Code: "0x6001"},
+	}, alloc)
+
+	path := filepath.Join(t.TempDir(), "state.json")
+	require.NoError(t, snapshot.WriteFile(path))
+	loaded, err := LoadStateSnapshot(path)
+	require.NoError(t, err)
+	assert.Equal(t, alloc, loaded.Alloc())
+	assert.Equal(t, snapshot.Block, loaded.Block)
+}
+
+func TestSnapshotStateWithoutGeth(t *testing.T) {
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Besu, "el-1-besu", "", "http://127.0.0.1:1", "", "", "", "", "el-1-besu", "", 0))
+	net := New(Config{Name: "test", ExecutionClients: executionClients, OrphanOnExit: true})
+
+	_, err := net.SnapshotState(context.Background())
+	assert.ErrorIs(t, err, ErrNoStateDumpClient)
+}
@@ -0,0 +1,120 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+const (
+	// AttestationSubnetCount is the number of attestation subnets
+	AttestationSubnetCount = 64
+	// SyncCommitteeSubnetCount is the number of sync committee subnets
+	SyncCommitteeSubnetCount = 4
+)
+
+// ErrSubnetGap is returned when a subnet has no node subscribed to it
+var ErrSubnetGap = errors.New("subnet not covered")
+
+// SubnetCoverage maps each attestation and sync committee subnet to the
+// consensus clients subscribed to it
+type SubnetCoverage struct {
+	Attestation map[int][]string
+	Sync        map[int][]string
+}
+
+// AttestationGaps returns the attestation subnets no node subscribes to
+func (c *SubnetCoverage) AttestationGaps() []int {
+	return subnetGaps(c.Attestation, AttestationSubnetCount)
+}
+
+// SyncGaps returns the sync committee subnets no node subscribes to
+func (c *SubnetCoverage) SyncGaps() []int {
+	return subnetGaps(c.Sync, SyncCommitteeSubnetCount)
+}
+
+// Err returns an ErrSubnetGap error listing the uncovered subnets, or nil if
+// every subnet has a subscriber
+func (c *SubnetCoverage) Err() error {
+	var gaps []string
+	if attestation := c.AttestationGaps(); len(attestation) > 0 {
+		gaps = append(gaps, fmt.Sprintf("attestation subnets %s", joinInts(attestation)))
+	}
+	if sync := c.SyncGaps(); len(sync) > 0 {
+		gaps = append(gaps, fmt.Sprintf("sync committee subnets %s", joinInts(sync)))
+	}
+	if len(gaps) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrSubnetGap, strings.Join(gaps, "; "))
+}
+
+// SubnetCoverage fetches the attnets and syncnets metadata of every running
+// consensus client and maps which subnets they cover. Gaps slow down or stop
+// aggregation on small networks without any client reporting an error.
+func (n *network) SubnetCoverage(ctx context.Context) (*SubnetCoverage, error) {
+	if n.consensusClients == nil {
+		return nil, fmt.Errorf("no consensus clients available")
+	}
+
+	stopped := n.stoppedServices()
+	var clients []client.ConsensusClient
+	for _, cl := range n.consensusClients.All() {
+		if !stopped[cl.Name()] {
+			clients = append(clients, cl)
+		}
+	}
+
+	identities := make([]*client.NodeIdentity, len(clients))
+	errs := client.FanOut(ctx, n.limiter, clients, func(ctx context.Context, i int, cl client.ConsensusClient) error {
+		identity, err := cl.FetchIdentity(ctx)
+		identities[i] = identity
+		return err
+	})
+
+	coverage := &SubnetCoverage{Attestation: make(map[int][]string), Sync: make(map[int][]string)}
+	for i, cl := range clients {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("failed to fetch identity of %s: %w", cl.Name(), errs[i])
+		}
+		attestation, err := identities[i].AttestationSubnets()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", cl.Name(), err)
+		}
+		sync, err := identities[i].SyncSubnets()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", cl.Name(), err)
+		}
+		for _, subnet := range attestation {
+			coverage.Attestation[subnet] = append(coverage.Attestation[subnet], cl.Name())
+		}
+		for _, subnet := range sync {
+			coverage.Sync[subnet] = append(coverage.Sync[subnet], cl.Name())
+		}
+	}
+
+	return coverage, nil
+}
+
+// subnetGaps returns the subnets below count without subscribers
+func subnetGaps(subscribers map[int][]string, count int) []int {
+	var gaps []int
+	for subnet := 0; subnet < count; subnet++ {
+		if len(subscribers[subnet]) == 0 {
+			gaps = append(gaps, subnet)
+		}
+	}
+	return gaps
+}
+
+// joinInts formats numbers as a comma separated list
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprint(v)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,62 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSubnetNetwork runs a consensus client per attnets/syncnets pair
+func newSubnetNetwork(t *testing.T, metadata ...[2]string) Network {
+	t.Helper()
+	consensusClients := client.NewConsensusClients()
+	for i, m := range metadata {
+		attnets, syncnets := m[0], m[1]
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/eth/v1/node/identity", r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"data":{"peer_id":"16Uiu2","metadata":{"seq_number":"1","attnets":%q,"syncnets":%q}}}`, attnets, syncnets)
+		}))
+		t.Cleanup(server.Close)
+		name := fmt.Sprintf("cl-%d-lighthouse-geth", i+1)
+		consensusClients.Add(client.NewConsensusClient(client.Lighthouse, name, "", server.URL, "", "", "", name, "", 0))
+	}
+	return New(Config{Name: "test", ConsensusClients: consensusClients, OrphanOnExit: true})
+}
+
+func TestSubnetCoverage(t *testing.T) {
+	// Node 1 covers subnets 0-31 and sync subnets 0-1, node 2 subnets 32-62 and sync subnet 2
+	net := newSubnetNetwork(t,
+		[2]string{"0xffffffff00000000", "0x03"},
+		[2]string{"0x00000000ffffff7f", "0x04"},
+	)
+
+	coverage, err := net.SubnetCoverage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cl-1-lighthouse-geth"}, coverage.Attestation[0])
+	assert.Equal(t, []string{"cl-2-lighthouse-geth"}, coverage.Attestation[32])
+	assert.Equal(t, []int{63}, coverage.AttestationGaps())
+	assert.Equal(t, []int{3}, coverage.SyncGaps())
+
+	err = coverage.Err()
+	assert.ErrorIs(t, err, ErrSubnetGap)
+	assert.EqualError(t, err, "subnet not covered: attestation subnets 63; sync committee subnets 3")
+}
+
+func TestSubnetCoverage_Full(t *testing.T) {
+	net := newSubnetNetwork(t,
+		[2]string{"0xffffffffffffffff", "0x0f"},
+		[2]string{"0x0000000000000000", "0x00"},
+	)
+
+	coverage, err := net.SubnetCoverage(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, coverage.AttestationGaps())
+	assert.NoError(t, coverage.Err())
+}
@@ -0,0 +1,54 @@
+package network
+
+import (
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+)
+
+// Well-known participant tags
+const (
+	TagBootnode   = "bootnode"
+	TagLateJoiner = config.LateJoinerTag
+	TagSupernode  = "supernode"
+)
+
+// TaggedClients holds the execution and consensus clients of nodes sharing a tag
+type TaggedClients struct {
+	Execution []client.ExecutionClient
+	Consensus []client.ConsensusClient
+}
+
+// Len returns the total number of clients
+func (t TaggedClients) Len() int {
+	return len(t.Execution) + len(t.Consensus)
+}
+
+// ClientsByTag returns the clients of all nodes whose participant carries the tag
+func (n *network) ClientsByTag(tag string) TaggedClients {
+	var result TaggedClients
+	if n.executionClients != nil {
+		result.Execution = n.executionClients.Filter(func(c client.ExecutionClient) bool {
+			return n.hasTag(c.Name(), tag)
+		})
+	}
+	if n.consensusClients != nil {
+		result.Consensus = n.consensusClients.Filter(func(c client.ConsensusClient) bool {
+			return n.hasTag(c.Name(), tag)
+		})
+	}
+	return result
+}
+
+// Tags returns the tags of the node running the named service
+func (n *network) Tags(serviceName string) []string {
+	return n.tags[serviceName]
+}
+
+func (n *network) hasTag(serviceName, tag string) bool {
+	for _, t := range n.tags[serviceName] {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
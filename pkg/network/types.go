@@ -2,13 +2,27 @@ package network
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
 	"os"
 	"os/signal"
 	"runtime"
 	"sync"
 	"syscall"
+	"time"
 
+	"github.com/ethpandaops/ethereum-package-go/pkg/artifacts"
 	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/ethpandaops/ethereum-package-go/pkg/proxy"
+	"github.com/ethpandaops/ethereum-package-go/pkg/services"
+	"github.com/ethpandaops/ethereum-package-go/pkg/spam"
+	"github.com/ethpandaops/ethereum-package-go/pkg/wallet"
 )
 
 // ServiceType represents the type of service in the network
@@ -24,6 +38,12 @@ const (
 	ServiceTypeDora            ServiceType = "dora"
 	ServiceTypeApache          ServiceType = "apache"
 	ServiceTypeSpamoor         ServiceType = "spamoor"
+	ServiceTypeTracoor         ServiceType = "tracoor"
+	ServiceTypeForky           ServiceType = "forky"
+	ServiceTypeCheckpointz     ServiceType = "checkpointz"
+	ServiceTypeRelay           ServiceType = "mev-relay"
+	ServiceTypeMetricsExporter ServiceType = "metrics-exporter"
+	ServiceTypeSidecar         ServiceType = "sidecar"
 	ServiceTypeOther           ServiceType = "other"
 )
 
@@ -33,33 +53,192 @@ type Network interface {
 	Name() string
 	ChainID() uint64
 	EnclaveName() string
+	SpecPreset() config.SpecPreset
+	ConfigHash() string
+	Seed() int64
 
 	// Client accessors
 	ExecutionClients() *client.ExecutionClients
 	ConsensusClients() *client.ConsensusClients
+	// Deprecated: use ValidatorClients
+	Validators() []Validator
+	ValidatorClients() *client.ValidatorClients
+	Keymanager(ctx context.Context, validator string) (*client.KeymanagerClient, error)
+	ClientsByTag(tag string) TaggedClients
+	Tags(serviceName string) []string
 
 	// Service accessors
 	Services() []Service
+	InternalURL(service, port string) (string, error)
+	AddService(ctx context.Context, spec ServiceSpec) (Service, error)
+	AddSidecar(ctx context.Context, node int, sidecar config.Sidecar) (Service, error)
+	Capture(ctx context.Context, service string, d time.Duration) (*PacketCapture, error)
 	ApacheConfig() ApacheConfigServer
+	MetricsExporters() []*client.MetricsExporter
+	Tracoor() (*services.TracoorClient, error)
+	Forky() (*services.ForkyClient, error)
+	Checkpointz() (*services.CheckpointzClient, error)
+	Relay() (*services.RelayClient, error)
+	Health(ctx context.Context) map[string]error
+	WaitForService(ctx context.Context, name string, strategy client.WaitStrategy) error
+	ServiceExpectations(serviceType ServiceType) *ServiceWaitStrategy
+	VerifyMonitoring(ctx context.Context) error
+	ExportDashboards(ctx context.Context, dir string, panels ...GrafanaPanel) ([]string, error)
+	ContainerStates(ctx context.Context) (map[string]ContainerState, error)
+	CrashLoops(ctx context.Context) ([]CrashLoop, error)
+	Plan() *Plan
+	Validation() ValidationResults
+	AnalyzeLogs(ctx context.Context) ([]LogFinding, error)
+	SubnetCoverage(ctx context.Context) (*SubnetCoverage, error)
+	WriteBootstrapFiles(ctx context.Context, dir string) ([]string, error)
+
+	// Chain parameters
+	Genesis(ctx context.Context) (*client.Genesis, error)
+	GenesisValidatorsRoot(ctx context.Context) (string, error)
+	GenesisForkVersion(ctx context.Context) (string, error)
+	CurrentForkVersion(ctx context.Context) (string, error)
+
+	// Nodes
+	Participants() []Participant
+	Participant(node int) (Participant, bool)
+	ValidatorNode(index uint64) (Participant, bool)
+
+	// Block proposals
+	NodeValidators(node int) (config.ValidatorRange, bool)
+	NextProposalBy(ctx context.Context, node int) (*Proposal, error)
+	NextProposalByValidator(ctx context.Context, index uint64) (*Proposal, error)
+	WaitForProposalFrom(ctx context.Context, node int) (*Proposal, error)
+
+	// Attestation inclusion
+	TrackAttestations(ctx context.Context, validators []uint64, epoch uint64) (*AttestationReport, error)
+	TrackNodeAttestations(ctx context.Context, node int, epoch uint64) (*AttestationReport, error)
+
+	// Proposer payments
+	VerifyFeeRecipients(ctx context.Context, from, to uint64) (*FeeRecipientReport, error)
+	AttributePayloads(ctx context.Context, from, to uint64, builderExtraData ...string) (*PayloadReport, error)
+
+	// Consensus debugging
+	CompareForkChoice(ctx context.Context) (*ForkChoiceComparison, error)
+	CrossValidateBlocks(ctx context.Context, from, to uint64) (*ConsistencyReport, error)
+	Proxy(c interface{ Name() string }) (*proxy.Proxy, error)
+	StreamBlocks(ctx context.Context) (<-chan StreamedBlock, <-chan error)
+	MeasurePropagation(ctx context.Context, d time.Duration) (*PropagationReport, error)
+	CheckTxPropagation(ctx context.Context, from client.ExecutionClient, rawTx string, timeout time.Duration) (*TxPropagation, error)
+
+	// Historical state
+	ArchiveClients(ctx context.Context) []client.ExecutionClient
+	ArchiveClient(ctx context.Context) (client.ExecutionClient, error)
+	HistoricalBalance(ctx context.Context, address string, block uint64) (*big.Int, error)
+	TraceTransaction(ctx context.Context, hash string) (json.RawMessage, error)
+	SnapshotState(ctx context.Context) (*StateSnapshot, error)
+	SnapshotEnclave(ctx context.Context, name string) (*EnclaveSnapshot, error)
+	RestoreEnclave(ctx context.Context, snapshot *EnclaveSnapshot) error
+
+	// Engine API authentication
+	JWTSecret(ctx context.Context) (string, error)
+
+	// Test accounts
+	Faucet() (*wallet.Signer, error)
+	NewFundedAccount(ctx context.Context, amount *big.Int) (*wallet.Signer, error)
+	FundedAccounts() []*wallet.Signer
+	Accounts() (*wallet.Manager, error)
+	Spammer() (*spam.Spammer, error)
+
+	// Startup milestones
+	Milestones() *Milestones
+	TrackMilestones(ctx context.Context, interval time.Duration) error
+	WaitForFinality(ctx context.Context, minEpoch uint64, opts ...FinalityWaitOption) (*client.Checkpoint, error)
+	RequireFinalityWithin(ctx context.Context, d time.Duration) error
+
+	// Late-joining nodes
+	LateJoiners() []string
+	StartLateJoiners(ctx context.Context) error
+
+	// Chaos testing
+	Chaos() *Chaos
 
 	// Lifecycle management
+	ReadOnly() bool
+	Policy() *Policy
 	Stop(ctx context.Context) error
 	Cleanup(ctx context.Context) error
 }
 
 // network is the concrete implementation of Network
 type network struct {
-	name             string
-	chainID          uint64
-	enclaveName      string
-	executionClients *client.ExecutionClients
-	consensusClients *client.ConsensusClients
-	services         []Service
-	apacheConfig     ApacheConfigServer
-	cleanupFunc      func(context.Context) error
-	orphanOnExit     bool
-	cleanupOnce      sync.Once
-	signalHandler    func()
+	name                string
+	chainID             uint64
+	enclaveName         string
+	specPreset          config.SpecPreset
+	configHash          string
+	executionClients    *client.ExecutionClients
+	consensusClients    *client.ConsensusClients
+	validatorClients    *client.ValidatorClients
+	services            []Service
+	servicesMu          sync.RWMutex
+	addServiceFunc      func(context.Context, ServiceSpec) (Service, error)
+	captureFunc         func(context.Context, string, time.Duration, io.Writer) error
+	readFileFunc        func(context.Context, string, string) ([]byte, error)
+	apacheConfig        ApacheConfigServer
+	tags                map[string][]string
+	lateJoiners         []string
+	validatorRanges     map[int]config.ValidatorRange
+	feeRecipients       map[int]string
+	startServiceFunc    func(context.Context, string) error
+	stopServiceFunc     func(context.Context, string) error
+	containerStatesFunc func(context.Context) (map[string]ContainerState, error)
+	maxRestarts         int
+	logsFunc            func(context.Context, string, int) ([]string, error)
+	snapshotFunc        func(context.Context, []ServiceDir) error
+	restoreFunc         func(context.Context, []ServiceDir) error
+	logHealthPatterns   []LogPattern
+	limiter             *client.Limiter
+	cleanupFunc         func(context.Context) error
+	orphanOnExit        bool
+	cleanupOnce         sync.Once
+	signalHandler       func()
+
+	lateJoinMu         sync.Mutex
+	lateJoinersStarted bool
+
+	genesisMu sync.Mutex
+	genesis   *client.Genesis
+
+	proxyMu sync.Mutex
+	proxies map[string]*proxy.Proxy
+
+	milestones *Milestones
+
+	archiveMu sync.Mutex
+	archive   client.ExecutionClient
+
+	accountsMu sync.Mutex
+	faucetKey  string
+	faucet     *wallet.Signer
+	accounts   []*wallet.Signer
+	prefunded  *wallet.Manager
+
+	seed    int64
+	keyRand *rand.Rand
+
+	tlsConfig       *tls.Config
+	probeTimeout    time.Duration
+	credentialsFunc func(serviceName string) *client.Credentials
+
+	created       time.Time
+	artifactsDir  string
+	grafanaPanels []GrafanaPanel
+	retention     artifacts.RetentionPolicy
+
+	metricsExporters []*client.MetricsExporter
+
+	plan       *Plan
+	validation ValidationResults
+
+	readOnly bool
+	policy   *Policy
+
+	chaos *Chaos
 }
 
 // Config holds configuration for creating a new network
@@ -67,30 +246,152 @@ type Config struct {
 	Name             string
 	ChainID          uint64
 	EnclaveName      string
+	SpecPreset       config.SpecPreset
+	ConfigHash       string // hash of the config and package version the network was deployed with
 	ExecutionClients *client.ExecutionClients
 	ConsensusClients *client.ConsensusClients
+	ValidatorClients *client.ValidatorClients
+	// Deprecated: Validators are added to ValidatorClients
+	Validators       []Validator
 	Services         []Service
 	ApacheConfig     ApacheConfigServer
+	Tags             map[string][]string           // participant tags keyed by client service name
+	LateJoiners      []string                      // service names of late-joining nodes in start order
+	ValidatorRanges  map[int]config.ValidatorRange // genesis validator indices keyed by 1-based node index
+	FeeRecipients    map[int]string                // configured fee recipients keyed by 1-based node index
+	StartServiceFunc func(ctx context.Context, serviceName string) error
+	// StopServiceFunc stops a running service in the enclave, for Chaos
+	StopServiceFunc func(ctx context.Context, serviceName string) error
+	// AddServiceFunc deploys an extra container into the enclave
+	AddServiceFunc func(ctx context.Context, spec ServiceSpec) (Service, error)
+	// CaptureFunc writes a pcap of a service's traffic over the duration to w
+	CaptureFunc func(ctx context.Context, service string, d time.Duration, w io.Writer) error
+	// ReadFileFunc returns the contents of a file inside a service's container
+	ReadFileFunc func(ctx context.Context, service, path string) ([]byte, error)
+	// ContainerStatesFunc inspects the containers of every service, keyed by service name
+	ContainerStatesFunc func(ctx context.Context) (map[string]ContainerState, error)
+	// MaxRestarts is how often a service may restart before Health reports it; negative disables the check
+	MaxRestarts int
+	// LogsFunc returns the last lines of a service's logs
+	LogsFunc func(ctx context.Context, service string, lines int) ([]string, error)
+	// SnapshotFunc saves service directories as files artifacts, with the
+	// services stopped
+	SnapshotFunc func(ctx context.Context, dirs []ServiceDir) error
+	// RestoreFunc replaces service directories with their files artifacts
+	RestoreFunc func(ctx context.Context, dirs []ServiceDir) error
+	// LogHealthPatterns are the log patterns Health reports clients as degraded
+	// for; nil disables log analysis
+	LogHealthPatterns []LogPattern
+	// FaucetKey is the hex private key NewFundedAccount funds accounts from; wallet.DefaultFaucetKey when empty
+	FaucetKey   string
+	FanoutLimit int // max concurrent calls for network-wide operations; 0 uses client.DefaultFanoutLimit
+	// Seed makes randomized behavior such as Random client choice, account keys and
+	// proxy faults reproducible; 0 picks a seed from the clock
+	Seed int64
+	// TLSConfig is used by readiness probes of https endpoints; nil uses the system defaults
+	TLSConfig *tls.Config
+	// ProbeTimeout is the per-request timeout of readiness probes; 5 seconds when zero
+	ProbeTimeout time.Duration
+	// CredentialsFunc returns the credentials readiness probes send to a service; nil sends none
+	CredentialsFunc func(serviceName string) *client.Credentials
+	// ArtifactsDir receives rendered Grafana panels when the network is cleaned
+	// up; empty disables the export. GrafanaPanels selects the panels, all
+	// dashboards when empty.
+	ArtifactsDir  string
+	GrafanaPanels []GrafanaPanel
+	// ArtifactsRetention prunes ArtifactsDir after the export
+	ArtifactsRetention artifacts.RetentionPolicy
+	// MetricsExporters are the network's ethereum-metrics-exporter instances
+	MetricsExporters []*client.MetricsExporter
 	CleanupFunc      func(context.Context) error
 	OrphanOnExit     bool
+	// ReadOnly disables the operations that change the network, and makes
+	// Cleanup a no-op
+	ReadOnly bool
+	// Policy decides which operations are allowed; nil allows all
+	Policy *Policy
+	// Plan is what a dry run would have deployed; nil for real deployments
+	Plan *Plan
+	// Validation is the package's validation feedback that didn't stop the run
+	Validation ValidationResults
 }
 
 // New creates a new Network instance
 func New(config Config) Network {
 	n := &network{
-		name:             config.Name,
-		chainID:          config.ChainID,
-		enclaveName:      config.EnclaveName,
-		executionClients: config.ExecutionClients,
-		consensusClients: config.ConsensusClients,
-		services:         config.Services,
-		apacheConfig:     config.ApacheConfig,
-		cleanupFunc:      config.CleanupFunc,
-		orphanOnExit:     config.OrphanOnExit,
+		name:                config.Name,
+		chainID:             config.ChainID,
+		enclaveName:         config.EnclaveName,
+		specPreset:          config.SpecPreset,
+		configHash:          config.ConfigHash,
+		executionClients:    config.ExecutionClients,
+		consensusClients:    config.ConsensusClients,
+		validatorClients:    config.ValidatorClients,
+		services:            config.Services,
+		apacheConfig:        config.ApacheConfig,
+		tags:                config.Tags,
+		lateJoiners:         config.LateJoiners,
+		validatorRanges:     config.ValidatorRanges,
+		feeRecipients:       config.FeeRecipients,
+		startServiceFunc:    config.StartServiceFunc,
+		stopServiceFunc:     config.StopServiceFunc,
+		addServiceFunc:      config.AddServiceFunc,
+		captureFunc:         config.CaptureFunc,
+		readFileFunc:        config.ReadFileFunc,
+		containerStatesFunc: config.ContainerStatesFunc,
+		maxRestarts:         config.MaxRestarts,
+		logsFunc:            config.LogsFunc,
+		snapshotFunc:        config.SnapshotFunc,
+		restoreFunc:         config.RestoreFunc,
+		logHealthPatterns:   config.LogHealthPatterns,
+		limiter:             client.NewLimiter(config.FanoutLimit),
+		cleanupFunc:         config.CleanupFunc,
+		orphanOnExit:        config.OrphanOnExit,
+		milestones:          NewMilestones(),
+		faucetKey:           config.FaucetKey,
+		seed:                config.Seed,
+		tlsConfig:           config.TLSConfig,
+		probeTimeout:        config.ProbeTimeout,
+		credentialsFunc:     config.CredentialsFunc,
+		created:             time.Now(),
+		artifactsDir:        config.ArtifactsDir,
+		grafanaPanels:       config.GrafanaPanels,
+		retention:           config.ArtifactsRetention,
+		metricsExporters:    config.MetricsExporters,
+		plan:                config.Plan,
+		validation:          config.Validation,
+		readOnly:            config.ReadOnly,
+		policy:              config.Policy,
+	}
+	if n.policy == nil {
+		n.policy = NewPolicy()
+	}
+	n.chaos = &Chaos{n: n, stopped: make(map[string]bool)}
+	if n.seed == 0 {
+		n.seed = time.Now().UnixNano()
+	}
+	n.keyRand = rand.New(rand.NewSource(DeriveSeed(n.seed, "accounts")))
+
+	// Share one limiter across all network-wide operations
+	if n.executionClients != nil {
+		n.executionClients.SetLimiter(n.limiter)
+		n.executionClients.SetSeed(DeriveSeed(n.seed, "execution-clients"))
+	}
+	if n.consensusClients != nil {
+		n.consensusClients.SetLimiter(n.limiter)
+		n.consensusClients.SetSeed(DeriveSeed(n.seed, "consensus-clients"))
 	}
+	if n.validatorClients == nil {
+		n.validatorClients = client.NewValidatorClients()
+	}
+	for _, v := range config.Validators {
+		n.validatorClients.Add(v)
+	}
+	n.validatorClients.SetLimiter(n.limiter)
+	n.validatorClients.SetSeed(DeriveSeed(n.seed, "validator-clients"))
 
 	// Set up automatic cleanup on process exit unless orphaned
-	if !config.OrphanOnExit {
+	if !config.OrphanOnExit && !config.ReadOnly {
 		n.setupAutoCleanup()
 		// Set up a finalizer as last resort cleanup
 		runtime.SetFinalizer(n, (*network).finalize)
@@ -102,22 +403,75 @@ func New(config Config) Network {
 func (n *network) Name() string                               { return n.name }
 func (n *network) ChainID() uint64                            { return n.chainID }
 func (n *network) EnclaveName() string                        { return n.enclaveName }
+func (n *network) ConfigHash() string                         { return n.configHash }
 func (n *network) ExecutionClients() *client.ExecutionClients { return n.executionClients }
 func (n *network) ConsensusClients() *client.ConsensusClients { return n.consensusClients }
-func (n *network) Services() []Service                        { return n.services }
-func (n *network) ApacheConfig() ApacheConfigServer           { return n.apacheConfig }
+func (n *network) ValidatorClients() *client.ValidatorClients { return n.validatorClients }
+func (n *network) Validators() []Validator                    { return n.validatorClients.All() }
+
+// Services returns the network's services, including ones added with AddService
+func (n *network) Services() []Service {
+	n.servicesMu.RLock()
+	defer n.servicesMu.RUnlock()
+	return append([]Service(nil), n.services...)
+}
+
+func (n *network) ApacheConfig() ApacheConfigServer { return n.apacheConfig }
+
+// MetricsExporters returns the network's ethereum-metrics-exporter instances in name order
+func (n *network) MetricsExporters() []*client.MetricsExporter {
+	return append([]*client.MetricsExporter(nil), n.metricsExporters...)
+}
+
+// SpecPreset returns the consensus spec preset, defaulting to mainnet
+func (n *network) SpecPreset() config.SpecPreset {
+	if n.specPreset == "" {
+		return config.SpecPresetMainnet
+	}
+	return n.specPreset
+}
 
 func (n *network) Stop(ctx context.Context) error {
+	if err := n.checkAllowed(OperationCleanup, "Stop"); err != nil {
+		return err
+	}
 	// In a real implementation, this would stop the Kurtosis enclave
 	// For now, we'll just return nil
 	return nil
 }
 
 func (n *network) Cleanup(ctx context.Context) error {
+	// The enclave belongs to whoever deployed it
+	if n.readOnly {
+		return nil
+	}
+	if err := n.checkAllowed(OperationCleanup, "Cleanup"); err != nil {
+		return err
+	}
+	return n.cleanup(ctx)
+}
+
+// cleanup tears the network down without consulting the policy, for the
+// library's own teardown on signals and finalization
+func (n *network) cleanup(ctx context.Context) error {
+	// The enclave belongs to whoever deployed it
+	if n.readOnly {
+		return nil
+	}
 	var err error
 	n.cleanupOnce.Do(func() {
+		n.closeProxies()
+		// Render dashboards while Grafana is still running
+		if n.artifactsDir != "" {
+			if _, exportErr := n.ExportDashboards(ctx, n.artifactsDir, n.grafanaPanels...); exportErr != nil {
+				err = fmt.Errorf("failed to export dashboards: %w", exportErr)
+			}
+			if _, pruneErr := artifacts.Prune(n.artifactsDir, n.retention); pruneErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to prune artifacts: %w", pruneErr))
+			}
+		}
 		if n.cleanupFunc != nil {
-			err = n.cleanupFunc(ctx)
+			err = errors.Join(err, n.cleanupFunc(ctx))
 		}
 		// Remove signal handler if it exists
 		if n.signalHandler != nil {
@@ -138,7 +492,7 @@ func (n *network) setupAutoCleanup() {
 	go func() {
 		<-sigChan
 		ctx := context.Background()
-		_ = n.Cleanup(ctx) // Best effort cleanup on signal
+		_ = n.cleanup(ctx) // Best effort cleanup on signal
 		os.Exit(0)
 	}()
 
@@ -152,6 +506,6 @@ func (n *network) setupAutoCleanup() {
 func (n *network) finalize() {
 	if !n.orphanOnExit {
 		ctx := context.Background()
-		_ = n.Cleanup(ctx) // Best effort cleanup
+		_ = n.cleanup(ctx) // Best effort cleanup
 	}
 }
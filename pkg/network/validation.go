@@ -0,0 +1,115 @@
+package network
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+)
+
+// ValidationResult is a piece of feedback ethereum-package gave on the run's
+// configuration. Errors stop the run; warnings are settings it accepted but
+// flagged, such as deprecated fields.
+type ValidationResult struct {
+	// Path is the configuration field the feedback is about, such as
+	// "participants[0].cl_type", or empty if the message names none
+	Path     string
+	Severity config.Severity
+	Message  string
+}
+
+// String returns the message, which already names the path
+func (r ValidationResult) String() string {
+	return r.Message
+}
+
+// validationPathPattern matches the dotted or indexed configuration fields
+// package messages refer to, such as network_params.seconds_per_slot
+var validationPathPattern = regexp.MustCompile(`\b[a-z][a-z0-9]*(?:_[a-z0-9]+)*(?:\[\d+\])?(?:\.[a-z][a-z0-9_]*(?:\[\d+\])?)+|\b[a-z][a-z0-9]*(?:_[a-z0-9]+)+(?:\[\d+\])?`)
+
+// NewValidationResult builds a result from a package message, taking the
+// first configuration field it mentions as the path
+func NewValidationResult(severity config.Severity, message string) ValidationResult {
+	message = strings.TrimSpace(message)
+	return ValidationResult{
+		Path:     validationPathPattern.FindString(message),
+		Severity: severity,
+		Message:  message,
+	}
+}
+
+// ValidationResults is the validation feedback of a run
+type ValidationResults []ValidationResult
+
+// Errors returns the results that stopped the run
+func (rs ValidationResults) Errors() ValidationResults {
+	return rs.bySeverity(config.SeverityError)
+}
+
+// Warnings returns the results that didn't stop the run
+func (rs ValidationResults) Warnings() ValidationResults {
+	return rs.bySeverity(config.SeverityWarning)
+}
+
+func (rs ValidationResults) bySeverity(severity config.Severity) ValidationResults {
+	var result ValidationResults
+	for _, r := range rs {
+		if r.Severity == severity {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// Without drops the warnings about the given paths, or every warning if no
+// paths are given. A path also covers the fields below it.
+func (rs ValidationResults) Without(paths ...string) ValidationResults {
+	var result ValidationResults
+	for _, r := range rs {
+		if r.Severity != config.SeverityWarning || !coversPath(paths, r.Path) {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// coversPath reports whether path is one of paths or below one of them
+func coversPath(paths []string, path string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, p := range paths {
+		if path == p || strings.HasPrefix(path, p+".") || strings.HasPrefix(path, p+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns a *ValidationError holding the errors, or nil if there are none
+func (rs ValidationResults) Err() error {
+	errs := rs.Errors()
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Results: errs}
+}
+
+// ValidationError is returned when ethereum-package rejects a configuration
+type ValidationError struct {
+	Results ValidationResults
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Results))
+	for i, r := range e.Results {
+		messages[i] = r.String()
+	}
+	return "ethereum-package validation errors: " + strings.Join(messages, "; ")
+}
+
+// Validation returns the warnings ethereum-package gave on the network's
+// configuration
+func (n *network) Validation() ValidationResults {
+	return n.validation
+}
@@ -0,0 +1,53 @@
+package network
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValidationResult(t *testing.T) {
+	tests := []struct {
+		message string
+		path    string
+	}{
+		{"participants[0].cl_type 'foo' is not supported", "participants[0].cl_type"},
+		{"Unsupported el_type 'foo'", "el_type"},
+		{"network_params.seconds_per_slot must be at least 1", "network_params.seconds_per_slot"},
+		{"Invalid value given", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			result := NewValidationResult(config.SeverityError, tt.message)
+			assert.Equal(t, tt.path, result.Path)
+			assert.Equal(t, tt.message, result.Message)
+		})
+	}
+}
+
+func TestValidationResults(t *testing.T) {
+	results := ValidationResults{
+		NewValidationResult(config.SeverityWarning, "participants[0].el_extra_params is deprecated"),
+		NewValidationResult(config.SeverityWarning, "network_params.preset is deprecated"),
+		NewValidationResult(config.SeverityError, "Unsupported cl_type 'foo'"),
+	}
+
+	assert.Len(t, results.Warnings(), 2)
+	assert.Len(t, results.Errors(), 1)
+
+	// Paths cover the fields below them and errors are never dropped
+	kept := results.Without("participants")
+	require.Len(t, kept, 2)
+	assert.Equal(t, "network_params.preset", kept[0].Path)
+	assert.Equal(t, results.Errors(), results.Without())
+	assert.Len(t, results.Without("participant"), 3)
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(results.Err(), &validationErr))
+	assert.Equal(t, "ethereum-package validation errors: Unsupported cl_type 'foo'", validationErr.Error())
+	assert.NoError(t, results.Warnings().Err())
+}
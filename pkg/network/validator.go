@@ -0,0 +1,16 @@
+package network
+
+import "github.com/ethpandaops/ethereum-package-go/pkg/client"
+
+// Validator represents a standalone validator client process.
+//
+// Deprecated: Validator is an alias of client.ValidatorClient, which
+// Network.ValidatorClients returns along with each client's type and key count.
+type Validator = client.ValidatorClient
+
+// NewValidator creates a validator client of unknown type running no genesis keys.
+//
+// Deprecated: use client.NewValidatorClient.
+func NewValidator(name, apiURL, metricsURL, serviceName, containerID string) Validator {
+	return client.NewValidatorClient(client.Unknown, name, serviceName, containerID, apiURL, metricsURL, 0)
+}
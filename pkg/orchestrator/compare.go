@@ -0,0 +1,226 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+// Variant describes one side of a comparative run
+type Variant struct {
+	// Name identifies the variant and is used as the enclave name
+	Name string
+
+	// Options are applied after the shared options, so they can override
+	// the package version, client images or anything else
+	Options []ethereum.RunOption
+}
+
+// PackageVersionVariant returns a variant that runs the given ethereum-package version
+func PackageVersionVariant(name, version string) Variant {
+	return Variant{
+		Name:    name,
+		Options: []ethereum.RunOption{ethereum.WithPackageVersion(version)},
+	}
+}
+
+// CompareOption configures a comparative run
+type CompareOption func(*compareConfig)
+
+// compareConfig holds configuration for Compare
+type compareConfig struct {
+	finalityTimeout  time.Duration
+	finalityInterval time.Duration
+	runFunc          func(ctx context.Context, opts ...ethereum.RunOption) (network.Network, error)
+	finalityFunc     func(ctx context.Context, net network.Network, interval time.Duration) error
+	healthFunc       func(ctx context.Context, net network.Network) map[string]error
+}
+
+// WithFinalityTimeout enables finality measurement, waiting at most timeout
+// for each network to finalize its first epoch
+func WithFinalityTimeout(timeout time.Duration) CompareOption {
+	return func(cfg *compareConfig) {
+		cfg.finalityTimeout = timeout
+	}
+}
+
+// WithFinalityPollInterval sets how often finality checkpoints are polled
+func WithFinalityPollInterval(interval time.Duration) CompareOption {
+	return func(cfg *compareConfig) {
+		cfg.finalityInterval = interval
+	}
+}
+
+// RunReport holds the measurements for a single variant
+type RunReport struct {
+	Name         string
+	StartupTime  time.Duration
+	FinalityTime time.Duration
+	Finalized    bool
+	ErrorCount   int
+	Errors       []error
+}
+
+// ComparisonReport compares the measurements of two variants
+type ComparisonReport struct {
+	Baseline  RunReport
+	Candidate RunReport
+}
+
+// StartupDelta returns how much slower (positive) or faster (negative) the
+// candidate started compared to the baseline
+func (r *ComparisonReport) StartupDelta() time.Duration {
+	return r.Candidate.StartupTime - r.Baseline.StartupTime
+}
+
+// FinalityDelta returns how much slower (positive) or faster (negative) the
+// candidate finalized compared to the baseline
+func (r *ComparisonReport) FinalityDelta() time.Duration {
+	return r.Candidate.FinalityTime - r.Baseline.FinalityTime
+}
+
+// String renders the report as a small human readable table
+func (r *ComparisonReport) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%-20s %15s %15s %8s\n", "variant", "startup", "finality", "errors")
+	for _, rep := range []RunReport{r.Baseline, r.Candidate} {
+		finality := "n/a"
+		if rep.Finalized {
+			finality = rep.FinalityTime.Round(time.Millisecond).String()
+		}
+		fmt.Fprintf(&sb, "%-20s %15s %15s %8d\n",
+			rep.Name, rep.StartupTime.Round(time.Millisecond), finality, rep.ErrorCount)
+	}
+
+	return sb.String()
+}
+
+// Comparison holds the paired networks of a comparative run and its report
+type Comparison struct {
+	Baseline  network.Network
+	Candidate network.Network
+	Report    *ComparisonReport
+}
+
+// Cleanup tears down both networks
+func (c *Comparison) Cleanup(ctx context.Context) error {
+	var errs []error
+	for _, net := range []network.Network{c.Baseline, c.Candidate} {
+		if net == nil {
+			continue
+		}
+		if err := net.Cleanup(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to cleanup network %s: %w", net.EnclaveName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Compare deploys the same configuration under two variants into separate
+// enclaves and reports how they differ in startup time, finality time and
+// error counts. Error counts include every client Health reports as failing
+// once startup and finality measurement are done. Networks that fail to start are reported rather than
+// returned as an error, so a broken candidate still yields a report.
+func Compare(ctx context.Context, shared []ethereum.RunOption, baseline, candidate Variant, opts ...CompareOption) (*Comparison, error) {
+	cfg := &compareConfig{
+		finalityInterval: 6 * time.Second,
+		runFunc:          ethereum.Run,
+		finalityFunc:     waitForFirstFinalizedEpoch,
+		healthFunc:       network.Network.Health,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if baseline.Name == "" || candidate.Name == "" {
+		return nil, fmt.Errorf("both variants require a name")
+	}
+	if baseline.Name == candidate.Name {
+		return nil, fmt.Errorf("variant names must differ, got %s twice", baseline.Name)
+	}
+
+	comparison := &Comparison{Report: &ComparisonReport{}}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		comparison.Baseline, comparison.Report.Baseline = cfg.runVariant(ctx, shared, baseline)
+	}()
+	go func() {
+		defer wg.Done()
+		comparison.Candidate, comparison.Report.Candidate = cfg.runVariant(ctx, shared, candidate)
+	}()
+	wg.Wait()
+
+	return comparison, nil
+}
+
+// runVariant deploys a single variant and collects its measurements
+func (cfg *compareConfig) runVariant(ctx context.Context, shared []ethereum.RunOption, variant Variant) (network.Network, RunReport) {
+	report := RunReport{Name: variant.Name}
+
+	runOpts := make([]ethereum.RunOption, 0, len(shared)+len(variant.Options)+1)
+	runOpts = append(runOpts, shared...)
+	runOpts = append(runOpts, ethereum.WithEnclaveName(variant.Name))
+	runOpts = append(runOpts, variant.Options...)
+
+	start := time.Now()
+	net, err := cfg.runFunc(ctx, runOpts...)
+	report.StartupTime = time.Since(start)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Errorf("startup failed: %w", err))
+	}
+
+	if net != nil && err == nil && cfg.finalityTimeout > 0 {
+		finalityCtx, cancel := context.WithTimeout(ctx, cfg.finalityTimeout)
+		if err := cfg.finalityFunc(finalityCtx, net, cfg.finalityInterval); err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("finality not reached: %w", err))
+		} else {
+			report.Finalized = true
+			report.FinalityTime = time.Since(start)
+		}
+		cancel()
+	}
+
+	if net != nil && err == nil {
+		report.Errors = append(report.Errors, clientErrors(cfg.healthFunc(ctx, net))...)
+	}
+
+	report.ErrorCount = len(report.Errors)
+
+	return net, report
+}
+
+// waitForFirstFinalizedEpoch waits until an epoch beyond genesis has been finalized
+func waitForFirstFinalizedEpoch(ctx context.Context, net network.Network, interval time.Duration) error {
+	_, err := net.WaitForFinality(ctx, 0, network.WithFinalityPollInterval(interval))
+	return err
+}
+
+// clientErrors flattens a Health result into one error per failing client,
+// ordered by client name
+func clientErrors(health map[string]error) []error {
+	names := make([]string, 0, len(health))
+	for name, err := range health {
+		if err != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	errs := make([]error, 0, len(names))
+	for _, name := range names {
+		errs = append(errs, fmt.Errorf("client %s: %w", name, health[name]))
+	}
+	return errs
+}
@@ -0,0 +1,118 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	runFunc := func(ctx context.Context, opts ...ethereum.RunOption) (network.Network, error) {
+		cfg := &ethereum.RunConfig{}
+		for _, opt := range opts {
+			opt(cfg)
+		}
+		if cfg.PackageVersion == "broken" {
+			return nil, errors.New("interpretation error")
+		}
+
+		return network.New(network.Config{EnclaveName: cfg.EnclaveName, OrphanOnExit: true}), nil
+	}
+
+	comparison, err := Compare(context.Background(),
+		[]ethereum.RunOption{ethereum.Minimal()},
+		PackageVersionVariant("baseline", "5.0.1"),
+		PackageVersionVariant("candidate", "broken"),
+		WithFinalityTimeout(time.Second),
+		func(cfg *compareConfig) {
+			cfg.runFunc = runFunc
+			cfg.finalityFunc = func(ctx context.Context, net network.Network, interval time.Duration) error {
+				return nil
+			}
+			cfg.healthFunc = func(ctx context.Context, net network.Network) map[string]error {
+				return nil
+			}
+		},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, comparison.Baseline)
+	assert.Nil(t, comparison.Candidate)
+
+	report := comparison.Report
+	assert.Equal(t, "baseline", report.Baseline.Name)
+	assert.Equal(t, "baseline", comparison.Baseline.EnclaveName())
+	assert.True(t, report.Baseline.Finalized)
+	assert.Equal(t, 0, report.Baseline.ErrorCount)
+
+	assert.Equal(t, "candidate", report.Candidate.Name)
+	assert.False(t, report.Candidate.Finalized)
+	assert.Equal(t, 1, report.Candidate.ErrorCount)
+	assert.Contains(t, report.Candidate.Errors[0].Error(), "interpretation error")
+
+	assert.Contains(t, report.String(), "baseline")
+	assert.Contains(t, report.String(), "n/a")
+	assert.NoError(t, comparison.Cleanup(context.Background()))
+}
+
+func TestCompare_FinalityFailure(t *testing.T) {
+	comparison, err := Compare(context.Background(), nil,
+		Variant{Name: "a"},
+		Variant{Name: "b"},
+		WithFinalityTimeout(time.Second),
+		func(cfg *compareConfig) {
+			cfg.runFunc = func(ctx context.Context, opts ...ethereum.RunOption) (network.Network, error) {
+				return network.New(network.Config{OrphanOnExit: true}), nil
+			}
+			cfg.finalityFunc = func(ctx context.Context, net network.Network, interval time.Duration) error {
+				return context.DeadlineExceeded
+			}
+			cfg.healthFunc = func(ctx context.Context, net network.Network) map[string]error {
+				return nil
+			}
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 1, comparison.Report.Baseline.ErrorCount)
+	assert.Equal(t, 1, comparison.Report.Candidate.ErrorCount)
+	assert.Equal(t, time.Duration(0), comparison.Report.FinalityDelta())
+}
+
+func TestCompare_ClientErrors(t *testing.T) {
+	comparison, err := Compare(context.Background(), nil,
+		Variant{Name: "a"},
+		Variant{Name: "b"},
+		func(cfg *compareConfig) {
+			cfg.runFunc = func(ctx context.Context, opts ...ethereum.RunOption) (network.Network, error) {
+				return network.New(network.Config{OrphanOnExit: true}), nil
+			}
+			cfg.healthFunc = func(ctx context.Context, net network.Network) map[string]error {
+				return map[string]error{
+					"el-2-besu-teku":       errors.New("probe failed"),
+					"cl-1-lighthouse-geth": network.ErrCrashLoop,
+					"el-1-geth-lighthouse": nil,
+				}
+			}
+		},
+	)
+	require.NoError(t, err)
+
+	report := comparison.Report.Baseline
+	assert.Equal(t, 2, report.ErrorCount)
+	assert.ErrorIs(t, report.Errors[0], network.ErrCrashLoop)
+	assert.Contains(t, report.Errors[0].Error(), "cl-1-lighthouse-geth")
+	assert.Contains(t, report.Errors[1].Error(), "el-2-besu-teku")
+}
+
+func TestCompare_InvalidVariants(t *testing.T) {
+	_, err := Compare(context.Background(), nil, Variant{Name: "a"}, Variant{})
+	assert.ErrorContains(t, err, "both variants require a name")
+
+	_, err = Compare(context.Background(), nil, Variant{Name: "a"}, Variant{Name: "a"})
+	assert.ErrorContains(t, err, "variant names must differ")
+}
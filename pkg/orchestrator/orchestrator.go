@@ -0,0 +1,229 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+// DefaultParallelism is the default number of networks provisioned concurrently
+const DefaultParallelism = 2
+
+// teardownTimeout bounds tearing down started networks after RunMany fails
+const teardownTimeout = 5 * time.Minute
+
+// NetworkSpec describes a single network to provision
+type NetworkSpec struct {
+	// Name identifies the network in the returned set and is used as the
+	// enclave name unless the options override it
+	Name string
+
+	// Options are passed through to ethereum.Run
+	Options []ethereum.RunOption
+}
+
+// Option configures how RunMany provisions networks
+type Option func(*orchestratorConfig)
+
+// orchestratorConfig holds configuration for RunMany
+type orchestratorConfig struct {
+	parallelism int
+	runFunc     func(ctx context.Context, opts ...ethereum.RunOption) (network.Network, error)
+}
+
+// WithParallelism limits how many networks are provisioned at the same time
+func WithParallelism(parallelism int) Option {
+	return func(cfg *orchestratorConfig) {
+		cfg.parallelism = parallelism
+	}
+}
+
+// Networks is a set of networks provisioned together
+type Networks struct {
+	networks map[string]network.Network
+	mu       sync.RWMutex
+}
+
+// Get returns the network with the given spec name
+func (n *Networks) Get(name string) (network.Network, bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	net, ok := n.networks[name]
+
+	return net, ok
+}
+
+// All returns a copy of all networks keyed by spec name
+func (n *Networks) All() map[string]network.Network {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	result := make(map[string]network.Network, len(n.networks))
+	for name, net := range n.networks {
+		result[name] = net
+	}
+
+	return result
+}
+
+// Names returns the sorted spec names of all networks
+func (n *Networks) Names() []string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	names := make([]string, 0, len(n.networks))
+	for name := range n.networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Count returns the number of networks in the set
+func (n *Networks) Count() int {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	return len(n.networks)
+}
+
+// Cleanup tears down all networks concurrently and returns the combined errors
+func (n *Networks) Cleanup(ctx context.Context) error {
+	n.mu.RLock()
+	networks := make(map[string]network.Network, len(n.networks))
+	for name, net := range n.networks {
+		networks[name] = net
+	}
+	n.mu.RUnlock()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	for name, net := range networks {
+		wg.Add(1)
+		go func(name string, net network.Network) {
+			defer wg.Done()
+
+			if err := net.Cleanup(ctx); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("failed to cleanup network %s: %w", name, err))
+				mu.Unlock()
+			}
+		}(name, net)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// RunMany provisions several networks concurrently and returns them as a set.
+// If any network fails to start, all networks that did start are torn down
+// and the combined error is returned.
+func RunMany(ctx context.Context, specs []NetworkSpec, opts ...Option) (*Networks, error) {
+	cfg := &orchestratorConfig{
+		parallelism: DefaultParallelism,
+		runFunc:     ethereum.Run,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if err := validateSpecs(specs); err != nil {
+		return nil, err
+	}
+	if cfg.parallelism < 1 {
+		return nil, fmt.Errorf("parallelism must be at least 1, got %d", cfg.parallelism)
+	}
+
+	result := &Networks{
+		networks: make(map[string]network.Network, len(specs)),
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	sem := make(chan struct{}, cfg.parallelism)
+
+	for _, spec := range specs {
+		wg.Add(1)
+		go func(spec NetworkSpec) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("network %s: %w", spec.Name, ctx.Err()))
+				mu.Unlock()
+				return
+			}
+
+			runOpts := append([]ethereum.RunOption{ethereum.WithEnclaveName(spec.Name)}, spec.Options...)
+			net, err := cfg.runFunc(ctx, runOpts...)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("network %s: %w", spec.Name, err))
+				// Run may return a partially started network alongside an error
+				if net != nil {
+					result.networks[spec.Name] = net
+				}
+				return
+			}
+			result.networks[spec.Name] = net
+		}(spec)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		// The failure may be ctx itself being cancelled or past its deadline;
+		// tear down regardless so the started enclaves don't leak
+		teardownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), teardownTimeout)
+		defer cancel()
+		if cleanupErr := result.Cleanup(teardownCtx); cleanupErr != nil {
+			errs = append(errs, cleanupErr)
+		}
+		return nil, fmt.Errorf("failed to run networks: %w", errors.Join(errs...))
+	}
+
+	return result, nil
+}
+
+// validateSpecs checks that every spec has a unique, non-empty name
+func validateSpecs(specs []NetworkSpec) error {
+	if len(specs) == 0 {
+		return fmt.Errorf("at least one network spec is required")
+	}
+
+	seen := make(map[string]bool, len(specs))
+	for i, spec := range specs {
+		if spec.Name == "" {
+			return fmt.Errorf("network spec %d: name is required", i)
+		}
+		if seen[spec.Name] {
+			return fmt.Errorf("duplicate network spec name: %s", spec.Name)
+		}
+		seen[spec.Name] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go"
+	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/ethpandaops/ethereum-package-go/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMany(t *testing.T) {
+	ctx := context.Background()
+	clientA := mocks.NewMockKurtosisClient()
+	clientB := mocks.NewMockKurtosisClient()
+
+	networks, err := RunMany(ctx, []NetworkSpec{
+		{Name: "net-a", Options: []ethereum.RunOption{ethereum.Minimal(), ethereum.WithKurtosisClient(clientA)}},
+		{Name: "net-b", Options: []ethereum.RunOption{ethereum.Minimal(), ethereum.WithKurtosisClient(clientB)}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, networks)
+
+	assert.Equal(t, 2, networks.Count())
+	assert.Equal(t, []string{"net-a", "net-b"}, networks.Names())
+
+	netA, ok := networks.Get("net-a")
+	require.True(t, ok)
+	assert.Equal(t, "net-a", netA.EnclaveName())
+
+	_, ok = networks.Get("missing")
+	assert.False(t, ok)
+
+	require.NoError(t, networks.Cleanup(ctx))
+	assert.Equal(t, 1, clientA.CallCount["DestroyEnclave"])
+	assert.Equal(t, 1, clientB.CallCount["DestroyEnclave"])
+}
+
+func TestRunMany_FailureTearsDownStartedNetworks(t *testing.T) {
+	ctx := context.Background()
+	healthy := mocks.NewMockKurtosisClient()
+	failing := mocks.NewMockKurtosisClient()
+	failing.RunPackageFunc = func(ctx context.Context, config kurtosis.RunPackageConfig) (*kurtosis.RunPackageResult, error) {
+		return nil, errors.New("boom")
+	}
+
+	networks, err := RunMany(ctx, []NetworkSpec{
+		{Name: "healthy", Options: []ethereum.RunOption{ethereum.Minimal(), ethereum.WithKurtosisClient(healthy)}},
+		{Name: "failing", Options: []ethereum.RunOption{ethereum.Minimal(), ethereum.WithKurtosisClient(failing)}},
+	})
+	require.Error(t, err)
+	assert.Nil(t, networks)
+	assert.Contains(t, err.Error(), "network failing")
+	assert.Contains(t, err.Error(), "boom")
+	assert.Equal(t, 1, healthy.CallCount["DestroyEnclave"])
+}
+
+func TestRunMany_CancelledTearsDownStartedNetworks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var cleanupErr error
+	cleanedUp := make(chan struct{})
+	started := make(chan struct{})
+	runFunc := func(ctx context.Context, opts ...ethereum.RunOption) (network.Network, error) {
+		cfg := &ethereum.RunConfig{}
+		for _, opt := range opts {
+			opt(cfg)
+		}
+		if cfg.EnclaveName == "fast" {
+			close(started)
+			return network.New(network.Config{
+				Name:         "fast",
+				OrphanOnExit: true,
+				CleanupFunc: func(ctx context.Context) error {
+					cleanupErr = ctx.Err()
+					close(cleanedUp)
+					return cleanupErr
+				},
+			}), nil
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	networks, err := RunMany(ctx, []NetworkSpec{{Name: "fast"}, {Name: "slow"}},
+		func(cfg *orchestratorConfig) { cfg.runFunc = runFunc },
+	)
+	require.Error(t, err)
+	assert.Nil(t, networks)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	select {
+	case <-cleanedUp:
+	default:
+		t.Fatal("started network was not torn down")
+	}
+	assert.NoError(t, cleanupErr)
+}
+
+func TestRunMany_Parallelism(t *testing.T) {
+	var (
+		current int32
+		peak    int32
+		mu      sync.Mutex
+	)
+
+	runFunc := func(ctx context.Context, opts ...ethereum.RunOption) (network.Network, error) {
+		n := atomic.AddInt32(&current, 1)
+		mu.Lock()
+		if n > peak {
+			peak = n
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+
+		return network.New(network.Config{Name: "test", OrphanOnExit: true}), nil
+	}
+
+	specs := []NetworkSpec{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}
+	networks, err := RunMany(context.Background(), specs,
+		WithParallelism(2),
+		func(cfg *orchestratorConfig) { cfg.runFunc = runFunc },
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 4, networks.Count())
+	assert.LessOrEqual(t, peak, int32(2))
+}
+
+func TestRunMany_InvalidSpecs(t *testing.T) {
+	tests := []struct {
+		name    string
+		specs   []NetworkSpec
+		opts    []Option
+		wantErr string
+	}{
+		{
+			name:    "no specs",
+			specs:   nil,
+			wantErr: "at least one network spec is required",
+		},
+		{
+			name:    "missing name",
+			specs:   []NetworkSpec{{}},
+			wantErr: "name is required",
+		},
+		{
+			name:    "duplicate name",
+			specs:   []NetworkSpec{{Name: "a"}, {Name: "a"}},
+			wantErr: "duplicate network spec name: a",
+		},
+		{
+			name:    "invalid parallelism",
+			specs:   []NetworkSpec{{Name: "a"}},
+			opts:    []Option{WithParallelism(0)},
+			wantErr: "parallelism must be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RunMany(context.Background(), tt.specs, tt.opts...)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMaxEntries is how many requests a proxy keeps in its log
+const DefaultMaxEntries = 1000
+
+// Entry records a single request that went through the proxy
+type Entry struct {
+	Time time.Time
+	// Method is the HTTP method
+	Method string
+	// Path is the request path including the query string
+	Path string
+	// RPCMethods are the JSON-RPC methods of the request body, if any
+	RPCMethods []string
+	// Status is the HTTP status returned to the caller
+	Status int
+	// Latency is the time from receiving the request to finishing the response
+	Latency time.Duration
+	// Fault describes the fault injected into the request, if any
+	Fault string
+	// Err is the error reaching the upstream client, if any
+	Err error
+}
+
+// String formats the entry as a single log line
+func (e Entry) String() string {
+	target := e.Path
+	if len(e.RPCMethods) > 0 {
+		target = strings.Join(e.RPCMethods, ",")
+	}
+	line := fmt.Sprintf("%s %s %d %s", e.Method, target, e.Status, e.Latency.Round(time.Microsecond))
+	if e.Fault != "" {
+		line += " fault=" + e.Fault
+	}
+	if e.Err != nil {
+		line += fmt.Sprintf(" err=%v", e.Err)
+	}
+	return line
+}
+
+// Fault is a failure injected into proxied requests
+type Fault struct {
+	// Delay holds each request back before it is forwarded or failed
+	Delay time.Duration
+	// Status fails the request with this HTTP status instead of forwarding it.
+	// Zero forwards the request.
+	Status int
+}
+
+// IsZero reports whether the fault injects nothing
+func (f Fault) IsZero() bool {
+	return f.Delay == 0 && f.Status == 0
+}
+
+// String describes the fault
+func (f Fault) String() string {
+	var parts []string
+	if f.Delay > 0 {
+		parts = append(parts, "delay "+f.Delay.String())
+	}
+	if f.Status != 0 {
+		parts = append(parts, fmt.Sprintf("status %d", f.Status))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Proxy is an in-process reverse proxy in front of a client endpoint. It logs
+// every request and can inject delays and error responses, either into every
+// request or through rules matching specific methods.
+type Proxy struct {
+	name     string
+	target   *url.URL
+	reverse  *httputil.ReverseProxy
+	listener net.Listener
+	server   *http.Server
+
+	mu         sync.Mutex
+	entries    []Entry
+	maxEntries int
+	logOutput  io.Writer
+	fault      Fault
+	rules      []activeRule
+	nextRuleID int
+	rand       *rand.Rand
+}
+
+// New creates a proxy forwarding to the target URL. Start must be called before use.
+func New(name, target string) (*Proxy, error) {
+	targetURL, err := url.Parse(target)
+	if err != nil || targetURL.Scheme == "" || targetURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy target %q", target)
+	}
+
+	p := &Proxy{
+		name:       name,
+		target:     targetURL,
+		maxEntries: DefaultMaxEntries,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	p.reverse = httputil.NewSingleHostReverseProxy(targetURL)
+	p.reverse.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		if rec, ok := w.(*recorder); ok {
+			rec.err = err
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}
+	return p, nil
+}
+
+// WithLogOutput writes a line per request to w in addition to the in-memory log
+func (p *Proxy) WithLogOutput(w io.Writer) *Proxy {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.logOutput = w
+	return p
+}
+
+// WithMaxEntries sets how many requests are kept in the log. Non-positive values are ignored.
+func (p *Proxy) WithMaxEntries(n int) *Proxy {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n > 0 {
+		p.maxEntries = n
+	}
+	return p
+}
+
+// Start listens on a free local port and serves until Close is called
+func (p *Proxy) Start() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	p.listener = listener
+	p.server = &http.Server{Handler: p, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() { _ = p.server.Serve(listener) }()
+	return nil
+}
+
+// Close stops the proxy
+func (p *Proxy) Close() error {
+	if p.server == nil {
+		return nil
+	}
+	return p.server.Close()
+}
+
+// Name returns the name of the proxied client
+func (p *Proxy) Name() string { return p.name }
+
+// Target returns the URL requests are forwarded to
+func (p *Proxy) Target() string { return p.target.String() }
+
+// URL returns the proxy's own URL, to be used in place of the client's
+func (p *Proxy) URL() string {
+	if p.listener == nil {
+		return ""
+	}
+	return "http://" + p.listener.Addr().String()
+}
+
+// SetFault injects the fault into every following request no rule applies to
+func (p *Proxy) SetFault(fault Fault) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.fault = fault
+}
+
+// ClearFault stops injecting faults
+func (p *Proxy) ClearFault() {
+	p.SetFault(Fault{})
+}
+
+// Entries returns the logged requests, oldest first
+func (p *Proxy) Entries() []Entry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Entry(nil), p.entries...)
+}
+
+// Reset clears the request log
+func (p *Proxy) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.entries = nil
+}
+
+// ServeHTTP forwards the request to the target, applying the configured fault
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	entry := Entry{
+		Time:       start,
+		Method:     r.Method,
+		Path:       r.URL.RequestURI(),
+		RPCMethods: rpcMethods(r),
+	}
+
+	fault := p.faultFor(entry)
+
+	rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+	if !fault.IsZero() {
+		entry.Fault = fault.String()
+	}
+	if err := applyFault(r.Context(), rec, fault); err != nil {
+		// The caller went away while the request was held back
+		entry.Err = err
+	} else {
+		if fault.Status == 0 {
+			p.reverse.ServeHTTP(rec, r)
+			entry.Err = rec.err
+		}
+		entry.Status = rec.status
+	}
+
+	entry.Latency = time.Since(start)
+	p.record(entry)
+}
+
+// applyFault delays the request and writes the fault's error response, if any
+func applyFault(ctx context.Context, w http.ResponseWriter, fault Fault) error {
+	if fault.Delay > 0 {
+		timer := time.NewTimer(fault.Delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+	if fault.Status != 0 {
+		http.Error(w, fmt.Sprintf("injected fault: %s", fault), fault.Status)
+	}
+	return nil
+}
+
+func (p *Proxy) record(entry Entry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.entries = append(p.entries, entry)
+	if len(p.entries) > p.maxEntries {
+		p.entries = p.entries[len(p.entries)-p.maxEntries:]
+	}
+	if p.logOutput != nil {
+		fmt.Fprintf(p.logOutput, "[ethereum-package-go] proxy %s: %s\n", p.name, entry)
+	}
+}
+
+// rpcMethods returns the JSON-RPC methods of a single or batch request body,
+// leaving the body readable for forwarding
+func rpcMethods(r *http.Request) []string {
+	if r.Body == nil || r.Method != http.MethodPost {
+		return nil
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+
+	type call struct {
+		Method string `json:"method"`
+	}
+	var single call
+	if err := json.Unmarshal(body, &single); err == nil && single.Method != "" {
+		return []string{single.Method}
+	}
+	var batch []call
+	if err := json.Unmarshal(body, &batch); err != nil {
+		return nil
+	}
+	methods := make([]string, 0, len(batch))
+	for _, c := range batch {
+		methods = append(methods, c.Method)
+	}
+	return methods
+}
+
+// recorder captures the status written to a response
+type recorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	err         error
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap lets the reverse proxy flush streamed responses such as beacon events
+func (r *recorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,162 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startProxy(t *testing.T, handler http.HandlerFunc) *Proxy {
+	t.Helper()
+	upstream := httptest.NewServer(handler)
+	t.Cleanup(upstream.Close)
+
+	p, err := New("el-1-geth-lighthouse", upstream.URL)
+	require.NoError(t, err)
+	require.NoError(t, p.Start())
+	t.Cleanup(func() { _ = p.Close() })
+	return p
+}
+
+// waitEntries waits for n requests to be logged, which happens just after the
+// response is sent
+func waitEntries(t *testing.T, p *Proxy, n int) []Entry {
+	t.Helper()
+	require.Eventually(t, func() bool { return len(p.Entries()) == n }, time.Second, 5*time.Millisecond)
+	return p.Entries()
+}
+
+func TestProxyLogsRequests(t *testing.T) {
+	p := startProxy(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	})
+	var log bytes.Buffer
+	p.WithLogOutput(&log)
+
+	resp, err := http.Post(p.URL(), "application/json", strings.NewReader(`{"jsonrpc":"2.0","method":"eth_blockNumber","id":1}`))
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), "eth_blockNumber", "body is forwarded intact")
+
+	resp, err = http.Post(p.URL(), "application/json", strings.NewReader(`[{"method":"eth_chainId"},{"method":"net_version"}]`))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	resp, err = http.Get(p.URL() + "/eth/v1/node/health?x=1")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	entries := waitEntries(t, p, 3)
+	assert.Equal(t, []string{"eth_blockNumber"}, entries[0].RPCMethods)
+	assert.Equal(t, http.StatusOK, entries[0].Status)
+	assert.Equal(t, []string{"eth_chainId", "net_version"}, entries[1].RPCMethods)
+	assert.Equal(t, http.MethodGet, entries[2].Method)
+	assert.Equal(t, "/eth/v1/node/health?x=1", entries[2].Path)
+	assert.Contains(t, log.String(), "proxy el-1-geth-lighthouse: POST eth_blockNumber 200")
+
+	p.Reset()
+	assert.Empty(t, p.Entries())
+}
+
+func TestProxyFaults(t *testing.T) {
+	var upstreamCalls int
+	p := startProxy(t, func(w http.ResponseWriter, r *http.Request) {
+		upstreamCalls++
+	})
+
+	p.SetFault(Fault{Status: http.StatusInternalServerError})
+	resp, err := http.Get(p.URL())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, 0, upstreamCalls, "failed requests are not forwarded")
+
+	p.SetFault(Fault{Delay: 50 * time.Millisecond})
+	start := time.Now()
+	resp, err = http.Get(p.URL())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Equal(t, 1, upstreamCalls)
+
+	p.ClearFault()
+	resp, err = http.Get(p.URL())
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	entries := waitEntries(t, p, 3)
+	assert.Equal(t, "status 500", entries[0].Fault)
+	assert.Equal(t, "delay 50ms", entries[1].Fault)
+	assert.GreaterOrEqual(t, entries[1].Latency, 50*time.Millisecond)
+	assert.Empty(t, entries[2].Fault)
+}
+
+func TestProxyCanceledDuringDelay(t *testing.T) {
+	p := startProxy(t, func(w http.ResponseWriter, r *http.Request) {})
+	p.SetFault(Fault{Delay: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.URL(), nil)
+	require.NoError(t, err)
+	_, err = http.DefaultClient.Do(req)
+	require.Error(t, err)
+
+	assert.Eventually(t, func() bool {
+		entries := p.Entries()
+		return len(entries) == 1 && entries[0].Err != nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestProxyUpstreamDown(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	upstream.Close()
+
+	p, err := New("cl-1-lighthouse-geth", upstream.URL)
+	require.NoError(t, err)
+	require.NoError(t, p.Start())
+	defer p.Close()
+
+	resp, err := http.Get(p.URL())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+
+	entries := waitEntries(t, p, 1)
+	assert.Equal(t, http.StatusBadGateway, entries[0].Status)
+	assert.Error(t, entries[0].Err)
+}
+
+func TestNewInvalidTarget(t *testing.T) {
+	_, err := New("x", "not a url")
+	assert.Error(t, err)
+}
+
+func TestProxyMaxEntries(t *testing.T) {
+	p := startProxy(t, func(w http.ResponseWriter, r *http.Request) {})
+	p.WithMaxEntries(2)
+
+	for _, path := range []string{"/a", "/b", "/c"} {
+		resp, err := http.Get(p.URL() + path)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	require.Eventually(t, func() bool {
+		entries := p.Entries()
+		return len(entries) == 2 && entries[1].Path == "/c"
+	}, time.Second, 5*time.Millisecond)
+	entries := p.Entries()
+	assert.Equal(t, "/b", entries[0].Path)
+	assert.Equal(t, "/c", entries[1].Path)
+}
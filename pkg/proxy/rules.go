@@ -0,0 +1,154 @@
+package proxy
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Rule injects a fault into a fraction of the requests matching a JSON-RPC
+// method or beacon API path. Rules only affect traffic sent through the proxy.
+type Rule struct {
+	// Method matches JSON-RPC requests calling this method, or any batch containing it
+	Method string
+	// PathPrefix matches requests whose path starts with this prefix
+	PathPrefix string
+	// Rate is the fraction of matching requests that are faulted, in (0, 1]
+	Rate float64
+	// Fault is what happens to faulted requests
+	Fault Fault
+}
+
+// DelayMethod returns a rule delaying every call of a JSON-RPC method
+func DelayMethod(method string, delay time.Duration) Rule {
+	return Rule{Method: method, Rate: 1, Fault: Fault{Delay: delay}}
+}
+
+// FailMethod returns a rule failing a fraction of the calls of a JSON-RPC method
+// with an internal server error
+func FailMethod(method string, rate float64) Rule {
+	return Rule{Method: method, Rate: rate, Fault: Fault{Status: http.StatusInternalServerError}}
+}
+
+// Validate checks that the rule matches something and faults something
+func (r Rule) Validate() error {
+	if r.Method != "" && r.PathPrefix != "" {
+		return fmt.Errorf("rule may set method or path prefix, not both")
+	}
+	if r.Rate <= 0 || r.Rate > 1 {
+		return fmt.Errorf("rule rate must be in (0, 1], got %v", r.Rate)
+	}
+	if r.Fault.IsZero() {
+		return fmt.Errorf("rule has no fault")
+	}
+	return nil
+}
+
+// String describes the rule
+func (r Rule) String() string {
+	target := "all requests"
+	switch {
+	case r.Method != "":
+		target = r.Method
+	case r.PathPrefix != "":
+		target = r.PathPrefix + "*"
+	}
+	if r.Rate < 1 {
+		return fmt.Sprintf("%s (%.0f%%): %s", target, r.Rate*100, r.Fault)
+	}
+	return fmt.Sprintf("%s: %s", target, r.Fault)
+}
+
+// matches reports whether the rule applies to the request described by the entry
+func (r Rule) matches(entry Entry) bool {
+	switch {
+	case r.Method != "":
+		for _, method := range entry.RPCMethods {
+			if method == r.Method {
+				return true
+			}
+		}
+		return false
+	case r.PathPrefix != "":
+		return strings.HasPrefix(entry.Path, r.PathPrefix)
+	default:
+		return true
+	}
+}
+
+// AddRule starts applying the rule to following requests and returns an ID for
+// RemoveRule. When several rules match a request, the first one added that
+// fires wins.
+func (p *Proxy) AddRule(rule Rule) (int, error) {
+	if err := rule.Validate(); err != nil {
+		return 0, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nextRuleID++
+	p.rules = append(p.rules, activeRule{id: p.nextRuleID, rule: rule})
+	return p.nextRuleID, nil
+}
+
+// RemoveRule stops applying a rule added by AddRule
+func (p *Proxy) RemoveRule(id int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, active := range p.rules {
+		if active.id == id {
+			p.rules = append(p.rules[:i], p.rules[i+1:]...)
+			return
+		}
+	}
+}
+
+// Rules returns the active rules in the order they are evaluated
+func (p *Proxy) Rules() []Rule {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	rules := make([]Rule, len(p.rules))
+	for i, active := range p.rules {
+		rules[i] = active.rule
+	}
+	return rules
+}
+
+// ClearRules removes every rule
+func (p *Proxy) ClearRules() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rules = nil
+}
+
+// WithSeed makes the choice of faulted requests reproducible
+func (p *Proxy) WithSeed(seed int64) *Proxy {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rand = rand.New(rand.NewSource(seed))
+	return p
+}
+
+type activeRule struct {
+	id   int
+	rule Rule
+}
+
+// faultFor returns the fault to inject into a request: that of the first firing
+// rule, or else the fault set by SetFault
+func (p *Proxy) faultFor(entry Entry) Fault {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, active := range p.rules {
+		if !active.rule.matches(entry) {
+			continue
+		}
+		if active.rule.Rate >= 1 || p.rand.Float64() < active.rule.Rate {
+			return active.rule.Fault
+		}
+	}
+	return p.fault
+}
@@ -0,0 +1,80 @@
+package proxy
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func call(t *testing.T, p *Proxy, method string) int {
+	t.Helper()
+	resp, err := http.Post(p.URL(), "application/json", strings.NewReader(`{"jsonrpc":"2.0","method":"`+method+`","id":1}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestRuleValidate(t *testing.T) {
+	assert.NoError(t, FailMethod("engine_newPayloadV3", 0.1).Validate())
+	assert.NoError(t, DelayMethod("eth_getBlockByNumber", 2*time.Second).Validate())
+	assert.Error(t, FailMethod("eth_call", 0).Validate())
+	assert.Error(t, FailMethod("eth_call", 1.5).Validate())
+	assert.Error(t, Rule{Method: "eth_call", Rate: 1}.Validate())
+	assert.Error(t, Rule{Method: "eth_call", PathPrefix: "/eth", Rate: 1, Fault: Fault{Status: 500}}.Validate())
+
+	assert.Equal(t, "engine_newPayloadV3 (10%): status 500", FailMethod("engine_newPayloadV3", 0.1).String())
+	assert.Equal(t, "eth_getBlockByNumber: delay 2s", DelayMethod("eth_getBlockByNumber", 2*time.Second).String())
+}
+
+func TestProxyRules(t *testing.T) {
+	p := startProxy(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	id, err := p.AddRule(FailMethod("eth_call", 1))
+	require.NoError(t, err)
+	_, err = p.AddRule(Rule{PathPrefix: "/eth/v1/node", Rate: 1, Fault: Fault{Status: http.StatusServiceUnavailable}})
+	require.NoError(t, err)
+	require.Len(t, p.Rules(), 2)
+
+	assert.Equal(t, http.StatusInternalServerError, call(t, p, "eth_call"))
+	assert.Equal(t, http.StatusOK, call(t, p, "eth_blockNumber"))
+
+	resp, err := http.Get(p.URL() + "/eth/v1/node/health")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	p.RemoveRule(id)
+	assert.Equal(t, http.StatusOK, call(t, p, "eth_call"))
+	require.Len(t, p.Rules(), 1)
+
+	// Rules take precedence over the catch-all fault
+	p.SetFault(Fault{Status: http.StatusBadRequest})
+	assert.Equal(t, http.StatusBadRequest, call(t, p, "eth_call"))
+	resp, err = http.Get(p.URL() + "/eth/v1/node/version")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	p.ClearRules()
+	assert.Empty(t, p.Rules())
+}
+
+func TestProxyRuleRate(t *testing.T) {
+	p := startProxy(t, func(w http.ResponseWriter, r *http.Request) {})
+	p.WithSeed(1)
+
+	_, err := p.AddRule(FailMethod("engine_newPayloadV3", 0.25))
+	require.NoError(t, err)
+
+	failed := 0
+	for i := 0; i < 200; i++ {
+		if call(t, p, "engine_newPayloadV3") != http.StatusOK {
+			failed++
+		}
+	}
+	assert.InDelta(t, 50, failed, 20)
+}
@@ -0,0 +1,247 @@
+// Package results exports test outcomes of devnet runs in formats that existing
+// dashboards consume: JUnit XML, TAP and Hive simulator suite JSON.
+package results
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Suite is a named group of test cases, such as one conformance run
+type Suite struct {
+	Name        string
+	Description string
+	// ClientVersions maps client name to the version under test
+	ClientVersions map[string]string
+	Cases          []Case
+}
+
+// Case is the outcome of one test
+type Case struct {
+	Name        string
+	Description string
+	// Client is the client the case ran against, if any
+	Client string
+	Start  time.Time
+	End    time.Time
+	Passed bool
+	// Details explains a failure
+	Details string
+}
+
+// Failures counts the failed cases of the suite
+func (s Suite) Failures() int {
+	failures := 0
+	for _, c := range s.Cases {
+		if !c.Passed {
+			failures++
+		}
+	}
+	return failures
+}
+
+// junitSuites is the root element of a JUnit XML report
+type junitSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name      string      `xml:"name,attr"`
+	Tests     int         `xml:"tests,attr"`
+	Failures  int         `xml:"failures,attr"`
+	Time      string      `xml:"time,attr"`
+	Timestamp string      `xml:"timestamp,attr,omitempty"`
+	Cases     []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Details string `xml:",chardata"`
+}
+
+// WriteJUnit writes the suites as a JUnit XML report. Cases are classed by
+// their client, or by their suite if they have none.
+func WriteJUnit(w io.Writer, suites ...Suite) error {
+	report := junitSuites{}
+	for _, suite := range suites {
+		start, end := suite.span()
+		js := junitSuite{
+			Name:     suite.Name,
+			Tests:    len(suite.Cases),
+			Failures: suite.Failures(),
+			Time:     seconds(end.Sub(start)),
+		}
+		if !start.IsZero() {
+			js.Timestamp = start.UTC().Format("2006-01-02T15:04:05")
+		}
+		for _, c := range suite.Cases {
+			jc := junitCase{Name: c.Name, ClassName: c.Client, Time: seconds(c.End.Sub(c.Start))}
+			if jc.ClassName == "" {
+				jc.ClassName = suite.Name
+			}
+			if !c.Passed {
+				jc.Failure = &junitFailure{Message: firstLine(c.Details), Details: c.Details}
+			}
+			js.Cases = append(js.Cases, jc)
+		}
+		report.Tests += js.Tests
+		report.Failures += js.Failures
+		report.Suites = append(report.Suites, js)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteTAP writes the suites as a TAP version 13 stream. Cases are prefixed
+// with their suite name and failures carry their details as a YAML block.
+func WriteTAP(w io.Writer, suites ...Suite) error {
+	total := 0
+	for _, suite := range suites {
+		total += len(suite.Cases)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "TAP version 13\n1..%d\n", total)
+	n := 0
+	for _, suite := range suites {
+		for _, c := range suite.Cases {
+			n++
+			status := "ok"
+			if !c.Passed {
+				status = "not ok"
+			}
+			fmt.Fprintf(&b, "%s %d - %s/%s\n", status, n, suite.Name, c.Name)
+			if !c.Passed && c.Details != "" {
+				b.WriteString("  ---\n  message: |\n")
+				for _, line := range strings.Split(c.Details, "\n") {
+					fmt.Fprintf(&b, "    %s\n", line)
+				}
+				b.WriteString("  ...\n")
+			}
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write TAP report: %w", err)
+	}
+	return nil
+}
+
+// hiveSuite is a Hive simulator suite result, as read by hiveview
+type hiveSuite struct {
+	ID             int                  `json:"id"`
+	Name           string               `json:"name"`
+	Description    string               `json:"description"`
+	ClientVersions map[string]string    `json:"clientVersions"`
+	TestCases      map[string]*hiveCase `json:"testCases"`
+}
+
+type hiveCase struct {
+	Name          string                     `json:"name"`
+	Description   string                     `json:"description"`
+	Start         time.Time                  `json:"start"`
+	End           time.Time                  `json:"end"`
+	SummaryResult hiveResult                 `json:"summaryResult"`
+	ClientInfo    map[string]*hiveClientInfo `json:"clientInfo"`
+}
+
+type hiveResult struct {
+	Pass    bool   `json:"pass"`
+	Details string `json:"details"`
+}
+
+type hiveClientInfo struct {
+	Name string `json:"name"`
+}
+
+// WriteHive writes the suite as a Hive simulator suite result with the given
+// suite ID. Test cases are numbered from 1 in order.
+func WriteHive(w io.Writer, id int, suite Suite) error {
+	hs := hiveSuite{
+		ID:             id,
+		Name:           suite.Name,
+		Description:    suite.Description,
+		ClientVersions: suite.ClientVersions,
+		TestCases:      make(map[string]*hiveCase, len(suite.Cases)),
+	}
+	if hs.ClientVersions == nil {
+		hs.ClientVersions = map[string]string{}
+	}
+	for i, c := range suite.Cases {
+		hc := &hiveCase{
+			Name:          c.Name,
+			Description:   c.Description,
+			Start:         c.Start,
+			End:           c.End,
+			SummaryResult: hiveResult{Pass: c.Passed, Details: c.Details},
+			ClientInfo:    map[string]*hiveClientInfo{},
+		}
+		if c.Client != "" {
+			hc.ClientInfo[c.Client] = &hiveClientInfo{Name: c.Client}
+		}
+		hs.TestCases[strconv.Itoa(i+1)] = hc
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(hs); err != nil {
+		return fmt.Errorf("failed to write Hive result: %w", err)
+	}
+	return nil
+}
+
+// span returns the earliest start and latest end of the suite's cases
+func (s Suite) span() (time.Time, time.Time) {
+	var start, end time.Time
+	for _, c := range s.Cases {
+		if !c.Start.IsZero() && (start.IsZero() || c.Start.Before(start)) {
+			start = c.Start
+		}
+		if c.End.After(end) {
+			end = c.End
+		}
+	}
+	return start, end
+}
+
+// seconds formats a duration as JUnit seconds
+func seconds(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
+
+// firstLine returns the first line of s
+func firstLine(s string) string {
+	for i, r := range s {
+		if r == '\n' {
+			return s[:i]
+		}
+	}
+	return s
+}
@@ -0,0 +1,99 @@
+package results
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSuite() Suite {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	return Suite{
+		Name:           "beacon-api",
+		ClientVersions: map[string]string{"cl-1-lighthouse-geth": "v5.0.0"},
+		Cases: []Case{
+			{Name: "cl-1-lighthouse-geth/node_version", Client: "cl-1-lighthouse-geth", Start: start, End: start.Add(250 * time.Millisecond), Passed: true},
+			{Name: "cl-1-lighthouse-geth/finality", Client: "cl-1-lighthouse-geth", Start: start, End: start.Add(time.Second), Details: "finalized epoch went back\nfrom 3 to 2"},
+		},
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteJUnit(&buf, testSuite(), Suite{Name: "soak", Cases: []Case{{Name: "reorg", Passed: true}}}))
+
+	var report junitSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &report))
+	assert.Equal(t, 3, report.Tests)
+	assert.Equal(t, 1, report.Failures)
+	require.Len(t, report.Suites, 2)
+
+	suite := report.Suites[0]
+	assert.Equal(t, "beacon-api", suite.Name)
+	assert.Equal(t, "1.000", suite.Time)
+	assert.Equal(t, "2024-05-01T10:00:00", suite.Timestamp)
+	require.Len(t, suite.Cases, 2)
+	assert.Equal(t, "cl-1-lighthouse-geth", suite.Cases[0].ClassName)
+	assert.Equal(t, "0.250", suite.Cases[0].Time)
+	assert.Nil(t, suite.Cases[0].Failure)
+	require.NotNil(t, suite.Cases[1].Failure)
+	assert.Equal(t, "finalized epoch went back", suite.Cases[1].Failure.Message)
+	assert.Equal(t, "finalized epoch went back\nfrom 3 to 2", suite.Cases[1].Failure.Details)
+
+	// Cases without a client are classed by their suite
+	assert.Equal(t, "soak", report.Suites[1].Cases[0].ClassName)
+}
+
+func TestWriteHive(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteHive(&buf, 7, testSuite()))
+
+	var suite struct {
+		ID             int               `json:"id"`
+		Name           string            `json:"name"`
+		ClientVersions map[string]string `json:"clientVersions"`
+		TestCases      map[string]struct {
+			Name          string    `json:"name"`
+			Start         time.Time `json:"start"`
+			SummaryResult struct {
+				Pass    bool   `json:"pass"`
+				Details string `json:"details"`
+			} `json:"summaryResult"`
+			ClientInfo map[string]struct {
+				Name string `json:"name"`
+			} `json:"clientInfo"`
+		} `json:"testCases"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &suite))
+	assert.Equal(t, 7, suite.ID)
+	assert.Equal(t, "beacon-api", suite.Name)
+	assert.Equal(t, "v5.0.0", suite.ClientVersions["cl-1-lighthouse-geth"])
+	require.Len(t, suite.TestCases, 2)
+	assert.Equal(t, "cl-1-lighthouse-geth/node_version", suite.TestCases["1"].Name)
+	assert.True(t, suite.TestCases["1"].SummaryResult.Pass)
+	assert.Contains(t, suite.TestCases["1"].ClientInfo, "cl-1-lighthouse-geth")
+	assert.False(t, suite.TestCases["2"].SummaryResult.Pass)
+	assert.Contains(t, suite.TestCases["2"].SummaryResult.Details, "finalized epoch went back")
+}
+
+func TestWriteTAP(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteTAP(&buf, testSuite(), Suite{Name: "soak", Cases: []Case{{Name: "reorg", Passed: true}}}))
+
+	assert.Equal(t, `TAP version 13
+1..3
+ok 1 - beacon-api/cl-1-lighthouse-geth/node_version
+not ok 2 - beacon-api/cl-1-lighthouse-geth/finality
+  ---
+  message: |
+    finalized epoch went back
+    from 3 to 2
+  ...
+ok 3 - soak/reorg
+`, buf.String())
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// CheckpointzClient reads the checkpointz service, which serves finalized
+// checkpoints that beacon nodes checkpoint sync from
+type CheckpointzClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewCheckpointzClient creates a new checkpointz client
+func NewCheckpointzClient(baseURL string) *CheckpointzClient {
+	return &CheckpointzClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for requests
+func (c *CheckpointzClient) WithHTTPClient(httpClient *http.Client) *CheckpointzClient {
+	c.httpClient = httpClient
+	return c
+}
+
+// URL returns the base URL of the checkpointz server
+func (c *CheckpointzClient) URL() string {
+	return c.baseURL
+}
+
+// Checkpoint is a finality checkpoint
+type Checkpoint struct {
+	Epoch Uint64 `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+// CheckpointzStatus is the finality checkpointz has reached consensus on among
+// its upstream beacon nodes
+type CheckpointzStatus struct {
+	Finalized         *Checkpoint `json:"finalized"`
+	CurrentJustified  *Checkpoint `json:"current_justified"`
+	PreviousJustified *Checkpoint `json:"previous_justified"`
+}
+
+// Status returns the finality checkpointz serves. Finalized is nil until the
+// network has finalized and checkpointz has fetched the checkpoint.
+func (c *CheckpointzClient) Status(ctx context.Context) (*CheckpointzStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/v1/status", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("checkpointz status request failed with status %d", resp.StatusCode)
+	}
+
+	var status struct {
+		Data struct {
+			Finality *CheckpointzStatus `json:"finality"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpointz status: %w", err)
+	}
+	if status.Data.Finality == nil {
+		return &CheckpointzStatus{}, nil
+	}
+	return status.Data.Finality, nil
+}
+
+// WaitForFinalized polls until checkpointz serves a finalized checkpoint at or
+// after epoch, which late joiners need to checkpoint sync
+func (c *CheckpointzClient) WaitForFinalized(ctx context.Context, epoch uint64, interval time.Duration) (*Checkpoint, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status, err := c.Status(ctx)
+		if err == nil && status.Finalized != nil && uint64(status.Finalized.Epoch) >= epoch {
+			return status.Finalized, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return nil, fmt.Errorf("checkpointz has no finalized checkpoint at epoch %d: %w", epoch, err)
+			}
+			return nil, fmt.Errorf("checkpointz has no finalized checkpoint at epoch %d: %w", epoch, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointzClient(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if calls.Add(1) == 1 {
+			w.Write([]byte(`{"data":{"finality":null}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"finality":{"finalized":{"epoch":"2","root":"0xabc"},"current_justified":{"epoch":"3","root":"0xdef"}}}}`))
+	}))
+	defer server.Close()
+
+	checkpointz := NewCheckpointzClient(server.URL)
+	status, err := checkpointz.Status(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, status.Finalized)
+
+	checkpoint, err := checkpointz.WaitForFinalized(context.Background(), 2, 10*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, &Checkpoint{Epoch: 2, Root: "0xabc"}, checkpoint)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = checkpointz.WaitForFinalized(ctx, 5, 10*time.Millisecond)
+	assert.ErrorContains(t, err, "checkpointz has no finalized checkpoint at epoch 5")
+}
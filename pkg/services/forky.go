@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ForkyClient fetches the fork choice snapshots the forky service captured from
+// the network's beacon nodes, for post-mortems of consensus anomalies
+type ForkyClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewForkyClient creates a new forky client
+func NewForkyClient(baseURL string) *ForkyClient {
+	return &ForkyClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for requests
+func (f *ForkyClient) WithHTTPClient(httpClient *http.Client) *ForkyClient {
+	f.httpClient = httpClient
+	return f
+}
+
+// URL returns the base URL of the forky server
+func (f *ForkyClient) URL() string {
+	return f.baseURL
+}
+
+// ForkChoiceFrame describes a fork choice snapshot captured by forky
+type ForkChoiceFrame struct {
+	ID             string    `json:"id"`
+	Node           string    `json:"node"`
+	FetchedAt      time.Time `json:"fetched_at"`
+	WallClockSlot  Uint64    `json:"wall_clock_slot"`
+	WallClockEpoch Uint64    `json:"wall_clock_epoch"`
+	Labels         []string  `json:"labels"`
+}
+
+// FrameFilter narrows ListFrames. Zero fields match everything.
+type FrameFilter struct {
+	Node   string
+	Slot   uint64 // wall clock slot
+	Before time.Time
+	After  time.Time
+	Limit  int // 100 when zero
+}
+
+// ListFrames returns the captured fork choice snapshots matching the filter
+func (f *ForkyClient) ListFrames(ctx context.Context, filter FrameFilter) ([]ForkChoiceFrame, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	frameFilter := map[string]interface{}{}
+	if filter.Node != "" {
+		frameFilter["node"] = filter.Node
+	}
+	if filter.Slot != 0 {
+		frameFilter["slot"] = filter.Slot
+	}
+	if !filter.Before.IsZero() {
+		frameFilter["before"] = filter.Before.UTC().Format(time.RFC3339)
+	}
+	if !filter.After.IsZero() {
+		frameFilter["after"] = filter.After.UTC().Format(time.RFC3339)
+	}
+	body := map[string]interface{}{
+		"filter":     frameFilter,
+		"pagination": map[string]interface{}{"limit": limit},
+	}
+
+	var resp struct {
+		Data struct {
+			Frames []ForkChoiceFrame `json:"frames"`
+		} `json:"data"`
+	}
+	if err := postJSON(ctx, f.httpClient, f.baseURL+"/api/v1/metadata", body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to list fork choice frames: %w", err)
+	}
+	return resp.Data.Frames, nil
+}
+
+// Frame returns the fork choice dump of the snapshot with the given ID, as the
+// beacon node's /eth/v1/debug/fork_choice response
+func (f *ForkyClient) Frame(ctx context.Context, id string) (json.RawMessage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.baseURL+"/api/v1/frames/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fork choice frame %s request failed with status %d", id, resp.StatusCode)
+	}
+
+	var frame struct {
+		Data struct {
+			Frame struct {
+				Data json.RawMessage `json:"data"`
+			} `json:"frame"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&frame); err != nil {
+		return nil, fmt.Errorf("failed to decode fork choice frame: %w", err)
+	}
+	return frame.Data.Frame.Data, nil
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkyClient(t *testing.T) {
+	var request struct {
+		Filter map[string]interface{} `json:"filter"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/metadata":
+			if err := json.NewDecoder(r.Body).Decode(&request); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.Write([]byte(`{"data":{"frames":[{"id":"f1","node":"cl-2-teku-besu","fetched_at":"2024-01-02T03:04:05Z","wall_clock_slot":33,"wall_clock_epoch":1,"labels":["xatu"]}]}}`))
+		case r.URL.Path == "/api/v1/frames/f1":
+			w.Write([]byte(`{"data":{"frame":{"metadata":{"id":"f1"},"data":{"fork_choice_nodes":[]}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	forky := NewForkyClient(server.URL)
+	frames, err := forky.ListFrames(context.Background(), FrameFilter{Node: "cl-2-teku-besu"})
+	require.NoError(t, err)
+	require.Len(t, frames, 1)
+	assert.Equal(t, "f1", frames[0].ID)
+	assert.Equal(t, Uint64(33), frames[0].WallClockSlot)
+	assert.Equal(t, []string{"xatu"}, frames[0].Labels)
+	assert.Equal(t, map[string]interface{}{"node": "cl-2-teku-besu"}, request.Filter)
+
+	frame, err := forky.Frame(context.Background(), "f1")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"fork_choice_nodes":[]}`, string(frame))
+
+	_, err = forky.Frame(context.Background(), "f2")
+	assert.ErrorContains(t, err, "fork choice frame f2 request failed with status 404")
+}
@@ -35,6 +35,7 @@ type HealthCheck struct {
 	Timeout      time.Duration
 	SuccessCodes []int
 	CheckFunc    func(ctx context.Context) error
+	Labels       map[string]string // identity of the checked client, copied into its status
 }
 
 // HealthCheckType represents the type of health check
@@ -48,12 +49,13 @@ const (
 
 // ServiceHealthStatus represents the health status of a service
 type ServiceHealthStatus struct {
-	Name      string        `json:"name"`
-	Status    ServiceStatus `json:"status"`
-	Message   string        `json:"message,omitempty"`
-	LastCheck time.Time     `json:"last_check"`
-	Uptime    time.Duration `json:"uptime,omitempty"`
-	Details   interface{}   `json:"details,omitempty"`
+	Name      string            `json:"name"`
+	Status    ServiceStatus     `json:"status"`
+	Message   string            `json:"message,omitempty"`
+	LastCheck time.Time         `json:"last_check"`
+	Uptime    time.Duration     `json:"uptime,omitempty"`
+	Details   interface{}       `json:"details,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
 }
 
 // ServiceStatus represents the status of a service
@@ -110,6 +112,7 @@ func (h *HealthChecker) CheckHealth(ctx context.Context, name string) (*ServiceH
 		Name:      name,
 		LastCheck: time.Now(),
 		Status:    StatusUnknown,
+		Labels:    check.Labels,
 	}
 
 	switch check.Type {
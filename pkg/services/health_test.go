@@ -81,6 +81,21 @@ func TestHealthChecker_CheckAllHealth(t *testing.T) {
 	assert.Equal(t, StatusUnhealthy, results["unhealthy-service"].Status)
 }
 
+func TestHealthChecker_CheckHealthLabels(t *testing.T) {
+	checker := NewHealthChecker()
+	checker.RegisterCheck(HealthCheck{
+		Name:      "el-1-geth-lighthouse",
+		Type:      HealthCheckCustom,
+		CheckFunc: func(ctx context.Context) error { return nil },
+		Labels:    map[string]string{"enclave": "devnet-a", "type": "geth", "index": "1"},
+	})
+
+	status, err := checker.CheckHealth(context.Background(), "el-1-geth-lighthouse")
+	assert.NoError(t, err)
+	assert.Equal(t, "devnet-a", status.Labels["enclave"])
+	assert.Equal(t, "geth", status.Labels["type"])
+}
+
 func TestHealthChecker_AggregateHealth(t *testing.T) {
 	checker := NewHealthChecker()
 
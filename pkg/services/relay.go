@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RelayClient reads the data API of the MEV relay, which records the builder
+// payloads it delivered to proposers
+type RelayClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewRelayClient creates a new relay client
+func NewRelayClient(baseURL string) *RelayClient {
+	return &RelayClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for requests
+func (r *RelayClient) WithHTTPClient(httpClient *http.Client) *RelayClient {
+	r.httpClient = httpClient
+	return r
+}
+
+// URL returns the base URL of the relay
+func (r *RelayClient) URL() string {
+	return r.baseURL
+}
+
+// BidTrace is a builder payload the relay delivered to a proposer
+type BidTrace struct {
+	Slot                 Uint64 `json:"slot"`
+	ParentHash           string `json:"parent_hash"`
+	BlockHash            string `json:"block_hash"`
+	BuilderPubkey        string `json:"builder_pubkey"`
+	ProposerPubkey       string `json:"proposer_pubkey"`
+	ProposerFeeRecipient string `json:"proposer_fee_recipient"`
+	// Value is the payment to the proposer in wei
+	Value       string `json:"value"`
+	BlockNumber Uint64 `json:"block_number"`
+	GasUsed     Uint64 `json:"gas_used"`
+	NumTx       Uint64 `json:"num_tx"`
+}
+
+// DeliveredPayloads returns the payloads the relay delivered for the slot. A
+// slot has at most one unless the proposer equivocated.
+func (r *RelayClient) DeliveredPayloads(ctx context.Context, slot uint64) ([]BidTrace, error) {
+	url := fmt.Sprintf("%s/relay/v1/data/bidtraces/proposer_payload_delivered?slot=%d", r.baseURL, slot)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("delivered payloads request for slot %d failed with status %d", slot, resp.StatusCode)
+	}
+
+	var traces []BidTrace
+	if err := json.NewDecoder(resp.Body).Decode(&traces); err != nil {
+		return nil, fmt.Errorf("failed to decode delivered payloads: %w", err)
+	}
+	return traces, nil
+}
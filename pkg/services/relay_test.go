@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/relay/v1/data/bidtraces/proposer_payload_delivered" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("slot") != "7" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"slot":"7","block_hash":"0xb7","builder_pubkey":"0xbuilder","value":"1000","block_number":"6","gas_used":"21000","num_tx":"1"}]`))
+	}))
+	defer server.Close()
+
+	relay := NewRelayClient(server.URL + "/")
+	assert.Equal(t, server.URL, relay.URL())
+
+	traces, err := relay.DeliveredPayloads(context.Background(), 7)
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	assert.Equal(t, BidTrace{Slot: 7, BlockHash: "0xb7", BuilderPubkey: "0xbuilder", Value: "1000", BlockNumber: 6, GasUsed: 21000, NumTx: 1}, traces[0])
+
+	traces, err = relay.DeliveredPayloads(context.Background(), 8)
+	require.NoError(t, err)
+	assert.Empty(t, traces)
+
+	_, err = NewRelayClient(server.URL+"/missing").DeliveredPayloads(context.Background(), 7)
+	assert.ErrorContains(t, err, "failed with status 404")
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TracoorClient fetches the beacon states the tracoor service captured from the
+// network's nodes, for post-mortems of consensus anomalies
+type TracoorClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewTracoorClient creates a new tracoor client
+func NewTracoorClient(baseURL string) *TracoorClient {
+	return &TracoorClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for requests
+func (t *TracoorClient) WithHTTPClient(httpClient *http.Client) *TracoorClient {
+	t.httpClient = httpClient
+	return t
+}
+
+// URL returns the base URL of the tracoor server
+func (t *TracoorClient) URL() string {
+	return t.baseURL
+}
+
+// BeaconState is a beacon state captured by tracoor
+type BeaconState struct {
+	ID                   string    `json:"id"`
+	Node                 string    `json:"node"`
+	FetchedAt            time.Time `json:"fetched_at"`
+	Slot                 Uint64    `json:"slot"`
+	Epoch                Uint64    `json:"epoch"`
+	StateRoot            string    `json:"state_root"`
+	NodeVersion          string    `json:"node_version"`
+	BeaconImplementation string    `json:"beacon_implementation"`
+}
+
+// BeaconStateFilter narrows ListBeaconStates. Zero fields match everything.
+type BeaconStateFilter struct {
+	Node      string
+	Slot      uint64
+	Epoch     uint64
+	StateRoot string
+	Limit     int // 100 when zero
+}
+
+// ListBeaconStates returns the captured beacon states matching the filter, newest first
+func (t *TracoorClient) ListBeaconStates(ctx context.Context, filter BeaconStateFilter) ([]BeaconState, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	body := map[string]interface{}{
+		"pagination": map[string]interface{}{"limit": limit, "order_by": "fetched_at DESC"},
+	}
+	if filter.Node != "" {
+		body["node"] = filter.Node
+	}
+	if filter.Slot != 0 {
+		body["slot"] = filter.Slot
+	}
+	if filter.Epoch != 0 {
+		body["epoch"] = filter.Epoch
+	}
+	if filter.StateRoot != "" {
+		body["state_root"] = filter.StateRoot
+	}
+
+	var resp struct {
+		BeaconStates []BeaconState `json:"beacon_states"`
+	}
+	if err := postJSON(ctx, t.httpClient, t.baseURL+"/v1/api/list-beacon-state", body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to list beacon states: %w", err)
+	}
+	return resp.BeaconStates, nil
+}
+
+// DownloadBeaconState downloads the SSZ-encoded beacon state with the given ID
+func (t *TracoorClient) DownloadBeaconState(ctx context.Context, id string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.baseURL+"/download/beacon_state/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download of beacon state %s failed with status %d", id, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read beacon state: %w", err)
+	}
+	return data, nil
+}
+
+// Uint64 decodes a uint64 sent as a JSON number or, as protobuf JSON does, a string
+type Uint64 uint64
+
+// UnmarshalJSON accepts both encodings
+func (u *Uint64) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*u = 0
+		return nil
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid uint64 %s: %w", data, err)
+	}
+	*u = Uint64(v)
+	return nil
+}
+
+// postJSON POSTs body as JSON and decodes a 200 response into v
+func postJSON(ctx context.Context, httpClient *http.Client, url string, body, v interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed with status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracoorClient_ListBeaconStates(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/api/list-beacon-state":
+			if err := json.NewDecoder(r.Body).Decode(&request); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.Write([]byte(`{"beacon_states":[{"id":"abc","node":"cl-1-lighthouse-geth","fetched_at":"2024-01-02T03:04:05Z","slot":"64","epoch":2,"state_root":"0x01","beacon_implementation":"lighthouse"}]}`))
+		case r.URL.Path == "/download/beacon_state/abc":
+			w.Write([]byte("ssz"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tracoor := NewTracoorClient(server.URL + "/")
+	states, err := tracoor.ListBeaconStates(context.Background(), BeaconStateFilter{Node: "cl-1-lighthouse-geth", Slot: 64})
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, "abc", states[0].ID)
+	assert.Equal(t, Uint64(64), states[0].Slot)
+	assert.Equal(t, Uint64(2), states[0].Epoch)
+	assert.Equal(t, "lighthouse", states[0].BeaconImplementation)
+	assert.Equal(t, "cl-1-lighthouse-geth", request["node"])
+	assert.Equal(t, float64(64), request["slot"])
+	assert.NotContains(t, request, "epoch")
+	assert.Equal(t, float64(100), request["pagination"].(map[string]interface{})["limit"])
+
+	data, err := tracoor.DownloadBeaconState(context.Background(), "abc")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ssz"), data)
+
+	_, err = tracoor.DownloadBeaconState(context.Background(), "missing")
+	assert.ErrorContains(t, err, "download of beacon state missing failed with status 404")
+}
+
+func TestUint64_UnmarshalJSON(t *testing.T) {
+	var v struct{ A, B, C Uint64 }
+	require.NoError(t, json.Unmarshal([]byte(`{"A":"12","B":34,"C":null}`), &v))
+	assert.Equal(t, Uint64(12), v.A)
+	assert.Equal(t, Uint64(34), v.B)
+	assert.Equal(t, Uint64(0), v.C)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"A":"x"}`), &v))
+}
@@ -0,0 +1,427 @@
+package soak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/ethpandaops/ethereum-package-go/pkg/results"
+)
+
+const (
+	// DefaultInterval is how often a soak run snapshots the network
+	DefaultInterval = time.Minute
+	// DefaultFinalityStallEpochs is how many epochs finality may lag the head
+	// before a soak run reports a stall
+	DefaultFinalityStallEpochs = 4
+	// maxSlotsPerSnapshot bounds how many slots are checked for missed blocks per snapshot
+	maxSlotsPerSnapshot = 256
+)
+
+// AnomalyKind classifies what went wrong during a soak run
+type AnomalyKind string
+
+const (
+	// AnomalyUnhealthy is a service failing its health check
+	AnomalyUnhealthy AnomalyKind = "unhealthy"
+	// AnomalyRecovered is a service passing its health check again, e.g. after a restart
+	AnomalyRecovered AnomalyKind = "recovered"
+	// AnomalyMissedSlot is a slot without a block
+	AnomalyMissedSlot AnomalyKind = "missed_slot"
+	// AnomalyReorg is a consensus client dropping the head it had at the previous snapshot
+	AnomalyReorg AnomalyKind = "reorg"
+	// AnomalyFinalityStall is finality lagging the head by more than the allowed epochs
+	AnomalyFinalityStall AnomalyKind = "finality_stall"
+	// AnomalyCheckFailed is a user check returning an error
+	AnomalyCheckFailed AnomalyKind = "check_failed"
+	// AnomalyQueryFailed is a client failing to answer a snapshot query
+	AnomalyQueryFailed AnomalyKind = "query_failed"
+)
+
+// anomalyKinds lists every anomaly kind in report order
+var anomalyKinds = []AnomalyKind{
+	AnomalyUnhealthy,
+	AnomalyRecovered,
+	AnomalyMissedSlot,
+	AnomalyReorg,
+	AnomalyFinalityStall,
+	AnomalyCheckFailed,
+	AnomalyQueryFailed,
+}
+
+// Anomaly is a problem observed during a soak run
+type Anomaly struct {
+	Time time.Time
+	Kind AnomalyKind
+	// Source is the service, client or check the anomaly was seen on
+	Source  string
+	Message string
+}
+
+// String formats the anomaly as a single line
+func (a Anomaly) String() string {
+	return fmt.Sprintf("%s %s %s: %s", a.Time.Format(time.RFC3339), a.Kind, a.Source, a.Message)
+}
+
+// Snapshot is the state of the network at one point of a soak run
+type Snapshot struct {
+	Time time.Time
+	// Unhealthy maps the services failing their health check to the failure
+	Unhealthy map[string]error
+	// Heads maps consensus client name to its fork choice head
+	Heads map[string]client.ForkChoiceNode
+	// BlockNumbers maps execution client name to its latest block number
+	BlockNumbers map[string]uint64
+	// HeadSlot is the highest head slot of any consensus client
+	HeadSlot uint64
+	// FinalizedEpoch is the highest finalized epoch of any consensus client
+	FinalizedEpoch uint64
+	// SlotsProduced and SlotsMissed count the slots since the previous snapshot
+	SlotsProduced int
+	SlotsMissed   int
+}
+
+// Check is a custom assertion run at every snapshot. An error is reported as an anomaly.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context, net network.Network) error
+}
+
+// Report is the outcome of a soak run
+type Report struct {
+	Start     time.Time
+	End       time.Time
+	Snapshots []Snapshot
+	Anomalies []Anomaly
+}
+
+// Passed reports whether the run saw no anomalies
+func (r *Report) Passed() bool {
+	return len(r.Anomalies) == 0
+}
+
+// ByKind returns the anomalies of one kind
+func (r *Report) ByKind(kind AnomalyKind) []Anomaly {
+	var anomalies []Anomaly
+	for _, anomaly := range r.Anomalies {
+		if anomaly.Kind == kind {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+	return anomalies
+}
+
+// ProductionRate returns the fraction of checked slots that had a block, or 1 if
+// no slots were checked
+func (r *Report) ProductionRate() float64 {
+	produced, missed := 0, 0
+	for _, snapshot := range r.Snapshots {
+		produced += snapshot.SlotsProduced
+		missed += snapshot.SlotsMissed
+	}
+	if produced+missed == 0 {
+		return 1
+	}
+	return float64(produced) / float64(produced+missed)
+}
+
+// String summarizes the run and lists its anomalies
+func (r *Report) String() string {
+	counts := make(map[AnomalyKind]int)
+	for _, anomaly := range r.Anomalies {
+		counts[anomaly.Kind]++
+	}
+	kinds := make([]string, 0, len(counts))
+	for kind, count := range counts {
+		kinds = append(kinds, fmt.Sprintf("%s=%d", kind, count))
+	}
+	sort.Strings(kinds)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "soak %s, %d snapshots, block production %.1f%%, %d anomalies",
+		r.End.Sub(r.Start).Round(time.Second), len(r.Snapshots), r.ProductionRate()*100, len(r.Anomalies))
+	if len(kinds) > 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(kinds, ", "))
+	}
+	b.WriteString("\n")
+	for _, anomaly := range r.Anomalies {
+		fmt.Fprintf(&b, "  %s\n", anomaly)
+	}
+	return b.String()
+}
+
+// Suite converts the report for export, with one case per anomaly kind that
+// fails if the run saw any anomaly of that kind
+func (r *Report) Suite(name string) results.Suite {
+	suite := results.Suite{
+		Name: name,
+		Description: fmt.Sprintf("soak %s, %d snapshots, block production %.1f%%",
+			r.End.Sub(r.Start).Round(time.Second), len(r.Snapshots), r.ProductionRate()*100),
+	}
+	for _, kind := range anomalyKinds {
+		anomalies := r.ByKind(kind)
+		lines := make([]string, len(anomalies))
+		for i, anomaly := range anomalies {
+			lines[i] = anomaly.String()
+		}
+		suite.Cases = append(suite.Cases, results.Case{
+			Name:    string(kind),
+			Start:   r.Start,
+			End:     r.End,
+			Passed:  len(anomalies) == 0,
+			Details: strings.Join(lines, "\n"),
+		})
+	}
+	return suite
+}
+
+// Runner snapshots a network periodically over a long run
+type Runner struct {
+	net         network.Network
+	interval    time.Duration
+	stallEpochs uint64
+	onSnapshot  func(Snapshot, []Anomaly)
+}
+
+// NewRunner creates a soak runner for the network
+func NewRunner(net network.Network) *Runner {
+	return &Runner{
+		net:         net,
+		interval:    DefaultInterval,
+		stallEpochs: DefaultFinalityStallEpochs,
+	}
+}
+
+// WithInterval sets how often the network is snapshotted. Non-positive values are ignored.
+func (r *Runner) WithInterval(interval time.Duration) *Runner {
+	if interval > 0 {
+		r.interval = interval
+	}
+	return r
+}
+
+// WithFinalityStallEpochs sets how many epochs finality may lag the head before a stall is reported
+func (r *Runner) WithFinalityStallEpochs(epochs uint64) *Runner {
+	r.stallEpochs = epochs
+	return r
+}
+
+// WithSnapshotHook calls fn after every snapshot with the anomalies it found,
+// e.g. to stream progress to a job log
+func (r *Runner) WithSnapshotHook(fn func(Snapshot, []Anomaly)) *Runner {
+	r.onSnapshot = fn
+	return r
+}
+
+// Run snapshots the network for the given duration with the default settings
+func Run(ctx context.Context, net network.Network, duration time.Duration, checks ...Check) (*Report, error) {
+	return NewRunner(net).Run(ctx, duration, checks...)
+}
+
+// Run snapshots the network every interval until duration has passed and
+// returns the report. The report so far is returned alongside the error if ctx
+// is canceled first.
+func (r *Runner) Run(ctx context.Context, duration time.Duration, checks ...Check) (*Report, error) {
+	report := &Report{Start: time.Now()}
+	state := &runState{unhealthy: make(map[string]bool), heads: make(map[string]client.ForkChoiceNode)}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		snapshot, anomalies := r.snapshot(ctx, state, checks)
+		if ctx.Err() != nil {
+			report.End = time.Now()
+			return report, ctx.Err()
+		}
+		report.Snapshots = append(report.Snapshots, snapshot)
+		report.Anomalies = append(report.Anomalies, anomalies...)
+		if r.onSnapshot != nil {
+			r.onSnapshot(snapshot, anomalies)
+		}
+
+		select {
+		case <-ctx.Done():
+			report.End = time.Now()
+			return report, ctx.Err()
+		case <-timer.C:
+			report.End = time.Now()
+			return report, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// runState carries what earlier snapshots saw
+type runState struct {
+	unhealthy   map[string]bool
+	heads       map[string]client.ForkChoiceNode
+	checkedSlot uint64
+	stalled     bool
+}
+
+// snapshot captures the network state and the anomalies since the previous snapshot
+func (r *Runner) snapshot(ctx context.Context, state *runState, checks []Check) (Snapshot, []Anomaly) {
+	now := time.Now()
+	snapshot := Snapshot{
+		Time:         now,
+		Unhealthy:    make(map[string]error),
+		Heads:        make(map[string]client.ForkChoiceNode),
+		BlockNumbers: make(map[string]uint64),
+	}
+	var anomalies []Anomaly
+	report := func(kind AnomalyKind, source, format string, args ...interface{}) {
+		anomalies = append(anomalies, Anomaly{Time: now, Kind: kind, Source: source, Message: fmt.Sprintf(format, args...)})
+	}
+
+	// Health transitions
+	health := r.net.Health(ctx)
+	names := make([]string, 0, len(health))
+	for name := range health {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := health[name]
+		if err != nil {
+			snapshot.Unhealthy[name] = err
+			if !state.unhealthy[name] {
+				report(AnomalyUnhealthy, name, "%v", err)
+			}
+		} else if state.unhealthy[name] {
+			report(AnomalyRecovered, name, "healthy again")
+		}
+		state.unhealthy[name] = err != nil
+	}
+
+	// Consensus heads, reorgs and finality
+	lateJoiners := r.net.LateJoiners()
+	var beacons []client.ConsensusClient
+	if r.net.ConsensusClients() != nil {
+		beacons = r.net.ConsensusClients().Except(lateJoiners...)
+	}
+	for _, beacon := range beacons {
+		forkChoice, err := beacon.ForkChoice(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				report(AnomalyQueryFailed, beacon.Name(), "fork choice: %v", err)
+			}
+			continue
+		}
+		head, ok := forkChoice.Head()
+		if !ok {
+			continue
+		}
+		snapshot.Heads[beacon.Name()] = head
+		if head.Slot > snapshot.HeadSlot {
+			snapshot.HeadSlot = head.Slot
+		}
+		if forkChoice.FinalizedCheckpoint.Epoch > snapshot.FinalizedEpoch {
+			snapshot.FinalizedEpoch = forkChoice.FinalizedCheckpoint.Epoch
+		}
+		if previous, ok := state.heads[beacon.Name()]; ok && reorged(forkChoice, head, previous, r.net.SpecPreset().SlotsPerEpoch()) {
+			report(AnomalyReorg, beacon.Name(), "head %s at slot %d was dropped, new head %s at slot %d",
+				previous.BlockRoot, previous.Slot, head.BlockRoot, head.Slot)
+		}
+		state.heads[beacon.Name()] = head
+	}
+
+	// Execution heads
+	if r.net.ExecutionClients() != nil {
+		for _, el := range r.net.ExecutionClients().Except(lateJoiners...) {
+			number, err := client.RPC(el).GetBlockNumber(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					report(AnomalyQueryFailed, el.Name(), "block number: %v", err)
+				}
+				continue
+			}
+			snapshot.BlockNumbers[el.Name()] = number
+		}
+	}
+
+	// Missed slots since the previous snapshot
+	if len(beacons) > 0 && snapshot.HeadSlot > state.checkedSlot {
+		from := state.checkedSlot + 1
+		if snapshot.HeadSlot-from >= maxSlotsPerSnapshot {
+			from = snapshot.HeadSlot - maxSlotsPerSnapshot + 1
+		}
+		for slot := from; slot <= snapshot.HeadSlot; slot++ {
+			_, err := beacons[0].BlockProposer(ctx, slot)
+			switch {
+			case errors.Is(err, client.ErrBlockNotFound):
+				snapshot.SlotsMissed++
+				report(AnomalyMissedSlot, beacons[0].Name(), "no block at slot %d", slot)
+			case err != nil:
+				continue
+			default:
+				snapshot.SlotsProduced++
+			}
+		}
+		state.checkedSlot = snapshot.HeadSlot
+	}
+
+	// Finality lag
+	if slotsPerEpoch := r.net.SpecPreset().SlotsPerEpoch(); slotsPerEpoch > 0 && len(snapshot.Heads) > 0 {
+		headEpoch := snapshot.HeadSlot / slotsPerEpoch
+		stalled := headEpoch > snapshot.FinalizedEpoch+r.stallEpochs
+		if stalled && !state.stalled {
+			report(AnomalyFinalityStall, "network", "head epoch %d, finalized epoch %d", headEpoch, snapshot.FinalizedEpoch)
+		}
+		state.stalled = stalled
+	}
+
+	for _, check := range checks {
+		if err := check.Run(ctx, r.net); err != nil && ctx.Err() == nil {
+			report(AnomalyCheckFailed, check.Name, "%v", err)
+		}
+	}
+
+	return snapshot, anomalies
+}
+
+// reorged reports whether the previous head is no longer an ancestor of the
+// current one. A previous head pruned from the store is only a reorg if it was
+// newer than the finalized checkpoint.
+func reorged(forkChoice *client.ForkChoice, head, previous client.ForkChoiceNode, slotsPerEpoch uint64) bool {
+	if head.BlockRoot == previous.BlockRoot {
+		return false
+	}
+
+	nodes := make(map[string]client.ForkChoiceNode, len(forkChoice.Nodes))
+	for _, node := range forkChoice.Nodes {
+		nodes[node.BlockRoot] = node
+	}
+	if _, known := nodes[previous.BlockRoot]; !known {
+		return previous.Slot > forkChoice.FinalizedCheckpoint.Epoch*slotsPerEpoch && previous.Slot > lowestSlot(forkChoice)
+	}
+
+	for root := head.BlockRoot; ; {
+		if root == previous.BlockRoot {
+			return false
+		}
+		node, ok := nodes[root]
+		if !ok {
+			return true
+		}
+		root = node.ParentRoot
+	}
+}
+
+// lowestSlot returns the slot of the oldest block in the store
+func lowestSlot(forkChoice *client.ForkChoice) uint64 {
+	var lowest uint64
+	for i, node := range forkChoice.Nodes {
+		if i == 0 || node.Slot < lowest {
+			lowest = node.Slot
+		}
+	}
+	return lowest
+}
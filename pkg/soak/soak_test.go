@@ -0,0 +1,169 @@
+package soak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// The first fork choice has head 0xa at slot 2; later ones switched to a fork
+	// through 0xb with head 0xc at slot 4
+	forkChoiceBefore = `{"finalized_checkpoint":{"epoch":"0","root":"0x00"},"fork_choice_nodes":[
+		{"slot":"0","block_root":"0x00","parent_root":"","weight":"1"},
+		{"slot":"2","block_root":"0xa","parent_root":"0x00","weight":"1"}]}`
+	forkChoiceAfter = `{"finalized_checkpoint":{"epoch":"0","root":"0x00"},"fork_choice_nodes":[
+		{"slot":"0","block_root":"0x00","parent_root":"","weight":"1"},
+		{"slot":"2","block_root":"0xa","parent_root":"0x00","weight":"0"},
+		{"slot":"1","block_root":"0xb","parent_root":"0x00","weight":"2"},
+		{"slot":"4","block_root":"0xc","parent_root":"0xb","weight":"2"}]}`
+)
+
+func newSoakNetwork(t *testing.T) network.Network {
+	t.Helper()
+	var forkChoiceCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/eth/v1/debug/fork_choice":
+			if forkChoiceCalls.Add(1) == 1 {
+				_, _ = w.Write([]byte(forkChoiceBefore))
+				return
+			}
+			_, _ = w.Write([]byte(forkChoiceAfter))
+		case r.URL.Path == "/eth/v1/beacon/headers/3":
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/headers/"):
+			_, _ = w.Write([]byte(`{"data":{"header":{"message":{"proposer_index":"1"}}}}`))
+		case r.URL.Path == "/":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x4"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	executionClients := client.NewExecutionClients()
+	executionClients.Add(client.NewExecutionClient(client.Geth, "el-1-geth-lighthouse", "", server.URL, "", "", "", "", "el-1-geth-lighthouse", "", 0))
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+	return network.New(network.Config{
+		Name:             "soak",
+		SpecPreset:       config.SpecPresetMinimal,
+		ExecutionClients: executionClients,
+		ConsensusClients: consensusClients,
+		OrphanOnExit:     true,
+	})
+}
+
+func TestRunnerRun(t *testing.T) {
+	net := newSoakNetwork(t)
+
+	var checks atomic.Int32
+	check := Check{Name: "custom", Run: func(ctx context.Context, net network.Network) error {
+		if checks.Add(1) == 1 {
+			return errors.New("first check fails")
+		}
+		return nil
+	}}
+	var hooked atomic.Int32
+
+	report, err := NewRunner(net).
+		WithInterval(10*time.Millisecond).
+		WithSnapshotHook(func(Snapshot, []Anomaly) { hooked.Add(1) }).
+		Run(context.Background(), 45*time.Millisecond, check)
+	require.NoError(t, err)
+
+	require.GreaterOrEqual(t, len(report.Snapshots), 2)
+	assert.Equal(t, int32(len(report.Snapshots)), hooked.Load())
+	first, second := report.Snapshots[0], report.Snapshots[1]
+	assert.Equal(t, uint64(2), first.HeadSlot)
+	assert.Equal(t, uint64(4), second.HeadSlot)
+	assert.Equal(t, uint64(4), second.BlockNumbers["el-1-geth-lighthouse"])
+
+	// Slots 1-2 are produced at the first snapshot, 3 is missed and 4 produced at the second
+	assert.Equal(t, 2, first.SlotsProduced)
+	assert.Equal(t, 1, second.SlotsMissed)
+	assert.InDelta(t, 0.75, report.ProductionRate(), 0.001)
+
+	require.Len(t, report.ByKind(AnomalyMissedSlot), 1)
+	reorgs := report.ByKind(AnomalyReorg)
+	require.Len(t, reorgs, 1)
+	assert.Contains(t, reorgs[0].Message, "head 0xa at slot 2 was dropped")
+	require.Len(t, report.ByKind(AnomalyCheckFailed), 1)
+	assert.Equal(t, "custom", report.ByKind(AnomalyCheckFailed)[0].Source)
+	assert.False(t, report.Passed())
+	assert.Contains(t, report.String(), "check_failed=1, missed_slot=1, reorg=1")
+}
+
+func TestRunCanceled(t *testing.T) {
+	net := newSoakNetwork(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	runner := NewRunner(net).WithInterval(time.Hour).WithSnapshotHook(func(Snapshot, []Anomaly) { cancel() })
+	report, err := runner.Run(ctx, time.Hour)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, report.Snapshots, 1)
+}
+
+func TestFinalityStall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/eth/v1/debug/fork_choice" {
+			fmt.Fprint(w, `{"finalized_checkpoint":{"epoch":"1","root":"0x01"},"fork_choice_nodes":[{"slot":"80","block_root":"0x50","parent_root":"0x4f","weight":"1"}]}`)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"header":{"message":{"proposer_index":"1"}}}}`))
+	}))
+	defer server.Close()
+
+	consensusClients := client.NewConsensusClients()
+	consensusClients.Add(client.NewConsensusClient(client.Lighthouse, "cl-1-lighthouse-geth", "", server.URL, "", "", "", "cl-1-lighthouse-geth", "", 0))
+	net := network.New(network.Config{Name: "soak", SpecPreset: config.SpecPresetMinimal, ConsensusClients: consensusClients, OrphanOnExit: true})
+
+	// Head epoch 10 with epoch 1 finalized is a stall, reported once
+	report, err := NewRunner(net).WithInterval(10*time.Millisecond).Run(context.Background(), 35*time.Millisecond)
+	require.NoError(t, err)
+	require.Len(t, report.ByKind(AnomalyFinalityStall), 1)
+	assert.Contains(t, report.ByKind(AnomalyFinalityStall)[0].Message, "head epoch 10, finalized epoch 1")
+}
+
+func TestReportSuite(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	report := &Report{
+		Start: start,
+		End:   start.Add(time.Hour),
+		Anomalies: []Anomaly{
+			{Time: start, Kind: AnomalyMissedSlot, Source: "network", Message: "slot 3 has no block"},
+			{Time: start, Kind: AnomalyMissedSlot, Source: "network", Message: "slot 9 has no block"},
+		},
+	}
+
+	suite := report.Suite("soak")
+	assert.Equal(t, "soak", suite.Name)
+	require.Len(t, suite.Cases, len(anomalyKinds))
+	assert.Equal(t, 1, suite.Failures())
+
+	for _, c := range suite.Cases {
+		assert.Equal(t, start, c.Start)
+		assert.Equal(t, start.Add(time.Hour), c.End)
+		if c.Name == string(AnomalyMissedSlot) {
+			assert.False(t, c.Passed)
+			assert.Contains(t, c.Details, "slot 3 has no block\n")
+			assert.Contains(t, c.Details, "slot 9 has no block")
+		} else {
+			assert.True(t, c.Passed, c.Name)
+		}
+	}
+}
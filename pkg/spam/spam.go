@@ -0,0 +1,254 @@
+// Package spam generates transaction load against a network from a funded
+// account, as a lightweight in-process alternative to the spamoor service.
+package spam
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/wallet"
+)
+
+const (
+	// DefaultTPS is how many transactions per second a spammer sends
+	DefaultTPS = 10
+	// DefaultDuration is how long a spammer runs
+	DefaultDuration = time.Minute
+	// DefaultBlobsPerTx is how many blobs each blob transaction carries
+	DefaultBlobsPerTx = 1
+	// MaxBlobsPerTx is the most blobs a transaction may carry
+	MaxBlobsPerTx = 6
+	// maxReportedErrors bounds how many send errors a report keeps
+	maxReportedErrors = 10
+	// deployPollInterval is how often the token deployment is checked
+	deployPollInterval = time.Second
+)
+
+// Scenario is the kind of transactions a spammer sends
+type Scenario string
+
+const (
+	// ScenarioTransfers sends 1 wei value transfers
+	ScenarioTransfers Scenario = "transfers"
+	// ScenarioERC20 deploys a token contract and mints one token per transaction
+	ScenarioERC20 Scenario = "erc20"
+	// ScenarioBlobs sends EIP-4844 transactions carrying blobs
+	ScenarioBlobs Scenario = "blobs"
+)
+
+// Report is the outcome of a spam run
+type Report struct {
+	Scenario Scenario
+	Start    time.Time
+	End      time.Time
+	// Sent and Failed count the transactions accepted and rejected by the node
+	Sent   int
+	Failed int
+	// Hashes are the hashes of the sent transactions
+	Hashes []string
+	// Errors are the first send errors
+	Errors []error
+	// Token is the address of the contract deployed by ScenarioERC20
+	Token string
+}
+
+// TPS returns the rate at which transactions were accepted
+func (r *Report) TPS() float64 {
+	elapsed := r.End.Sub(r.Start).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(r.Sent) / elapsed
+}
+
+// String summarizes the run
+func (r *Report) String() string {
+	return fmt.Sprintf("spam %s %s, %d sent, %d failed, %.1f tx/s",
+		r.Scenario, r.End.Sub(r.Start).Round(time.Second), r.Sent, r.Failed, r.TPS())
+}
+
+// Spammer sends transactions from one account at a steady rate
+type Spammer struct {
+	signer     *wallet.Signer
+	rpc        *client.BaseExecutionClient
+	scenario   Scenario
+	tps        float64
+	duration   time.Duration
+	recipient  string
+	blobsPerTx int
+}
+
+// New creates a spammer sending value transfers from the signer. el is used to
+// check the token deployment of ScenarioERC20.
+func New(signer *wallet.Signer, el client.ExecutionClient) *Spammer {
+	return &Spammer{
+		signer:     signer,
+		rpc:        client.RPC(el),
+		scenario:   ScenarioTransfers,
+		tps:        DefaultTPS,
+		duration:   DefaultDuration,
+		blobsPerTx: DefaultBlobsPerTx,
+	}
+}
+
+// WithScenario sets the kind of transactions to send
+func (s *Spammer) WithScenario(scenario Scenario) *Spammer {
+	s.scenario = scenario
+	return s
+}
+
+// WithTPS sets how many transactions per second to send. Non-positive values are ignored.
+func (s *Spammer) WithTPS(tps float64) *Spammer {
+	if tps > 0 {
+		s.tps = tps
+	}
+	return s
+}
+
+// WithDuration sets how long to send transactions. Non-positive values are ignored.
+func (s *Spammer) WithDuration(duration time.Duration) *Spammer {
+	if duration > 0 {
+		s.duration = duration
+	}
+	return s
+}
+
+// WithRecipient sets the address transfers, mints and blobs are sent to. It
+// defaults to the sending account itself, so transfers only cost gas.
+func (s *Spammer) WithRecipient(address string) *Spammer {
+	s.recipient = address
+	return s
+}
+
+// WithBlobsPerTx sets how many blobs each blob transaction carries, between 1 and MaxBlobsPerTx
+func (s *Spammer) WithBlobsPerTx(blobs int) *Spammer {
+	if blobs > 0 && blobs <= MaxBlobsPerTx {
+		s.blobsPerTx = blobs
+	}
+	return s
+}
+
+// Run sends transactions at the configured rate until the duration has passed
+// and returns the report. ScenarioERC20 first deploys the token and waits for
+// its inclusion. Transactions are sent one at a time, so the rate is
+// capped by the node's response time; Report.TPS shows the rate reached. The
+// report so far is returned alongside the error if ctx is canceled first.
+func (s *Spammer) Run(ctx context.Context) (*Report, error) {
+	recipient := s.recipient
+	if recipient == "" {
+		recipient = s.signer.Address()
+	}
+
+	var send func(ctx context.Context, i int) (string, error)
+	report := &Report{Scenario: s.scenario}
+	switch s.scenario {
+	case ScenarioTransfers:
+		send = func(ctx context.Context, _ int) (string, error) {
+			return s.signer.Transfer(ctx, recipient, big.NewInt(1))
+		}
+	case ScenarioERC20:
+		token, err := s.deployToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		report.Token = token
+		mint := mintCalldata(recipient)
+		send = func(ctx context.Context, _ int) (string, error) {
+			return s.signer.Send(ctx, client.TransactionRequest{To: token, Data: mint})
+		}
+	case ScenarioBlobs:
+		send = func(ctx context.Context, i int) (string, error) {
+			return s.signer.SendBlobs(ctx, recipient, makeBlobs(i, s.blobsPerTx))
+		}
+	default:
+		return nil, fmt.Errorf("unknown spam scenario %q", s.scenario)
+	}
+
+	// The token deployment is setup, not part of the run
+	report.Start = time.Now()
+	timer := time.NewTimer(s.duration)
+	defer timer.Stop()
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / s.tps))
+	defer ticker.Stop()
+
+	for i := 0; ; i++ {
+		hash, err := send(ctx, i)
+		if ctx.Err() != nil {
+			report.End = time.Now()
+			return report, ctx.Err()
+		}
+		if err != nil {
+			report.Failed++
+			if len(report.Errors) < maxReportedErrors {
+				report.Errors = append(report.Errors, err)
+			}
+		} else {
+			report.Sent++
+			report.Hashes = append(report.Hashes, hash)
+		}
+		// A slow send may overrun the duration with both the timer and the ticker ready
+		if time.Since(report.Start) >= s.duration {
+			report.End = time.Now()
+			return report, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			report.End = time.Now()
+			return report, ctx.Err()
+		case <-timer.C:
+			report.End = time.Now()
+			return report, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// deployToken deploys the token contract and waits until it is included
+func (s *Spammer) deployToken(ctx context.Context) (string, error) {
+	_, address, err := s.signer.Deploy(ctx, tokenCode)
+	if err != nil {
+		return "", fmt.Errorf("failed to deploy token: %w", err)
+	}
+
+	ticker := time.NewTicker(deployPollInterval)
+	defer ticker.Stop()
+	for {
+		if code, err := s.rpc.Code(ctx, address); err == nil && code != "" && code != "0x" {
+			return address, nil
+		}
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("token %s was not deployed: %w", address, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// mintCalldata encodes mint(to, 1)
+func mintCalldata(to string) string {
+	return "0x" + mintSelector +
+		strings.Repeat("0", 24) + strings.ToLower(strings.TrimPrefix(to, "0x")) +
+		fmt.Sprintf("%064x", 1)
+}
+
+// makeBlobs returns blobs whose content is unique to transaction i, so every
+// blob has its own commitment. Each 32-byte field element starts with a zero
+// byte to stay below the BLS modulus.
+func makeBlobs(i, count int) []kzg4844.Blob {
+	blobs := make([]kzg4844.Blob, count)
+	for j := range blobs {
+		for offset := 0; offset < len(blobs[j]); offset += 32 {
+			binary.BigEndian.PutUint64(blobs[j][offset+8:], uint64(i))
+			binary.BigEndian.PutUint64(blobs[j][offset+16:], uint64(j))
+			binary.BigEndian.PutUint64(blobs[j][offset+24:], uint64(offset))
+		}
+	}
+	return blobs
+}
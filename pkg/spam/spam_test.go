@@ -0,0 +1,143 @@
+package spam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/wallet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSpamSigner serves an execution client that accepts every transaction and
+// returns a faucet signer sending through it along with the raw transactions sent
+func newSpamSigner(t *testing.T) (*wallet.Signer, client.ExecutionClient, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		var result interface{}
+		switch req.Method {
+		case "eth_getTransactionCount":
+			result = "0x0"
+		case "eth_gasPrice":
+			result = "0x3b9aca00"
+		case "eth_blobBaseFee":
+			result = "0x1"
+		case "eth_estimateGas":
+			result = "0xc350"
+		case "eth_getCode":
+			result = "0x6000"
+		case "eth_sendRawTransaction":
+			var raw string
+			if err := json.Unmarshal(req.Params[0], &raw); !assert.NoError(t, err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			sent = append(sent, raw)
+			result = "0xtx"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+	t.Cleanup(server.Close)
+
+	key, err := wallet.KeyFromHex(wallet.DefaultFaucetKey)
+	require.NoError(t, err)
+	el := client.NewExecutionClient(client.Geth, "el-1-geth", "", server.URL, "", "", "", "", "el-1-geth", "", 0)
+	return wallet.NewSigner(key, el, 3151908), el, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), sent...)
+	}
+}
+
+func TestRunTransfers(t *testing.T) {
+	signer, el, sent := newSpamSigner(t)
+
+	report, err := New(signer, el).WithTPS(100).WithDuration(200 * time.Millisecond).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, ScenarioTransfers, report.Scenario)
+	assert.Greater(t, report.Sent, 1)
+	assert.Zero(t, report.Failed)
+	assert.Len(t, report.Hashes, report.Sent)
+	assert.Len(t, sent(), report.Sent)
+	assert.Greater(t, report.TPS(), 0.0)
+	assert.Contains(t, report.String(), "spam transfers")
+}
+
+func TestRunERC20(t *testing.T) {
+	signer, el, sent := newSpamSigner(t)
+
+	report, err := New(signer, el).WithScenario(ScenarioERC20).WithDuration(50 * time.Millisecond).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, wallet.ContractAddress(signer.Address(), 0), report.Token)
+	assert.Greater(t, report.Sent, 0)
+	// The deployment comes first and is not counted
+	assert.Len(t, sent(), report.Sent+1)
+}
+
+func TestRunBlobs(t *testing.T) {
+	signer, el, sent := newSpamSigner(t)
+
+	report, err := New(signer, el).WithScenario(ScenarioBlobs).WithBlobsPerTx(2).WithDuration(time.Millisecond).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Sent)
+	require.Len(t, sent(), 1)
+	assert.True(t, strings.HasPrefix(sent()[0], "0x03"))
+}
+
+func TestRunUnknownScenario(t *testing.T) {
+	signer, el, _ := newSpamSigner(t)
+
+	_, err := New(signer, el).WithScenario("nft").Run(context.Background())
+	assert.Error(t, err)
+}
+
+func TestRunCanceled(t *testing.T) {
+	signer, el, _ := newSpamSigner(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	report, err := New(signer, el).WithDuration(time.Hour).Run(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotNil(t, report)
+	assert.Greater(t, report.Sent, 0)
+}
+
+func TestMakeBlobs(t *testing.T) {
+	blobs := makeBlobs(7, 2)
+	require.Len(t, blobs, 2)
+	assert.NotEqual(t, blobs[0], blobs[1])
+	assert.NotEqual(t, makeBlobs(8, 1)[0], blobs[0])
+	for offset := 0; offset < len(blobs[0]); offset += 32 {
+		assert.Zero(t, blobs[0][offset])
+	}
+}
+
+func TestMintCalldata(t *testing.T) {
+	data := mintCalldata("0x8943545177806ED17B9F23F0a21ee5948eCaa776")
+	assert.Equal(t, "0x40c10f19"+
+		"0000000000000000000000008943545177806ed17b9f23f0a21ee5948ecaa776"+
+		"0000000000000000000000000000000000000000000000000000000000000001", data)
+}
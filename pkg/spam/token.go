@@ -0,0 +1,40 @@
+package spam
+
+import "encoding/hex"
+
+// mintSelector is the selector of mint(address,uint256)
+const mintSelector = "40c10f19"
+
+// tokenCode is the init code of a minimal ERC-20 style token. The balance of
+// an address is stored in the slot numbered by the address. It implements
+//
+//	mint(address to, uint256 amount)             40c10f19, open to anyone
+//	transfer(address to, uint256 amount) bool    a9059cbb, reverts on insufficient balance
+//	balanceOf(address owner) uint256             70a08231
+//
+// and emits the standard Transfer event for mints and transfers. Any other
+// call reverts.
+var tokenCode = mustDecodeHex("" +
+	// init: copy the 189-byte runtime after the 11-byte init code to memory and return it
+	"60bd80600b6000396000f3" +
+	// dispatch on the selector
+	"60003560e01c" +
+	"806340c10f19146029578063a9059cbb14606257806370a082311460b057" +
+	// revert
+	"5b600080fd" +
+	// mint: balance[to] += amount; emit Transfer(0, to, amount)
+	"5b60043560243580825401825560005260007fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef60206000a300" +
+	// transfer: check, debit the caller, credit to, emit Transfer(caller, to, amount), return true
+	"5b6004356024353354818110602457819003335580825401825560005233" +
+	"7fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef60206000a3600160005260206000f3" +
+	// balanceOf: return balance[owner]
+	"5b6004355460005260206000f3")
+
+// mustDecodeHex decodes a hex constant
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
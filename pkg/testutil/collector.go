@@ -0,0 +1,59 @@
+package testutil
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/results"
+)
+
+// Collector records assertion outcomes so they can be exported as JUnit XML or
+// TAP, e.g. from a nightly runner binary that does not run under go test. It is
+// safe for concurrent use.
+type Collector struct {
+	mu    sync.Mutex
+	suite string
+	cases []results.Case
+}
+
+// NewCollector creates a collector whose outcomes form the named suite
+func NewCollector(suite string) *Collector {
+	return &Collector{suite: suite}
+}
+
+// Record adds the outcome of an assertion. An empty failure means it passed.
+func (c *Collector) Record(name, failure string) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cases = append(c.cases, results.Case{
+		Name:    name,
+		Start:   now,
+		End:     now,
+		Passed:  failure == "",
+		Details: failure,
+	})
+}
+
+// Passed reports whether every recorded assertion passed
+func (c *Collector) Passed() bool {
+	return c.Suite().Failures() == 0
+}
+
+// Suite returns the recorded outcomes in order
+func (c *Collector) Suite() results.Suite {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return results.Suite{Name: c.suite, Cases: append([]results.Case(nil), c.cases...)}
+}
+
+// WriteJUnit writes the recorded outcomes as a JUnit XML report
+func (c *Collector) WriteJUnit(w io.Writer) error {
+	return results.WriteJUnit(w, c.Suite())
+}
+
+// WriteTAP writes the recorded outcomes as a TAP stream
+func (c *Collector) WriteTAP(w io.Writer) error {
+	return results.WriteTAP(w, c.Suite())
+}
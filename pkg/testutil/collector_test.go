@@ -0,0 +1,57 @@
+package testutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+func TestAssertIntoCollector(t *testing.T) {
+	net := network.New(network.Config{
+		Name:             "test",
+		ChainID:          12345,
+		ExecutionClients: client.NewExecutionClients(),
+		OrphanOnExit:     true,
+	})
+
+	collector := NewCollector("smoke")
+	AssertInto(collector, net).
+		HasChainID(12345).
+		HasExecutionClients(1)
+
+	assert.False(t, collector.Passed())
+	suite := collector.Suite()
+	assert.Equal(t, "smoke", suite.Name)
+	require.Len(t, suite.Cases, 2)
+	assert.Equal(t, "HasChainID(12345)", suite.Cases[0].Name)
+	assert.True(t, suite.Cases[0].Passed)
+	assert.False(t, suite.Cases[1].Passed)
+	assert.Equal(t, "Expected 1 execution clients, got 0", suite.Cases[1].Details)
+
+	var junit, tap bytes.Buffer
+	require.NoError(t, collector.WriteJUnit(&junit))
+	assert.Contains(t, junit.String(), `<testsuite name="smoke"`)
+	require.NoError(t, collector.WriteTAP(&tap))
+	assert.Contains(t, tap.String(), "ok 1 - smoke/HasChainID(12345)")
+	assert.Contains(t, tap.String(), "not ok 2 - smoke/HasExecutionClients(1)")
+}
+
+func TestAssertWithCollector(t *testing.T) {
+	net := network.New(network.Config{
+		Name:             "test",
+		ChainID:          12345,
+		ExecutionClients: client.NewExecutionClients(),
+		OrphanOnExit:     true,
+	})
+
+	collector := NewCollector("smoke")
+	Assert(t, net).WithCollector(collector).HasChainID(12345)
+
+	assert.True(t, collector.Passed())
+	assert.Len(t, collector.Suite().Cases, 1)
+}
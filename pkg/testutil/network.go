@@ -2,6 +2,8 @@ package testutil
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -10,6 +12,7 @@ import (
 	"github.com/ethpandaops/ethereum-package-go/pkg/client"
 	"github.com/ethpandaops/ethereum-package-go/pkg/config"
 	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/ethpandaops/ethereum-package-go/pkg/wallet"
 )
 
 // TestNetwork wraps a network with test-specific functionality
@@ -208,6 +211,31 @@ func (tn *TestNetwork) RequireHealthy() {
 	tn.t.Log("All network components are healthy")
 }
 
+// FundedAccount creates an account funded with amount wei for the test and
+// logs how much of it the test spent when the test ends
+func (tn *TestNetwork) FundedAccount(t testing.TB, amount *big.Int) *wallet.Signer {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	account, err := tn.NewFundedAccount(ctx, amount)
+	if err != nil {
+		t.Fatalf("Failed to create funded account: %v", err)
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		spent, err := account.Spent(ctx)
+		if err != nil {
+			t.Logf("Failed to get spend of account %s: %v", account.Address(), err)
+			return
+		}
+		t.Logf("Account %s spent %s of %s wei", account.Address(), spent, account.Funded())
+	})
+	return account
+}
+
 // AddCleanup adds a cleanup function to be called when the test ends
 func (tn *TestNetwork) AddCleanup(fn func()) {
 	tn.mu.Lock()
@@ -298,8 +326,9 @@ func (pn *ParallelNetworks) GetAllNetworks() []*TestNetwork {
 
 // NetworkAssertion provides assertion helpers for networks
 type NetworkAssertion struct {
-	t       testing.TB
-	network network.Network
+	t         testing.TB
+	collector *Collector
+	network   network.Network
 }
 
 // Assert creates a new network assertion helper
@@ -310,45 +339,82 @@ func Assert(t testing.TB, net network.Network) *NetworkAssertion {
 	}
 }
 
+// AssertInto creates a network assertion helper that records into the
+// collector instead of failing a test, for use outside go test
+func AssertInto(collector *Collector, net network.Network) *NetworkAssertion {
+	return &NetworkAssertion{
+		collector: collector,
+		network:   net,
+	}
+}
+
+// WithCollector also records every assertion into the collector
+func (na *NetworkAssertion) WithCollector(collector *Collector) *NetworkAssertion {
+	na.collector = collector
+	return na
+}
+
+// report records the outcome of an assertion and fails the test on failure
+func (na *NetworkAssertion) report(name, failure string) {
+	if na.collector != nil {
+		na.collector.Record(name, failure)
+	}
+	if na.t != nil && failure != "" {
+		na.t.Helper()
+		na.t.Error(failure)
+	}
+}
+
 // HasExecutionClients asserts that the network has execution clients
 func (na *NetworkAssertion) HasExecutionClients(count int) *NetworkAssertion {
-	na.t.Helper()
+	if na.t != nil {
+		na.t.Helper()
+	}
 
-	actual := len(na.network.ExecutionClients().All())
-	if actual != count {
-		na.t.Errorf("Expected %d execution clients, got %d", count, actual)
+	var failure string
+	if actual := len(na.network.ExecutionClients().All()); actual != count {
+		failure = fmt.Sprintf("Expected %d execution clients, got %d", count, actual)
 	}
+	na.report(fmt.Sprintf("HasExecutionClients(%d)", count), failure)
 
 	return na
 }
 
 // HasConsensusClients asserts that the network has consensus clients
 func (na *NetworkAssertion) HasConsensusClients(count int) *NetworkAssertion {
-	na.t.Helper()
+	if na.t != nil {
+		na.t.Helper()
+	}
 
-	actual := len(na.network.ConsensusClients().All())
-	if actual != count {
-		na.t.Errorf("Expected %d consensus clients, got %d", count, actual)
+	var failure string
+	if actual := len(na.network.ConsensusClients().All()); actual != count {
+		failure = fmt.Sprintf("Expected %d consensus clients, got %d", count, actual)
 	}
+	na.report(fmt.Sprintf("HasConsensusClients(%d)", count), failure)
 
 	return na
 }
 
 // HasChainID asserts that the network has the expected chain ID
 func (na *NetworkAssertion) HasChainID(chainID uint64) *NetworkAssertion {
-	na.t.Helper()
+	if na.t != nil {
+		na.t.Helper()
+	}
 
-	actual := na.network.ChainID()
-	if actual != chainID {
-		na.t.Errorf("Expected chain ID %d, got %d", chainID, actual)
+	var failure string
+	if actual := na.network.ChainID(); actual != chainID {
+		failure = fmt.Sprintf("Expected chain ID %d, got %d", chainID, actual)
 	}
+	na.report(fmt.Sprintf("HasChainID(%d)", chainID), failure)
 
 	return na
 }
 
 // HasService asserts that the network has a specific service
 func (na *NetworkAssertion) HasService(serviceType network.ServiceType) *NetworkAssertion {
-	na.t.Helper()
+	if na.t != nil {
+		na.t.Helper()
+	}
 
 	found := false
 	for _, service := range na.network.Services() {
@@ -358,9 +424,11 @@ func (na *NetworkAssertion) HasService(serviceType network.ServiceType) *Network
 		}
 	}
 
+	var failure string
 	if !found {
-		na.t.Errorf("Expected to find service of type %s", serviceType)
+		failure = fmt.Sprintf("Expected to find service of type %s", serviceType)
 	}
+	na.report(fmt.Sprintf("HasService(%s)", serviceType), failure)
 
 	return na
 }
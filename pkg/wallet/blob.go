@@ -0,0 +1,80 @@
+package wallet
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+)
+
+// BlobTx is an EIP-4844 transaction carrying blobs
+type BlobTx struct {
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	BlobFeeCap *big.Int
+	// To is the recipient address; blob transactions cannot create contracts
+	To    string
+	Value *big.Int
+	Data  []byte
+	Blobs []kzg4844.Blob
+}
+
+// SignBlobTx computes the KZG commitments and proofs of the blobs, signs the
+// transaction for the chain and returns it in the network encoding
+// eth_sendRawTransaction expects, along with its hash
+func (k *Key) SignBlobTx(tx BlobTx, chainID uint64) (rawTx, hash string, err error) {
+	to, err := parseAddress(tx.To)
+	if err != nil {
+		return "", "", err
+	}
+	if to == nil {
+		return "", "", fmt.Errorf("blob transactions need a recipient")
+	}
+	if len(tx.Blobs) == 0 {
+		return "", "", fmt.Errorf("blob transactions need at least one blob")
+	}
+
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       tx.Blobs,
+		Commitments: make([]kzg4844.Commitment, len(tx.Blobs)),
+		Proofs:      make([]kzg4844.Proof, len(tx.Blobs)),
+	}
+	for i := range tx.Blobs {
+		blob := &tx.Blobs[i]
+		if sidecar.Commitments[i], err = kzg4844.BlobToCommitment(blob); err != nil {
+			return "", "", fmt.Errorf("failed to commit to blob %d: %w", i, err)
+		}
+		if sidecar.Proofs[i], err = kzg4844.ComputeBlobProof(blob, sidecar.Commitments[i]); err != nil {
+			return "", "", fmt.Errorf("failed to prove blob %d: %w", i, err)
+		}
+	}
+
+	for _, amount := range []*big.Int{tx.GasTipCap, tx.GasFeeCap, tx.Value, tx.BlobFeeCap} {
+		if amount != nil && (amount.Sign() < 0 || amount.BitLen() > 256) {
+			return "", "", fmt.Errorf("amount %s out of range", amount)
+		}
+	}
+
+	id := new(big.Int).SetUint64(chainID)
+	signed, err := types.SignNewTx(k.key, types.NewCancunSigner(id), &types.BlobTx{
+		ChainID:    uint256.MustFromBig(id),
+		Nonce:      tx.Nonce,
+		GasTipCap:  toUint256(tx.GasTipCap),
+		GasFeeCap:  toUint256(tx.GasFeeCap),
+		Gas:        tx.Gas,
+		To:         *to,
+		Value:      toUint256(tx.Value),
+		Data:       tx.Data,
+		BlobFeeCap: toUint256(tx.BlobFeeCap),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to sign blob transaction: %w", err)
+	}
+	return encodeTx(signed)
+}
@@ -0,0 +1,62 @@
+// Package wallet signs execution layer transactions with secp256k1 keys.
+package wallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Key is a secp256k1 private key
+type Key struct {
+	key     *ecdsa.PrivateKey
+	address string
+}
+
+// GenerateKey returns a new random key
+func GenerateKey() (*Key, error) {
+	return GenerateKeyFrom(rand.Reader)
+}
+
+// GenerateKeyFrom returns a new key read from r. A seeded reader gives
+// reproducible keys, which must never hold real funds.
+func GenerateKeyFrom(r io.Reader) (*Key, error) {
+	b := make([]byte, 32)
+	for {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, fmt.Errorf("failed to generate key: %w", err)
+		}
+		// Zero and values past the curve order are rejected; draw again
+		if key, err := crypto.ToECDSA(b); err == nil {
+			return newKey(key), nil
+		}
+	}
+}
+
+// KeyFromHex parses a hex private key, with or without 0x prefix
+func KeyFromHex(privateKey string) (*Key, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	return newKey(key), nil
+}
+
+func newKey(key *ecdsa.PrivateKey) *Key {
+	return &Key{key: key, address: crypto.PubkeyToAddress(key.PublicKey).Hex()}
+}
+
+// Address returns the EIP-55 checksummed address of the key
+func (k *Key) Address() string {
+	return k.address
+}
+
+// Hex returns the 0x-prefixed private key
+func (k *Key) Hex() string {
+	return hexutil.Encode(crypto.FromECDSA(k.key))
+}
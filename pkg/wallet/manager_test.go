@@ -0,0 +1,89 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefundedKeys(t *testing.T) {
+	require.Len(t, PrefundedKeys, 21)
+	assert.Equal(t, DefaultFaucetKey, PrefundedKeys[0])
+
+	key, err := KeyFromHex(PrefundedKeys[20])
+	require.NoError(t, err)
+	assert.Equal(t, "0xafF0CA253b97e54440965855cec0A8a2E2399896", key.Address())
+}
+
+func TestManager(t *testing.T) {
+	var nonceReads int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		var result interface{}
+		switch req.Method {
+		case "eth_getTransactionCount":
+			nonceReads++
+			result = "0x7"
+		case "eth_gasPrice":
+			result = "0x3b9aca00"
+		case "eth_sendRawTransaction":
+			result = "0xtx"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+	defer server.Close()
+
+	el := client.NewExecutionClient(client.Geth, "el-1-geth", "", server.URL, "", "", "", "", "el-1-geth", "", 0)
+	manager, err := NewPrefundedManager(el, 3151908)
+	require.NoError(t, err)
+	require.Equal(t, 21, manager.Len())
+	assert.Equal(t, "0x8943545177806ED17B9F23F0a21ee5948eCaa776", manager.Addresses()[0])
+	assert.Equal(t, "0x"+DefaultFaucetKey, manager.PrivateKeys()[0])
+
+	second, err := manager.Signer(1)
+	require.NoError(t, err)
+	byAddress, err := manager.ByAddress(strings.ToLower(second.Address()))
+	require.NoError(t, err)
+	assert.Same(t, second, byAddress)
+	_, err = manager.Signer(21)
+	assert.Error(t, err)
+	_, err = manager.ByAddress("0x0000000000000000000000000000000000000000")
+	assert.Error(t, err)
+
+	ctx := context.Background()
+	from := second.Address()
+	raw, hash, err := manager.SignTx(ctx, from, client.TransactionRequest{To: from})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(raw, "0x"))
+	assert.Len(t, hash, 66)
+
+	// Signing used nonce 7, so the next transaction takes 8 without asking the node
+	nonce, err := second.Nonce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), nonce)
+	_, err = manager.SendTransaction(ctx, from, client.TransactionRequest{To: from})
+	require.NoError(t, err)
+	nonce, err = second.Nonce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), nonce)
+	assert.Equal(t, 1, nonceReads)
+
+	second.ResetNonce()
+	nonce, err = second.Nonce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), nonce)
+}
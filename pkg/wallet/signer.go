@@ -0,0 +1,259 @@
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// DefaultFaucetKey is the private key of the first account ethereum-package prefunds at genesis
+const DefaultFaucetKey = "bcdf20249abf0ed6d944c0288fad489e33f66b3960d9e6229c1cd214ed3bbe31"
+
+// transferGas is the gas of a plain value transfer
+const transferGas = 21000
+
+// Signer signs and sends transactions from one key through an execution client.
+// It tracks the nonce locally, so transactions can be sent back to back.
+type Signer struct {
+	key     *Key
+	rpc     *client.BaseExecutionClient
+	chainID uint64
+
+	mu         sync.Mutex
+	nonce      uint64
+	nonceKnown bool
+	funded     *big.Int
+}
+
+// NewSigner creates a signer sending through the execution client
+func NewSigner(key *Key, el client.ExecutionClient, chainID uint64) *Signer {
+	return &Signer{
+		key:     key,
+		rpc:     client.RPC(el),
+		chainID: chainID,
+		funded:  new(big.Int),
+	}
+}
+
+// Address returns the signer's address
+func (s *Signer) Address() string {
+	return s.key.Address()
+}
+
+// Key returns the signer's private key
+func (s *Signer) Key() *Key {
+	return s.key
+}
+
+// Send signs and submits a transaction and returns its hash. Transfers without
+// data use 21000 gas; other transactions are estimated.
+func (s *Signer) Send(ctx context.Context, tx client.TransactionRequest) (string, error) {
+	return s.submit(ctx, func(nonce uint64) (string, error) {
+		raw, _, err := s.signLegacy(ctx, tx, nonce)
+		return raw, err
+	})
+}
+
+// SignTx signs a transaction with the next nonce without sending it and
+// returns the raw transaction and its hash. The nonce counts as used, so
+// transactions signed back to back can be submitted together; call ResetNonce
+// if one is never submitted.
+func (s *Signer) SignTx(ctx context.Context, tx client.TransactionRequest) (rawTx, hash string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	nonce, err := s.nextNonce(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if rawTx, hash, err = s.signLegacy(ctx, tx, nonce); err != nil {
+		return "", "", err
+	}
+	s.nonce++
+	return rawTx, hash, nil
+}
+
+// Nonce returns the nonce the next transaction will use
+func (s *Signer) Nonce(ctx context.Context) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.nextNonce(ctx)
+}
+
+// ResetNonce makes the next transaction re-read the nonce from the node, e.g.
+// after the account was used by another sender
+func (s *Signer) ResetNonce() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nonceKnown = false
+}
+
+// Deploy sends a contract creation with the init code and returns the
+// transaction hash and the address the contract will be deployed at
+func (s *Signer) Deploy(ctx context.Context, code []byte) (hash, address string, err error) {
+	hash, err = s.submit(ctx, func(nonce uint64) (string, error) {
+		address = ContractAddress(s.Address(), nonce)
+		raw, _, err := s.signLegacy(ctx, client.TransactionRequest{Data: "0x" + hex.EncodeToString(code)}, nonce)
+		return raw, err
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return hash, address, nil
+}
+
+// SendBlobs sends an EIP-4844 transaction carrying the blobs to an address and
+// returns its hash. The blob fee cap is twice the current blob base fee, so the
+// transaction stays includable if blob space fills up for a few blocks.
+func (s *Signer) SendBlobs(ctx context.Context, to string, blobs []kzg4844.Blob) (string, error) {
+	return s.submit(ctx, func(nonce uint64) (string, error) {
+		gasPrice, err := s.rpc.GasPrice(ctx)
+		if err != nil {
+			return "", err
+		}
+		blobBaseFee, err := s.rpc.BlobBaseFee(ctx)
+		if err != nil {
+			return "", err
+		}
+		raw, _, err := s.key.SignBlobTx(BlobTx{
+			Nonce:      nonce,
+			GasTipCap:  gasPrice,
+			GasFeeCap:  gasPrice,
+			Gas:        transferGas,
+			BlobFeeCap: new(big.Int).Mul(blobBaseFee, big.NewInt(2)),
+			To:         to,
+			Blobs:      blobs,
+		}, s.chainID)
+		return raw, err
+	})
+}
+
+// signLegacy prices, estimates and signs a legacy transaction
+func (s *Signer) signLegacy(ctx context.Context, tx client.TransactionRequest, nonce uint64) (rawTx, hash string, err error) {
+	data, err := decodeHex(tx.Data)
+	if err != nil {
+		return "", "", err
+	}
+	gasPrice, err := s.rpc.GasPrice(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	gas := uint64(transferGas)
+	if tx.Data != "" {
+		if gas, err = s.rpc.EstimateGas(ctx, s.Address(), tx); err != nil {
+			return "", "", err
+		}
+	}
+	return s.key.SignTx(Tx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		To:       tx.To,
+		Value:    tx.Value,
+		Data:     data,
+	}, s.chainID)
+}
+
+// submit signs a transaction with the next nonce and sends it
+func (s *Signer) submit(ctx context.Context, signTx func(nonce uint64) (string, error)) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	nonce, err := s.nextNonce(ctx)
+	if err != nil {
+		return "", err
+	}
+	raw, err := signTx(nonce)
+	if err != nil {
+		return "", err
+	}
+	hash, err := s.rpc.SendRawTransaction(ctx, raw)
+	if err != nil {
+		// The node may have seen the nonce used elsewhere; re-read it next time
+		s.nonceKnown = false
+		return "", err
+	}
+	s.nonce++
+	return hash, nil
+}
+
+// Transfer sends value in wei to an address
+func (s *Signer) Transfer(ctx context.Context, to string, value *big.Int) (string, error) {
+	return s.Send(ctx, client.TransactionRequest{To: to, Value: value})
+}
+
+// Balance returns the signer's current balance in wei
+func (s *Signer) Balance(ctx context.Context) (*big.Int, error) {
+	return s.rpc.Balance(ctx, s.Address(), nil)
+}
+
+// WaitForBalance polls until the signer's balance reaches at least min
+func (s *Signer) WaitForBalance(ctx context.Context, min *big.Int, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if balance, err := s.Balance(ctx); err == nil && balance.Cmp(min) >= 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("balance of %s did not reach %s: %w", s.Address(), min, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// AddFunding records wei sent to the signer, for Spent
+func (s *Signer) AddFunding(amount *big.Int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.funded.Add(s.funded, amount)
+}
+
+// Funded returns the wei recorded as sent to the signer
+func (s *Signer) Funded() *big.Int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return new(big.Int).Set(s.funded)
+}
+
+// Spent returns how much of its funding the signer no longer holds
+func (s *Signer) Spent(ctx context.Context) (*big.Int, error) {
+	balance, err := s.Balance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Sub(s.Funded(), balance), nil
+}
+
+// nextNonce returns the next nonce, reading it from the node when unknown. s.mu must be held.
+func (s *Signer) nextNonce(ctx context.Context) (uint64, error) {
+	if !s.nonceKnown {
+		nonce, err := s.rpc.PendingNonce(ctx, s.Address())
+		if err != nil {
+			return 0, err
+		}
+		s.nonce, s.nonceKnown = nonce, true
+	}
+	return s.nonce, nil
+}
+
+// decodeHex decodes 0x-prefixed calldata
+func decodeHex(data string) ([]byte, error) {
+	if data == "" {
+		return nil, nil
+	}
+	b, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid data %q: %w", data, err)
+	}
+	return b, nil
+}
@@ -0,0 +1,84 @@
+package wallet
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+)
+
+// Tx is a legacy transaction, signed with EIP-155 replay protection
+type Tx struct {
+	Nonce    uint64
+	GasPrice *big.Int
+	Gas      uint64
+	// To is the recipient address; empty creates a contract
+	To    string
+	Value *big.Int
+	Data  []byte
+}
+
+// SignTx signs the transaction for the chain and returns the raw transaction
+// ready for eth_sendRawTransaction along with its hash
+func (k *Key) SignTx(tx Tx, chainID uint64) (rawTx, hash string, err error) {
+	to, err := parseAddress(tx.To)
+	if err != nil {
+		return "", "", err
+	}
+
+	signer := types.LatestSignerForChainID(new(big.Int).SetUint64(chainID))
+	signed, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    tx.Nonce,
+		GasPrice: tx.GasPrice,
+		Gas:      tx.Gas,
+		To:       to,
+		Value:    tx.Value,
+		Data:     tx.Data,
+	}), signer, k.key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	return encodeTx(signed)
+}
+
+// ContractAddress returns the address of the contract created by the
+// transaction with the given nonce from sender
+func ContractAddress(sender string, nonce uint64) string {
+	if !common.IsHexAddress(sender) {
+		return ""
+	}
+	return crypto.CreateAddress(common.HexToAddress(sender), nonce).Hex()
+}
+
+// parseAddress parses a hex address; an empty address parses to nil
+func parseAddress(address string) (*common.Address, error) {
+	if address == "" {
+		return nil, nil
+	}
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("invalid address %q", address)
+	}
+	parsed := common.HexToAddress(address)
+	return &parsed, nil
+}
+
+// toUint256 converts an amount already checked to fit; nil converts to zero
+func toUint256(n *big.Int) *uint256.Int {
+	if n == nil {
+		return new(uint256.Int)
+	}
+	return uint256.MustFromBig(n)
+}
+
+// encodeTx returns the raw encoding of a signed transaction and its hash
+func encodeTx(tx *types.Transaction) (rawTx, hash string, err error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode transaction: %w", err)
+	}
+	return hexutil.Encode(raw), tx.Hash().Hex(), nil
+}
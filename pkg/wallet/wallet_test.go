@@ -0,0 +1,114 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyAddress(t *testing.T) {
+	key, err := KeyFromHex("0x0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	assert.Equal(t, "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf", key.Address())
+
+	faucet, err := KeyFromHex(DefaultFaucetKey)
+	require.NoError(t, err)
+	assert.Equal(t, "0x8943545177806ED17B9F23F0a21ee5948eCaa776", faucet.Address())
+
+	generated, err := GenerateKey()
+	require.NoError(t, err)
+	parsed, err := KeyFromHex(generated.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, generated.Address(), parsed.Address())
+
+	_, err = KeyFromHex("0x00")
+	assert.Error(t, err)
+}
+
+func TestGenerateKeyFrom(t *testing.T) {
+	first, err := GenerateKeyFrom(rand.New(rand.NewSource(7)))
+	require.NoError(t, err)
+	second, err := GenerateKeyFrom(rand.New(rand.NewSource(7)))
+	require.NoError(t, err)
+	assert.Equal(t, first.Address(), second.Address())
+
+	other, err := GenerateKeyFrom(rand.New(rand.NewSource(8)))
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Address(), other.Address())
+}
+
+func TestSignTx(t *testing.T) {
+	// Example transaction from EIP-155
+	key, err := KeyFromHex("4646464646464646464646464646464646464646464646464646464646464646")
+	require.NoError(t, err)
+
+	value, _ := new(big.Int).SetString("1000000000000000000", 10)
+	raw, hash, err := key.SignTx(Tx{
+		Nonce:    9,
+		GasPrice: big.NewInt(20000000000),
+		Gas:      21000,
+		To:       "0x3535353535353535353535353535353535353535",
+		Value:    value,
+	}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83", raw)
+	assert.Len(t, hash, 66)
+
+	_, _, err = key.SignTx(Tx{To: "0x1234"}, 1)
+	assert.Error(t, err)
+}
+
+func TestContractAddress(t *testing.T) {
+	sender := "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0"
+	assert.Equal(t, "0xcd234a471b72ba2f1ccf0a70fcaba648a5eecd8d", strings.ToLower(ContractAddress(sender, 0)))
+	assert.Equal(t, "0x343c43a37d37dff08ae8c4a11544c718abb4fcf8", strings.ToLower(ContractAddress(sender, 1)))
+	assert.Empty(t, ContractAddress("0x1234", 0))
+}
+
+func TestSignBlobTx(t *testing.T) {
+	key, err := KeyFromHex("4646464646464646464646464646464646464646464646464646464646464646")
+	require.NoError(t, err)
+
+	blobs := make([]kzg4844.Blob, 2)
+	blobs[0][1] = 0x01
+	blobs[1][1] = 0x02
+	raw, hash, err := key.SignBlobTx(BlobTx{
+		Nonce:      3,
+		GasTipCap:  big.NewInt(1000000000),
+		GasFeeCap:  big.NewInt(2000000000),
+		Gas:        21000,
+		BlobFeeCap: big.NewInt(100),
+		To:         "0x3535353535353535353535353535353535353535",
+		Blobs:      blobs,
+	}, 3151908)
+	require.NoError(t, err)
+
+	// Decode with go-ethereum to check the encoding, hash and signature
+	encoded, err := hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+	require.NoError(t, err)
+	var tx types.Transaction
+	require.NoError(t, tx.UnmarshalBinary(encoded))
+	assert.Equal(t, uint8(types.BlobTxType), tx.Type())
+	assert.Equal(t, hash, tx.Hash().Hex())
+	assert.Equal(t, uint64(3), tx.Nonce())
+	assert.Equal(t, big.NewInt(100), tx.BlobGasFeeCap())
+	require.NotNil(t, tx.BlobTxSidecar())
+	assert.Len(t, tx.BlobTxSidecar().Blobs, 2)
+	assert.Equal(t, tx.BlobTxSidecar().BlobHashes(), tx.BlobHashes())
+
+	sender, err := types.Sender(types.NewCancunSigner(big.NewInt(3151908)), &tx)
+	require.NoError(t, err)
+	assert.Equal(t, key.Address(), sender.Hex())
+
+	_, _, err = key.SignBlobTx(BlobTx{Blobs: blobs}, 1)
+	assert.Error(t, err)
+	_, _, err = key.SignBlobTx(BlobTx{To: "0x3535353535353535353535353535353535353535"}, 1)
+	assert.Error(t, err)
+}
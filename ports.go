@@ -0,0 +1,143 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+)
+
+// ErrPortConflict is returned when public ports the port publisher needs are
+// already taken
+var ErrPortConflict = errors.New("port conflict")
+
+const (
+	// portDialTimeout bounds probing a port on another host
+	portDialTimeout = 500 * time.Millisecond
+	// portProbes caps how many ports are probed at once
+	portProbes = 64
+)
+
+// isPortInUse reports whether a TCP port is taken: on this machine when host is
+// empty, as it cannot be bound, and on another host when it accepts connections
+func isPortInUse(host string, port int) bool {
+	if host == "" {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err != nil {
+			return true
+		}
+		listener.Close()
+		return false
+	}
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(host, fmt.Sprint(port)), portDialTimeout)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// portHosts returns the hosts the public ports are published on: the Docker
+// host, "" for this machine, and the NAT exit IP when one is set
+func portHosts(cfg *RunConfig, ethConfig *config.EthereumPackageConfig) []string {
+	hosts := []string{hostname(remoteDockerHost(cfg))}
+	if publisher := ethConfig.PortPublisher; publisher != nil {
+		switch ip := publisher.NatExitIP; ip {
+		case "", "auto", "KURTOSIS_IP_ADDR_PLACEHOLDER", hosts[0]:
+		default:
+			hosts = append(hosts, ip)
+		}
+	}
+	return hosts
+}
+
+// hostname strips the scheme, user and port from a Docker host such as
+// tcp://10.0.0.5:2376 or ssh://user@docker.example.com
+func hostname(dockerHost string) string {
+	if strings.Contains(dockerHost, "://") {
+		if u, err := url.Parse(dockerHost); err == nil {
+			return u.Hostname()
+		}
+	}
+	if host, _, err := net.SplitHostPort(dockerHost); err == nil {
+		return host
+	}
+	return dockerHost
+}
+
+// findPortConflicts probes every port of the ranges on every host and returns
+// the taken ones per component, e.g. "el: 32001, 32004 in use"
+func findPortConflicts(ranges []config.PortRange, hosts []string) []string {
+	type probe struct {
+		rangeIdx int
+		host     string
+		port     int
+	}
+	var probes []probe
+	for i, r := range ranges {
+		for _, host := range hosts {
+			for port := r.Start; port < r.End; port++ {
+				probes = append(probes, probe{i, host, port})
+			}
+		}
+	}
+
+	busy := make([]bool, len(probes))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, portProbes)
+	for i, p := range probes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			busy[i] = portInUse(p.host, p.port)
+			<-sem
+		}()
+	}
+	wg.Wait()
+
+	var conflicts []string
+	for i, r := range ranges {
+		for _, host := range hosts {
+			var ports []string
+			for j, p := range probes {
+				if busy[j] && p.rangeIdx == i && p.host == host {
+					ports = append(ports, fmt.Sprint(p.port))
+				}
+			}
+			if len(ports) == 0 {
+				continue
+			}
+			conflict := fmt.Sprintf("%s: %s in use", r.Component, strings.Join(ports, ", "))
+			if host != "" {
+				conflict += " on " + host
+			}
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return conflicts
+}
+
+// checkPortConflicts fails with ErrPortConflict when ports the port publisher
+// would publish on are taken. An enclave that already has services holds its
+// own ports and is not checked.
+func checkPortConflicts(ctx context.Context, cfg *RunConfig, ethConfig *config.EthereumPackageConfig) error {
+	ranges := ethConfig.PublicPortRanges()
+	if len(ranges) == 0 {
+		return nil
+	}
+	if services, err := cfg.KurtosisClient.GetServices(ctx, cfg.EnclaveName); err == nil && len(services) > 0 {
+		return nil
+	}
+	if conflicts := findPortConflicts(ranges, portHosts(cfg, ethConfig)); len(conflicts) > 0 {
+		return fmt.Errorf("%w: %s; stop the processes or enclaves holding the ports (`kurtosis clean -a`) or move public_port_start",
+			ErrPortConflict, strings.Join(conflicts, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+)
+
+// ErrPreflight is returned by PreflightReport.Err when a check failed
+var ErrPreflight = errors.New("preflight failed")
+
+// memoryWarnRatio is the share of Docker's memory above which the estimate warns
+const memoryWarnRatio = 0.75
+
+// CheckStatus is the outcome of a preflight check
+type CheckStatus string
+
+const (
+	CheckPassed  CheckStatus = "pass" // the prerequisite is met
+	CheckWarning CheckStatus = "warn" // the deployment may still work
+	CheckFailed  CheckStatus = "fail" // the deployment is expected to fail
+	CheckSkipped CheckStatus = "skip" // the prerequisite could not be checked
+)
+
+// PreflightCheck is one item of the preflight checklist
+type PreflightCheck struct {
+	Name   string
+	Status CheckStatus
+	Detail string
+	// Hint tells how to fix a failure or warning
+	Hint string
+}
+
+// String describes the check, e.g. "[fail] kurtosis: kurtosis engine is not running"
+func (c PreflightCheck) String() string {
+	s := fmt.Sprintf("[%s] %s: %s", c.Status, c.Name, c.Detail)
+	if c.Hint != "" {
+		s += " (" + c.Hint + ")"
+	}
+	return s
+}
+
+// Requirements estimates what a config needs from the Docker host
+type Requirements struct {
+	config.ResourceEstimate
+	Ports []config.PortRange
+}
+
+// PreflightReport is the checklist Preflight returns
+type PreflightReport struct {
+	Requirements Requirements
+	Checks       []PreflightCheck
+}
+
+// Failed returns the failed checks
+func (r *PreflightReport) Failed() []PreflightCheck {
+	return r.withStatus(CheckFailed)
+}
+
+// Warnings returns the checks that warned
+func (r *PreflightReport) Warnings() []PreflightCheck {
+	return r.withStatus(CheckWarning)
+}
+
+// Err returns an error wrapping ErrPreflight that lists the failed checks, or
+// nil when none failed
+func (r *PreflightReport) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	descriptions := make([]string, len(failed))
+	for i, check := range failed {
+		descriptions[i] = check.String()
+	}
+	return fmt.Errorf("%w: %s", ErrPreflight, strings.Join(descriptions, "; "))
+}
+
+// String lists the checks, one per line
+func (r *PreflightReport) String() string {
+	lines := make([]string, len(r.Checks))
+	for i, check := range r.Checks {
+		lines[i] = check.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *PreflightReport) withStatus(status CheckStatus) []PreflightCheck {
+	var checks []PreflightCheck
+	for _, check := range r.Checks {
+		if check.Status == status {
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+func (r *PreflightReport) add(name string, status CheckStatus, detail, hint string) {
+	r.Checks = append(r.Checks, PreflightCheck{Name: name, Status: status, Detail: detail, Hint: hint})
+}
+
+// dockerHost is what Preflight reads from docker info
+type dockerHost struct {
+	ServerVersion string `json:"ServerVersion"`
+	NCPU          int    `json:"NCPU"`
+	MemTotal      int64  `json:"MemTotal"`
+	DockerRootDir string `json:"DockerRootDir"`
+}
+
+// Hooks replaced in tests
+var (
+	dockerInfo = queryDockerInfo
+	diskFree   = freeDiskSpace
+	portInUse  = isPortInUse
+)
+
+// queryDockerInfo asks the Docker daemon for its version and resources
+func queryDockerInfo(ctx context.Context) (*dockerHost, error) {
+	output, err := exec.CommandContext(ctx, "docker", "info", "--format", "{{json .}}").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("docker info failed: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("docker info failed: %w", err)
+	}
+	var host dockerHost
+	if err := json.Unmarshal(output, &host); err != nil {
+		return nil, fmt.Errorf("failed to parse docker info output: %w", err)
+	}
+	return &host, nil
+}
+
+// Preflight checks the local prerequisites of a deployment with the same
+// options as Run, without deploying: that Docker and a compatible Kurtosis
+// engine are running, that Docker has the CPUs, memory and disk space
+// config.EstimateResources estimates for the config, and that the public ports
+// of an enabled port publisher are free. It fails only on an invalid config;
+// failed checks are in the report, and report.Err() turns them into an error.
+func Preflight(ctx context.Context, opts ...RunOption) (*PreflightReport, error) {
+	cfg := defaultRunConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if err := validateRunConfig(cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	ethConfig, err := buildEthereumConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build configuration: %w", err)
+	}
+
+	report := &PreflightReport{Requirements: estimateRequirements(ethConfig)}
+	host := checkDocker(ctx, report)
+	checkKurtosis(ctx, cfg, report)
+
+	report.add(cpuStatus(host, report.Requirements))
+	report.add(memoryStatus(host, report.Requirements))
+
+	// Disk space can only be measured when Docker runs on this machine
+	if remote := remoteDockerHost(cfg); remote != "" {
+		report.add("disk", CheckSkipped, "Docker runs on "+remote, "")
+	} else {
+		report.add(diskStatus(host, report.Requirements))
+	}
+	report.add(portsStatus(report.Requirements, portHosts(cfg, ethConfig)))
+	return report, nil
+}
+
+// estimateRequirements estimates the resources of the config's containers and
+// the public ports they publish
+func estimateRequirements(ethConfig *config.EthereumPackageConfig) Requirements {
+	return Requirements{
+		ResourceEstimate: config.EstimateResources(ethConfig),
+		Ports:            ethConfig.PublicPortRanges(),
+	}
+}
+
+// checkDocker adds whether the Docker daemon answers and returns what it reported
+func checkDocker(ctx context.Context, report *PreflightReport) *dockerHost {
+	host, err := dockerInfo(ctx)
+	if err != nil {
+		report.add("docker", CheckFailed, err.Error(), "install Docker and start the daemon, or point DOCKER_HOST at it")
+		return nil
+	}
+	report.add("docker", CheckPassed, "Docker "+host.ServerVersion, "")
+	return host
+}
+
+// checkKurtosis adds whether a Kurtosis engine the SDK can talk to is running
+func checkKurtosis(ctx context.Context, cfg *RunConfig, report *PreflightReport) {
+	if cfg.KurtosisClient == nil {
+		client, err := newKurtosisClient(ctx, cfg)
+		if err != nil {
+			hint := ""
+			if errors.Is(err, kurtosis.ErrKurtosisNotRunning) {
+				hint = "start it with 'kurtosis engine start'"
+			}
+			report.add("kurtosis", CheckFailed, err.Error(), hint)
+			return
+		}
+		cfg.KurtosisClient = client
+	}
+
+	versioned, ok := cfg.KurtosisClient.(interface{ EngineVersion() string })
+	if !ok || versioned.EngineVersion() == "" {
+		report.add("kurtosis", CheckPassed, "engine connected, version unknown", "")
+		return
+	}
+	version := versioned.EngineVersion()
+	compatibility, err := kurtosis.CheckEngineVersion(version)
+	switch {
+	case err != nil:
+		report.add("kurtosis", CheckFailed, err.Error(), "")
+	case compatibility == kurtosis.EngineUnknown:
+		report.add("kurtosis", CheckWarning, fmt.Sprintf("engine %s is not a release (SDK %s)", version, kurtosis.SDKVersion), "")
+	default:
+		report.add("kurtosis", CheckPassed, fmt.Sprintf("engine %s (SDK %s)", version, kurtosis.SDKVersion), "")
+	}
+}
+
+// remoteDockerHost returns the host Docker runs on when it is not this machine
+func remoteDockerHost(cfg *RunConfig) string {
+	switch {
+	case cfg.DockerHostOverride != "":
+		return cfg.DockerHostOverride
+	case cfg.KurtosisEngineHost != "":
+		return cfg.KurtosisEngineHost
+	}
+	dockerHost := os.Getenv("DOCKER_HOST")
+	if strings.HasPrefix(dockerHost, "tcp://") || strings.HasPrefix(dockerHost, "ssh://") {
+		return dockerHost
+	}
+	return ""
+}
+
+// cpuStatus compares the estimated CPU with the CPUs Docker has. Clients share
+// CPUs, so too few only slow the network down and the check warns.
+func cpuStatus(host *dockerHost, req Requirements) (string, CheckStatus, string, string) {
+	if host == nil || host.NCPU <= 0 {
+		return "cpu", CheckSkipped, "Docker did not report its CPUs", ""
+	}
+	detail := fmt.Sprintf("%.1f CPUs estimated, Docker has %d", float64(req.CPUMillicores)/1000, host.NCPU)
+	if req.CPUMillicores > host.NCPU*1000 {
+		return "cpu", CheckWarning, detail, "slots may be missed; give Docker more CPUs or run fewer participants"
+	}
+	return "cpu", CheckPassed, detail, ""
+}
+
+// memoryStatus compares the estimated memory with what Docker can use
+func memoryStatus(host *dockerHost, req Requirements) (string, CheckStatus, string, string) {
+	if host == nil || host.MemTotal <= 0 {
+		return "memory", CheckSkipped, "Docker did not report its memory", ""
+	}
+	available := int(host.MemTotal >> 20)
+	detail := fmt.Sprintf("%d MB estimated for %d containers, Docker has %d MB", req.MemoryMB, req.Containers, available)
+	hint := "give Docker more memory, run fewer participants or cap clients with el_max_mem and cl_max_mem"
+	switch {
+	case req.MemoryMB > available:
+		return "memory", CheckFailed, detail, hint
+	case float64(req.MemoryMB) > memoryWarnRatio*float64(available):
+		return "memory", CheckWarning, detail, hint
+	default:
+		return "memory", CheckPassed, detail, ""
+	}
+}
+
+// diskStatus compares the estimated disk space with what is free where Docker
+// keeps images and volumes
+func diskStatus(host *dockerHost, req Requirements) (string, CheckStatus, string, string) {
+	if host == nil || host.DockerRootDir == "" {
+		return "disk", CheckSkipped, "Docker did not report its root directory", ""
+	}
+	free, err := diskFree(host.DockerRootDir)
+	if err != nil {
+		// e.g. Docker Desktop keeps its root directory inside a VM
+		return "disk", CheckSkipped, fmt.Sprintf("cannot measure %s: %v", host.DockerRootDir, err), ""
+	}
+	freeMB := int(free >> 20)
+	detail := fmt.Sprintf("%d MB estimated, %d MB free in %s", req.DiskMB, freeMB, host.DockerRootDir)
+	if req.DiskMB > freeMB {
+		return "disk", CheckFailed, detail, "free disk space with `docker system prune` and `kurtosis clean -a`"
+	}
+	return "disk", CheckPassed, detail, ""
+}
+
+// portsStatus checks that the public port ranges are free on the Docker host
+// and NAT exit IP
+func portsStatus(req Requirements, hosts []string) (string, CheckStatus, string, string) {
+	if len(req.Ports) == 0 {
+		return "ports", CheckPassed, "no public ports requested", ""
+	}
+	if conflicts := findPortConflicts(req.Ports, hosts); len(conflicts) > 0 {
+		return "ports", CheckFailed, strings.Join(conflicts, "; "),
+			"stop the processes or enclaves holding the ports (`kurtosis clean -a`) or move public_port_start"
+	}
+	var ranges []string
+	for _, r := range req.Ports {
+		ranges = append(ranges, r.String())
+	}
+	return "ports", CheckPassed, strings.Join(ranges, ", ") + " free", ""
+}
@@ -0,0 +1,10 @@
+//go:build !linux && !darwin
+
+package ethereum
+
+import "errors"
+
+// freeDiskSpace is not implemented on this platform, so the disk check is skipped
+func freeDiskSpace(path string) (uint64, error) {
+	return 0, errors.New("not supported on this platform")
+}
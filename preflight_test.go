@@ -0,0 +1,130 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+	"github.com/ethpandaops/ethereum-package-go/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPreflight replaces the Docker, disk and port probes for the test
+func stubPreflight(t *testing.T, host *dockerHost, hostErr error, free uint64, busy ...int) {
+	t.Helper()
+	t.Setenv("DOCKER_HOST", "")
+	origInfo, origDisk, origPort := dockerInfo, diskFree, portInUse
+	t.Cleanup(func() { dockerInfo, diskFree, portInUse = origInfo, origDisk, origPort })
+
+	dockerInfo = func(ctx context.Context) (*dockerHost, error) { return host, hostErr }
+	diskFree = func(path string) (uint64, error) { return free, nil }
+	portInUse = func(host string, port int) bool {
+		for _, b := range busy {
+			if port == b {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// versionedClient is a Kurtosis client reporting its engine version
+type versionedClient struct {
+	*mocks.MockKurtosisClient
+	version string
+}
+
+func (c versionedClient) EngineVersion() string { return c.version }
+
+func checkNamed(t *testing.T, report *PreflightReport, name string) PreflightCheck {
+	t.Helper()
+	for _, check := range report.Checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	require.Failf(t, "missing check", "no %s check in %s", name, report)
+	return PreflightCheck{}
+}
+
+func TestPreflight(t *testing.T) {
+	stubPreflight(t, &dockerHost{ServerVersion: "27.3.1", NCPU: 16, MemTotal: 64 << 30, DockerRootDir: "/var/lib/docker"}, nil, 500<<30)
+
+	report, err := Preflight(context.Background(), Minimal(), WithKurtosisClient(versionedClient{mocks.NewMockKurtosisClient(), "1.10.2"}))
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+	assert.Empty(t, report.Warnings())
+	assert.Greater(t, report.Requirements.Containers, 0)
+	assert.Greater(t, report.Requirements.MemoryMB, 0)
+	assert.Empty(t, report.Requirements.Ports)
+
+	assert.Equal(t, "[pass] docker: Docker 27.3.1", checkNamed(t, report, "docker").String())
+	assert.Contains(t, checkNamed(t, report, "kurtosis").Detail, "engine 1.10.2")
+	assert.Equal(t, CheckPassed, checkNamed(t, report, "cpu").Status)
+	assert.Equal(t, CheckPassed, checkNamed(t, report, "memory").Status)
+	assert.Equal(t, CheckPassed, checkNamed(t, report, "disk").Status)
+	assert.Equal(t, "no public ports requested", checkNamed(t, report, "ports").Detail)
+}
+
+func TestPreflightFailures(t *testing.T) {
+	stubPreflight(t, &dockerHost{ServerVersion: "27.3.1", NCPU: 1, MemTotal: 1 << 30, DockerRootDir: "/var/lib/docker"}, nil, 1<<20, 32001)
+
+	report, err := Preflight(context.Background(), Minimal(),
+		WithKurtosisClient(versionedClient{mocks.NewMockKurtosisClient(), "1.9.0"}),
+		WithPortPublisher(&config.PortPublisherConfig{EL: &config.PortPublisherComponent{Enabled: true, PublicPortStart: 32000}}))
+	require.NoError(t, err)
+
+	require.Len(t, report.Requirements.Ports, 1)
+	assert.Equal(t, 32000, report.Requirements.Ports[0].Start)
+
+	kurtosisCheck := checkNamed(t, report, "kurtosis")
+	assert.Equal(t, CheckFailed, kurtosisCheck.Status)
+	assert.Contains(t, kurtosisCheck.Detail, kurtosis.ErrEngineVersion.Error())
+	assert.Equal(t, CheckWarning, checkNamed(t, report, "cpu").Status)
+	assert.Equal(t, CheckFailed, checkNamed(t, report, "memory").Status)
+	assert.Equal(t, CheckFailed, checkNamed(t, report, "disk").Status)
+	ports := checkNamed(t, report, "ports")
+	assert.Equal(t, CheckFailed, ports.Status)
+	assert.Equal(t, "el: 32001 in use", ports.Detail)
+	assert.Contains(t, ports.Hint, "public_port_start")
+
+	err = report.Err()
+	assert.ErrorIs(t, err, ErrPreflight)
+	assert.Len(t, report.Failed(), 4)
+}
+
+func TestPreflightDockerDownRemote(t *testing.T) {
+	stubPreflight(t, nil, errors.New("docker info failed: Cannot connect to the Docker daemon"), 0)
+
+	report, err := Preflight(context.Background(), Minimal(), WithKurtosisClient(mocks.NewMockKurtosisClient()),
+		WithDockerHostOverride("docker.example.com"))
+	require.NoError(t, err)
+
+	docker := checkNamed(t, report, "docker")
+	assert.Equal(t, CheckFailed, docker.Status)
+	assert.Contains(t, docker.Hint, "DOCKER_HOST")
+	assert.Equal(t, "engine connected, version unknown", checkNamed(t, report, "kurtosis").Detail)
+	assert.Equal(t, CheckSkipped, checkNamed(t, report, "cpu").Status)
+	assert.Equal(t, CheckSkipped, checkNamed(t, report, "memory").Status)
+	assert.Equal(t, "Docker runs on docker.example.com", checkNamed(t, report, "disk").Detail)
+	assert.Equal(t, CheckPassed, checkNamed(t, report, "ports").Status)
+	assert.Len(t, report.Failed(), 1)
+}
+
+func TestPreflightInvalidConfig(t *testing.T) {
+	_, err := Preflight(context.Background(), WithEnclaveName(""))
+	assert.Error(t, err)
+}
+
+func TestPortHosts(t *testing.T) {
+	t.Setenv("DOCKER_HOST", "tcp://10.0.0.5:2376")
+	ethConfig := &config.EthereumPackageConfig{PortPublisher: &config.PortPublisherConfig{NatExitIP: "203.0.113.7"}}
+	assert.Equal(t, []string{"10.0.0.5", "203.0.113.7"}, portHosts(defaultRunConfig(), ethConfig))
+
+	t.Setenv("DOCKER_HOST", "")
+	ethConfig.PortPublisher.NatExitIP = "KURTOSIS_IP_ADDR_PLACEHOLDER"
+	assert.Equal(t, []string{""}, portHosts(defaultRunConfig(), ethConfig))
+}
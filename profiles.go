@@ -0,0 +1,277 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+// ProjectConfigFile is the name of the project-level file defining named profiles
+const ProjectConfigFile = ".ethereum-package-go.yaml"
+
+var (
+	// ErrProjectConfigNotFound is returned when no project config file exists in the
+	// working directory or any of its parents
+	ErrProjectConfigNotFound = errors.New("project config file not found")
+	// ErrProfileNotFound is returned when a project config has no profile with the requested name
+	ErrProfileNotFound = errors.New("profile not found")
+)
+
+// ProjectConfig holds the named profiles of a project config file
+type ProjectConfig struct {
+	Profiles map[string]*Profile `yaml:"profiles"`
+}
+
+// Profile is a named, shareable run definition. At most one of Preset, ConfigFile
+// and Config selects the participants; the remaining fields map onto RunOptions.
+type Profile struct {
+	Preset     config.Preset                 `yaml:"preset,omitempty"`
+	ConfigFile string                        `yaml:"config_file,omitempty"` // relative to the project config file
+	Config     *config.EthereumPackageConfig `yaml:"config,omitempty"`
+
+	NetworkParams      *config.NetworkParams `yaml:"network_params,omitempty"`
+	AdditionalServices []string              `yaml:"additional_services,omitempty"`
+	GlobalLogLevel     string                `yaml:"global_log_level,omitempty"`
+
+	EnclaveName    string   `yaml:"enclave_name,omitempty"`
+	PackageID      string   `yaml:"package_id,omitempty"`
+	PackageVersion string   `yaml:"package_version,omitempty"`
+	Parallelism    int      `yaml:"parallelism,omitempty"`
+	FanoutLimit    int      `yaml:"fanout_limit,omitempty"`
+	RandomSeed     int64    `yaml:"random_seed,omitempty"`
+	DockerHost     string   `yaml:"docker_host_override,omitempty"`
+	KurtosisHost   string   `yaml:"kurtosis_engine_host,omitempty"`
+	KurtosisPort   uint16   `yaml:"kurtosis_engine_port,omitempty"`
+	TLSCABundle    string   `yaml:"tls_ca_bundle,omitempty"`
+	TLSInsecure    bool     `yaml:"tls_insecure_skip_verify,omitempty"`
+	Timeouts       Timeouts `yaml:"timeouts,omitempty"`
+	WaitForGenesis bool     `yaml:"wait_for_genesis,omitempty"`
+	VerifyMonitor  bool     `yaml:"verify_monitoring,omitempty"`
+	ArtifactsDir   string   `yaml:"artifacts_dir,omitempty"`
+	OrphanOnExit   bool     `yaml:"orphan_on_exit,omitempty"`
+	Reuse          bool     `yaml:"reuse,omitempty"` // reuse the enclave named EnclaveName
+
+	dir string
+}
+
+// FindProjectConfig returns the path of the project config file in dir or the
+// closest parent directory that has one
+func FindProjectConfig(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve directory: %w", err)
+	}
+
+	for {
+		path := filepath.Join(dir, ProjectConfigFile)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ErrProjectConfigNotFound
+		}
+		dir = parent
+	}
+}
+
+// LoadProjectConfig reads and validates a project config file. Unknown keys are
+// rejected so typos don't silently change a profile.
+func LoadProjectConfig(path string) (*ProjectConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read project config: %w", err)
+	}
+
+	var project ProjectConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&project); err != nil {
+		return nil, fmt.Errorf("failed to parse project config %s: %w", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	for name, profile := range project.Profiles {
+		if profile == nil {
+			return nil, fmt.Errorf("profile %q is empty", name)
+		}
+		profile.dir = dir
+		if err := profile.validate(); err != nil {
+			return nil, fmt.Errorf("profile %q: %w", name, err)
+		}
+	}
+
+	return &project, nil
+}
+
+// Names returns the profile names in sorted order
+func (p *ProjectConfig) Names() []string {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Profile returns the named profile
+func (p *ProjectConfig) Profile(name string) (*Profile, error) {
+	profile, ok := p.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (available: %v)", ErrProfileNotFound, name, p.Names())
+	}
+	return profile, nil
+}
+
+func (p *Profile) validate() error {
+	sources := 0
+	for _, set := range []bool{p.Preset != "", p.ConfigFile != "", p.Config != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return fmt.Errorf("only one of preset, config_file and config may be set")
+	}
+	if p.Preset != "" {
+		if _, err := config.GetPresetConfig(p.Preset); err != nil {
+			return fmt.Errorf("%w: %s", err, p.Preset)
+		}
+	}
+	if p.Reuse && p.EnclaveName == "" {
+		return fmt.Errorf("reuse requires enclave_name")
+	}
+	return nil
+}
+
+// Options converts the profile into RunOptions
+func (p *Profile) Options() ([]RunOption, error) {
+	var opts []RunOption
+
+	switch {
+	case p.Preset != "":
+		opts = append(opts, WithPreset(p.Preset))
+	case p.ConfigFile != "":
+		path := p.ConfigFile
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(p.dir, path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+		cfg, err := config.FromYAML(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+		}
+		opts = append(opts, WithConfig(cfg))
+	case p.Config != nil:
+		opts = append(opts, WithConfig(p.Config))
+	}
+
+	if p.NetworkParams != nil {
+		opts = append(opts, WithNetworkParams(p.NetworkParams))
+	}
+	if len(p.AdditionalServices) > 0 {
+		opts = append(opts, WithAdditionalServices(p.AdditionalServices...))
+	}
+	if p.GlobalLogLevel != "" {
+		opts = append(opts, WithGlobalLogLevel(p.GlobalLogLevel))
+	}
+	if p.EnclaveName != "" {
+		opts = append(opts, WithEnclaveName(p.EnclaveName))
+	}
+	if p.PackageID != "" {
+		opts = append(opts, WithPackageID(p.PackageID))
+	}
+	if p.PackageVersion != "" {
+		opts = append(opts, WithPackageVersion(p.PackageVersion))
+	}
+	if p.Parallelism > 0 {
+		opts = append(opts, WithParallelism(p.Parallelism))
+	}
+	if p.FanoutLimit > 0 {
+		opts = append(opts, WithFanoutLimit(p.FanoutLimit))
+	}
+	if p.DockerHost != "" {
+		opts = append(opts, WithDockerHostOverride(p.DockerHost))
+	}
+	if p.KurtosisHost != "" {
+		opts = append(opts, WithKurtosisEngine(p.KurtosisHost, p.KurtosisPort))
+	}
+	if p.TLSCABundle != "" {
+		path := p.TLSCABundle
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(p.dir, path)
+		}
+		opts = append(opts, WithTLSCABundle(path))
+	}
+	if p.TLSInsecure {
+		opts = append(opts, WithTLSInsecureSkipVerify())
+	}
+	if p.RandomSeed != 0 {
+		opts = append(opts, WithRandomSeed(p.RandomSeed))
+	}
+	if p.Timeouts != (Timeouts{}) {
+		opts = append(opts, WithTimeouts(p.Timeouts))
+	}
+	if p.WaitForGenesis {
+		opts = append(opts, WithWaitForGenesis())
+	}
+	if p.VerifyMonitor {
+		opts = append(opts, WithMonitoringCheck())
+	}
+	if p.ArtifactsDir != "" {
+		path := p.ArtifactsDir
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(p.dir, path)
+		}
+		opts = append(opts, WithArtifactsDir(path))
+	}
+	if p.OrphanOnExit {
+		opts = append(opts, WithOrphanOnExit())
+	}
+	if p.Reuse {
+		opts = append(opts, WithReuse(p.EnclaveName))
+	}
+
+	return opts, nil
+}
+
+// RunProfile starts the network defined by the named profile of the project config
+// file found in the working directory or its parents. Additional options are
+// applied after the profile's and override it.
+func RunProfile(ctx context.Context, name string, opts ...RunOption) (network.Network, error) {
+	path, err := FindProjectConfig(".")
+	if err != nil {
+		return nil, err
+	}
+	return RunProfileFromFile(ctx, path, name, opts...)
+}
+
+// RunProfileFromFile is RunProfile with an explicit project config file
+func RunProfileFromFile(ctx context.Context, path, name string, opts ...RunOption) (network.Network, error) {
+	project, err := LoadProjectConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := project.Profile(name)
+	if err != nil {
+		return nil, err
+	}
+	profileOpts, err := profile.Options()
+	if err != nil {
+		return nil, fmt.Errorf("profile %q: %w", name, err)
+	}
+
+	return Run(ctx, append(profileOpts, opts...)...)
+}
@@ -0,0 +1,180 @@
+package ethereum
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
+	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+	"github.com/ethpandaops/ethereum-package-go/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProjectConfig = `profiles:
+  interop-matrix:
+    preset: all-clients-matrix
+    additional_services: [dora]
+    enclave_name: interop
+    reuse: true
+    timeouts:
+      readiness: 15m
+      rpc: 10s
+  custom:
+    config_file: configs/custom.yaml
+    wait_for_genesis: true
+  inline:
+    config:
+      participants:
+        - el_type: besu
+          cl_type: teku
+          count: 2
+`
+
+const testCustomConfig = `participants:
+  - el_type: nethermind
+    cl_type: lodestar
+`
+
+func writeProject(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "configs"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ProjectConfigFile), []byte(testProjectConfig), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "configs", "custom.yaml"), []byte(testCustomConfig), 0o600))
+	return dir
+}
+
+func applyProfile(t *testing.T, profile *Profile) *RunConfig {
+	t.Helper()
+
+	opts, err := profile.Options()
+	require.NoError(t, err)
+	cfg := defaultRunConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+func TestFindProjectConfig(t *testing.T) {
+	dir := writeProject(t)
+	nested := filepath.Join(dir, "a", "b")
+	require.NoError(t, os.MkdirAll(nested, 0o755))
+
+	path, err := FindProjectConfig(nested)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, ProjectConfigFile), path)
+
+	_, err = FindProjectConfig(t.TempDir())
+	assert.ErrorIs(t, err, ErrProjectConfigNotFound)
+}
+
+func TestLoadProjectConfig(t *testing.T) {
+	dir := writeProject(t)
+	project, err := LoadProjectConfig(filepath.Join(dir, ProjectConfigFile))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"custom", "inline", "interop-matrix"}, project.Names())
+
+	profile, err := project.Profile("interop-matrix")
+	require.NoError(t, err)
+	cfg := applyProfile(t, profile)
+	assert.Equal(t, config.NewPresetConfigSource(config.PresetAllClientsMatrix), cfg.ConfigSource)
+	assert.Equal(t, "interop", cfg.EnclaveName)
+	assert.True(t, cfg.ReuseExisting)
+	assert.Equal(t, 15*time.Minute, cfg.Timeouts.Readiness)
+	assert.Equal(t, 10*time.Second, cfg.Timeouts.RPC)
+	assert.Equal(t, DefaultTimeouts().Deploy, cfg.Timeouts.Deploy)
+	require.Len(t, cfg.AdditionalServices, 1)
+	assert.Equal(t, "dora", cfg.AdditionalServices[0].Name)
+
+	// Config files resolve relative to the project config
+	profile, err = project.Profile("custom")
+	require.NoError(t, err)
+	cfg = applyProfile(t, profile)
+	assert.True(t, cfg.WaitForGenesis)
+	inline, ok := cfg.ConfigSource.(*config.InlineConfigSource)
+	require.True(t, ok)
+	assert.Equal(t, client.Nethermind, inline.GetConfig().Participants[0].ELType)
+
+	profile, err = project.Profile("inline")
+	require.NoError(t, err)
+	cfg = applyProfile(t, profile)
+	inline, ok = cfg.ConfigSource.(*config.InlineConfigSource)
+	require.True(t, ok)
+	assert.Equal(t, 2, inline.GetConfig().Participants[0].Count)
+
+	_, err = project.Profile("missing")
+	assert.ErrorIs(t, err, ErrProfileNotFound)
+}
+
+func TestLoadProjectConfigInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "unknown key",
+			content: "profiles:\n  ci:\n    presett: minimal\n",
+			wantErr: "field presett not found",
+		},
+		{
+			name:    "multiple sources",
+			content: "profiles:\n  ci:\n    preset: minimal\n    config_file: x.yaml\n",
+			wantErr: `profile "ci": only one of preset, config_file and config may be set`,
+		},
+		{
+			name:    "unknown preset",
+			content: "profiles:\n  ci:\n    preset: everything\n",
+			wantErr: "invalid preset: everything",
+		},
+		{
+			name:    "reuse without enclave",
+			content: "profiles:\n  ci:\n    reuse: true\n",
+			wantErr: "reuse requires enclave_name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ProjectConfigFile)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			_, err := LoadProjectConfig(path)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRunProfileFromFile(t *testing.T) {
+	dir := writeProject(t)
+	mockClient := mocks.NewMockKurtosisClient()
+
+	var enclaveName string
+	mockClient.RunPackageFunc = func(ctx context.Context, config kurtosis.RunPackageConfig) (*kurtosis.RunPackageResult, error) {
+		enclaveName = config.EnclaveName
+		return &kurtosis.RunPackageResult{EnclaveName: config.EnclaveName}, nil
+	}
+	mockClient.WaitForServicesFunc = func(ctx context.Context, enclaveName string, serviceNames []string, timeout time.Duration) error {
+		return nil
+	}
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{}, nil
+	}
+
+	// Options passed alongside the profile override it
+	net, err := RunProfileFromFile(context.Background(), filepath.Join(dir, ProjectConfigFile), "inline",
+		WithKurtosisClient(mockClient),
+		WithEnclaveName("override"),
+		WithOrphanOnExit(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "override", enclaveName)
+	assert.Equal(t, "override", net.EnclaveName())
+}
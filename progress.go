@@ -0,0 +1,55 @@
+package ethereum
+
+import (
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+)
+
+// ProgressPhase is a stage of Run
+type ProgressPhase string
+
+const (
+	PhaseConfigure ProgressPhase = "configure" // building the ethereum-package configuration
+	PhaseDeploy    ProgressPhase = "deploy"    // running ethereum-package
+	PhaseReadiness ProgressPhase = "readiness" // waiting for services to accept connections
+	PhaseDiscovery ProgressPhase = "discovery" // mapping services to clients
+	PhaseGenesis   ProgressPhase = "genesis"   // waiting for every client to produce blocks
+	PhaseComplete  ProgressPhase = "complete"  // the network is ready
+)
+
+// ProgressEvent reports deployment progress to the handler set with WithProgressHandler
+type ProgressEvent struct {
+	Phase ProgressPhase
+	// Step and TotalSteps count ethereum-package's instructions during
+	// PhaseDeploy. They are zero for other events.
+	Step       int
+	TotalSteps int
+	Message    string
+	Timestamp  time.Time
+}
+
+// reportProgress sends an event to the progress handler, if one is set
+func reportProgress(cfg *RunConfig, phase ProgressPhase, message string) {
+	if cfg.ProgressHandler == nil {
+		return
+	}
+	cfg.ProgressHandler(ProgressEvent{Phase: phase, Message: message, Timestamp: time.Now()})
+}
+
+// packageProgressHandler forwards ethereum-package's progress as PhaseDeploy
+// events; nil without a progress handler so the package runs as before
+func packageProgressHandler(cfg *RunConfig) func(kurtosis.PackageProgress) {
+	if cfg.ProgressHandler == nil {
+		return nil
+	}
+	return func(progress kurtosis.PackageProgress) {
+		cfg.ProgressHandler(ProgressEvent{
+			Phase:      PhaseDeploy,
+			Step:       progress.Step,
+			TotalSteps: progress.TotalSteps,
+			Message:    progress.Message,
+			Timestamp:  time.Now(),
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+)
+
+// ErrSnapshotNotFound is returned when a snapshot or the enclave it was taken
+// in no longer exists
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// SnapshotDir is where Snapshot records which enclave holds each snapshot
+var SnapshotDir = defaultSnapshotDir()
+
+// snapshotNamePattern keeps snapshot names usable in file and artifact names
+var snapshotNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+func defaultSnapshotDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "ethereum-package-go", "snapshots")
+}
+
+// Snapshot saves the data directories of the network's execution and
+// consensus clients as files artifacts in its enclave, so a long setup, such
+// as reaching a fork, can be restored in seconds with RestoreSnapshot. The
+// clients are stopped while their data is copied. The snapshot lives as long
+// as the enclave, so networks to restore later should run WithOrphanOnExit.
+// Names are lowercase letters, digits and dashes, and unique per enclave.
+func Snapshot(ctx context.Context, net network.Network, name string) (*network.EnclaveSnapshot, error) {
+	if !snapshotNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid snapshot name %q: use lowercase letters, digits and dashes", name)
+	}
+
+	snapshot, err := net.SnapshotEnclave(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := os.MkdirAll(SnapshotDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	if err := os.WriteFile(snapshotPath(name), data, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// LoadSnapshot reads the record of a snapshot taken with Snapshot
+func LoadSnapshot(name string) (*network.EnclaveSnapshot, error) {
+	data, err := os.ReadFile(snapshotPath(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrSnapshotNotFound, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	var snapshot network.EnclaveSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", name, err)
+	}
+	return &snapshot, nil
+}
+
+// RestoreSnapshot maps the enclave the snapshot was taken in to a network and
+// rolls its clients back to the snapshot. The options must describe the same
+// configuration the network was started with.
+func RestoreSnapshot(ctx context.Context, name string, opts ...RunOption) (network.Network, error) {
+	snapshot, err := LoadSnapshot(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := defaultRunConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.KurtosisClient == nil {
+		client, err := newKurtosisClient(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		cfg.KurtosisClient = client
+	}
+
+	// A new enclave would have a new genesis the snapshot cannot apply to
+	services, err := cfg.KurtosisClient.GetServices(ctx, snapshot.EnclaveName)
+	if err != nil || len(services) == 0 {
+		return nil, fmt.Errorf("%w: enclave %s of snapshot %s no longer exists", ErrSnapshotNotFound, snapshot.EnclaveName, name)
+	}
+
+	net, err := FindOrCreateNetwork(ctx, snapshot.EnclaveName, append(opts, WithKurtosisClient(cfg.KurtosisClient))...)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("[ethereum-package-go] Restoring snapshot %s (finalized epoch %d)...\n", name, snapshot.Finalized.Epoch)
+	if err := net.RestoreEnclave(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	return net, nil
+}
+
+// snapshotPath returns the file recording the named snapshot
+func snapshotPath(name string) string {
+	return filepath.Join(SnapshotDir, name+".json")
+}
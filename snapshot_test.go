@@ -0,0 +1,44 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/ethpandaops/ethereum-package-go/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot_InvalidName(t *testing.T) {
+	for _, name := range []string{"", "Electra", "../electra", "electra fork"} {
+		_, err := Snapshot(context.Background(), nil, name)
+		assert.ErrorContains(t, err, "invalid snapshot name")
+	}
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	SnapshotDir = t.TempDir()
+	t.Cleanup(func() { SnapshotDir = defaultSnapshotDir() })
+	mockClient := mocks.NewMockKurtosisClient()
+
+	_, err := RestoreSnapshot(context.Background(), "electra", WithKurtosisClient(mockClient))
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+
+	// The enclave holding the snapshot is gone
+	data, err := json.Marshal(network.EnclaveSnapshot{Name: "electra", EnclaveName: "removed-enclave"})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(SnapshotDir, "electra.json"), data, 0o644))
+
+	snapshot, err := LoadSnapshot("electra")
+	require.NoError(t, err)
+	assert.Equal(t, "removed-enclave", snapshot.EnclaveName)
+
+	_, err = RestoreSnapshot(context.Background(), "electra", WithKurtosisClient(mockClient))
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+	assert.ErrorContains(t, err, "enclave removed-enclave of snapshot electra no longer exists")
+	assert.Equal(t, 0, mockClient.CallCount["RunPackage"], "a new enclave would have another genesis")
+}
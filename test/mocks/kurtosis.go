@@ -3,22 +3,39 @@ package mocks
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
+	"github.com/ethpandaops/ethereum-package-go/pkg/config"
 	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
 )
 
 // MockKurtosisClient is a mock implementation of the Kurtosis client for testing
 type MockKurtosisClient struct {
 	// Control behavior
-	RunPackageFunc      func(ctx context.Context, config kurtosis.RunPackageConfig) (*kurtosis.RunPackageResult, error)
-	GetServicesFunc     func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error)
-	StopEnclaveFunc     func(ctx context.Context, enclaveName string) error
-	DestroyEnclaveFunc  func(ctx context.Context, enclaveName string) error
-	WaitForServicesFunc func(ctx context.Context, enclaveName string, serviceNames []string, timeout time.Duration) error
+	RunPackageFunc       func(ctx context.Context, config kurtosis.RunPackageConfig) (*kurtosis.RunPackageResult, error)
+	GetServicesFunc      func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error)
+	StopEnclaveFunc      func(ctx context.Context, enclaveName string) error
+	DestroyEnclaveFunc   func(ctx context.Context, enclaveName string) error
+	WaitForServicesFunc  func(ctx context.Context, enclaveName string, serviceNames []string, timeout time.Duration) error
+	StopServiceFunc      func(ctx context.Context, enclaveName, serviceName string) error
+	StartServiceFunc     func(ctx context.Context, enclaveName, serviceName string) error
+	EnclaveLabelsFunc    func(ctx context.Context, enclaveName string) (map[string]string, error)
+	SetEnclaveLabelFunc  func(ctx context.Context, enclaveName, key, value string) error
+	ContainerStatesFunc  func(ctx context.Context, enclaveName string) (map[string]network.ContainerState, error)
+	AddServiceFunc       func(ctx context.Context, enclaveName string, spec network.ServiceSpec) (*kurtosis.ServiceInfo, error)
+	CapturePacketsFunc   func(ctx context.Context, enclaveName, serviceName string, duration time.Duration, w io.Writer) error
+	ReadFileFunc         func(ctx context.Context, enclaveName, serviceName, path string) ([]byte, error)
+	ServiceLogsFunc      func(ctx context.Context, enclaveName, serviceName string, lines int) ([]string, error)
+	ExecCommandFunc      func(ctx context.Context, enclaveName, serviceName string, cmd []string) (int, string, string, error)
+	ReadArtifactFileFunc func(ctx context.Context, enclaveName, artifactName, filePath string) ([]byte, error)
+	SnapshotServicesFunc func(ctx context.Context, enclaveName string, dirs []network.ServiceDir) error
+	RestoreServicesFunc  func(ctx context.Context, enclaveName string, dirs []network.ServiceDir) error
 
 	// State tracking
 	Enclaves      map[string]*EnclaveState
+	Labels        map[string]map[string]string
 	CallCount     map[string]int
 	LastRunConfig *kurtosis.RunPackageConfig
 }
@@ -34,6 +51,7 @@ type EnclaveState struct {
 func NewMockKurtosisClient() *MockKurtosisClient {
 	return &MockKurtosisClient{
 		Enclaves:  make(map[string]*EnclaveState),
+		Labels:    make(map[string]map[string]string),
 		CallCount: make(map[string]int),
 	}
 }
@@ -55,15 +73,22 @@ func (m *MockKurtosisClient) RunPackage(ctx context.Context, config kurtosis.Run
 	}
 	m.Enclaves[config.EnclaveName] = enclave
 
+	lines := []string{
+		"Starting ethereum-package",
+		"Creating execution clients",
+		"Creating consensus clients",
+		"Starting validators",
+		"Network ready",
+	}
+	if config.OnProgress != nil {
+		for i, line := range lines {
+			config.OnProgress(kurtosis.PackageProgress{Step: i + 1, TotalSteps: len(lines), Message: line})
+		}
+	}
+
 	return &kurtosis.RunPackageResult{
-		EnclaveName: config.EnclaveName,
-		ResponseLines: []string{
-			"Starting ethereum-package",
-			"Creating execution clients",
-			"Creating consensus clients",
-			"Starting validators",
-			"Network ready",
-		},
+		EnclaveName:   config.EnclaveName,
+		ResponseLines: lines,
 	}, nil
 }
 
@@ -77,7 +102,7 @@ func (m *MockKurtosisClient) GetServices(ctx context.Context, enclaveName string
 
 	enclave, exists := m.Enclaves[enclaveName]
 	if !exists {
-		return nil, fmt.Errorf("enclave not found: %s", enclaveName)
+		return nil, fmt.Errorf("%w: %s", kurtosis.ErrEnclaveNotFound, enclaveName)
 	}
 
 	if !enclave.Running {
@@ -132,6 +157,202 @@ func (m *MockKurtosisClient) WaitForServices(ctx context.Context, enclaveName st
 	return nil
 }
 
+// StopService mocks the StopService method
+func (m *MockKurtosisClient) StopService(ctx context.Context, enclaveName, serviceName string) error {
+	m.CallCount["StopService"]++
+
+	if m.StopServiceFunc != nil {
+		return m.StopServiceFunc(ctx, enclaveName, serviceName)
+	}
+
+	return m.SetServiceStatus(enclaveName, serviceName, "STOPPED")
+}
+
+// StartService mocks the StartService method
+func (m *MockKurtosisClient) StartService(ctx context.Context, enclaveName, serviceName string) error {
+	m.CallCount["StartService"]++
+
+	if m.StartServiceFunc != nil {
+		return m.StartServiceFunc(ctx, enclaveName, serviceName)
+	}
+
+	return m.SetServiceStatus(enclaveName, serviceName, "RUNNING")
+}
+
+// CapturePackets mocks the CapturePackets method. By default it captures nothing.
+func (m *MockKurtosisClient) CapturePackets(ctx context.Context, enclaveName, serviceName string, duration time.Duration, w io.Writer) error {
+	m.CallCount["CapturePackets"]++
+
+	if m.CapturePacketsFunc != nil {
+		return m.CapturePacketsFunc(ctx, enclaveName, serviceName, duration, w)
+	}
+
+	services, err := m.GetServices(ctx, enclaveName)
+	if err != nil {
+		return err
+	}
+	if _, exists := services[serviceName]; !exists {
+		return fmt.Errorf("%w: %s", kurtosis.ErrServiceNotFound, serviceName)
+	}
+	return nil
+}
+
+// ReadFile mocks the ReadFile method. By default every file is missing.
+func (m *MockKurtosisClient) ReadFile(ctx context.Context, enclaveName, serviceName, path string) ([]byte, error) {
+	m.CallCount["ReadFile"]++
+
+	if m.ReadFileFunc != nil {
+		return m.ReadFileFunc(ctx, enclaveName, serviceName, path)
+	}
+
+	services, err := m.GetServices(ctx, enclaveName)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := services[serviceName]; !exists {
+		return nil, fmt.Errorf("%w: %s", kurtosis.ErrServiceNotFound, serviceName)
+	}
+	return nil, fmt.Errorf("failed to read %s from %s: no such file", path, serviceName)
+}
+
+// ExecCommand mocks the ExecCommand method. By default every command succeeds
+// without output.
+func (m *MockKurtosisClient) ExecCommand(ctx context.Context, enclaveName, serviceName string, cmd []string) (int, string, string, error) {
+	m.CallCount["ExecCommand"]++
+
+	if m.ExecCommandFunc != nil {
+		return m.ExecCommandFunc(ctx, enclaveName, serviceName, cmd)
+	}
+
+	services, err := m.GetServices(ctx, enclaveName)
+	if err != nil {
+		return 0, "", "", err
+	}
+	if _, exists := services[serviceName]; !exists {
+		return 0, "", "", fmt.Errorf("%w: %s", kurtosis.ErrServiceNotFound, serviceName)
+	}
+	return 0, "", "", nil
+}
+
+// MockJWTSecret is the JWT secret the mock's jwt_file artifact holds
+const MockJWTSecret = "0xdc49981516e8e72b401a63e6405495a32dafc3939b5d6d83cc319ac0388bca1b"
+
+// ReadArtifactFile mocks the ReadArtifactFile method. By default only
+// ethereum-package's JWT secret exists, holding MockJWTSecret.
+func (m *MockKurtosisClient) ReadArtifactFile(ctx context.Context, enclaveName, artifactName, filePath string) ([]byte, error) {
+	m.CallCount["ReadArtifactFile"]++
+
+	if m.ReadArtifactFileFunc != nil {
+		return m.ReadArtifactFileFunc(ctx, enclaveName, artifactName, filePath)
+	}
+
+	if _, err := m.GetServices(ctx, enclaveName); err != nil {
+		return nil, err
+	}
+	if artifactName == config.JWTSecretArtifact && filePath == config.JWTSecretFile {
+		return []byte(MockJWTSecret + "\n"), nil
+	}
+	return nil, fmt.Errorf("%w: %s", kurtosis.ErrArtifactFileNotFound, filePath)
+}
+
+// ServiceLogs mocks the ServiceLogs method. By default every service has no logs.
+func (m *MockKurtosisClient) ServiceLogs(ctx context.Context, enclaveName, serviceName string, lines int) ([]string, error) {
+	m.CallCount["ServiceLogs"]++
+
+	if m.ServiceLogsFunc != nil {
+		return m.ServiceLogsFunc(ctx, enclaveName, serviceName, lines)
+	}
+
+	services, err := m.GetServices(ctx, enclaveName)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := services[serviceName]; !exists {
+		return nil, fmt.Errorf("%w: %s", kurtosis.ErrServiceNotFound, serviceName)
+	}
+	return nil, nil
+}
+
+// AddService mocks the AddService method
+func (m *MockKurtosisClient) AddService(ctx context.Context, enclaveName string, spec network.ServiceSpec) (*kurtosis.ServiceInfo, error) {
+	m.CallCount["AddService"]++
+
+	if m.AddServiceFunc != nil {
+		return m.AddServiceFunc(ctx, enclaveName, spec)
+	}
+
+	ports := make(map[string]kurtosis.PortInfo, len(spec.Ports))
+	for _, port := range spec.Ports {
+		ports[port.Name] = kurtosis.PortInfo{Number: uint16(port.InternalPort), PrivateNumber: uint16(port.InternalPort), Protocol: port.Protocol}
+	}
+	service := &kurtosis.ServiceInfo{
+		Name:     spec.Name,
+		UUID:     "uuid-" + spec.Name,
+		Status:   "RUNNING",
+		Hostname: spec.Name,
+		Ports:    ports,
+	}
+	if err := m.PutService(enclaveName, service); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// EnclaveLabels mocks the EnclaveLabels method
+func (m *MockKurtosisClient) EnclaveLabels(ctx context.Context, enclaveName string) (map[string]string, error) {
+	m.CallCount["EnclaveLabels"]++
+
+	if m.EnclaveLabelsFunc != nil {
+		return m.EnclaveLabelsFunc(ctx, enclaveName)
+	}
+
+	labels, labeled := m.Labels[enclaveName]
+	if _, exists := m.Enclaves[enclaveName]; !exists && !labeled {
+		return nil, fmt.Errorf("%w: %s", kurtosis.ErrEnclaveNotFound, enclaveName)
+	}
+
+	result := make(map[string]string, len(labels))
+	for key, value := range labels {
+		result[key] = value
+	}
+	return result, nil
+}
+
+// SetEnclaveLabel mocks the SetEnclaveLabel method
+func (m *MockKurtosisClient) SetEnclaveLabel(ctx context.Context, enclaveName, key, value string) error {
+	m.CallCount["SetEnclaveLabel"]++
+
+	if m.SetEnclaveLabelFunc != nil {
+		return m.SetEnclaveLabelFunc(ctx, enclaveName, key, value)
+	}
+
+	if m.Labels[enclaveName] == nil {
+		m.Labels[enclaveName] = make(map[string]string)
+	}
+	m.Labels[enclaveName][key] = value
+	return nil
+}
+
+// ContainerStates mocks the ContainerStates method. By default every service is
+// running without restarts.
+func (m *MockKurtosisClient) ContainerStates(ctx context.Context, enclaveName string) (map[string]network.ContainerState, error) {
+	m.CallCount["ContainerStates"]++
+
+	if m.ContainerStatesFunc != nil {
+		return m.ContainerStatesFunc(ctx, enclaveName)
+	}
+
+	services, err := m.GetServices(ctx, enclaveName)
+	if err != nil {
+		return nil, err
+	}
+	states := make(map[string]network.ContainerState, len(services))
+	for name := range services {
+		states[name] = network.ContainerState{Status: "running"}
+	}
+	return states, nil
+}
+
 // createDefaultServices creates a default set of services for testing
 func (m *MockKurtosisClient) createDefaultServices() map[string]*kurtosis.ServiceInfo {
 	return map[string]*kurtosis.ServiceInfo{
@@ -194,8 +415,8 @@ func (m *MockKurtosisClient) createDefaultServices() map[string]*kurtosis.Servic
 	}
 }
 
-// AddService adds a service to an enclave
-func (m *MockKurtosisClient) AddService(enclaveName string, service *kurtosis.ServiceInfo) error {
+// PutService adds a service to an enclave
+func (m *MockKurtosisClient) PutService(enclaveName string, service *kurtosis.ServiceInfo) error {
 	enclave, exists := m.Enclaves[enclaveName]
 	if !exists {
 		return fmt.Errorf("enclave not found: %s", enclaveName)
@@ -225,9 +446,44 @@ func (m *MockKurtosisClient) SetServiceStatus(enclaveName, serviceName, status s
 	return nil
 }
 
+// SnapshotServices mocks the SnapshotServices method
+func (m *MockKurtosisClient) SnapshotServices(ctx context.Context, enclaveName string, dirs []network.ServiceDir) error {
+	m.CallCount["SnapshotServices"]++
+
+	if m.SnapshotServicesFunc != nil {
+		return m.SnapshotServicesFunc(ctx, enclaveName, dirs)
+	}
+	return m.checkServiceDirs(ctx, enclaveName, dirs)
+}
+
+// RestoreServices mocks the RestoreServices method
+func (m *MockKurtosisClient) RestoreServices(ctx context.Context, enclaveName string, dirs []network.ServiceDir) error {
+	m.CallCount["RestoreServices"]++
+
+	if m.RestoreServicesFunc != nil {
+		return m.RestoreServicesFunc(ctx, enclaveName, dirs)
+	}
+	return m.checkServiceDirs(ctx, enclaveName, dirs)
+}
+
+// checkServiceDirs fails unless every directory belongs to a service of the enclave
+func (m *MockKurtosisClient) checkServiceDirs(ctx context.Context, enclaveName string, dirs []network.ServiceDir) error {
+	services, err := m.GetServices(ctx, enclaveName)
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if _, exists := services[dir.Service]; !exists {
+			return fmt.Errorf("%w: %s", kurtosis.ErrServiceNotFound, dir.Service)
+		}
+	}
+	return nil
+}
+
 // Reset resets the mock state
 func (m *MockKurtosisClient) Reset() {
 	m.Enclaves = make(map[string]*EnclaveState)
+	m.Labels = make(map[string]map[string]string)
 	m.CallCount = make(map[string]int)
 	m.LastRunConfig = nil
 	m.RunPackageFunc = nil
@@ -235,6 +491,15 @@ func (m *MockKurtosisClient) Reset() {
 	m.StopEnclaveFunc = nil
 	m.DestroyEnclaveFunc = nil
 	m.WaitForServicesFunc = nil
+	m.StopServiceFunc = nil
+	m.StartServiceFunc = nil
+	m.EnclaveLabelsFunc = nil
+	m.SetEnclaveLabelFunc = nil
+	m.ContainerStatesFunc = nil
+	m.AddServiceFunc = nil
+	m.CapturePacketsFunc = nil
+	m.SnapshotServicesFunc = nil
+	m.RestoreServicesFunc = nil
 }
 
 // Verify interface compliance
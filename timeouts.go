@@ -0,0 +1,74 @@
+package ethereum
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/client"
+)
+
+// Timeouts bounds each phase of a network's lifecycle
+type Timeouts struct {
+	// Deploy bounds running ethereum-package in the enclave
+	Deploy time.Duration `yaml:"deploy,omitempty"`
+	// Readiness bounds waiting for services to pass their readiness probes
+	Readiness time.Duration `yaml:"readiness,omitempty"`
+	// Genesis is how long after genesis every client has to start producing
+	Genesis time.Duration `yaml:"genesis,omitempty"`
+	// RPC is the per-request timeout of client JSON-RPC, beacon API and readiness probe calls
+	RPC time.Duration `yaml:"rpc,omitempty"`
+	// Cleanup bounds destroying the enclave
+	Cleanup time.Duration `yaml:"cleanup,omitempty"`
+}
+
+// DefaultTimeouts returns the timeouts used when none are configured
+func DefaultTimeouts() Timeouts {
+	return Timeouts{
+		Deploy:    30 * time.Minute,
+		Readiness: 10 * time.Minute,
+		Genesis:   DefaultGenesisProgressTimeout,
+		RPC:       client.DefaultRPCTimeout,
+		Cleanup:   2 * time.Minute,
+	}
+}
+
+// Validate checks that every timeout is positive
+func (t Timeouts) Validate() error {
+	var errs []error
+	for _, field := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"deploy", t.Deploy},
+		{"readiness", t.Readiness},
+		{"genesis", t.Genesis},
+		{"RPC", t.RPC},
+		{"cleanup", t.Cleanup},
+	} {
+		if field.value <= 0 {
+			errs = append(errs, fmt.Errorf("%s timeout must be positive, got %s", field.name, field.value))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// merge returns t with every non-zero field of overrides applied
+func (t Timeouts) merge(overrides Timeouts) Timeouts {
+	if overrides.Deploy != 0 {
+		t.Deploy = overrides.Deploy
+	}
+	if overrides.Readiness != 0 {
+		t.Readiness = overrides.Readiness
+	}
+	if overrides.Genesis != 0 {
+		t.Genesis = overrides.Genesis
+	}
+	if overrides.RPC != 0 {
+		t.RPC = overrides.RPC
+	}
+	if overrides.Cleanup != 0 {
+		t.Cleanup = overrides.Cleanup
+	}
+	return t
+}
@@ -0,0 +1,112 @@
+package ethereum
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/ethereum-package-go/pkg/kurtosis"
+	"github.com/ethpandaops/ethereum-package-go/pkg/network"
+	"github.com/ethpandaops/ethereum-package-go/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutsValidate(t *testing.T) {
+	assert.NoError(t, DefaultTimeouts().Validate())
+
+	timeouts := DefaultTimeouts()
+	timeouts.RPC = 0
+	timeouts.Cleanup = -time.Second
+	err := timeouts.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "RPC timeout must be positive")
+	assert.Contains(t, err.Error(), "cleanup timeout must be positive")
+}
+
+func TestWithTimeouts(t *testing.T) {
+	cfg := defaultRunConfig()
+	WithTimeouts(Timeouts{Deploy: time.Hour, RPC: 5 * time.Second})(cfg)
+
+	expected := DefaultTimeouts()
+	expected.Deploy = time.Hour
+	expected.RPC = 5 * time.Second
+	assert.Equal(t, expected, cfg.Timeouts)
+
+	// The deprecated option only touches the readiness timeout
+	WithTimeout(time.Minute)(cfg)
+	assert.Equal(t, time.Minute, cfg.Timeouts.Readiness)
+	assert.Equal(t, time.Hour, cfg.Timeouts.Deploy)
+}
+
+func TestRun_AppliesTimeouts(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+
+	var deployDeadline time.Time
+	var readiness time.Duration
+	mockClient.RunPackageFunc = func(ctx context.Context, config kurtosis.RunPackageConfig) (*kurtosis.RunPackageResult, error) {
+		deployDeadline, _ = ctx.Deadline()
+		return &kurtosis.RunPackageResult{EnclaveName: config.EnclaveName}, nil
+	}
+	mockClient.WaitForServicesFunc = func(ctx context.Context, enclaveName string, serviceNames []string, timeout time.Duration) error {
+		readiness = timeout
+		return nil
+	}
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{
+			"el-1-geth-lighthouse": {
+				Name:      "el-1-geth-lighthouse",
+				IPAddress: "10.0.0.1",
+				Ports:     map[string]kurtosis.PortInfo{"rpc": {Number: 8545}},
+			},
+		}, nil
+	}
+
+	start := time.Now()
+	net, err := Run(context.Background(),
+		Minimal(),
+		WithKurtosisClient(mockClient),
+		WithOrphanOnExit(),
+		WithTimeouts(Timeouts{Deploy: 3 * time.Minute, Readiness: 2 * time.Minute, RPC: 7 * time.Second}),
+	)
+	require.NoError(t, err)
+
+	assert.WithinDuration(t, start.Add(3*time.Minute), deployDeadline, 10*time.Second)
+	assert.Equal(t, 2*time.Minute, readiness)
+	assert.Equal(t, 7*time.Second, net.ExecutionClients().All()[0].RPCTimeout())
+
+	// Deploy phases are recorded as startup milestones
+	ready, ok := net.Milestones().Between(network.MilestoneRunStarted, network.MilestoneServicesReady)
+	require.True(t, ok)
+	assert.GreaterOrEqual(t, ready, time.Duration(0))
+	_, ok = net.Milestones().Time(network.MilestoneEnclaveCreated)
+	assert.True(t, ok)
+}
+
+func TestRun_DeprecatedTimeoutField(t *testing.T) {
+	mockClient := mocks.NewMockKurtosisClient()
+
+	var readiness time.Duration
+	mockClient.WaitForServicesFunc = func(ctx context.Context, enclaveName string, serviceNames []string, timeout time.Duration) error {
+		readiness = timeout
+		return nil
+	}
+	mockClient.GetServicesFunc = func(ctx context.Context, enclaveName string) (map[string]*kurtosis.ServiceInfo, error) {
+		return map[string]*kurtosis.ServiceInfo{
+			"el-1-geth-lighthouse": {
+				Name:      "el-1-geth-lighthouse",
+				IPAddress: "10.0.0.1",
+				Ports:     map[string]kurtosis.PortInfo{"rpc": {Number: 8545}},
+			},
+		}, nil
+	}
+
+	_, err := Run(context.Background(),
+		Minimal(),
+		WithKurtosisClient(mockClient),
+		WithOrphanOnExit(),
+		func(cfg *RunConfig) { cfg.Timeout = 90 * time.Second },
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, readiness)
+}